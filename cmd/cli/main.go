@@ -2,9 +2,16 @@ package main
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -12,9 +19,14 @@ import (
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/iho/goledger/internal/adapter/http/dto"
 	"github.com/iho/goledger/internal/adapter/repository/postgres"
 	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/backup"
+	"github.com/iho/goledger/internal/infrastructure/outboxretention"
 	infraPostgres "github.com/iho/goledger/internal/infrastructure/postgres"
+	"github.com/iho/goledger/internal/infrastructure/statement"
+	"github.com/iho/goledger/internal/infrastructure/transferimport"
 	"github.com/iho/goledger/internal/usecase"
 )
 
@@ -43,8 +55,14 @@ func main() {
 	rootCmd.AddCommand(transferCmd())
 	rootCmd.AddCommand(holdCmd())
 	rootCmd.AddCommand(ledgerCmd())
+	rootCmd.AddCommand(backupCmd())
 	rootCmd.AddCommand(auditCmd())
 	rootCmd.AddCommand(outboxCmd())
+	rootCmd.AddCommand(projectorCmd())
+	rootCmd.AddCommand(accrualCmd())
+	rootCmd.AddCommand(feePolicyCmd())
+	rootCmd.AddCommand(webhookCmd())
+	rootCmd.AddCommand(statementCmd())
 	rootCmd.AddCommand(hashPasswordCmd())
 
 	if err := rootCmd.Execute(); err != nil {
@@ -278,9 +296,10 @@ func accountCmd() *cobra.Command {
 
 	// List accounts
 	var limit, offset int
+	var cursor string
 	listCmd := &cobra.Command{
 		Use:   "list",
-		Short: "List all accounts",
+		Short: "List all accounts, newest first",
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := context.Background()
 			pool := mustConnectDB(ctx)
@@ -294,28 +313,43 @@ func accountCmd() *cobra.Command {
 				nil,
 			)
 
-			accounts, err := accountUC.ListAccounts(ctx, usecase.ListAccountsInput{
-				Limit:  limit,
-				Offset: offset,
-			})
+			var result *usecase.ListAccountsCursorResult
+			var err error
+			if cmd.Flags().Changed("offset") {
+				result = &usecase.ListAccountsCursorResult{}
+				result.Accounts, err = accountUC.ListAccounts(ctx, usecase.ListAccountsInput{
+					Limit:  limit,
+					Offset: offset,
+				})
+			} else {
+				result, err = accountUC.ListAccountsCursor(ctx, usecase.ListAccountsCursorInput{
+					Cursor: cursor,
+					Limit:  limit,
+				})
+			}
 			if err != nil {
 				fmt.Printf("❌ Failed to list accounts: %v\n", err)
 				os.Exit(1)
 			}
 
 			if jsonOutput {
-				printJSON(accounts)
-			} else {
-				fmt.Printf("%-28s %-20s %-8s %-15s\n", "ID", "NAME", "CURRENCY", "BALANCE")
-				fmt.Println("-----------------------------------------------------------------------")
-				for _, a := range accounts {
-					fmt.Printf("%-28s %-20s %-8s %-15s\n", a.ID, truncate(a.Name, 20), a.Currency, a.Balance.String())
-				}
+				printJSON(result)
+				return
+			}
+
+			fmt.Printf("%-28s %-20s %-8s %-15s\n", "ID", "NAME", "CURRENCY", "BALANCE")
+			fmt.Println("-----------------------------------------------------------------------")
+			for _, a := range result.Accounts {
+				fmt.Printf("%-28s %-20s %-8s %-15s\n", a.ID, truncate(a.Name, 20), a.Currency, a.Balance.String())
+			}
+			if result.NextCursor != "" {
+				fmt.Printf("\nNext page: --cursor %s\n", result.NextCursor)
 			}
 		},
 	}
-	listCmd.Flags().IntVar(&limit, "limit", 100, "Limit results")
-	listCmd.Flags().IntVar(&offset, "offset", 0, "Offset results")
+	listCmd.Flags().IntVar(&limit, "limit", 100, "Limit results (max 100)")
+	listCmd.Flags().StringVar(&cursor, "cursor", "", "Continue after this account ID (from the previous page)")
+	listCmd.Flags().IntVar(&offset, "offset", 0, "Offset results (deprecated, use --cursor)")
 
 	// Get account
 	getCmd := &cobra.Command{
@@ -353,7 +387,49 @@ func accountCmd() *cobra.Command {
 		},
 	}
 
-	cmd.AddCommand(createCmd, listCmd, getCmd)
+	// List an account's entries
+	var entriesCursor string
+	var entriesLimit int
+	entriesCmd := &cobra.Command{
+		Use:   "entries [id]",
+		Short: "List an account's entries, newest first",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			entryUC := usecase.NewEntryUseCase(postgres.NewEntryRepository(pool))
+
+			result, err := entryUC.GetEntriesByAccountCursor(ctx, usecase.GetEntriesByAccountCursorInput{
+				AccountID: args[0],
+				Cursor:    entriesCursor,
+				Limit:     entriesLimit,
+			})
+			if err != nil {
+				fmt.Printf("❌ Failed to list entries: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(result)
+				return
+			}
+
+			fmt.Printf("%-28s %-28s %-15s %-15s %-8s\n", "ID", "TRANSFER", "AMOUNT", "BALANCE", "VERSION")
+			fmt.Println("-----------------------------------------------------------------------------------------------------")
+			for _, e := range result.Entries {
+				fmt.Printf("%-28s %-28s %-15s %-15s %-8d\n", e.ID, e.TransferID, e.Amount.String(), e.AccountCurrentBalance.String(), e.AccountVersion)
+			}
+			if result.NextCursor != "" {
+				fmt.Printf("\nNext page: --cursor %s\n", result.NextCursor)
+			}
+		},
+	}
+	entriesCmd.Flags().StringVar(&entriesCursor, "cursor", "", "Continue after this entry ID (from the previous page)")
+	entriesCmd.Flags().IntVar(&entriesLimit, "limit", 20, "Limit results (max 100)")
+
+	cmd.AddCommand(createCmd, listCmd, getCmd, entriesCmd)
 	return cmd
 }
 
@@ -385,7 +461,7 @@ func transferCmd() *cobra.Command {
 				postgres.NewAuditRepository(pool),
 				postgres.NewULIDGenerator(),
 				nil,
-			)
+			).WithFeePolicies(postgres.NewFeePolicyRepository(pool))
 
 			amt, err := decimal.NewFromString(amount)
 			if err != nil {
@@ -410,6 +486,9 @@ func transferCmd() *cobra.Command {
 				fmt.Printf("   From: %s\n", transfer.FromAccountID)
 				fmt.Printf("   To:   %s\n", transfer.ToAccountID)
 				fmt.Printf("   Amount: %s\n", transfer.Amount.String())
+				for _, f := range transfer.Fees {
+					fmt.Printf("   Fee:    %s (%s -> %s, policy %s)\n", f.Amount.String(), f.FromAccountID, f.ToAccountID, f.PolicyID)
+				}
 			}
 		},
 	}
@@ -460,10 +539,367 @@ func transferCmd() *cobra.Command {
 		},
 	}
 
-	cmd.AddCommand(createCmd, getCmd)
+	cmd.AddCommand(createCmd, getCmd, transferSearchCmd(), transferImportCmd())
+	return cmd
+}
+
+func transferSearchCmd() *cobra.Command {
+	var (
+		accountID, direction, currency, reversalStatus string
+		minAmount, maxAmount                           string
+		eventFrom, eventTo, createdFrom, createdTo     string
+		sortField, cursor                              string
+		metadata                                       []string
+		ascending                                      bool
+		limit                                          int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search",
+		Short: "Search transfers across accounts",
+		Long: `Search transfers by account, direction, amount, time, currency, reversal
+status and metadata, e.g. all EUR transfers over 10000 yesterday for an order:
+
+  goledger transfer search --currency EUR --min-amount 10000 \
+    --created-from 2026-10-17 --created-to 2026-10-18 --metadata order_id=X`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			filter := domain.TransferSearchFilter{
+				AccountID:      accountID,
+				Direction:      domain.TransferDirection(direction),
+				MinAmount:      mustParseOptionalDecimal(minAmount),
+				MaxAmount:      mustParseOptionalDecimal(maxAmount),
+				EventAtFrom:    mustParseOptionalTime(eventFrom),
+				EventAtTo:      mustParseOptionalTime(eventTo),
+				CreatedAtFrom:  mustParseOptionalTime(createdFrom),
+				CreatedAtTo:    mustParseOptionalTime(createdTo),
+				Currency:       currency,
+				ReversalStatus: domain.TransferReversalStatus(reversalStatus),
+			}
+			if len(metadata) > 0 {
+				filter.Metadata = make(map[string]any, len(metadata))
+				for _, kv := range metadata {
+					k, v, ok := strings.Cut(kv, "=")
+					if !ok || k == "" {
+						fmt.Printf("❌ Invalid metadata match %q (expected key=value)\n", kv)
+						os.Exit(1)
+					}
+					filter.Metadata[k] = v
+				}
+			}
+
+			transferUC := usecase.NewTransferUseCase(
+				postgres.NewTxManager(pool),
+				postgres.NewAccountRepository(pool),
+				postgres.NewTransferRepository(pool),
+				postgres.NewEntryRepository(pool),
+				postgres.NewOutboxRepository(pool),
+				postgres.NewAuditRepository(pool),
+				postgres.NewULIDGenerator(),
+				nil,
+			)
+
+			result, err := transferUC.SearchTransfers(ctx, usecase.SearchTransfersInput{
+				Filter: filter,
+				Sort:   domain.TransferSort{Field: domain.TransferSortField(sortField), Ascending: ascending},
+				Cursor: cursor,
+				Limit:  limit,
+			})
+			if err != nil {
+				fmt.Printf("❌ Failed to search transfers: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(result)
+				return
+			}
+
+			fmt.Printf("%-28s %-28s %-28s %-15s %-20s\n", "ID", "FROM", "TO", "AMOUNT", "CREATED")
+			fmt.Println("------------------------------------------------------------------------------------------------------------------------")
+			for _, t := range result.Transfers {
+				fmt.Printf("%-28s %-28s %-28s %-15s %-20s\n", t.ID, t.FromAccountID, t.ToAccountID, t.Amount.String(), t.CreatedAt.Format(time.RFC3339))
+			}
+			if result.NextCursor != "" {
+				fmt.Printf("\nNext page: --cursor %s\n", result.NextCursor)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&accountID, "account", "", "Only transfers from or to this account")
+	cmd.Flags().StringVar(&direction, "direction", "", "With --account, only incoming or outgoing transfers")
+	cmd.Flags().StringVar(&minAmount, "min-amount", "", "Only transfers of at least this amount")
+	cmd.Flags().StringVar(&maxAmount, "max-amount", "", "Only transfers of at most this amount")
+	cmd.Flags().StringVar(&eventFrom, "event-from", "", "Only transfers with event time at or after this (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&eventTo, "event-to", "", "Only transfers with event time before this (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&createdFrom, "created-from", "", "Only transfers created at or after this (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&createdTo, "created-to", "", "Only transfers created before this (YYYY-MM-DD or RFC 3339)")
+	cmd.Flags().StringVar(&currency, "currency", "", "Only transfers in this currency")
+	cmd.Flags().StringVar(&reversalStatus, "reversal-status", "", "Only reversed, not_reversed or reversal transfers")
+	cmd.Flags().StringArrayVar(&metadata, "metadata", nil, "Only transfers with this metadata, key=value (repeatable)")
+	cmd.Flags().StringVar(&sortField, "sort", "created_at", "Sort by created_at, event_at or amount")
+	cmd.Flags().BoolVar(&ascending, "asc", false, "Sort ascending instead of newest/largest first")
+	cmd.Flags().StringVar(&cursor, "cursor", "", "Continue from the previous page's cursor")
+	cmd.Flags().IntVar(&limit, "limit", 20, "Limit results (max 100)")
+
+	return cmd
+}
+
+func transferImportCmd() *cobra.Command {
+	var format, keyPrefix, rejectsPath, checkpointPath string
+	var chunkSize int
+	var validateOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "import [file]",
+		Short: "Bulk import transfers from a CSV or NDJSON file",
+		Long: `Import transfers from a CSV (with a header row) or NDJSON file.
+
+Columns/fields: from_account_id, to_account_id, amount (required) and
+event_at (RFC3339), idempotency_key, metadata (JSON object) (optional).
+
+The whole file is validated before anything is posted: if any row is
+invalid, the rejects are written to the rejects file with the reason and
+nothing is imported. Valid files are then applied in batches, in file order,
+without fees; rows the ledger refuses (e.g. an overdraft) are also written
+to the rejects file. Progress is saved to the checkpoint file after every
+batch, and re-running the same command resumes from it. Rows without an
+idempotency_key get "<key-prefix>:<line>", so a batch is never applied
+twice. The default prefix is the SHA-256 of the file's contents, so
+different files never share keys; pass --key-prefix to keep keys stable if
+the file is edited after a partial import.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			path := args[0]
+
+			f, err := transferimport.ParseFormat(format, path)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+
+			if rejectsPath == "" {
+				rejectsPath = path + ".rejects.csv"
+			}
+			if checkpointPath == "" {
+				checkpointPath = path + ".checkpoint"
+			}
+
+			resumeAfter := 0
+			if !validateOnly {
+				resumeAfter, err = readImportCheckpoint(checkpointPath)
+				if err != nil {
+					fmt.Printf("❌ Failed to read checkpoint: %v\n", err)
+					os.Exit(1)
+				}
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				fmt.Printf("❌ Failed to open file: %v\n", err)
+				os.Exit(1)
+			}
+			defer file.Close()
+
+			if keyPrefix == "" {
+				keyPrefix, err = fileSHA256(file)
+				if err != nil {
+					fmt.Printf("❌ Failed to hash file: %v\n", err)
+					os.Exit(1)
+				}
+			}
+
+			// Import reads the file twice: to validate, then to apply.
+			open := func() (usecase.ImportSource, error) {
+				if _, err := file.Seek(0, io.SeekStart); err != nil {
+					return nil, err
+				}
+				return transferimport.NewReader(file, f)
+			}
+
+			if _, err := open(); err != nil {
+				fmt.Printf("❌ Invalid import file: %v\n", err)
+				os.Exit(1)
+			}
+
+			rejects, err := newRejectsWriter(rejectsPath)
+			if err != nil {
+				fmt.Printf("❌ Failed to open rejects file: %v\n", err)
+				os.Exit(1)
+			}
+			defer rejects.Close()
+
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			accountRepo := postgres.NewAccountRepository(pool)
+			transferRepo := postgres.NewTransferRepository(pool)
+			transferUC := usecase.NewTransferUseCase(
+				postgres.NewTxManager(pool),
+				accountRepo,
+				transferRepo,
+				postgres.NewEntryRepository(pool),
+				postgres.NewOutboxRepository(pool),
+				postgres.NewAuditRepository(pool),
+				postgres.NewULIDGenerator(),
+				nil,
+			).WithRetrier(postgres.NewRetrier())
+
+			importUC := usecase.NewTransferImportUseCase(transferUC, accountRepo, transferRepo)
+			report, err := importUC.Import(ctx, open, usecase.ImportOptions{
+				KeyPrefix:    keyPrefix,
+				ResumeAfter:  resumeAfter,
+				ChunkSize:    chunkSize,
+				ValidateOnly: validateOnly,
+				OnReject:     rejects.Write,
+				OnCheckpoint: func(line int) error {
+					return writeImportCheckpoint(checkpointPath, line)
+				},
+			})
+
+			if report != nil {
+				if jsonOutput {
+					printJSON(report)
+				} else {
+					if resumeAfter > 0 {
+						fmt.Printf("↪️  Resumed after line %d (%d rows skipped)\n", resumeAfter, report.Skipped)
+					}
+					fmt.Printf("Read:             %d\n", report.Read)
+					fmt.Printf("Imported:         %d\n", report.Imported)
+					fmt.Printf("Already imported: %d\n", report.AlreadyImported)
+					fmt.Printf("Rejected:         %d\n", report.Rejected)
+					if report.Rejected > 0 {
+						fmt.Printf("Rejects written to %s\n", rejectsPath)
+					}
+				}
+			}
+
+			if errors.Is(err, usecase.ErrImportRejected) {
+				fmt.Printf("❌ %d invalid rows, see %s; nothing was imported\n", report.Rejected, rejectsPath)
+				os.Exit(1)
+			}
+
+			if err != nil {
+				fmt.Printf("❌ Import stopped after line %d: %v\n", report.LastLine, err)
+				fmt.Println("   Re-run the same command to resume.")
+				os.Exit(1)
+			}
+
+			if validateOnly {
+				if report.Rejected > 0 {
+					os.Exit(1)
+				}
+				fmt.Println("✅ Validation passed")
+				return
+			}
+
+			if err := os.Remove(checkpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				fmt.Printf("⚠️  Failed to remove checkpoint: %v\n", err)
+			}
+			fmt.Println("✅ Import complete")
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "", "File format: csv or ndjson (default: from the file extension)")
+	cmd.Flags().IntVar(&chunkSize, "chunk-size", usecase.DefaultImportChunkSize, "Transfers per batch")
+	cmd.Flags().StringVar(&keyPrefix, "key-prefix", "", "Idempotency key prefix for rows without a key (default: SHA-256 of the file)")
+	cmd.Flags().StringVar(&rejectsPath, "rejects", "", "Rejects file (default: <file>.rejects.csv)")
+	cmd.Flags().StringVar(&checkpointPath, "checkpoint", "", "Checkpoint file (default: <file>.checkpoint)")
+	cmd.Flags().BoolVar(&validateOnly, "validate-only", false, "Validate every row without posting anything")
+
 	return cmd
 }
 
+// fileSHA256 returns the hex SHA-256 of f's contents.
+func fileSHA256(f *os.File) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// readImportCheckpoint returns the last line a previous import run
+// completed, or 0 when there is no checkpoint.
+func readImportCheckpoint(path string) (int, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	var checkpoint struct {
+		Line int `json:"line"`
+	}
+	if err := json.Unmarshal(b, &checkpoint); err != nil {
+		return 0, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return checkpoint.Line, nil
+}
+
+// writeImportCheckpoint replaces the checkpoint file atomically, so a crash
+// mid-write never leaves a corrupt checkpoint behind.
+func writeImportCheckpoint(path string, line int) error {
+	b, err := json.Marshal(map[string]int{"line": line})
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// rejectsWriter appends rejected import rows to a CSV file, flushing after
+// every row so rejects are on disk before the checkpoint moves past them.
+type rejectsWriter struct {
+	file *os.File
+	w    *csv.Writer
+}
+
+func newRejectsWriter(path string) (*rejectsWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	w := csv.NewWriter(file)
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	if info.Size() == 0 {
+		_ = w.Write([]string{"line", "idempotency_key", "reason"})
+	}
+
+	return &rejectsWriter{file: file, w: w}, nil
+}
+
+func (r *rejectsWriter) Write(rej usecase.ImportRejection) error {
+	_ = r.w.Write([]string{strconv.Itoa(rej.Line), rej.IdempotencyKey, rej.Reason})
+	r.w.Flush()
+
+	return r.w.Error()
+}
+
+func (r *rejectsWriter) Close() error {
+	r.w.Flush()
+
+	return r.file.Close()
+}
+
 // ============ HOLD COMMAND ============
 
 func holdCmd() *cobra.Command {
@@ -472,6 +908,20 @@ func holdCmd() *cobra.Command {
 		Short: "Hold management",
 	}
 
+	newHoldUseCase := func(pool *pgxpool.Pool) *usecase.HoldUseCase {
+		return usecase.NewHoldUseCase(
+			postgres.NewTxManager(pool),
+			postgres.NewAccountRepository(pool),
+			postgres.NewHoldRepository(pool),
+			postgres.NewTransferRepository(pool),
+			postgres.NewEntryRepository(pool),
+			postgres.NewOutboxRepository(pool),
+			postgres.NewAuditRepository(pool),
+			postgres.NewULIDGenerator(),
+			nil,
+		)
+	}
+
 	// Create hold
 	var accountID, amount, description string
 	createCmd := &cobra.Command{
@@ -482,18 +932,7 @@ func holdCmd() *cobra.Command {
 			pool := mustConnectDB(ctx)
 			defer pool.Close()
 
-			txManager := postgres.NewTxManager(pool)
-			holdUC := usecase.NewHoldUseCase(
-				txManager,
-				postgres.NewAccountRepository(pool),
-				postgres.NewHoldRepository(pool),
-				postgres.NewTransferRepository(pool),
-				postgres.NewEntryRepository(pool),
-				postgres.NewOutboxRepository(pool),
-				postgres.NewAuditRepository(pool),
-				postgres.NewULIDGenerator(),
-				nil,
-			)
+			holdUC := newHoldUseCase(pool)
 
 			amt, err := decimal.NewFromString(amount)
 			if err != nil {
@@ -533,18 +972,7 @@ func holdCmd() *cobra.Command {
 			pool := mustConnectDB(ctx)
 			defer pool.Close()
 
-			txManager := postgres.NewTxManager(pool)
-			holdUC := usecase.NewHoldUseCase(
-				txManager,
-				postgres.NewAccountRepository(pool),
-				postgres.NewHoldRepository(pool),
-				postgres.NewTransferRepository(pool),
-				postgres.NewEntryRepository(pool),
-				postgres.NewOutboxRepository(pool),
-				postgres.NewAuditRepository(pool),
-				postgres.NewULIDGenerator(),
-				nil,
-			)
+			holdUC := newHoldUseCase(pool)
 
 			transfer, err := holdUC.CaptureHold(ctx, args[0], captureToID)
 			if err != nil {
@@ -572,18 +1000,7 @@ func holdCmd() *cobra.Command {
 			pool := mustConnectDB(ctx)
 			defer pool.Close()
 
-			txManager := postgres.NewTxManager(pool)
-			holdUC := usecase.NewHoldUseCase(
-				txManager,
-				postgres.NewAccountRepository(pool),
-				postgres.NewHoldRepository(pool),
-				postgres.NewTransferRepository(pool),
-				postgres.NewEntryRepository(pool),
-				postgres.NewOutboxRepository(pool),
-				postgres.NewAuditRepository(pool),
-				postgres.NewULIDGenerator(),
-				nil,
-			)
+			holdUC := newHoldUseCase(pool)
 
 			if err := holdUC.VoidHold(ctx, args[0]); err != nil {
 				fmt.Printf("❌ Failed to void hold: %v\n", err)
@@ -594,76 +1011,1395 @@ func holdCmd() *cobra.Command {
 		},
 	}
 
-	cmd.AddCommand(createCmd, captureCmd, voidCmd)
-	return cmd
-}
+	// Get hold
+	getCmd := &cobra.Command{
+		Use:   "get [hold-id]",
+		Short: "Get hold by ID",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
 
-// ============ LEDGER COMMAND ============
+			hold, err := newHoldUseCase(pool).GetHold(ctx, args[0])
+			if err != nil {
+				fmt.Printf("❌ Failed to get hold: %v\n", err)
+				os.Exit(1)
+			}
 
-func ledgerCmd() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "ledger",
-		Short: "Ledger operations",
+			if jsonOutput {
+				printJSON(hold)
+			} else {
+				fmt.Printf("Hold: %s\n", hold.ID)
+				fmt.Printf("   Account: %s\n", hold.AccountID)
+				fmt.Printf("   Amount: %s\n", hold.Amount.String())
+				fmt.Printf("   Status: %s\n", hold.Status)
+				if hold.ExpiresAt != nil {
+					fmt.Printf("   Expires: %s\n", hold.ExpiresAt.Format(time.RFC3339))
+				}
+				fmt.Printf("   Created: %s\n", hold.CreatedAt.Format(time.RFC3339))
+			}
+		},
 	}
 
-	consistencyCmd := &cobra.Command{
-		Use:   "consistency",
-		Short: "Check ledger consistency",
+	// List holds
+	var listStatus, expiresAfter, expiresBefore, cursor string
+	var limit int
+	listCmd := &cobra.Command{
+		Use:   "list [account-id]",
+		Short: "List an account's holds, newest first",
+		Args:  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := context.Background()
 			pool := mustConnectDB(ctx)
 			defer pool.Close()
-			checkConsistency(pool)
-		},
-	}
-
-	cmd.AddCommand(consistencyCmd)
-	return cmd
-}
 
-// ============ AUDIT COMMAND ============
+			result, err := newHoldUseCase(pool).ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{
+				AccountID: args[0],
+				Filter: domain.HoldFilter{
+					Status:        domain.HoldStatus(listStatus),
+					ExpiresAfter:  mustParseOptionalTime(expiresAfter),
+					ExpiresBefore: mustParseOptionalTime(expiresBefore),
+				},
+				Cursor: cursor,
+				Limit:  limit,
+			})
+			if err != nil {
+				fmt.Printf("❌ Failed to list holds: %v\n", err)
+				os.Exit(1)
+			}
 
-func auditCmd() *cobra.Command {
+			if jsonOutput {
+				printJSON(result)
+				return
+			}
+
+			fmt.Printf("%-28s %-15s %-10s %-20s\n", "ID", "AMOUNT", "STATUS", "EXPIRES")
+			fmt.Println("---------------------------------------------------------------------------")
+			for _, h := range result.Holds {
+				expires := "-"
+				if h.ExpiresAt != nil {
+					expires = h.ExpiresAt.Format(time.RFC3339)
+				}
+				fmt.Printf("%-28s %-15s %-10s %-20s\n", h.ID, h.Amount.String(), h.Status, expires)
+			}
+			if result.NextCursor != "" {
+				fmt.Printf("\nNext page: --cursor %s\n", result.NextCursor)
+			}
+		},
+	}
+	listCmd.Flags().StringVar(&listStatus, "status", "", "Only holds with this status (active, voided, captured)")
+	listCmd.Flags().StringVar(&expiresAfter, "expires-after", "", "Only holds expiring at or after this time (YYYY-MM-DD or RFC 3339)")
+	listCmd.Flags().StringVar(&expiresBefore, "expires-before", "", "Only holds expiring before this time (YYYY-MM-DD or RFC 3339)")
+	listCmd.Flags().StringVar(&cursor, "cursor", "", "Continue after this hold ID (from the previous page)")
+	listCmd.Flags().IntVar(&limit, "limit", 20, "Limit results (max 100)")
+
+	cmd.AddCommand(createCmd, captureCmd, voidCmd, getCmd, listCmd)
+	return cmd
+}
+
+// ============ LEDGER COMMAND ============
+
+func ledgerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger",
+		Short: "Ledger operations",
+	}
+
+	consistencyCmd := &cobra.Command{
+		Use:   "consistency",
+		Short: "Check ledger consistency",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+			checkConsistency(pool)
+		},
+	}
+
+	cmd.AddCommand(consistencyCmd)
+	return cmd
+}
+
+// ============ BACKUP COMMAND ============
+
+func backupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Logical ledger export and restore",
+		Long: `Export accounts, transfers, entries, holds, audit logs and ledger
+configuration (fee policies, accrual rules, groups and runs, webhook
+subscriptions) to a portable, versioned archive (gzipped tar of NDJSON files
+with SHA-256 checksums), and restore one into a fresh database.`,
+	}
+
+	var output string
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the ledger to an archive",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			if output == "" {
+				output = fmt.Sprintf("goledger-%s.tar.gz", time.Now().UTC().Format("20060102T150405Z"))
+			}
+
+			// Write to a temporary file so a failed export never leaves a
+			// truncated archive under the final name.
+			tmp := output + ".partial"
+			file, err := os.Create(tmp)
+			if err != nil {
+				fmt.Printf("❌ Failed to create archive: %v\n", err)
+				os.Exit(1)
+			}
+
+			info, err := exportLedger(ctx, pool, file)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err == nil {
+				err = os.Rename(tmp, output)
+			}
+			if err != nil {
+				_ = os.Remove(tmp)
+				fmt.Printf("❌ Export failed: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(info)
+				return
+			}
+
+			fmt.Printf("✅ Ledger exported to %s (schema version %d)\n", output, info.SchemaVersion)
+			for _, t := range info.Tables {
+				fmt.Printf("   %-12s %d rows\n", t.Name, t.Rows)
+			}
+		},
+	}
+	exportCmd.Flags().StringVarP(&output, "output", "o", "", "Archive file (default: goledger-<timestamp>.tar.gz)")
+
+	restoreCmd := &cobra.Command{
+		Use:   "restore [archive]",
+		Short: "Restore an archive into an empty, migrated database and verify it",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				fmt.Printf("❌ Failed to open archive: %v\n", err)
+				os.Exit(1)
+			}
+			defer file.Close()
+
+			archive, err := backup.NewReader(file)
+			if err != nil {
+				fmt.Printf("❌ Invalid archive: %v\n", err)
+				os.Exit(1)
+			}
+			defer archive.Close()
+
+			report, err := newBackupUseCase(pool).Restore(ctx, archive)
+			if report == nil {
+				fmt.Printf("❌ Restore failed: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(report)
+			} else {
+				for _, t := range report.Tables {
+					fmt.Printf("   %-12s %d rows\n", t.Name, t.Rows)
+				}
+				printRestoreVerification(report)
+			}
+
+			if err != nil {
+				fmt.Printf("❌ Restore failed: %v\n", err)
+				os.Exit(1)
+			}
+
+			fmt.Println("✅ Ledger restored and verified")
+		},
+	}
+
+	cmd.AddCommand(exportCmd, restoreCmd)
+	return cmd
+}
+
+func newBackupUseCase(pool *pgxpool.Pool) *usecase.BackupUseCase {
+	reconciliationUC := usecase.NewReconciliationUseCase(
+		postgres.NewAccountRepository(pool),
+		postgres.NewEntryRepository(pool),
+		postgres.NewLedgerRepository(pool),
+	)
+
+	return usecase.NewBackupUseCase(postgres.NewTxManager(pool), postgres.NewBackupRepository(pool), reconciliationUC)
+}
+
+func exportLedger(ctx context.Context, pool *pgxpool.Pool, file *os.File) (*usecase.LedgerArchiveInfo, error) {
+	archive, err := backup.NewWriter(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBackupUseCase(pool).Export(ctx, archive)
+}
+
+func printRestoreVerification(report *usecase.RestoreReport) {
+	for _, d := range report.Discrepancies {
+		fmt.Printf("   ❌ account %s: balance %s, entries sum to %s\n",
+			d.AccountID, d.RecordedBalance.String(), d.CalculatedBalance.String())
+	}
+
+	for _, chain := range report.EntryChainBreaks {
+		for _, b := range chain.Breaks {
+			fmt.Printf("   ❌ account %s entry %s: %s\n", chain.AccountID, b.EntryID, b.Reason)
+		}
+	}
+
+	if report.LedgerConsistencyError != "" {
+		fmt.Printf("   ❌ %s\n", report.LedgerConsistencyError)
+	}
+
+	for _, b := range report.AuditChainBreaks {
+		fmt.Printf("   ❌ audit log %s: %s\n", b.AuditID, b.Reason)
+	}
+
+	if report.AuditChainHeadMismatch {
+		fmt.Println("   ❌ audit log hash chain does not end at the archived head")
+	}
+}
+
+// ============ AUDIT COMMAND ============
+
+func auditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit trail operations",
+	}
+
+	verifyChainCmd := &cobra.Command{
+		Use:   "verify-chain",
+		Short: "Verify the audit_logs hash chain for tamper evidence",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+			verifyAuditChain(ctx, pool)
+		},
+	}
+
+	var userID, action, resourceType, resourceID, cursor string
+	var limit int
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit logs, newest first",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			filter := domain.AuditFilter{
+				UserID:       userID,
+				Action:       action,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Limit:        limit,
+			}
+			if cursor != "" {
+				after, err := domain.DecodeTimeCursor(cursor)
+				if err != nil {
+					fmt.Printf("❌ Invalid --cursor: %v\n", err)
+					os.Exit(1)
+				}
+				filter.After = &after
+			}
+
+			logs, err := postgres.NewAuditRepository(pool).List(ctx, filter)
+			if err != nil {
+				fmt.Printf("❌ Failed to list audit logs: %v\n", err)
+				os.Exit(1)
+			}
+			nextCursor := domain.NextAuditCursor(logs, limit)
+
+			if jsonOutput {
+				printJSON(struct {
+					AuditLogs  []*domain.AuditLog
+					NextCursor string
+				}{logs, nextCursor})
+				return
+			}
+
+			fmt.Printf("%-28s %-20s %-28s %-24s %-8s\n", "ID", "CREATED", "USER", "ACTION", "STATUS")
+			fmt.Println("---------------------------------------------------------------------------------------------------------------")
+			for _, l := range logs {
+				fmt.Printf("%-28s %-20s %-28s %-24s %-8s\n", l.ID, l.CreatedAt.Format(time.RFC3339), l.UserID, truncate(l.Action, 24), l.Status)
+			}
+			if nextCursor != "" {
+				fmt.Printf("\nNext page: --cursor %s\n", nextCursor)
+			}
+		},
+	}
+	listCmd.Flags().StringVar(&userID, "user", "", "Only actions by this user ID")
+	listCmd.Flags().StringVar(&action, "action", "", "Only this action, e.g. transfer.create")
+	listCmd.Flags().StringVar(&resourceType, "resource-type", "", "Only this resource type")
+	listCmd.Flags().StringVar(&resourceID, "resource-id", "", "Only this resource ID")
+	listCmd.Flags().StringVar(&cursor, "cursor", "", "Continue from the previous page's cursor")
+	listCmd.Flags().IntVar(&limit, "limit", 100, "Limit results")
+
+	cmd.AddCommand(verifyChainCmd, listCmd)
+	return cmd
+}
+
+// ============ OUTBOX COMMAND ============
+
+func outboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbox",
+		Short: "Outbox event operations",
+	}
+
+	newOutboxUseCase := func(pool *pgxpool.Pool) *usecase.OutboxUseCase {
+		return usecase.NewOutboxUseCase(
+			postgres.NewTxManager(pool),
+			postgres.NewOutboxRepository(pool),
+			postgres.NewAuditRepository(pool),
+			postgres.NewULIDGenerator(),
+		)
+	}
+
+	var deadLetterCursor string
+	var deadLetterLimit int
+	deadLettersCmd := &cobra.Command{
+		Use:   "dead-letters",
+		Short: "List outbox events that exhausted delivery attempts, most recent first",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+			listDeadLetters(ctx, newOutboxUseCase(pool), deadLetterCursor, deadLetterLimit)
+		},
+	}
+	deadLettersCmd.Flags().StringVar(&deadLetterCursor, "cursor", "", "Continue from the previous page's cursor")
+	deadLettersCmd.Flags().IntVar(&deadLetterLimit, "limit", 100, "Limit results")
+
+	// Shared dead-letter selection flags for replay and archive
+	var ids []string
+	var eventType, from, to string
+	var all bool
+	addFilterFlags := func(c *cobra.Command) {
+		c.Flags().StringArrayVar(&ids, "id", nil, "Event ID (repeatable)")
+		c.Flags().StringVar(&eventType, "type", "", "Only events of this type, e.g. transfer.created")
+		c.Flags().StringVar(&from, "from", "", "Only events created at or after this time (YYYY-MM-DD or RFC 3339)")
+		c.Flags().StringVar(&to, "to", "", "Only events created before this time (YYYY-MM-DD or RFC 3339)")
+		c.Flags().BoolVar(&all, "all", false, "Select every dead-lettered event")
+	}
+	buildFilter := func() domain.DeadLetterFilter {
+		return domain.DeadLetterFilter{
+			IDs:         ids,
+			EventType:   eventType,
+			CreatedFrom: mustParseOptionalTime(from),
+			CreatedTo:   mustParseOptionalTime(to),
+			All:         all,
+		}
+	}
+	printAction := func(verb string, events []*domain.OutboxEvent) {
+		if jsonOutput {
+			printJSON(events)
+			return
+		}
+
+		fmt.Printf("✅ %s %d event(s)\n", verb, len(events))
+		for _, e := range events {
+			fmt.Printf("  %s  %s/%s  %s\n", e.ID, e.AggregateType, e.AggregateID, e.EventType)
+		}
+	}
+
+	replayCmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Requeue dead-lettered events for publishing with a fresh attempt budget",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			events, err := newOutboxUseCase(pool).ReplayDeadLettered(ctx, buildFilter())
+			if err != nil {
+				fmt.Printf("❌ Failed to replay dead letters: %v\n", err)
+				os.Exit(1)
+			}
+			printAction("Requeued", events)
+		},
+	}
+	addFilterFlags(replayCmd)
+
+	var reason string
+	archiveCmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Move dead-lettered events out of the outbox into the dead-letter archive",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			events, err := newOutboxUseCase(pool).ArchiveDeadLettered(ctx, buildFilter(), reason)
+			if err != nil {
+				fmt.Printf("❌ Failed to archive dead letters: %v\n", err)
+				os.Exit(1)
+			}
+			printAction("Archived", events)
+		},
+	}
+	addFilterFlags(archiveCmd)
+	archiveCmd.Flags().StringVar(&reason, "reason", "", "Why the events will not be published (required)")
+	_ = archiveCmd.MarkFlagRequired("reason")
+
+	var days int
+	var archiveMode, archiveDir string
+	var dryRun bool
+	pruneCmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Archive and remove monthly outbox partitions older than the retention window",
+		Long: `Archive and remove monthly outbox partitions whose whole month is older than
+--days. With --archive table (default) a partition is moved to
+outbox_events_archive; with --archive file its events are written to
+<archive-dir>/<partition>.ndjson.gz before it is dropped. Partitions still
+holding unpublished or dead-lettered events are kept.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			mode := domain.OutboxArchiveMode(archiveMode)
+			var archiver usecase.OutboxPartitionArchiver
+			if mode == domain.OutboxArchiveFile {
+				if archiveDir == "" {
+					fmt.Println("❌ --archive-dir is required with --archive file")
+					os.Exit(1)
+				}
+				archiver = outboxretention.NewFileArchiver(archiveDir)
+			}
+
+			uc := usecase.NewOutboxRetentionUseCase(postgres.NewTxManager(pool), postgres.NewOutboxRetentionRepository(pool), archiver)
+
+			report, err := uc.Prune(ctx, usecase.OutboxPruneOptions{Mode: mode, RetentionDays: days, DryRun: dryRun})
+			if report != nil {
+				printPruneReport(report)
+			}
+			if err != nil {
+				fmt.Printf("❌ Prune failed: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+	pruneCmd.Flags().IntVar(&days, "days", 0, "Keep published events for this many days (required)")
+	pruneCmd.Flags().StringVar(&archiveMode, "archive", string(domain.OutboxArchiveTable), "Where pruned events go: table or file")
+	pruneCmd.Flags().StringVar(&archiveDir, "archive-dir", "", "Directory for --archive file")
+	pruneCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show which partitions would be pruned without changing anything")
+	_ = pruneCmd.MarkFlagRequired("days")
+
+	cmd.AddCommand(deadLettersCmd, replayCmd, archiveCmd, pruneCmd)
+	return cmd
+}
+
+func printPruneReport(report *domain.OutboxPruneReport) {
+	if jsonOutput {
+		printJSON(report)
+		return
+	}
+
+	fmt.Printf("Cutoff: %s (archive: %s)\n", report.Cutoff.Format(time.RFC3339), report.Mode)
+	for _, p := range report.Partitions {
+		switch p.Status {
+		case domain.OutboxPartitionPruned:
+			fmt.Printf("  ✅ %s  %d events → %s\n", p.Partition.Name, p.Stats.Events, p.ArchivedTo)
+		case domain.OutboxPartitionPrunable:
+			fmt.Printf("  •  %s  %d events would be pruned\n", p.Partition.Name, p.Stats.Events)
+		default:
+			fmt.Printf("  ⚠️  %s  kept: %s\n", p.Partition.Name, p.Reason)
+		}
+	}
+
+	if report.DefaultPartitionEvents > 0 {
+		fmt.Printf("⚠️  %d event(s) in outbox_events_default are never pruned\n", report.DefaultPartitionEvents)
+	}
+
+	if report.DryRun {
+		fmt.Printf("Dry run: %d partition(s) prunable\n", len(report.Partitions)-report.Retained())
+		return
+	}
+
+	fmt.Printf("✅ Pruned %d partition(s), %d event(s); kept %d\n", report.Pruned(), report.EventsArchived(), report.Retained())
+}
+
+// ============ PROJECTOR COMMAND ============
+
+func projectorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projector",
+		Short: "Reporting read-model projector operations",
+	}
+
+	newReportingUseCase := func(pool *pgxpool.Pool) *usecase.ReportingUseCase {
+		return usecase.NewReportingUseCase(postgres.NewTxManager(pool), postgres.NewReportingRepository(pool))
+	}
+
+	printResult := func(verb string, result *usecase.ProjectionResult) {
+		if jsonOutput {
+			printJSON(result)
+			return
+		}
+
+		fmt.Printf("✅ %s %d entries in %d batch(es)\n", verb, result.Entries, result.Batches)
+		if result.Entries > 0 {
+			fmt.Printf("   Checkpoint: tx %d, entry %s\n", result.Checkpoint.TxID, result.Checkpoint.EntryID)
+		}
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show the projection checkpoint and lag",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			status, err := newReportingUseCase(pool).Status(ctx)
+			if err != nil {
+				fmt.Printf("❌ Failed to get projection status: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(status)
+				return
+			}
+
+			fmt.Printf("Projection:        %s\n", status.Checkpoint.Name)
+			fmt.Printf("Checkpoint:        tx %d, entry %s\n", status.Checkpoint.Cursor.TxID, status.Checkpoint.Cursor.EntryID)
+			fmt.Printf("Entries projected: %d\n", status.Checkpoint.EntriesProjected)
+			fmt.Printf("Lag:               %s\n", status.Lag.Round(time.Millisecond))
+		},
+	}
+
+	var batchSize int
+
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Apply every entry not yet projected, then exit",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			result, err := newReportingUseCase(pool).Project(ctx, batchSize)
+			if err != nil {
+				fmt.Printf("❌ Projection failed after %d entries: %v\n", result.Entries, err)
+				os.Exit(1)
+			}
+			printResult("Projected", result)
+		},
+	}
+	runCmd.Flags().IntVar(&batchSize, "batch-size", usecase.DefaultProjectionBatchSize, "Entries applied per transaction")
+
+	rebuildCmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Empty the reporting read models and project every entry from scratch",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			result, err := newReportingUseCase(pool).Rebuild(ctx, batchSize)
+			if err != nil {
+				fmt.Printf("❌ Rebuild failed: %v\n", err)
+				os.Exit(1)
+			}
+			printResult("Rebuilt read models from", result)
+		},
+	}
+	rebuildCmd.Flags().IntVar(&batchSize, "batch-size", usecase.DefaultProjectionBatchSize, "Entries applied per transaction")
+
+	cmd.AddCommand(statusCmd, runCmd, rebuildCmd)
+	return cmd
+}
+
+// ============ ACCRUAL COMMAND ============
+
+func accrualCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrual",
+		Short: "Interest and fee accrual rules",
+	}
+
+	ruleCmd := &cobra.Command{
+		Use:   "rule",
+		Short: "Manage accrual rules",
+	}
+
+	// Create rule
+	var name, kind, schedule, accountID, group, counterparty, rate, amount string
+	var precision int32
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an interest or fee accrual rule",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			input := usecase.CreateAccrualRuleInput{
+				Name:                  name,
+				Kind:                  domain.AccrualKind(kind),
+				Schedule:              domain.AccrualSchedule(schedule),
+				AccountID:             accountID,
+				AccountGroup:          group,
+				CounterpartyAccountID: counterparty,
+				Precision:             &precision,
+			}
+
+			var err error
+			if rate != "" {
+				if input.Rate, err = decimal.NewFromString(rate); err != nil {
+					fmt.Printf("❌ Invalid rate: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			if amount != "" {
+				if input.Amount, err = decimal.NewFromString(amount); err != nil {
+					fmt.Printf("❌ Invalid amount: %v\n", err)
+					os.Exit(1)
+				}
+			}
+
+			rule, err := newAccrualUseCase(pool).CreateRule(ctx, input)
+			if err != nil {
+				fmt.Printf("❌ Failed to create accrual rule: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(rule)
+			} else {
+				fmt.Printf("✅ Accrual rule created: %s\n", rule.ID)
+				fmt.Printf("   Kind: %s (%s)\n", rule.Kind, rule.Schedule)
+				fmt.Printf("   Counterparty: %s\n", rule.CounterpartyAccountID)
+			}
+		},
+	}
+	createCmd.Flags().StringVar(&name, "name", "", "Rule name (required)")
+	createCmd.Flags().StringVar(&kind, "kind", "", "Rule kind: interest or fee (required)")
+	createCmd.Flags().StringVar(&schedule, "schedule", string(domain.AccrualScheduleDaily), "Schedule: daily or monthly")
+	createCmd.Flags().StringVar(&accountID, "account", "", "Target account ID (or use --group)")
+	createCmd.Flags().StringVar(&group, "group", "", "Target account group (or use --account)")
+	createCmd.Flags().StringVar(&counterparty, "counterparty", "", "Interest expense / fee income account ID (required)")
+	createCmd.Flags().StringVar(&rate, "rate", "", "Annual interest rate, e.g. 0.05 (interest rules)")
+	createCmd.Flags().StringVar(&amount, "amount", "", "Flat fee per period (fee rules)")
+	createCmd.Flags().Int32Var(&precision, "precision", domain.DefaultAccrualPrecision, "Decimal places accrual amounts are rounded to")
+	_ = createCmd.MarkFlagRequired("name")
+	_ = createCmd.MarkFlagRequired("kind")
+	_ = createCmd.MarkFlagRequired("counterparty")
+
+	// List rules
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List accrual rules",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			rules, err := newAccrualUseCase(pool).ListRules(ctx, 100, 0)
+			if err != nil {
+				fmt.Printf("❌ Failed to list accrual rules: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(rules)
+				return
+			}
+
+			fmt.Printf("%-28s %-20s %-9s %-8s %-28s %-10s %-7s\n", "ID", "NAME", "KIND", "SCHEDULE", "TARGET", "RATE/AMT", "ACTIVE")
+			fmt.Println("--------------------------------------------------------------------------------------------------------------------")
+			for _, r := range rules {
+				target := ""
+				if r.AccountID != nil {
+					target = *r.AccountID
+				} else if r.AccountGroup != nil {
+					target = "group:" + *r.AccountGroup
+				}
+
+				value := r.Amount.String()
+				if r.Kind == domain.AccrualKindInterest {
+					value = r.Rate.String()
+				}
+
+				active := "yes"
+				if !r.Active {
+					active = "no"
+				}
+
+				fmt.Printf("%-28s %-20s %-9s %-8s %-28s %-10s %-7s\n", r.ID, truncate(r.Name, 20), r.Kind, r.Schedule, truncate(target, 28), value, active)
+			}
+		},
+	}
+
+	setActive := func(use, short string, active bool) *cobra.Command {
+		return &cobra.Command{
+			Use:   use + " [rule-id]",
+			Short: short,
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				ctx := context.Background()
+				pool := mustConnectDB(ctx)
+				defer pool.Close()
+
+				if err := newAccrualUseCase(pool).SetRuleActive(ctx, args[0], active); err != nil {
+					fmt.Printf("❌ Failed to %s accrual rule: %v\n", use, err)
+					os.Exit(1)
+				}
+				fmt.Printf("✅ Accrual rule %sd: %s\n", use, args[0])
+			},
+		}
+	}
+
+	ruleCmd.AddCommand(createCmd, listCmd,
+		setActive("enable", "Enable an accrual rule", true),
+		setActive("disable", "Disable an accrual rule", false))
+
+	groupCmd := &cobra.Command{
+		Use:   "group",
+		Short: "Manage account group membership",
+	}
+
+	groupAddCmd := &cobra.Command{
+		Use:   "add [group] [account-id]",
+		Short: "Add an account to a group",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			if err := newAccrualUseCase(pool).AddAccountToGroup(ctx, args[0], args[1]); err != nil {
+				fmt.Printf("❌ Failed to add account to group: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("✅ Account %s added to group %s\n", args[1], args[0])
+		},
+	}
+
+	groupRemoveCmd := &cobra.Command{
+		Use:   "remove [group] [account-id]",
+		Short: "Remove an account from a group",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			if err := newAccrualUseCase(pool).RemoveAccountFromGroup(ctx, args[0], args[1]); err != nil {
+				fmt.Printf("❌ Failed to remove account from group: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("✅ Account %s removed from group %s\n", args[1], args[0])
+		},
+	}
+
+	groupCmd.AddCommand(groupAddCmd, groupRemoveCmd)
+
+	// Run accruals for a day
+	var date string
+	runCmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run accruals for a completed day (re-runs are no-ops)",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			day := time.Now().UTC().AddDate(0, 0, -1)
+			if date != "" {
+				parsed, err := time.Parse(time.DateOnly, date)
+				if err != nil {
+					fmt.Printf("❌ Invalid date (expected YYYY-MM-DD): %v\n", err)
+					os.Exit(1)
+				}
+				day = parsed
+			}
+
+			report, err := newAccrualUseCase(pool).RunAccruals(ctx, day)
+			if err != nil {
+				fmt.Printf("❌ Accrual run failed: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(report)
+			} else {
+				fmt.Printf("Accruals for %s: posted=%d skipped=%d already_processed=%d failed=%d\n",
+					report.Day.Format(time.DateOnly), report.Posted, report.Skipped, report.AlreadyProcessed, len(report.Failures))
+				for _, f := range report.Failures {
+					fmt.Printf("   ❌ rule %s account %s: %s\n", f.RuleID, f.AccountID, f.Error)
+				}
+			}
+
+			if len(report.Failures) > 0 {
+				os.Exit(1)
+			}
+		},
+	}
+	runCmd.Flags().StringVar(&date, "date", "", "Day to accrue for, YYYY-MM-DD (default: yesterday, UTC)")
+
+	// List runs
+	var ruleID string
+	runsCmd := &cobra.Command{
+		Use:   "runs",
+		Short: "List recorded accrual runs",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			runs, err := newAccrualUseCase(pool).ListRuns(ctx, ruleID, 100, 0)
+			if err != nil {
+				fmt.Printf("❌ Failed to list accrual runs: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(runs)
+				return
+			}
+
+			fmt.Printf("%-10s %-28s %-28s %-8s %-12s %-28s\n", "PERIOD", "RULE", "ACCOUNT", "STATUS", "AMOUNT", "TRANSFER")
+			fmt.Println("------------------------------------------------------------------------------------------------------------------")
+			for _, r := range runs {
+				transferID := ""
+				if r.TransferID != nil {
+					transferID = *r.TransferID
+				}
+				fmt.Printf("%-10s %-28s %-28s %-8s %-12s %-28s\n",
+					r.PeriodStart.Format(time.DateOnly), r.RuleID, r.AccountID, r.Status, r.Amount.String(), transferID)
+			}
+		},
+	}
+	runsCmd.Flags().StringVar(&ruleID, "rule", "", "Only show runs for this rule ID")
+
+	cmd.AddCommand(ruleCmd, groupCmd, runCmd, runsCmd)
+	return cmd
+}
+
+// ============ FEE POLICY COMMAND ============
+
+func feePolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "audit",
-		Short: "Audit trail operations",
+		Use:   "fee-policy",
+		Short: "Fee policies applied on transfer creation",
 	}
 
-	verifyChainCmd := &cobra.Command{
-		Use:   "verify-chain",
-		Short: "Verify the audit_logs hash chain for tamper evidence",
+	newFeePolicyUseCase := func(pool *pgxpool.Pool) *usecase.FeePolicyUseCase {
+		return usecase.NewFeePolicyUseCase(
+			postgres.NewFeePolicyRepository(pool),
+			postgres.NewAccountRepository(pool),
+			postgres.NewULIDGenerator(),
+		)
+	}
+
+	// Create policy
+	var name, fromID, toID, currency, payer, revenue, percentage, fixed string
+	var metadata []string
+	var precision int32
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a fee policy",
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := context.Background()
 			pool := mustConnectDB(ctx)
 			defer pool.Close()
-			verifyAuditChain(ctx, pool)
+
+			input := usecase.CreateFeePolicyInput{
+				Name:             name,
+				FromAccountID:    fromID,
+				ToAccountID:      toID,
+				Currency:         currency,
+				Payer:            domain.FeePayer(payer),
+				RevenueAccountID: revenue,
+				Precision:        &precision,
+			}
+
+			var err error
+			if percentage != "" {
+				if input.Percentage, err = decimal.NewFromString(percentage); err != nil {
+					fmt.Printf("❌ Invalid percentage: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			if fixed != "" {
+				if input.FixedAmount, err = decimal.NewFromString(fixed); err != nil {
+					fmt.Printf("❌ Invalid fixed amount: %v\n", err)
+					os.Exit(1)
+				}
+			}
+			if len(metadata) > 0 {
+				input.MetadataMatch = make(map[string]string, len(metadata))
+				for _, kv := range metadata {
+					k, v, ok := strings.Cut(kv, "=")
+					if !ok || k == "" {
+						fmt.Printf("❌ Invalid metadata match %q (expected key=value)\n", kv)
+						os.Exit(1)
+					}
+					input.MetadataMatch[k] = v
+				}
+			}
+
+			policy, err := newFeePolicyUseCase(pool).CreatePolicy(ctx, input)
+			if err != nil {
+				fmt.Printf("❌ Failed to create fee policy: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(policy)
+			} else {
+				fmt.Printf("✅ Fee policy created: %s\n", policy.ID)
+				fmt.Printf("   Fee: %s + %s, paid by %s\n", policy.Percentage.String(), policy.FixedAmount.String(), policy.Payer)
+				fmt.Printf("   Revenue: %s\n", policy.RevenueAccountID)
+			}
+		},
+	}
+	createCmd.Flags().StringVar(&name, "name", "", "Policy name (required)")
+	createCmd.Flags().StringVar(&fromID, "from", "", "Only match transfers from this account")
+	createCmd.Flags().StringVar(&toID, "to", "", "Only match transfers to this account")
+	createCmd.Flags().StringVar(&currency, "currency", "", "Only match transfers in this currency (default: the revenue account's; must match it)")
+	createCmd.Flags().StringArrayVar(&metadata, "metadata", nil, "Only match transfers with this metadata, key=value (repeatable)")
+	createCmd.Flags().StringVar(&payer, "payer", string(domain.FeePayerSource), "Who pays the fee: source or destination")
+	createCmd.Flags().StringVar(&revenue, "revenue", "", "Account fees are credited to (required)")
+	createCmd.Flags().StringVar(&percentage, "percentage", "", "Fee as a fraction of the amount, e.g. 0.029")
+	createCmd.Flags().StringVar(&fixed, "fixed", "", "Fixed fee per transfer")
+	createCmd.Flags().Int32Var(&precision, "precision", domain.DefaultFeePrecision, "Decimal places fees are rounded to")
+	_ = createCmd.MarkFlagRequired("name")
+	_ = createCmd.MarkFlagRequired("revenue")
+
+	// List policies
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List fee policies",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			policies, err := newFeePolicyUseCase(pool).ListPolicies(ctx, 100, 0)
+			if err != nil {
+				fmt.Printf("❌ Failed to list fee policies: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(policies)
+				return
+			}
+
+			fmt.Printf("%-28s %-20s %-10s %-10s %-12s %-28s %-7s\n", "ID", "NAME", "PERCENT", "FIXED", "PAYER", "REVENUE", "ACTIVE")
+			fmt.Println("-----------------------------------------------------------------------------------------------------------------------")
+			for _, p := range policies {
+				active := "yes"
+				if !p.Active {
+					active = "no"
+				}
+
+				fmt.Printf("%-28s %-20s %-10s %-10s %-12s %-28s %-7s\n",
+					p.ID, truncate(p.Name, 20), p.Percentage.String(), p.FixedAmount.String(), p.Payer, p.RevenueAccountID, active)
+			}
 		},
 	}
 
-	cmd.AddCommand(verifyChainCmd)
+	setActive := func(use, short string, active bool) *cobra.Command {
+		return &cobra.Command{
+			Use:   use + " [policy-id]",
+			Short: short,
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				ctx := context.Background()
+				pool := mustConnectDB(ctx)
+				defer pool.Close()
+
+				if err := newFeePolicyUseCase(pool).SetPolicyActive(ctx, args[0], active); err != nil {
+					fmt.Printf("❌ Failed to %s fee policy: %v\n", use, err)
+					os.Exit(1)
+				}
+				fmt.Printf("✅ Fee policy %sd: %s\n", use, args[0])
+			},
+		}
+	}
+
+	cmd.AddCommand(createCmd, listCmd,
+		setActive("enable", "Enable a fee policy", true),
+		setActive("disable", "Disable a fee policy", false))
 	return cmd
 }
 
-// ============ OUTBOX COMMAND ============
+// ============ WEBHOOK COMMAND ============
 
-func outboxCmd() *cobra.Command {
+func webhookCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "outbox",
-		Short: "Outbox event operations",
+		Use:   "webhook",
+		Short: "Webhook subscriptions and deliveries",
 	}
 
-	deadLettersCmd := &cobra.Command{
-		Use:   "dead-letters",
-		Short: "List outbox events that exhausted delivery attempts",
+	newWebhookUseCase := func(pool *pgxpool.Pool) *usecase.WebhookUseCase {
+		return usecase.NewWebhookUseCase(postgres.NewWebhookRepository(pool), postgres.NewULIDGenerator())
+	}
+
+	// Create subscription
+	var url, aggregateType, aggregateID, description, secret string
+	var events []string
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a webhook subscription",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			sub, err := newWebhookUseCase(pool).CreateSubscription(ctx, usecase.CreateWebhookSubscriptionInput{
+				URL:           url,
+				EventTypes:    events,
+				AggregateType: aggregateType,
+				AggregateID:   aggregateID,
+				Description:   description,
+				Secret:        secret,
+			})
+			if err != nil {
+				fmt.Printf("❌ Failed to create webhook: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				resp := dto.WebhookFromDomain(sub)
+				resp.Secret = sub.Secret
+				printJSON(resp)
+			} else {
+				fmt.Printf("✅ Webhook created: %s\n", sub.ID)
+				fmt.Printf("   URL: %s\n", sub.URL)
+				fmt.Printf("   Secret: %s (shown only once)\n", sub.Secret)
+			}
+		},
+	}
+	createCmd.Flags().StringVar(&url, "url", "", "Endpoint deliveries are POSTed to (required)")
+	createCmd.Flags().StringArrayVar(&events, "event", nil, "Only deliver this event type, e.g. transfer.created or transfer.* (repeatable)")
+	createCmd.Flags().StringVar(&aggregateType, "aggregate-type", "", "Only deliver events of this aggregate type")
+	createCmd.Flags().StringVar(&aggregateID, "aggregate-id", "", "Only deliver events of this aggregate (requires --aggregate-type)")
+	createCmd.Flags().StringVar(&description, "description", "", "Free-form description")
+	createCmd.Flags().StringVar(&secret, "secret", "", "Signing secret (generated when omitted)")
+	_ = createCmd.MarkFlagRequired("url")
+
+	// List subscriptions
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhook subscriptions",
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			subs, err := newWebhookUseCase(pool).ListSubscriptions(ctx, 100, 0)
+			if err != nil {
+				fmt.Printf("❌ Failed to list webhooks: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(dto.WebhooksFromDomain(subs))
+				return
+			}
+
+			fmt.Printf("%-28s %-40s %-30s %-7s\n", "ID", "URL", "EVENTS", "ACTIVE")
+			fmt.Println("-------------------------------------------------------------------------------------------------------------")
+			for _, s := range subs {
+				active := "yes"
+				if !s.Active {
+					active = "no"
+				}
+
+				eventTypes := "*"
+				if len(s.EventTypes) > 0 {
+					eventTypes = strings.Join(s.EventTypes, ",")
+				}
+
+				fmt.Printf("%-28s %-40s %-30s %-7s\n", s.ID, truncate(s.URL, 40), truncate(eventTypes, 30), active)
+			}
+		},
+	}
+
+	// Get subscription
+	getCmd := &cobra.Command{
+		Use:   "get [webhook-id]",
+		Short: "Show a webhook subscription",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			sub, err := newWebhookUseCase(pool).GetSubscription(ctx, args[0])
+			if err != nil {
+				fmt.Printf("❌ Failed to get webhook: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(dto.WebhookFromDomain(sub))
+				return
+			}
+
+			fmt.Printf("ID:          %s\n", sub.ID)
+			fmt.Printf("URL:         %s\n", sub.URL)
+			fmt.Printf("Events:      %s\n", strings.Join(sub.EventTypes, ", "))
+			if sub.AggregateType != nil {
+				fmt.Printf("Aggregate:   %s", *sub.AggregateType)
+				if sub.AggregateID != nil {
+					fmt.Printf(" %s", *sub.AggregateID)
+				}
+				fmt.Println()
+			}
+			fmt.Printf("Description: %s\n", sub.Description)
+			fmt.Printf("Active:      %v\n", sub.Active)
+			fmt.Printf("Created:     %s\n", sub.CreatedAt.Format(time.RFC3339))
+		},
+	}
+
+	// Update subscription
+	updateCmd := &cobra.Command{
+		Use:   "update [webhook-id]",
+		Short: "Change a webhook subscription; only the flags given are changed",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			var input usecase.UpdateWebhookSubscriptionInput
+			flags := cmd.Flags()
+			if flags.Changed("url") {
+				input.URL = &url
+			}
+			if flags.Changed("event") {
+				input.EventTypes = &events
+			}
+			if flags.Changed("aggregate-type") {
+				input.AggregateType = &aggregateType
+			}
+			if flags.Changed("aggregate-id") {
+				input.AggregateID = &aggregateID
+			}
+			if flags.Changed("description") {
+				input.Description = &description
+			}
+
+			sub, err := newWebhookUseCase(pool).UpdateSubscription(ctx, args[0], input)
+			if err != nil {
+				fmt.Printf("❌ Failed to update webhook: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(dto.WebhookFromDomain(sub))
+			} else {
+				fmt.Printf("✅ Webhook updated: %s\n", sub.ID)
+			}
+		},
+	}
+	updateCmd.Flags().StringVar(&url, "url", "", "Endpoint deliveries are POSTed to")
+	updateCmd.Flags().StringArrayVar(&events, "event", nil, "Replace the event type filter (repeatable)")
+	updateCmd.Flags().StringVar(&aggregateType, "aggregate-type", "", "Aggregate type filter; empty clears it")
+	updateCmd.Flags().StringVar(&aggregateID, "aggregate-id", "", "Aggregate ID filter; empty clears it")
+	updateCmd.Flags().StringVar(&description, "description", "", "Free-form description")
+
+	setActive := func(use, short string, active bool) *cobra.Command {
+		return &cobra.Command{
+			Use:   use + " [webhook-id]",
+			Short: short,
+			Args:  cobra.ExactArgs(1),
+			Run: func(cmd *cobra.Command, args []string) {
+				ctx := context.Background()
+				pool := mustConnectDB(ctx)
+				defer pool.Close()
+
+				input := usecase.UpdateWebhookSubscriptionInput{Active: &active}
+				if _, err := newWebhookUseCase(pool).UpdateSubscription(ctx, args[0], input); err != nil {
+					fmt.Printf("❌ Failed to %s webhook: %v\n", use, err)
+					os.Exit(1)
+				}
+				fmt.Printf("✅ Webhook %sd: %s\n", use, args[0])
+			},
+		}
+	}
+
+	// Delete subscription
+	deleteCmd := &cobra.Command{
+		Use:   "delete [webhook-id]",
+		Short: "Delete a webhook subscription and its deliveries",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			if err := newWebhookUseCase(pool).DeleteSubscription(ctx, args[0]); err != nil {
+				fmt.Printf("❌ Failed to delete webhook: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("✅ Webhook deleted: %s\n", args[0])
+		},
+	}
+
+	// List deliveries
+	var status string
+	deliveriesCmd := &cobra.Command{
+		Use:   "deliveries [webhook-id]",
+		Short: "List a webhook's deliveries, newest first",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			deliveries, err := newWebhookUseCase(pool).ListDeliveries(ctx, args[0], domain.WebhookDeliveryStatus(status), 100, 0)
+			if err != nil {
+				fmt.Printf("❌ Failed to list deliveries: %v\n", err)
+				os.Exit(1)
+			}
+
+			if jsonOutput {
+				printJSON(dto.WebhookDeliveriesFromDomain(deliveries))
+				return
+			}
+
+			fmt.Printf("%-28s %-28s %-22s %-14s %-8s %-30s\n", "ID", "EVENT", "TYPE", "STATUS", "TRIES", "LAST ERROR")
+			fmt.Println("--------------------------------------------------------------------------------------------------------------------------------")
+			for _, d := range deliveries {
+				fmt.Printf("%-28s %-28s %-22s %-14s %-8d %-30s\n",
+					d.ID, d.EventID, truncate(d.EventType, 22), d.Status, d.Attempts, truncate(d.LastError, 30))
+			}
+		},
+	}
+	deliveriesCmd.Flags().StringVar(&status, "status", "", "Only list pending, delivered or dead_lettered deliveries")
+
+	// Redeliver
+	redeliverCmd := &cobra.Command{
+		Use:   "redeliver [webhook-id] [delivery-id]",
+		Short: "Queue a delivery to be sent again now",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := context.Background()
+			pool := mustConnectDB(ctx)
+			defer pool.Close()
+
+			delivery, err := newWebhookUseCase(pool).Redeliver(ctx, args[0], args[1])
+			if err != nil {
+				fmt.Printf("❌ Failed to redeliver: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("✅ Delivery queued: %s (sent by the server's dispatcher)\n", delivery.ID)
+		},
+	}
+
+	cmd.AddCommand(createCmd, listCmd, getCmd, updateCmd,
+		setActive("enable", "Enable a webhook subscription", true),
+		setActive("disable", "Disable a webhook subscription", false),
+		deleteCmd, deliveriesCmd, redeliverCmd)
+	return cmd
+}
+
+// ============ STATEMENT COMMAND ============
+
+func statementCmd() *cobra.Command {
+	var from, to, format, output string
+	cmd := &cobra.Command{
+		Use:   "statement [account-id]",
+		Short: "Generate an account statement (json, csv or camt053)",
+		Args:  cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := context.Background()
+
+			f, err := statement.ParseFormat(format)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				os.Exit(1)
+			}
+
+			fromTime, err := statement.ParseTime(from)
+			if err != nil {
+				fmt.Printf("❌ Invalid --from (expected YYYY-MM-DD or RFC3339): %v\n", err)
+				os.Exit(1)
+			}
+
+			toTime, err := statement.ParseTime(to)
+			if err != nil {
+				fmt.Printf("❌ Invalid --to (expected YYYY-MM-DD or RFC3339): %v\n", err)
+				os.Exit(1)
+			}
+
 			pool := mustConnectDB(ctx)
 			defer pool.Close()
-			listDeadLetters(ctx, pool)
+
+			statementUC := usecase.NewStatementUseCase(
+				postgres.NewAccountRepository(pool),
+				postgres.NewEntryRepository(pool),
+				postgres.NewTransferRepository(pool),
+			)
+
+			stmt, err := statementUC.GenerateStatement(ctx, usecase.GenerateStatementInput{
+				AccountID: args[0],
+				From:      fromTime,
+				To:        toTime,
+			})
+			if err != nil {
+				fmt.Printf("❌ Failed to generate statement: %v\n", err)
+				os.Exit(1)
+			}
+
+			if output == "" || output == "-" {
+				if err := statement.Write(os.Stdout, f, stmt); err != nil {
+					fmt.Printf("❌ Failed to write statement: %v\n", err)
+					os.Exit(1)
+				}
+				return
+			}
+
+			file, err := os.Create(output)
+			if err != nil {
+				fmt.Printf("❌ Failed to create %s: %v\n", output, err)
+				os.Exit(1)
+			}
+			defer file.Close()
+
+			if err := statement.Write(file, f, stmt); err != nil {
+				fmt.Printf("❌ Failed to write statement: %v\n", err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("✅ Statement written to %s (%d entries)\n", output, len(stmt.Lines))
+			fmt.Printf("   Opening: %s %s\n", stmt.OpeningBalance.String(), stmt.Currency)
+			fmt.Printf("   Closing: %s %s\n", stmt.ClosingBalance.String(), stmt.Currency)
 		},
 	}
+	cmd.Flags().StringVar(&from, "from", "", "Period start, inclusive: YYYY-MM-DD or RFC3339 (required)")
+	cmd.Flags().StringVar(&to, "to", "", "Period end, exclusive: YYYY-MM-DD or RFC3339 (required)")
+	cmd.Flags().StringVar(&format, "format", string(statement.FormatJSON), "Output format: json, csv or camt053")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (default: stdout)")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to")
 
-	cmd.AddCommand(deadLettersCmd)
 	return cmd
 }
 
@@ -708,6 +2444,27 @@ func mustConnectDB(ctx context.Context) *pgxpool.Pool {
 	return pool
 }
 
+// newAccrualUseCase builds an AccrualUseCase that posts through a
+// TransferUseCase, exactly as the server's scheduler does.
+func newAccrualUseCase(pool *pgxpool.Pool) *usecase.AccrualUseCase {
+	idGen := postgres.NewULIDGenerator()
+	accountRepo := postgres.NewAccountRepository(pool)
+	entryRepo := postgres.NewEntryRepository(pool)
+
+	transferUC := usecase.NewTransferUseCase(
+		postgres.NewTxManager(pool),
+		accountRepo,
+		postgres.NewTransferRepository(pool),
+		entryRepo,
+		postgres.NewOutboxRepository(pool),
+		postgres.NewAuditRepository(pool),
+		idGen,
+		nil,
+	).WithRetrier(postgres.NewRetrier())
+
+	return usecase.NewAccrualUseCase(postgres.NewAccrualRepository(pool), accountRepo, entryRepo, transferUC, idGen)
+}
+
 func createUser(ctx context.Context, pool *pgxpool.Pool, email, name, password, role string) error {
 	hashedPassword, err := bcryptGenerate([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -731,30 +2488,31 @@ func createUser(ctx context.Context, pool *pgxpool.Pool, email, name, password,
 	return userRepo.Create(ctx, user)
 }
 
-func listDeadLetters(ctx context.Context, pool *pgxpool.Pool) {
-	outboxRepo := postgres.NewOutboxRepository(pool)
-
-	events, err := outboxRepo.GetDeadLettered(ctx, 100, 0)
+func listDeadLetters(ctx context.Context, outboxUC *usecase.OutboxUseCase, cursor string, limit int) {
+	result, err := outboxUC.ListDeadLetteredCursor(ctx, cursor, limit)
 	if err != nil {
 		fmt.Printf("❌ Failed to list dead letters: %v\n", err)
 		os.Exit(1)
 	}
 
 	if jsonOutput {
-		printJSON(events)
+		printJSON(result)
 		return
 	}
 
-	if len(events) == 0 {
+	if len(result.Events) == 0 {
 		fmt.Println("✅ No dead-lettered outbox events")
 		return
 	}
 
-	fmt.Printf("Found %d dead-lettered event(s):\n", len(events))
-	for _, e := range events {
+	fmt.Printf("Found %d dead-lettered event(s):\n", len(result.Events))
+	for _, e := range result.Events {
 		fmt.Printf("  %s  %s/%s  %s  attempts=%d  last_error=%s\n",
 			e.ID, e.AggregateType, e.AggregateID, e.EventType, e.Attempts, truncate(e.LastError, 80))
 	}
+	if result.NextCursor != "" {
+		fmt.Printf("\nNext page: --cursor %s\n", result.NextCursor)
+	}
 }
 
 type auditChainBreak struct {
@@ -829,6 +2587,36 @@ func checkConsistency(pool *pgxpool.Pool) {
 	}
 }
 
+// mustParseOptionalTime parses a YYYY-MM-DD or RFC 3339 flag value,
+// returning nil when it is empty.
+func mustParseOptionalTime(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+
+	t, err := statement.ParseTime(s)
+	if err != nil {
+		fmt.Printf("❌ Invalid time %q: %v\n", s, err)
+		os.Exit(1)
+	}
+
+	return &t
+}
+
+func mustParseOptionalDecimal(s string) *decimal.Decimal {
+	if s == "" {
+		return nil
+	}
+
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		fmt.Printf("❌ Invalid amount %q: %v\n", s, err)
+		os.Exit(1)
+	}
+
+	return &d
+}
+
 func truncate(s string, n int) string {
 	if len(s) <= n {
 		return s
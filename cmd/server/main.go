@@ -14,8 +14,11 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/iho/goledger/internal/adapter/graphql"
+	"github.com/iho/goledger/internal/adapter/grpc/gateway"
 	grpcMiddleware "github.com/iho/goledger/internal/adapter/grpc/middleware"
 	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
 	grpcServer "github.com/iho/goledger/internal/adapter/grpc/server"
@@ -24,15 +27,20 @@ import (
 	postgresRepo "github.com/iho/goledger/internal/adapter/repository/postgres"
 	redisRepo "github.com/iho/goledger/internal/adapter/repository/redis"
 	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/accrual"
 	"github.com/iho/goledger/internal/infrastructure/auth"
 	"github.com/iho/goledger/internal/infrastructure/config"
 	"github.com/iho/goledger/internal/infrastructure/eventpublisher"
+	"github.com/iho/goledger/internal/infrastructure/eventstream"
 	"github.com/iho/goledger/internal/infrastructure/logger"
 	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/infrastructure/outboxretention"
 	"github.com/iho/goledger/internal/infrastructure/postgres"
+	"github.com/iho/goledger/internal/infrastructure/projector"
 	"github.com/iho/goledger/internal/infrastructure/reconciliation"
 	"github.com/iho/goledger/internal/infrastructure/redis"
 	"github.com/iho/goledger/internal/infrastructure/tracing"
+	"github.com/iho/goledger/internal/infrastructure/webhook"
 	"github.com/iho/goledger/internal/usecase"
 )
 
@@ -117,6 +125,11 @@ func run() int {
 	outboxRepo := postgresRepo.NewOutboxRepository(pool)
 	auditRepo := postgresRepo.NewAuditRepository(pool)
 	userRepo := postgresRepo.NewUserRepository(pool)
+	accrualRepo := postgresRepo.NewAccrualRepository(pool)
+	feePolicyRepo := postgresRepo.NewFeePolicyRepository(pool)
+	webhookRepo := postgresRepo.NewWebhookRepository(pool)
+	reportingRepo := postgresRepo.NewReportingRepository(pool)
+	outboxRetentionRepo := postgresRepo.NewOutboxRetentionRepository(pool)
 	idempotencyStore := redisRepo.NewIdempotencyStore(redisClient)
 	idGen := postgresRepo.NewULIDGenerator()
 
@@ -124,12 +137,31 @@ func run() int {
 	retrier := postgresRepo.NewRetrier()
 	accountUC := usecase.NewAccountUseCase(txManager, accountRepo, auditRepo, idGen, m)
 	transferUC := usecase.NewTransferUseCase(txManager, accountRepo, transferRepo, entryRepo, outboxRepo, auditRepo, idGen, m).
-		WithRetrier(retrier)
+		WithRetrier(retrier).
+		WithFeePolicies(feePolicyRepo)
 	entryUC := usecase.NewEntryUseCase(entryRepo)
 	ledgerUC := usecase.NewLedgerUseCase(ledgerRepo)
 	holdUC := usecase.NewHoldUseCase(txManager, accountRepo, holdRepo, transferRepo, entryRepo, outboxRepo, auditRepo, idGen, m)
-	userUC := usecase.NewUserUseCase(userRepo)
+	userUC := usecase.NewUserUseCase(txManager, userRepo, auditRepo, idGen)
 	reconciliationUC := usecase.NewReconciliationUseCase(accountRepo, entryRepo, ledgerRepo)
+	accrualUC := usecase.NewAccrualUseCase(accrualRepo, accountRepo, entryRepo, transferUC, idGen)
+	statementUC := usecase.NewStatementUseCase(accountRepo, entryRepo, transferRepo)
+	outboxUC := usecase.NewOutboxUseCase(txManager, outboxRepo, auditRepo, idGen)
+	webhookUC := usecase.NewWebhookUseCase(webhookRepo, idGen).
+		WithSender(webhook.NewHTTPSender(cfg.WebhookTimeout), usecase.WebhookRetryPolicy{
+			MaxAttempts: cfg.WebhookMaxAttempts,
+			BackoffBase: cfg.WebhookBackoffBase,
+			BackoffMax:  cfg.WebhookBackoffMax,
+		})
+	eventStreamUC := usecase.NewEventStreamUseCase(outboxRepo)
+	reportingUC := usecase.NewReportingUseCase(txManager, reportingRepo)
+	var outboxArchiver usecase.OutboxPartitionArchiver
+	if cfg.OutboxArchive == string(domain.OutboxArchiveFile) {
+		outboxArchiver = outboxretention.NewFileArchiver(cfg.OutboxArchiveDir)
+	}
+	outboxRetentionUC := usecase.NewOutboxRetentionUseCase(txManager, outboxRetentionRepo, outboxArchiver)
+	cloudEvents := domain.CloudEventOptions{Source: cfg.EventSource, SchemaBaseURL: cfg.EventSchemaBaseURL}
+	webhookUC.WithCloudEvents(cloudEvents)
 
 	// Initialize handlers
 	accountHandler := handler.NewAccountHandler(accountUC)
@@ -137,42 +169,134 @@ func run() int {
 	entryHandler := handler.NewEntryHandler(entryUC)
 	ledgerHandler := handler.NewLedgerHandler(ledgerUC)
 	holdHandler := handler.NewHoldHandler(holdUC)
+	statementHandler := handler.NewStatementHandler(statementUC)
+	webhookHandler := handler.NewWebhookHandler(webhookUC)
+	outboxHandler := handler.NewOutboxHandler(outboxUC)
+	reportingHandler := handler.NewReportingHandler(reportingUC)
+	var eventStreamHandler *handler.EventStreamHandler
+	if cfg.EventStreamEnabled {
+		eventStreamHandler = handler.NewEventStreamHandler(eventStreamUC)
+	}
+	eventSchemaHandler := handler.NewEventSchemaHandler(cloudEvents)
 	healthHandler := handler.NewHealthHandler(pool, redisClient)
 
 	// Create JWT manager for authentication
 	jwtManager := auth.NewJWTManager(cfg.JWTSecret, cfg.JWTExpiration)
+	authenticator := auth.NewAuthenticator(jwtManager, userUC, auth.DefaultUserCacheTTL)
 	authHandler := handler.NewAuthHandler(jwtManager, userUC).WithAudit(auditRepo, idGen)
 	auditHandler := handler.NewAuditHandler(auditRepo)
+	userHandler := handler.NewUserHandler(userUC)
+
+	// Serve the gRPC API as HTTP/JSON under /v1 by dialing our own gRPC
+	// server, so gateway calls pass its auth, RBAC and idempotency
+	// interceptors. The client connects lazily, once the server is up.
+	grpcPort := resolveGRPCPort()
+	var gatewayHandler http.Handler
+	if cfg.GatewayEnabled {
+		gatewayConn, err := grpc.NewClient("localhost:"+grpcPort,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		)
+		if err != nil {
+			l.Error("failed to create gateway client", "error", err)
+			return 1
+		}
+		defer gatewayConn.Close()
+
+		gatewayHandler, err = gateway.NewHandler(context.Background(), gatewayConn)
+		if err != nil {
+			l.Error("failed to create gateway", "error", err)
+			return 1
+		}
+	}
+
+	var graphqlHandler http.Handler
+	if cfg.GraphQLEnabled {
+		graphqlHandler, err = graphql.NewHandler(graphql.Config{
+			Accounts:    accountUC,
+			Transfers:   transferUC,
+			Entries:     entryUC,
+			Holds:       holdUC,
+			Audit:       auditRepo,
+			AuthEnabled: cfg.AuthEnabled,
+			MaxDepth:    cfg.GraphQLMaxDepth,
+			MaxCost:     cfg.GraphQLMaxCost,
+		})
+		if err != nil {
+			l.Error("failed to create graphql handler", "error", err)
+			return 1
+		}
+	}
 
 	// Create router
 	router := httpAdapter.NewRouter(httpAdapter.RouterConfig{
-		AccountHandler:   accountHandler,
-		TransferHandler:  transferHandler,
-		EntryHandler:     entryHandler,
-		HealthHandler:    healthHandler,
-		LedgerHandler:    ledgerHandler,
-		HoldHandler:      holdHandler,
-		AuthHandler:      authHandler,
-		AuditHandler:     auditHandler,
-		IdempotencyStore: idempotencyStore,
-		Logger:           l,
-		JWTManager:       jwtManager,
-		AuthEnabled:      cfg.AuthEnabled,
+		AccountHandler:     accountHandler,
+		TransferHandler:    transferHandler,
+		EntryHandler:       entryHandler,
+		HealthHandler:      healthHandler,
+		LedgerHandler:      ledgerHandler,
+		HoldHandler:        holdHandler,
+		AuthHandler:        authHandler,
+		AuditHandler:       auditHandler,
+		UserHandler:        userHandler,
+		StatementHandler:   statementHandler,
+		WebhookHandler:     webhookHandler,
+		OutboxHandler:      outboxHandler,
+		ReportingHandler:   reportingHandler,
+		EventStreamHandler: eventStreamHandler,
+		EventSchemaHandler: eventSchemaHandler,
+		GatewayHandler:     gatewayHandler,
+		GraphQLHandler:     graphqlHandler,
+		IdempotencyStore:   idempotencyStore,
+		Logger:             l,
+		Authenticator:      authenticator,
+		AuthEnabled:        cfg.AuthEnabled,
 	})
 
 	// Create event publisher worker
+	publisher, closePublisher, err := newOutboxPublisher(cfg, cloudEvents, l)
+	if err != nil {
+		l.Error("failed to create outbox publisher", "error", err)
+		return 1
+	}
+	defer closePublisher()
+
+	// Queue webhook deliveries before handing the event to the primary sink,
+	// so a retried event never skips its webhooks.
+	if cfg.WebhooksEnabled {
+		publisher = eventpublisher.NewFanoutPublisher(eventpublisher.PublisherFunc(webhookUC.EnqueueDeliveries), publisher)
+	}
+
+	// Start event publisher in background
+	publisherCtx, cancelPublisher := context.WithCancel(context.Background())
+	defer cancelPublisher()
+
+	// Wake the publisher on NOTIFY from the outbox insert trigger; polling
+	// stays on as a fallback.
+	var outboxWakeups <-chan struct{}
+	if cfg.OutboxNotify {
+		outboxListener := postgres.NewListener(pool, postgres.OutboxChannel, l)
+		outboxWakeups = outboxListener.C()
+
+		go func() {
+			if err := outboxListener.Run(publisherCtx); err != nil && !errors.Is(err, context.Canceled) {
+				l.Error("outbox listener stopped with error", "error", err)
+			}
+		}()
+	}
+
 	eventPublisher := eventpublisher.NewEventPublisher(eventpublisher.Config{
 		OutboxRepo:  outboxRepo,
-		Publisher:   eventpublisher.NewLogPublisher(l),
+		Publisher:   publisher,
 		Logger:      l,
 		Metrics:     m,
+		Interval:    cfg.OutboxPollInterval,
+		Wakeups:     outboxWakeups,
 		MaxAttempts: cfg.OutboxMaxAttempts,
+		Workers:     cfg.OutboxWorkers,
+		Lease:       cfg.OutboxLease,
 	})
 
-	// Start event publisher in background
-	publisherCtx, cancelPublisher := context.WithCancel(context.Background())
-	defer cancelPublisher()
-
 	go func() {
 		if err := eventPublisher.Start(publisherCtx); err != nil && !errors.Is(err, context.Canceled) {
 			l.Error("event publisher stopped with error", "error", err)
@@ -200,6 +324,109 @@ func run() int {
 		}()
 	}
 
+	// Start scheduled interest/fee accruals in background (0 interval disables it)
+	var cancelAccrual context.CancelFunc
+	if cfg.AccrualInterval > 0 {
+		accrualScheduler := accrual.NewScheduler(accrual.Config{
+			AccrualUC:   accrualUC,
+			Logger:      l,
+			Metrics:     m,
+			Interval:    cfg.AccrualInterval,
+			CatchUpDays: cfg.AccrualCatchUpDays,
+		})
+
+		var accrualCtx context.Context
+		accrualCtx, cancelAccrual = context.WithCancel(context.Background())
+
+		go func() {
+			if err := accrualScheduler.Start(accrualCtx); err != nil && !errors.Is(err, context.Canceled) {
+				l.Error("accrual scheduler stopped with error", "error", err)
+			}
+		}()
+	}
+
+	// Start the reporting projector in background (0 interval disables it)
+	var cancelProjector context.CancelFunc
+	if cfg.ProjectorInterval > 0 {
+		projectorScheduler := projector.NewScheduler(projector.Config{
+			ReportingUC: reportingUC,
+			Logger:      l,
+			Metrics:     m,
+			Interval:    cfg.ProjectorInterval,
+			BatchSize:   cfg.ProjectorBatchSize,
+		})
+
+		var projectorCtx context.Context
+		projectorCtx, cancelProjector = context.WithCancel(context.Background())
+
+		go func() {
+			if err := projectorScheduler.Start(projectorCtx); err != nil && !errors.Is(err, context.Canceled) {
+				l.Error("reporting projector stopped with error", "error", err)
+			}
+		}()
+	}
+
+	// Start outbox retention in background (0 interval disables it)
+	var cancelOutboxRetention context.CancelFunc
+	if cfg.OutboxRetentionInterval > 0 {
+		retentionScheduler := outboxretention.NewScheduler(outboxretention.Config{
+			RetentionUC:   outboxRetentionUC,
+			Logger:        l,
+			Metrics:       m,
+			Mode:          domain.OutboxArchiveMode(cfg.OutboxArchive),
+			Interval:      cfg.OutboxRetentionInterval,
+			RetentionDays: cfg.OutboxRetentionDays,
+		})
+
+		var retentionCtx context.Context
+		retentionCtx, cancelOutboxRetention = context.WithCancel(context.Background())
+
+		go func() {
+			if err := retentionScheduler.Start(retentionCtx); err != nil && !errors.Is(err, context.Canceled) {
+				l.Error("outbox retention stopped with error", "error", err)
+			}
+		}()
+	}
+
+	// Start webhook delivery dispatcher in background
+	var cancelWebhooks context.CancelFunc
+	if cfg.WebhooksEnabled {
+		webhookDispatcher := webhook.NewDispatcher(webhook.Config{
+			WebhookUC: webhookUC,
+			Logger:    l,
+			Metrics:   m,
+			Interval:  cfg.WebhookPollInterval,
+		})
+
+		var webhookCtx context.Context
+		webhookCtx, cancelWebhooks = context.WithCancel(context.Background())
+
+		go func() {
+			if err := webhookDispatcher.Start(webhookCtx); err != nil && !errors.Is(err, context.Canceled) {
+				l.Error("webhook dispatcher stopped with error", "error", err)
+			}
+		}()
+	}
+
+	// Feed the live event stream from the outbox in background
+	var cancelEventStream context.CancelFunc
+	if cfg.EventStreamEnabled {
+		eventStreamFeeder := eventstream.NewFeeder(eventstream.Config{
+			StreamUC: eventStreamUC,
+			Logger:   l,
+			Interval: cfg.EventStreamPollInterval,
+		})
+
+		var eventStreamCtx context.Context
+		eventStreamCtx, cancelEventStream = context.WithCancel(context.Background())
+
+		go func() {
+			if err := eventStreamFeeder.Start(eventStreamCtx); err != nil && !errors.Is(err, context.Canceled) {
+				l.Error("event stream feeder stopped with error", "error", err)
+			}
+		}()
+	}
+
 	// Create HTTP server with timeouts. otelhttp.NewHandler wraps the whole
 	// router with one span per request; a no-op when tracing is disabled.
 	httpServer := &http.Server{
@@ -214,22 +441,37 @@ func run() int {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		grpcMiddleware.IdempotencyInterceptor(idempotencyStore),
 	}
+	var streamInterceptors []grpc.StreamServerInterceptor
 	if cfg.AuthEnabled {
 		unaryInterceptors = append(unaryInterceptors,
-			grpcMiddleware.AuthInterceptor(jwtManager),
+			grpcMiddleware.AuthInterceptor(authenticator),
 			grpcMiddleware.MethodRoleInterceptor(grpcMethodRoles),
 		)
+		streamInterceptors = append(streamInterceptors,
+			grpcMiddleware.StreamAuthInterceptor(authenticator),
+			grpcMiddleware.StreamMethodRoleInterceptor(grpcMethodRoles),
+		)
 	}
 
 	grpcSrv := grpc.NewServer(
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
 	// Register gRPC services
 	pb.RegisterAccountServiceServer(grpcSrv, grpcServer.NewAccountServer(accountUC))
 	pb.RegisterTransferServiceServer(grpcSrv, grpcServer.NewTransferServer(transferUC))
 	pb.RegisterHoldServiceServer(grpcSrv, grpcServer.NewHoldServer(holdUC))
+	pb.RegisterStatementServiceServer(grpcSrv, grpcServer.NewStatementServer(statementUC))
+	pb.RegisterWebhookServiceServer(grpcSrv, grpcServer.NewWebhookServer(webhookUC))
+	pb.RegisterEntryServiceServer(grpcSrv, grpcServer.NewEntryServer(entryUC))
+	pb.RegisterLedgerServiceServer(grpcSrv, grpcServer.NewLedgerServer(reconciliationUC))
+	pb.RegisterAuditServiceServer(grpcSrv, grpcServer.NewAuditServer(auditRepo))
+	pb.RegisterUserServiceServer(grpcSrv, grpcServer.NewUserServer(userUC))
+	if cfg.EventStreamEnabled {
+		pb.RegisterEventServiceServer(grpcSrv, grpcServer.NewEventServer(eventStreamUC))
+	}
 
 	// Register reflection service for grpcurl
 	reflection.Register(grpcSrv)
@@ -246,7 +488,6 @@ func run() int {
 	}()
 
 	// Start gRPC server in goroutine
-	grpcPort := resolveGRPCPort()
 	go func() {
 		lis, err := net.Listen("tcp", ":"+grpcPort)
 		if err != nil {
@@ -281,6 +522,33 @@ func run() int {
 		l.Info("reconciliation scheduler stopped")
 	}
 
+	if cancelAccrual != nil {
+		cancelAccrual()
+		l.Info("accrual scheduler stopped")
+	}
+
+	if cancelProjector != nil {
+		cancelProjector()
+		l.Info("reporting projector stopped")
+	}
+
+	if cancelOutboxRetention != nil {
+		cancelOutboxRetention()
+		l.Info("outbox retention stopped")
+	}
+
+	if cancelWebhooks != nil {
+		cancelWebhooks()
+		l.Info("webhook dispatcher stopped")
+	}
+
+	// End open event streams, so they don't hold the servers' shutdown open
+	if cancelEventStream != nil {
+		cancelEventStream()
+		eventStreamUC.Close()
+		l.Info("event stream closed")
+	}
+
 	// Shutdown gRPC server
 	grpcSrv.GracefulStop()
 	l.Info("gRPC server stopped")
@@ -296,6 +564,56 @@ func run() int {
 	return 0
 }
 
+// newOutboxPublisher builds the Publisher selected by OUTBOX_PUBLISHER and
+// a func that releases it on shutdown.
+func newOutboxPublisher(cfg *config.Config, cloudEvents domain.CloudEventOptions, l *slog.Logger) (eventpublisher.Publisher, func(), error) {
+	switch cfg.OutboxPublisher {
+	case "kafka":
+		p, err := eventpublisher.NewKafkaPublisher(eventpublisher.KafkaConfig{
+			Brokers:               cfg.KafkaBrokers,
+			Topic:                 cfg.KafkaTopic,
+			ClientID:              cfg.KafkaClientID,
+			DisableIdempotence:    !cfg.KafkaIdempotent,
+			DeliveryTimeout:       cfg.KafkaDeliveryTimeout,
+			TLSEnabled:            cfg.KafkaTLSEnabled,
+			TLSCAFile:             cfg.KafkaTLSCAFile,
+			TLSCertFile:           cfg.KafkaTLSCertFile,
+			TLSKeyFile:            cfg.KafkaTLSKeyFile,
+			TLSInsecureSkipVerify: cfg.KafkaTLSSkipVerify,
+			SASLMechanism:         cfg.KafkaSASLMechanism,
+			SASLUsername:          cfg.KafkaSASLUsername,
+			SASLPassword:          cfg.KafkaSASLPassword,
+			CloudEvents:           cloudEvents,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		l.Info("publishing outbox events to kafka", "brokers", cfg.KafkaBrokers, "topic", cfg.KafkaTopic)
+
+		return p, p.Close, nil
+	case "nats":
+		p, err := eventpublisher.NewNATSPublisher(eventpublisher.NATSConfig{
+			URL:             cfg.NATSURL,
+			CredsFile:       cfg.NATSCredsFile,
+			SubjectPrefix:   cfg.NATSSubjectPrefix,
+			Stream:          cfg.NATSStream,
+			DuplicateWindow: cfg.NATSDuplicateWindow,
+			PublishTimeout:  cfg.NATSPublishTimeout,
+			CloudEvents:     cloudEvents,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		l.Info("publishing outbox events to nats", "url", cfg.NATSURL, "stream", cfg.NATSStream, "subject_prefix", cfg.NATSSubjectPrefix)
+
+		return p, p.Close, nil
+	default:
+		return eventpublisher.NewLogPublisher(l), func() {}, nil
+	}
+}
+
 func resolveGRPCPort() string {
 	if port := os.Getenv("GRPC_PORT"); port != "" {
 		return port
@@ -314,4 +632,26 @@ var grpcMethodRoles = map[string]domain.Role{
 	"/goledger.v1.HoldService/HoldFunds":               domain.RoleOperator,
 	"/goledger.v1.HoldService/VoidHold":                domain.RoleOperator,
 	"/goledger.v1.HoldService/CaptureHold":             domain.RoleOperator,
+	// Webhook subscriptions see every ledger event, so all of WebhookService,
+	// reads included, is admin-only like the /api/v1/webhooks routes.
+	"/goledger.v1.WebhookService/CreateWebhook":         domain.RoleAdmin,
+	"/goledger.v1.WebhookService/GetWebhook":            domain.RoleAdmin,
+	"/goledger.v1.WebhookService/ListWebhooks":          domain.RoleAdmin,
+	"/goledger.v1.WebhookService/UpdateWebhook":         domain.RoleAdmin,
+	"/goledger.v1.WebhookService/DeleteWebhook":         domain.RoleAdmin,
+	"/goledger.v1.WebhookService/ListWebhookDeliveries": domain.RoleAdmin,
+	"/goledger.v1.WebhookService/GetWebhookDelivery":    domain.RoleAdmin,
+	"/goledger.v1.WebhookService/RedeliverWebhook":      domain.RoleAdmin,
+	// The audit trail is admin-only like /api/v1/audit, and the
+	// reconciliation report walks every account's entries.
+	"/goledger.v1.AuditService/ListAuditLogs":                 domain.RoleAdmin,
+	"/goledger.v1.AuditService/GetResourceAuditLogs":          domain.RoleAdmin,
+	"/goledger.v1.LedgerService/GenerateReconciliationReport": domain.RoleAdmin,
+	// User management is admin-only like /api/v1/users; ChangePassword is
+	// left out so any authenticated user can change their own password.
+	"/goledger.v1.UserService/CreateUser": domain.RoleAdmin,
+	"/goledger.v1.UserService/GetUser":    domain.RoleAdmin,
+	"/goledger.v1.UserService/ListUsers":  domain.RoleAdmin,
+	"/goledger.v1.UserService/UpdateUser": domain.RoleAdmin,
+	"/goledger.v1.UserService/DeleteUser": domain.RoleAdmin,
 }
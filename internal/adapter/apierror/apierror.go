@@ -0,0 +1,226 @@
+// Package apierror is the one table that turns errors into what API
+// clients see: a stable machine-readable code, a title, and the HTTP status
+// and gRPC code each transport answers with. HTTP renders it as RFC 7807
+// problem+json, gRPC as a status carrying google.rpc.ErrorInfo and
+// BadRequest details.
+package apierror
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// Domain is the ErrorInfo domain of every error the ledger reports.
+const Domain = "goledger"
+
+// Spec is how one kind of error is reported to clients. Codes are part of
+// the API: clients branch on them, so they never change once published.
+type Spec struct {
+	Code       string
+	Title      string
+	HTTPStatus int
+	GRPCCode   codes.Code
+	// Detail, when set, replaces the error's own message, which may carry
+	// internals the client must not see.
+	Detail string
+}
+
+type entry struct {
+	err  error
+	spec Spec
+}
+
+// table maps every domain error, plus the context errors and BadField's,
+// to its spec. The
+// first entry err matches with errors.Is wins.
+var table = []entry{
+	// Accounts
+	{domain.ErrAccountNotFound, Spec{Code: "ACCOUNT_NOT_FOUND", Title: "Account not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInvalidAccountName, Spec{Code: "INVALID_ACCOUNT_NAME", Title: "Invalid account name", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidCurrency, Spec{Code: "INVALID_CURRENCY", Title: "Invalid currency", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrNegativeBalanceNotAllowed, Spec{Code: "NEGATIVE_BALANCE_NOT_ALLOWED", Title: "Negative balance not allowed", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition}},
+	{domain.ErrPositiveBalanceNotAllowed, Spec{Code: "POSITIVE_BALANCE_NOT_ALLOWED", Title: "Positive balance not allowed", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition}},
+	{domain.ErrVersionMismatch, Spec{Code: "VERSION_MISMATCH", Title: "Account version mismatch", HTTPStatus: http.StatusPreconditionFailed, GRPCCode: codes.FailedPrecondition}},
+
+	// Transfers
+	{domain.ErrTransferNotFound, Spec{Code: "TRANSFER_NOT_FOUND", Title: "Transfer not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrSameAccount, Spec{Code: "SAME_ACCOUNT", Title: "Transfer to the same account", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidAmount, Spec{Code: "INVALID_AMOUNT", Title: "Invalid amount", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrAmountTooSmall, Spec{Code: "AMOUNT_TOO_SMALL", Title: "Amount too small", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrAmountTooLarge, Spec{Code: "AMOUNT_TOO_LARGE", Title: "Amount too large", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrCurrencyMismatch, Spec{Code: "CURRENCY_MISMATCH", Title: "Currency mismatch", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrMetadataTooLarge, Spec{Code: "METADATA_TOO_LARGE", Title: "Metadata too large", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrTransferAlreadyReversed, Spec{Code: "TRANSFER_ALREADY_REVERSED", Title: "Transfer already reversed", HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition}},
+	{domain.ErrDuplicateIdempotencyKey, Spec{Code: "DUPLICATE_IDEMPOTENCY_KEY", Title: "Duplicate idempotency key", HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists}},
+	{domain.ErrIdempotencyKeyReused, Spec{Code: "IDEMPOTENCY_KEY_REUSED", Title: "Idempotency key reused", HTTPStatus: http.StatusUnprocessableEntity, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrRequestAlreadyProcessed, Spec{Code: "REQUEST_ALREADY_PROCESSED", Title: "Request already processed", HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists}},
+	{domain.ErrInvalidTransferSearch, Spec{Code: "INVALID_TRANSFER_SEARCH", Title: "Invalid transfer search", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+
+	// Holds
+	{domain.ErrHoldNotFound, Spec{Code: "HOLD_NOT_FOUND", Title: "Hold not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInsufficientFunds, Spec{Code: "INSUFFICIENT_FUNDS", Title: "Insufficient funds", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.FailedPrecondition}},
+	{domain.ErrHoldNotActive, Spec{Code: "HOLD_NOT_ACTIVE", Title: "Hold not active", HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition}},
+	{domain.ErrInvalidHoldFilter, Spec{Code: "INVALID_HOLD_FILTER", Title: "Invalid hold filter", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+
+	// Entries, listings and reports
+	{domain.ErrInvalidEntryExport, Spec{Code: "INVALID_ENTRY_EXPORT", Title: "Invalid entry export", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidCursor, Spec{Code: "INVALID_CURSOR", Title: "Invalid cursor", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidIDFormat, Spec{Code: "INVALID_ID_FORMAT", Title: "Invalid ID format", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidStatementPeriod, Spec{Code: "INVALID_STATEMENT_PERIOD", Title: "Invalid statement period", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidReportingPeriod, Spec{Code: "INVALID_REPORTING_PERIOD", Title: "Invalid reporting period", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+
+	// Fee policies and accrual rules
+	{domain.ErrFeePolicyNotFound, Spec{Code: "FEE_POLICY_NOT_FOUND", Title: "Fee policy not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInvalidFeePolicy, Spec{Code: "INVALID_FEE_POLICY", Title: "Invalid fee policy", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrAccrualRuleNotFound, Spec{Code: "ACCRUAL_RULE_NOT_FOUND", Title: "Accrual rule not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInvalidAccrualRule, Spec{Code: "INVALID_ACCRUAL_RULE", Title: "Invalid accrual rule", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+
+	// Outbox, events and webhooks
+	{domain.ErrOutboxEventNotFound, Spec{Code: "OUTBOX_EVENT_NOT_FOUND", Title: "Outbox event not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInvalidDeadLetterFilter, Spec{Code: "INVALID_DEAD_LETTER_FILTER", Title: "Invalid dead letter filter", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrOutboxLeaseLost, Spec{Code: "OUTBOX_LEASE_LOST", Title: "Outbox lease lost", HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted}},
+	// A malformed partition name or event payload is the ledger's own fault.
+	{domain.ErrInvalidOutboxPartition, Spec{Code: "INVALID_OUTBOX_PARTITION", Title: "Invalid outbox partition", HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal}},
+	{domain.ErrInvalidEventPayload, Spec{Code: "INVALID_EVENT_PAYLOAD", Title: "Invalid event payload", HTTPStatus: http.StatusInternalServerError, GRPCCode: codes.Internal}},
+	{domain.ErrEventSchemaNotFound, Spec{Code: "EVENT_SCHEMA_NOT_FOUND", Title: "Event schema not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInvalidEventStreamRequest, Spec{Code: "INVALID_EVENT_STREAM_REQUEST", Title: "Invalid event stream request", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrEventStreamCursorNotFound, Spec{Code: "EVENT_STREAM_CURSOR_NOT_FOUND", Title: "Event stream cursor not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	// Stream endings the client recovers from by resubscribing.
+	{domain.ErrEventStreamLagging, Spec{Code: "EVENT_STREAM_LAGGING", Title: "Event stream lagging", HTTPStatus: http.StatusConflict, GRPCCode: codes.Aborted}},
+	{domain.ErrEventStreamClosed, Spec{Code: "EVENT_STREAM_CLOSED", Title: "Event stream closed", HTTPStatus: http.StatusServiceUnavailable, GRPCCode: codes.Unavailable}},
+	{domain.ErrWebhookSubscriptionNotFound, Spec{Code: "WEBHOOK_SUBSCRIPTION_NOT_FOUND", Title: "Webhook subscription not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrWebhookDeliveryNotFound, Spec{Code: "WEBHOOK_DELIVERY_NOT_FOUND", Title: "Webhook delivery not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrInvalidWebhookSubscription, Spec{Code: "INVALID_WEBHOOK_SUBSCRIPTION", Title: "Invalid webhook subscription", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+
+	// Users and authentication
+	{domain.ErrUnauthorized, Spec{Code: "UNAUTHENTICATED", Title: "Authentication required", HTTPStatus: http.StatusUnauthorized, GRPCCode: codes.Unauthenticated}},
+	{domain.ErrInvalidToken, Spec{Code: "INVALID_TOKEN", Title: "Invalid token", HTTPStatus: http.StatusUnauthorized, GRPCCode: codes.Unauthenticated}},
+	{domain.ErrExpiredToken, Spec{Code: "EXPIRED_TOKEN", Title: "Expired token", HTTPStatus: http.StatusUnauthorized, GRPCCode: codes.Unauthenticated}},
+	{domain.ErrInsufficientRole, Spec{Code: "INSUFFICIENT_ROLE", Title: "Insufficient role", HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied}},
+	{domain.ErrUserNotFound, Spec{Code: "USER_NOT_FOUND", Title: "User not found", HTTPStatus: http.StatusNotFound, GRPCCode: codes.NotFound}},
+	{domain.ErrUserAlreadyExists, Spec{Code: "USER_ALREADY_EXISTS", Title: "User already exists", HTTPStatus: http.StatusConflict, GRPCCode: codes.AlreadyExists}},
+	{domain.ErrUserInactive, Spec{Code: "USER_INACTIVE", Title: "User inactive", HTTPStatus: http.StatusForbidden, GRPCCode: codes.PermissionDenied}},
+	{domain.ErrInvalidRole, Spec{Code: "INVALID_ROLE", Title: "Invalid role", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrInvalidEmail, Spec{Code: "INVALID_EMAIL", Title: "Invalid email", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrPasswordTooWeak, Spec{Code: "PASSWORD_TOO_WEAK", Title: "Password too weak", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrIncorrectPassword, Spec{Code: "INCORRECT_PASSWORD", Title: "Incorrect password", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+	{domain.ErrLastAdmin, Spec{Code: "LAST_ADMIN", Title: "Last admin", HTTPStatus: http.StatusConflict, GRPCCode: codes.FailedPrecondition}},
+
+	// Input a transport could not parse, reported with BadField
+	{errInvalidRequest, Spec{Code: "INVALID_REQUEST", Title: "Invalid request", HTTPStatus: http.StatusBadRequest, GRPCCode: codes.InvalidArgument}},
+
+	// Timeouts and cancellations
+	{context.DeadlineExceeded, Spec{Code: "DEADLINE_EXCEEDED", Title: "Deadline exceeded", HTTPStatus: http.StatusGatewayTimeout, GRPCCode: codes.DeadlineExceeded, Detail: "operation timed out"}},
+	{context.Canceled, Spec{Code: "CANCELED", Title: "Canceled", HTTPStatus: 499, GRPCCode: codes.Canceled, Detail: "operation was canceled"}},
+}
+
+// Internal is the spec of every error the table does not know, whose
+// message is never shown.
+var Internal = Spec{
+	Code:       "INTERNAL",
+	Title:      "Internal error",
+	HTTPStatus: http.StatusInternalServerError,
+	GRPCCode:   codes.Internal,
+	Detail:     "an internal error occurred",
+}
+
+// statusSpecs are the specs of errors raised by the transports themselves,
+// such as an unparsable body, which have no domain error behind them.
+var statusSpecs = map[int]Spec{
+	http.StatusBadRequest:          {Code: "INVALID_REQUEST", Title: "Invalid request", GRPCCode: codes.InvalidArgument},
+	http.StatusUnauthorized:        {Code: "UNAUTHENTICATED", Title: "Authentication required", GRPCCode: codes.Unauthenticated},
+	http.StatusForbidden:           {Code: "PERMISSION_DENIED", Title: "Permission denied", GRPCCode: codes.PermissionDenied},
+	http.StatusNotFound:            {Code: "NOT_FOUND", Title: "Not found", GRPCCode: codes.NotFound},
+	http.StatusConflict:            {Code: "CONFLICT", Title: "Conflict", GRPCCode: codes.Aborted},
+	http.StatusPreconditionFailed:  {Code: "PRECONDITION_FAILED", Title: "Precondition failed", GRPCCode: codes.FailedPrecondition},
+	http.StatusUnprocessableEntity: {Code: "UNPROCESSABLE", Title: "Unprocessable request", GRPCCode: codes.InvalidArgument},
+	http.StatusTooManyRequests:     {Code: "RATE_LIMITED", Title: "Rate limit exceeded", GRPCCode: codes.ResourceExhausted},
+	http.StatusServiceUnavailable:  {Code: "UNAVAILABLE", Title: "Service unavailable", GRPCCode: codes.Unavailable},
+}
+
+// Lookup returns the spec of the first table error err matches, and false
+// when there is none.
+func Lookup(err error) (Spec, bool) {
+	for _, e := range table {
+		if errors.Is(err, e.err) {
+			return e.spec, true
+		}
+	}
+
+	return Spec{}, false
+}
+
+// ForError returns err's spec, Internal when the table does not know it.
+func ForError(err error) Spec {
+	if spec, ok := Lookup(err); ok {
+		return spec
+	}
+
+	return Internal
+}
+
+// ForStatus returns the spec of a transport-level error answered with the
+// HTTP status, Internal for 5xx statuses it does not list.
+func ForStatus(status int) Spec {
+	spec, ok := statusSpecs[status]
+	if !ok {
+		if status < http.StatusInternalServerError {
+			spec = statusSpecs[http.StatusBadRequest]
+		} else {
+			spec = Internal
+		}
+	}
+	spec.HTTPStatus = status
+
+	return spec
+}
+
+// detail is the message a client sees for err under spec.
+func (s Spec) detail(err error) string {
+	if s.Detail != "" || err == nil {
+		return s.Detail
+	}
+
+	return err.Error()
+}
+
+// InvalidParam is one offending input field.
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// invalidParams lists the input fields err attributes itself to.
+func invalidParams(err error) []InvalidParam {
+	fieldErrs := domain.FieldErrors(err)
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+
+	out := make([]InvalidParam, len(fieldErrs))
+	for i, fe := range fieldErrs {
+		out[i] = InvalidParam{Name: fe.Field, Reason: fe.Err.Error()}
+	}
+
+	return out
+}
+
+// errInvalidRequest is the error behind BadField.
+var errInvalidRequest = errors.New("invalid request")
+
+type badFieldError struct{ reason string }
+
+func (e *badFieldError) Error() string { return e.reason }
+
+func (e *badFieldError) Is(target error) bool { return target == errInvalidRequest }
+
+// BadField reports an input field a transport could not parse, which no
+// domain error describes, as an INVALID_REQUEST attributed to field.
+func BadField(field, reason string) error {
+	return domain.NewFieldError(field, &badFieldError{reason: reason})
+}
@@ -0,0 +1,298 @@
+package apierror_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/domain"
+)
+
+type want struct {
+	err  error
+	code string
+	http int
+	grpc codes.Code
+}
+
+// domainErrors is every error the domain package declares, by name, with
+// how it must be reported. TestDomainErrorsListed keeps it complete.
+var domainErrors = map[string]want{
+	"ErrAccountNotFound":             {domain.ErrAccountNotFound, "ACCOUNT_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInvalidAccountName":          {domain.ErrInvalidAccountName, "INVALID_ACCOUNT_NAME", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidCurrency":             {domain.ErrInvalidCurrency, "INVALID_CURRENCY", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrNegativeBalanceNotAllowed":   {domain.ErrNegativeBalanceNotAllowed, "NEGATIVE_BALANCE_NOT_ALLOWED", http.StatusBadRequest, codes.FailedPrecondition},
+	"ErrPositiveBalanceNotAllowed":   {domain.ErrPositiveBalanceNotAllowed, "POSITIVE_BALANCE_NOT_ALLOWED", http.StatusBadRequest, codes.FailedPrecondition},
+	"ErrVersionMismatch":             {domain.ErrVersionMismatch, "VERSION_MISMATCH", http.StatusPreconditionFailed, codes.FailedPrecondition},
+	"ErrTransferNotFound":            {domain.ErrTransferNotFound, "TRANSFER_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrSameAccount":                 {domain.ErrSameAccount, "SAME_ACCOUNT", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidAmount":               {domain.ErrInvalidAmount, "INVALID_AMOUNT", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrAmountTooSmall":              {domain.ErrAmountTooSmall, "AMOUNT_TOO_SMALL", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrAmountTooLarge":              {domain.ErrAmountTooLarge, "AMOUNT_TOO_LARGE", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrCurrencyMismatch":            {domain.ErrCurrencyMismatch, "CURRENCY_MISMATCH", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrMetadataTooLarge":            {domain.ErrMetadataTooLarge, "METADATA_TOO_LARGE", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrTransferAlreadyReversed":     {domain.ErrTransferAlreadyReversed, "TRANSFER_ALREADY_REVERSED", http.StatusConflict, codes.FailedPrecondition},
+	"ErrDuplicateIdempotencyKey":     {domain.ErrDuplicateIdempotencyKey, "DUPLICATE_IDEMPOTENCY_KEY", http.StatusConflict, codes.AlreadyExists},
+	"ErrIdempotencyKeyReused":        {domain.ErrIdempotencyKeyReused, "IDEMPOTENCY_KEY_REUSED", http.StatusUnprocessableEntity, codes.InvalidArgument},
+	"ErrRequestAlreadyProcessed":     {domain.ErrRequestAlreadyProcessed, "REQUEST_ALREADY_PROCESSED", http.StatusConflict, codes.AlreadyExists},
+	"ErrInvalidTransferSearch":       {domain.ErrInvalidTransferSearch, "INVALID_TRANSFER_SEARCH", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrHoldNotFound":                {domain.ErrHoldNotFound, "HOLD_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInsufficientFunds":           {domain.ErrInsufficientFunds, "INSUFFICIENT_FUNDS", http.StatusBadRequest, codes.FailedPrecondition},
+	"ErrHoldNotActive":               {domain.ErrHoldNotActive, "HOLD_NOT_ACTIVE", http.StatusConflict, codes.FailedPrecondition},
+	"ErrInvalidHoldFilter":           {domain.ErrInvalidHoldFilter, "INVALID_HOLD_FILTER", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidEntryExport":          {domain.ErrInvalidEntryExport, "INVALID_ENTRY_EXPORT", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidCursor":               {domain.ErrInvalidCursor, "INVALID_CURSOR", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidIDFormat":             {domain.ErrInvalidIDFormat, "INVALID_ID_FORMAT", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidStatementPeriod":      {domain.ErrInvalidStatementPeriod, "INVALID_STATEMENT_PERIOD", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidReportingPeriod":      {domain.ErrInvalidReportingPeriod, "INVALID_REPORTING_PERIOD", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrFeePolicyNotFound":           {domain.ErrFeePolicyNotFound, "FEE_POLICY_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInvalidFeePolicy":            {domain.ErrInvalidFeePolicy, "INVALID_FEE_POLICY", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrAccrualRuleNotFound":         {domain.ErrAccrualRuleNotFound, "ACCRUAL_RULE_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInvalidAccrualRule":          {domain.ErrInvalidAccrualRule, "INVALID_ACCRUAL_RULE", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrOutboxEventNotFound":         {domain.ErrOutboxEventNotFound, "OUTBOX_EVENT_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInvalidDeadLetterFilter":     {domain.ErrInvalidDeadLetterFilter, "INVALID_DEAD_LETTER_FILTER", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrOutboxLeaseLost":             {domain.ErrOutboxLeaseLost, "OUTBOX_LEASE_LOST", http.StatusConflict, codes.Aborted},
+	"ErrInvalidOutboxPartition":      {domain.ErrInvalidOutboxPartition, "INVALID_OUTBOX_PARTITION", http.StatusInternalServerError, codes.Internal},
+	"ErrInvalidEventPayload":         {domain.ErrInvalidEventPayload, "INVALID_EVENT_PAYLOAD", http.StatusInternalServerError, codes.Internal},
+	"ErrEventSchemaNotFound":         {domain.ErrEventSchemaNotFound, "EVENT_SCHEMA_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInvalidEventStreamRequest":   {domain.ErrInvalidEventStreamRequest, "INVALID_EVENT_STREAM_REQUEST", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrEventStreamCursorNotFound":   {domain.ErrEventStreamCursorNotFound, "EVENT_STREAM_CURSOR_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrEventStreamLagging":          {domain.ErrEventStreamLagging, "EVENT_STREAM_LAGGING", http.StatusConflict, codes.Aborted},
+	"ErrEventStreamClosed":           {domain.ErrEventStreamClosed, "EVENT_STREAM_CLOSED", http.StatusServiceUnavailable, codes.Unavailable},
+	"ErrWebhookSubscriptionNotFound": {domain.ErrWebhookSubscriptionNotFound, "WEBHOOK_SUBSCRIPTION_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrWebhookDeliveryNotFound":     {domain.ErrWebhookDeliveryNotFound, "WEBHOOK_DELIVERY_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrInvalidWebhookSubscription":  {domain.ErrInvalidWebhookSubscription, "INVALID_WEBHOOK_SUBSCRIPTION", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrUnauthorized":                {domain.ErrUnauthorized, "UNAUTHENTICATED", http.StatusUnauthorized, codes.Unauthenticated},
+	"ErrInvalidToken":                {domain.ErrInvalidToken, "INVALID_TOKEN", http.StatusUnauthorized, codes.Unauthenticated},
+	"ErrExpiredToken":                {domain.ErrExpiredToken, "EXPIRED_TOKEN", http.StatusUnauthorized, codes.Unauthenticated},
+	"ErrInsufficientRole":            {domain.ErrInsufficientRole, "INSUFFICIENT_ROLE", http.StatusForbidden, codes.PermissionDenied},
+	"ErrUserNotFound":                {domain.ErrUserNotFound, "USER_NOT_FOUND", http.StatusNotFound, codes.NotFound},
+	"ErrUserAlreadyExists":           {domain.ErrUserAlreadyExists, "USER_ALREADY_EXISTS", http.StatusConflict, codes.AlreadyExists},
+	"ErrUserInactive":                {domain.ErrUserInactive, "USER_INACTIVE", http.StatusForbidden, codes.PermissionDenied},
+	"ErrInvalidRole":                 {domain.ErrInvalidRole, "INVALID_ROLE", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrInvalidEmail":                {domain.ErrInvalidEmail, "INVALID_EMAIL", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrPasswordTooWeak":             {domain.ErrPasswordTooWeak, "PASSWORD_TOO_WEAK", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrIncorrectPassword":           {domain.ErrIncorrectPassword, "INCORRECT_PASSWORD", http.StatusBadRequest, codes.InvalidArgument},
+	"ErrLastAdmin":                   {domain.ErrLastAdmin, "LAST_ADMIN", http.StatusConflict, codes.FailedPrecondition},
+}
+
+// TestDomainErrorsListed fails when the domain package declares an error
+// domainErrors, and so possibly the table, does not know.
+func TestDomainErrorsListed(t *testing.T) {
+	files, err := filepath.Glob("../../domain/*.go")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("domain sources not found: %v", err)
+	}
+
+	var declared []string
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					if strings.HasPrefix(name.Name, "Err") {
+						declared = append(declared, name.Name)
+					}
+				}
+			}
+		}
+	}
+
+	sort.Strings(declared)
+	for _, name := range declared {
+		if _, ok := domainErrors[name]; !ok {
+			t.Errorf("domain.%s has no expected API error; add it to the table and to domainErrors", name)
+		}
+	}
+	if len(declared) != len(domainErrors) {
+		t.Errorf("domain declares %d errors, domainErrors lists %d", len(declared), len(domainErrors))
+	}
+}
+
+func TestEveryDomainErrorIsMapped(t *testing.T) {
+	for name, w := range domainErrors {
+		t.Run(name, func(t *testing.T) {
+			// Domain errors usually reach the adapters wrapped.
+			err := fmt.Errorf("use case: %w", w.err)
+
+			spec, ok := apierror.Lookup(err)
+			if !ok {
+				t.Fatalf("%s is not in the table", name)
+			}
+			if spec.Code != w.code || spec.HTTPStatus != w.http || spec.GRPCCode != w.grpc {
+				t.Fatalf("expected %s/%d/%s, got %s/%d/%s", w.code, w.http, w.grpc, spec.Code, spec.HTTPStatus, spec.GRPCCode)
+			}
+			if spec.Title == "" {
+				t.Fatal("expected a title")
+			}
+
+			rr := httptest.NewRecorder()
+			apierror.ProblemFor(err).Write(rr)
+			if rr.Code != w.http {
+				t.Fatalf("expected HTTP %d, got %d", w.http, rr.Code)
+			}
+			var problem apierror.Problem
+			if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("decode problem: %v", err)
+			}
+			if problem.Code != w.code || problem.Type != "urn:goledger:error:"+w.code || problem.Detail != err.Error() {
+				t.Fatalf("unexpected problem %+v", problem)
+			}
+
+			st := apierror.Status(err)
+			if st.Code() != w.grpc || st.Message() != err.Error() {
+				t.Fatalf("unexpected status %s %q", st.Code(), st.Message())
+			}
+			if reason := errorInfoReason(st.Details()); reason != w.code {
+				t.Fatalf("expected ErrorInfo reason %s, got %q", w.code, reason)
+			}
+		})
+	}
+}
+
+func TestCodesAreUnique(t *testing.T) {
+	seen := map[string]string{}
+	for name, w := range domainErrors {
+		if other, ok := seen[w.code]; ok {
+			t.Errorf("%s and %s share code %s", name, other, w.code)
+		}
+		seen[w.code] = name
+	}
+}
+
+func TestContextAndUnknownErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   string
+		http   int
+		grpc   codes.Code
+		detail string
+	}{
+		{"deadline exceeded", fmt.Errorf("query: %w", context.DeadlineExceeded), "DEADLINE_EXCEEDED", http.StatusGatewayTimeout, codes.DeadlineExceeded, "operation timed out"},
+		{"canceled", context.Canceled, "CANCELED", 499, codes.Canceled, "operation was canceled"},
+		{"unknown", errors.New("pq: relation does not exist"), "INTERNAL", http.StatusInternalServerError, codes.Internal, "an internal error occurred"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problem := apierror.ProblemFor(tt.err)
+			if problem.Code != tt.code || problem.Status != tt.http || problem.Detail != tt.detail {
+				t.Fatalf("unexpected problem %+v", problem)
+			}
+
+			st := apierror.Status(tt.err)
+			if st.Code() != tt.grpc || st.Message() != tt.detail {
+				t.Fatalf("unexpected status %s %q", st.Code(), st.Message())
+			}
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	err := domain.NewFieldError("currency", fmt.Errorf("%w: XYZ is not a valid ISO 4217 currency code", domain.ErrInvalidCurrency))
+
+	problem := apierror.ProblemFor(err)
+	if problem.Code != "INVALID_CURRENCY" {
+		t.Fatalf("expected INVALID_CURRENCY, got %s", problem.Code)
+	}
+	if len(problem.InvalidParams) != 1 || problem.InvalidParams[0] != (apierror.InvalidParam{Name: "currency", Reason: err.Error()}) {
+		t.Fatalf("unexpected invalid params %+v", problem.InvalidParams)
+	}
+
+	var badRequest *errdetails.BadRequest
+	for _, d := range apierror.Status(err).Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			badRequest = br
+		}
+	}
+	if badRequest == nil || len(badRequest.GetFieldViolations()) != 1 || badRequest.GetFieldViolations()[0].GetField() != "currency" {
+		t.Fatalf("expected a currency field violation, got %v", badRequest)
+	}
+}
+
+func TestBadField(t *testing.T) {
+	err := apierror.BadField("amount", "invalid amount format")
+
+	if err.Error() != "invalid amount format" {
+		t.Fatalf("expected the reason as message, got %q", err.Error())
+	}
+
+	problem := apierror.ProblemFor(err)
+	if problem.Code != "INVALID_REQUEST" || problem.Status != http.StatusBadRequest {
+		t.Fatalf("unexpected problem %+v", problem)
+	}
+	if len(problem.InvalidParams) != 1 || problem.InvalidParams[0].Name != "amount" {
+		t.Fatalf("unexpected invalid params %+v", problem.InvalidParams)
+	}
+
+	if st := apierror.Status(err); st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %s", st.Code())
+	}
+}
+
+func TestForStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		code   string
+	}{
+		{http.StatusBadRequest, "INVALID_REQUEST"},
+		{http.StatusUnauthorized, "UNAUTHENTICATED"},
+		{http.StatusTooManyRequests, "RATE_LIMITED"},
+		{http.StatusServiceUnavailable, "UNAVAILABLE"},
+		{http.StatusRequestEntityTooLarge, "INVALID_REQUEST"},
+		{http.StatusBadGateway, "INTERNAL"},
+	}
+
+	for _, tt := range tests {
+		spec := apierror.ForStatus(tt.status)
+		if spec.Code != tt.code || spec.HTTPStatus != tt.status {
+			t.Errorf("ForStatus(%d) = %s/%d, expected %s", tt.status, spec.Code, spec.HTTPStatus, tt.code)
+		}
+	}
+}
+
+func TestProblemContentType(t *testing.T) {
+	rr := httptest.NewRecorder()
+	apierror.ProblemFor(domain.ErrHoldNotFound).Write(rr)
+
+	if ct := rr.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Fatalf("expected application/problem+json, got %s", ct)
+	}
+}
+
+func errorInfoReason(details []any) string {
+	for _, d := range details {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetDomain() == apierror.Domain {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,31 @@
+package apierror
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Status returns the gRPC status reporting err. It carries an ErrorInfo
+// whose reason is the error's code and, when err names offending fields, a
+// BadRequest listing them.
+func Status(err error) *status.Status {
+	spec := ForError(err)
+	st := status.New(spec.GRPCCode, spec.detail(err))
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: spec.Code, Domain: Domain}}
+	if params := invalidParams(err); len(params) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(params))
+		for i, p := range params {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: p.Name, Description: p.Reason}
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	withDetails, detailErr := st.WithDetails(details...)
+	if detailErr != nil {
+		return st
+	}
+
+	return withDetails
+}
@@ -0,0 +1,53 @@
+package apierror
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ContentType is the media type of Problem documents.
+const ContentType = "application/problem+json"
+
+// typePrefix makes an error code a problem type URI.
+const typePrefix = "urn:goledger:error:"
+
+// Problem is an RFC 7807 problem details document, extended with the
+// error's code and the fields that caused it.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Code          string         `json:"code"`
+	InvalidParams []InvalidParam `json:"invalid_params,omitempty"`
+	// Error names the operation that failed. It was the whole error body
+	// before problem documents and stays for the clients that read it.
+	Error string `json:"error,omitempty"`
+}
+
+// NewProblem returns the problem of spec with detail.
+func NewProblem(spec Spec, detail string) *Problem {
+	return &Problem{
+		Type:   typePrefix + spec.Code,
+		Title:  spec.Title,
+		Status: spec.HTTPStatus,
+		Detail: detail,
+		Code:   spec.Code,
+	}
+}
+
+// ProblemFor returns the problem reporting err.
+func ProblemFor(err error) *Problem {
+	spec := ForError(err)
+	p := NewProblem(spec, spec.detail(err))
+	p.InvalidParams = invalidParams(err)
+
+	return p
+}
+
+// Write writes the problem as the response.
+func (p *Problem) Write(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(p.Status)
+	_ = json.NewEncoder(w).Encode(p) // Error handled by http.ResponseWriter
+}
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// authError is a field-level authorization failure. The field resolves to
+// null and the error carries a machine-readable code.
+type authError struct {
+	err  error
+	code string
+}
+
+func (e *authError) Error() string { return e.err.Error() }
+
+func (e *authError) Unwrap() error { return e.err }
+
+// Extensions is added to the GraphQL error.
+func (e *authError) Extensions() map[string]any {
+	return map[string]any{"code": e.code}
+}
+
+// guard enforces the @hasRole directive of field before it resolves.
+func (r *Resolver) guard(ctx context.Context, field *ast.FieldDefinition) error {
+	d := field.Directives.ForName("hasRole")
+	if d == nil {
+		return nil
+	}
+
+	return r.authorize(ctx, domain.Role(strings.ToLower(d.Arguments.ForName("role").Value.Raw)))
+}
+
+// authorize checks that the user in ctx has at least min. With auth
+// disabled every field is open, as requireRole is a no-op in the router.
+func (r *Resolver) authorize(ctx context.Context, min domain.Role) error {
+	if !r.authEnabled {
+		return nil
+	}
+
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return &authError{err: domain.ErrUnauthorized, code: "UNAUTHENTICATED"}
+	}
+
+	if !user.Role.Satisfies(min) {
+		return &authError{err: domain.ErrInsufficientRole, code: "FORBIDDEN"}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"encoding/json"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// defaultPageSize and maxPageSize are the page size the use cases use for a
+// non-positive limit, and the largest they return.
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// selectionCost estimates the work of resolving set: every field costs 1,
+// and everything selected below a field taking `first` counts once per
+// requested item. A connection's default page size comes from the schema,
+// so omitting `first` is no cheaper than passing it.
+func selectionCost(set ast.SelectionSet, vars map[string]any) int {
+	cost := 0
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			children := selectionCost(sel.SelectionSet, vars)
+			cost += 1 + pageSize(sel, vars)*children
+		case *ast.InlineFragment:
+			cost += selectionCost(sel.SelectionSet, vars)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				cost += selectionCost(sel.Definition.SelectionSet, vars)
+			}
+		}
+	}
+
+	return cost
+}
+
+// selectionDepth is how deeply set nests fields: a root field is at depth
+// 1 and its selections one deeper. Fragments don't add depth.
+func selectionDepth(set ast.SelectionSet) int {
+	depth := 0
+	for _, sel := range set {
+		var d int
+		switch sel := sel.(type) {
+		case *ast.Field:
+			d = 1 + selectionDepth(sel.SelectionSet)
+		case *ast.InlineFragment:
+			d = selectionDepth(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil {
+				d = selectionDepth(sel.Definition.SelectionSet)
+			}
+		}
+		depth = max(depth, d)
+	}
+
+	return depth
+}
+
+// pageSize is how many items field returns at most: its `first` argument
+// as the use cases apply it, or 1 for fields that aren't paginated.
+func pageSize(field *ast.Field, vars map[string]any) int {
+	if field.Definition == nil || field.Definition.Arguments.ForName("first") == nil {
+		return 1
+	}
+
+	var n int64
+	switch v := field.ArgumentMap(vars)["first"].(type) {
+	case int64:
+		n = v
+	case int:
+		n = int64(v)
+	case float64:
+		n = int64(v)
+	case json.Number:
+		n, _ = v.Int64()
+	}
+
+	switch {
+	case n <= 0:
+		return defaultPageSize
+	case n > maxPageSize:
+		return maxPageSize
+	}
+
+	return int(n)
+}
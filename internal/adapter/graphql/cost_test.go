@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestSelectionCost(t *testing.T) {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Input: schemaSDL})
+	if err != nil {
+		t.Fatalf("LoadSchema: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]any
+		want  int
+	}{
+		{
+			name:  "scalar fields",
+			query: `{ account(id: "a") { id name } }`,
+			want:  3,
+		},
+		{
+			name:  "default page size",
+			query: `{ accounts { nodes { id } } }`,
+			want:  1 + 20*2,
+		},
+		{
+			name:  "explicit first",
+			query: `{ accounts(first: 5) { nodes { id } } }`,
+			want:  1 + 5*2,
+		},
+		{
+			name:  "first from a variable",
+			query: `query($n: Int) { accounts(first: $n) { nodes { id } } }`,
+			vars:  map[string]any{"n": float64(3)},
+			want:  1 + 3*2,
+		},
+		{
+			name:  "first above the cap",
+			query: `{ accounts(first: 1000) { nodes { id } } }`,
+			want:  1 + 100*2,
+		},
+		{
+			name:  "nested connections multiply",
+			query: `{ accounts(first: 10) { nodes { entries(first: 10) { nodes { id } } } } }`,
+			want:  1 + 10*(1+1+10*2),
+		},
+		{
+			name: "fragments",
+			query: `
+				{ accounts(first: 2) { nodes { ...fields ... on Account { currency } } } }
+				fragment fields on Account { id name }`,
+			want: 1 + 2*(1+3),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(schema, tt.query)
+			if len(errs) > 0 {
+				t.Fatalf("LoadQuery: %v", errs)
+			}
+
+			if got := selectionCost(doc.Operations[0].SelectionSet, tt.vars); got != tt.want {
+				t.Errorf("expected cost %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
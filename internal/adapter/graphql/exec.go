@@ -0,0 +1,477 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var jsonNull = json.RawMessage("null")
+
+// executor resolves one operation of a document gqlparser validated, so a
+// query is parsed once for the depth and cost checks and its execution.
+//
+// Fields resolve by calling the method of the parent resolver named like
+// the field, ignoring case. A method may take a context.Context and a
+// struct of the field's arguments, in that order, and may return an error
+// after its result. Fields whose method takes a context and the items of
+// object lists resolve concurrently, so the request's loaders batch their
+// lookups.
+type executor struct {
+	schema *ast.Schema
+	vars   map[string]any
+	// guard runs before a field resolves; an error resolves it to null.
+	guard func(ctx context.Context, field *ast.FieldDefinition) error
+	// limit bounds the resolver methods running at once.
+	limit chan struct{}
+
+	mu   sync.Mutex
+	errs gqlerror.List
+}
+
+func newExecutor(schema *ast.Schema, vars map[string]any, guard func(context.Context, *ast.FieldDefinition) error) *executor {
+	return &executor{
+		schema: schema,
+		vars:   vars,
+		guard:  guard,
+		// A page's by-ID lookups batch only as far as its items resolve
+		// concurrently.
+		limit: make(chan struct{}, maxPageSize),
+	}
+}
+
+// execute resolves op on root. data is null when a non-null root field
+// failed.
+func (e *executor) execute(ctx context.Context, op *ast.OperationDefinition, root any) (json.RawMessage, gqlerror.List) {
+	if op.Operation != ast.Query {
+		return nil, gqlerror.List{gqlerror.Errorf("%s operations are not supported", op.Operation)}
+	}
+
+	data, ok := e.object(ctx, nil, e.schema.Query, op.SelectionSet, reflect.ValueOf(root))
+	if !ok {
+		data = jsonNull
+	}
+
+	return data, e.errs
+}
+
+// collectedField is a field selected on an object, with the selections of
+// every field sharing its response name merged.
+type collectedField struct {
+	*ast.Field
+	sels ast.SelectionSet
+}
+
+// collectFields appends the fields set selects on an object of type def to
+// fields, following fragments and honouring @skip and @include.
+func (e *executor) collectFields(def *ast.Definition, set ast.SelectionSet, fields []*collectedField) []*collectedField {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if !e.included(sel.Directives) {
+				continue
+			}
+			i := slices.IndexFunc(fields, func(f *collectedField) bool { return f.Alias == sel.Alias })
+			if i >= 0 {
+				fields[i].sels = append(fields[i].sels, sel.SelectionSet...)
+				continue
+			}
+			fields = append(fields, &collectedField{Field: sel, sels: slices.Clip(sel.SelectionSet)})
+		case *ast.InlineFragment:
+			if e.included(sel.Directives) && (sel.TypeCondition == "" || sel.TypeCondition == def.Name) {
+				fields = e.collectFields(def, sel.SelectionSet, fields)
+			}
+		case *ast.FragmentSpread:
+			if e.included(sel.Directives) && sel.Definition != nil && sel.Definition.TypeCondition == def.Name {
+				fields = e.collectFields(def, sel.Definition.SelectionSet, fields)
+			}
+		}
+	}
+
+	return fields
+}
+
+func (e *executor) included(directives ast.DirectiveList) bool {
+	if d := directives.ForName("skip"); d != nil && d.ArgumentMap(e.vars)["if"] == true {
+		return false
+	}
+	if d := directives.ForName("include"); d != nil && d.ArgumentMap(e.vars)["if"] == false {
+		return false
+	}
+	return true
+}
+
+// object resolves set on value, an object of type def. ok is false when a
+// non-null field failed, which makes the object null.
+func (e *executor) object(ctx context.Context, path ast.Path, def *ast.Definition, set ast.SelectionSet, value reflect.Value) (json.RawMessage, bool) {
+	fields := e.collectFields(def, set, nil)
+	results := make([]json.RawMessage, len(fields))
+	oks := make([]bool, len(fields))
+
+	var wg sync.WaitGroup
+	for i, f := range fields {
+		fieldPath := append(slices.Clip(path), ast.PathName(f.Alias))
+		resolve := func() { results[i], oks[i] = e.field(ctx, fieldPath, def, f, value) }
+		if m, err := methodFor(value.Type(), f.Name); err == nil && m.hasContext {
+			wg.Go(resolve)
+		} else {
+			resolve()
+		}
+	}
+	wg.Wait()
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, f := range fields {
+		if !oks[i] {
+			return nil, false
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(f.Alias)
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(results[i])
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), true
+}
+
+// field resolves f on parent, an object of type def.
+func (e *executor) field(ctx context.Context, path ast.Path, def *ast.Definition, f *collectedField, parent reflect.Value) (json.RawMessage, bool) {
+	value, err := e.resolve(ctx, def, f.Field, parent)
+	if err != nil {
+		e.addError(path, f.Field, err)
+		return jsonNull, !f.Definition.Type.NonNull
+	}
+
+	return e.value(ctx, path, f, f.Definition.Type, value)
+}
+
+func (e *executor) resolve(ctx context.Context, def *ast.Definition, f *ast.Field, parent reflect.Value) (value reflect.Value, err error) {
+	switch f.Name {
+	case "__typename":
+		return reflect.ValueOf(def.Name), nil
+	case "__schema":
+		return reflect.ValueOf(&schemaResolver{schema: e.schema}), nil
+	case "__type":
+		name, _ := f.ArgumentMap(e.vars)["name"].(string)
+		return reflect.ValueOf(newTypeResolver(e.schema, e.schema.Types[name])), nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return reflect.Value{}, err
+	}
+	if err := e.guard(ctx, f.Definition); err != nil {
+		return reflect.Value{}, err
+	}
+
+	m, err := methodFor(parent.Type(), f.Name)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	e.limit <- struct{}{}
+	defer func() { <-e.limit }()
+
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("graphql: panic resolving %s.%s: %v", def.Name, f.Name, p)
+		}
+	}()
+
+	return m.call(ctx, parent, f.ArgumentMap(e.vars))
+}
+
+// value completes v, the result of field f, as type t.
+func (e *executor) value(ctx context.Context, path ast.Path, f *collectedField, t *ast.Type, v reflect.Value) (json.RawMessage, bool) {
+	for v.IsValid() && (v.Kind() == reflect.Interface || v.Kind() == reflect.Pointer && t.Elem != nil) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() || (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface || v.Kind() == reflect.Map) && v.IsNil() {
+		if t.NonNull {
+			e.addError(path, f.Field, fmt.Errorf("graphql: got nil for non-null %q", t.String()))
+			return jsonNull, false
+		}
+		return jsonNull, true
+	}
+
+	if t.Elem != nil {
+		return e.list(ctx, path, f, t, v)
+	}
+
+	def := e.schema.Types[t.NamedType]
+	switch def.Kind {
+	case ast.Object:
+		if data, ok := e.object(ctx, path, def, f.sels, v); ok {
+			return data, true
+		}
+		return jsonNull, !t.NonNull
+	case ast.Scalar, ast.Enum:
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			e.addError(path, f.Field, err)
+			return jsonNull, !t.NonNull
+		}
+		return b, true
+	}
+
+	e.addError(path, f.Field, fmt.Errorf("graphql: unsupported %s type %s", def.Kind, def.Name))
+	return jsonNull, !t.NonNull
+}
+
+// list completes v, a slice, as the list type t. A null item that may not
+// be null makes the list null.
+func (e *executor) list(ctx context.Context, path ast.Path, f *collectedField, t *ast.Type, v reflect.Value) (json.RawMessage, bool) {
+	if v.Kind() != reflect.Slice {
+		e.addError(path, f.Field, fmt.Errorf("graphql: expected a slice for %q, got %s", t.String(), v.Type()))
+		return jsonNull, !t.NonNull
+	}
+
+	items := make([]json.RawMessage, v.Len())
+	oks := make([]bool, v.Len())
+	concurrent := v.Len() > 1 && e.schema.Types[t.Elem.Name()].Kind == ast.Object
+
+	var wg sync.WaitGroup
+	for i := range items {
+		itemPath := append(slices.Clip(path), ast.PathIndex(i))
+		complete := func() { items[i], oks[i] = e.value(ctx, itemPath, f, t.Elem, v.Index(i)) }
+		if concurrent {
+			wg.Go(complete)
+		} else {
+			complete()
+		}
+	}
+	wg.Wait()
+
+	if slices.Contains(oks, false) {
+		return jsonNull, !t.NonNull
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(item)
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes(), true
+}
+
+// addError records err as the error of the field at path. Errors with an
+// Extensions method, like authError, contribute them.
+func (e *executor) addError(path ast.Path, f *ast.Field, err error) {
+	gqlErr := gqlerror.WrapPath(path, err)
+	if f.Position != nil {
+		gqlErr.Locations = []gqlerror.Location{{Line: f.Position.Line, Column: f.Position.Column}}
+	}
+
+	var ext interface{ Extensions() map[string]any }
+	if errors.As(err, &ext) {
+		gqlErr.Extensions = ext.Extensions()
+	}
+
+	e.mu.Lock()
+	e.errs = append(e.errs, gqlErr)
+	e.mu.Unlock()
+}
+
+// resolverMethod is the method resolving a field of a resolver type.
+type resolverMethod struct {
+	fn         reflect.Value
+	hasContext bool
+	// args is the struct the field's arguments decode into, or nil.
+	args     reflect.Type
+	hasError bool
+}
+
+type methodKey struct {
+	typ   reflect.Type
+	field string
+}
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+
+	// methods caches resolver methods by methodKey.
+	methods sync.Map
+)
+
+// methodFor finds the method of t resolving field.
+func methodFor(t reflect.Type, field string) (*resolverMethod, error) {
+	key := methodKey{typ: t, field: field}
+	if m, ok := methods.Load(key); ok {
+		return m.(*resolverMethod), nil
+	}
+
+	for i := range t.NumMethod() {
+		method := t.Method(i)
+		if !strings.EqualFold(method.Name, field) {
+			continue
+		}
+
+		m, err := newResolverMethod(method)
+		if err != nil {
+			return nil, fmt.Errorf("graphql: %s.%s: %w", t, method.Name, err)
+		}
+		methods.Store(key, m)
+		return m, nil
+	}
+
+	return nil, fmt.Errorf("graphql: %s has no method resolving %q", t, field)
+}
+
+func newResolverMethod(method reflect.Method) (*resolverMethod, error) {
+	ft := method.Type
+	m := &resolverMethod{fn: method.Func}
+
+	// In(0) is the receiver.
+	in := 1
+	if in < ft.NumIn() && ft.In(in) == contextType {
+		m.hasContext = true
+		in++
+	}
+	if in < ft.NumIn() && ft.In(in).Kind() == reflect.Struct {
+		m.args = ft.In(in)
+		in++
+	}
+	if in != ft.NumIn() {
+		return nil, errors.New("parameters must be an optional context and an optional argument struct")
+	}
+
+	switch {
+	case ft.NumOut() == 1:
+	case ft.NumOut() == 2 && ft.Out(1) == errorType:
+		m.hasError = true
+	default:
+		return nil, errors.New("results must be a value and an optional error")
+	}
+
+	return m, nil
+}
+
+func (m *resolverMethod) call(ctx context.Context, recv reflect.Value, args map[string]any) (reflect.Value, error) {
+	in := []reflect.Value{recv}
+	if m.hasContext {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+	if m.args != nil {
+		argValue := reflect.New(m.args).Elem()
+		if err := decodeInput(argValue, args); err != nil {
+			return reflect.Value{}, err
+		}
+		in = append(in, argValue)
+	}
+
+	out := m.fn.Call(in)
+	if m.hasError && !out[1].IsNil() {
+		return reflect.Value{}, out[1].Interface().(error)
+	}
+
+	return out[0], nil
+}
+
+// unmarshaler is implemented by the scalars, which decode their own input.
+type unmarshaler interface {
+	UnmarshalGraphQL(input any) error
+}
+
+// decodeInput stores input, a coerced argument value, in dst. Struct fields
+// match input object fields ignoring case; a null or missing value leaves
+// dst zero.
+func decodeInput(dst reflect.Value, input any) error {
+	if input == nil {
+		return nil
+	}
+
+	if dst.Kind() == reflect.Pointer {
+		v := reflect.New(dst.Type().Elem())
+		if err := decodeInput(v.Elem(), input); err != nil {
+			return err
+		}
+		dst.Set(v)
+		return nil
+	}
+
+	if u, ok := dst.Addr().Interface().(unmarshaler); ok {
+		return u.UnmarshalGraphQL(input)
+	}
+
+	switch dst.Kind() {
+	case reflect.String:
+		s, ok := input.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %T", input)
+		}
+		dst.SetString(s)
+	case reflect.Bool:
+		b, ok := input.(bool)
+		if !ok {
+			return fmt.Errorf("expected a boolean, got %T", input)
+		}
+		dst.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		var n int64
+		switch v := input.(type) {
+		case int64:
+			n = v
+		case float64:
+			n = int64(v)
+		case json.Number:
+			var err error
+			if n, err = v.Int64(); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("expected an integer, got %T", input)
+		}
+		if dst.OverflowInt(n) {
+			return fmt.Errorf("integer %d out of range", n)
+		}
+		dst.SetInt(n)
+	case reflect.Slice:
+		items, ok := input.([]any)
+		if !ok {
+			items = []any{input}
+		}
+		dst.Set(reflect.MakeSlice(dst.Type(), len(items), len(items)))
+		for i, item := range items {
+			if err := decodeInput(dst.Index(i), item); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		fields, ok := input.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected an object, got %T", input)
+		}
+		for name, value := range fields {
+			field := dst.FieldByNameFunc(func(f string) bool { return strings.EqualFold(f, name) })
+			if !field.IsValid() {
+				return fmt.Errorf("%s has no field for %q", dst.Type(), name)
+			}
+			if err := decodeInput(field, value); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported argument type %s", dst.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+// Package graphql serves a read-only GraphQL API over the account, transfer,
+// entry and hold use cases (see schema.graphql).
+package graphql
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// DefaultMaxDepth and DefaultMaxCost bound queries when Config leaves them
+// zero. An account page with its first 20 entries, transfers and holds
+// costs a few hundred.
+const (
+	DefaultMaxDepth = 10
+	DefaultMaxCost  = 2000
+)
+
+// maxRequestBytes bounds a request body.
+const maxRequestBytes = 1 << 20
+
+// Config wires the GraphQL API to the use cases it reads from.
+type Config struct {
+	Accounts  AccountService
+	Transfers TransferService
+	Entries   EntryService
+	Holds     HoldService
+	Audit     AuditService
+	// AuthEnabled enforces the schema's @hasRole field roles, e.g. admin
+	// for auditTrail.
+	// The route itself sits behind the router's auth middleware.
+	AuthEnabled bool
+	// MaxDepth bounds selection nesting and MaxCost the estimated cost of a
+	// query (see selectionCost); zero uses DefaultMaxDepth/DefaultMaxCost.
+	MaxDepth int
+	MaxCost  int
+}
+
+// Handler serves GraphQL queries POSTed as {query, operationName,
+// variables}.
+type Handler struct {
+	schema    *ast.Schema
+	resolver  *Resolver
+	accounts  AccountService
+	transfers TransferService
+	maxDepth  int
+	maxCost   int
+}
+
+// NewHandler loads the schema and binds it to cfg's services.
+func NewHandler(cfg Config) (*Handler, error) {
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = DefaultMaxDepth
+	}
+	if cfg.MaxCost <= 0 {
+		cfg.MaxCost = DefaultMaxCost
+	}
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSDL})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load graphql schema: %w", err)
+	}
+
+	return &Handler{
+		schema: schema,
+		resolver: &Resolver{
+			accounts:    cfg.Accounts,
+			transfers:   cfg.Transfers,
+			entries:     cfg.Entries,
+			holds:       cfg.Holds,
+			audit:       cfg.Audit,
+			authEnabled: cfg.AuthEnabled,
+		},
+		accounts:  cfg.Accounts,
+		transfers: cfg.Transfers,
+		maxDepth:  cfg.MaxDepth,
+		maxCost:   cfg.MaxCost,
+	}, nil
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type response struct {
+	Data   json.RawMessage `json:"data,omitempty"`
+	Errors gqlerror.List   `json:"errors,omitempty"`
+}
+
+// ServeHTTP executes one query. Like any GraphQL server it answers 200
+// with an "errors" list for invalid, too costly or failing queries; only an
+// unreadable request body is a 400.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(&req); err != nil {
+		writeResponse(w, http.StatusBadRequest, &response{
+			Errors: gqlerror.List{gqlerror.Errorf("invalid request body: %s", err)},
+		})
+		return
+	}
+
+	op, vars, errs := h.prepare(req)
+	if len(errs) > 0 {
+		writeResponse(w, http.StatusOK, &response{Errors: errs})
+		return
+	}
+
+	ctx := withLoaders(r.Context(), newLoaders(h.accounts, h.transfers))
+	data, errs := newExecutor(h.schema, vars, h.resolver.guard).execute(ctx, op, h.resolver)
+	writeResponse(w, http.StatusOK, &response{Data: data, Errors: errs})
+}
+
+// prepare parses and validates req's query against the schema, selects its
+// operation and coerces its variables. The operation is rejected when it
+// nests deeper than maxDepth or costs more than maxCost, so the same
+// parsed document is checked and executed.
+func (h *Handler) prepare(req request) (*ast.OperationDefinition, map[string]any, gqlerror.List) {
+	doc, errs := gqlparser.LoadQuery(h.schema, req.Query)
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+
+	op := doc.Operations.ForName(req.OperationName)
+	if op == nil {
+		if req.OperationName == "" {
+			return nil, nil, gqlerror.List{gqlerror.Errorf("an operation name is required for a document with %d operations", len(doc.Operations))}
+		}
+		return nil, nil, gqlerror.List{gqlerror.Errorf("no operation named %q", req.OperationName)}
+	}
+
+	vars, err := validator.VariableValues(h.schema, op, req.Variables)
+	if err != nil {
+		var gqlErr *gqlerror.Error
+		if !errors.As(err, &gqlErr) {
+			gqlErr = gqlerror.Wrap(err)
+		}
+		return nil, nil, gqlerror.List{gqlErr}
+	}
+
+	if depth := selectionDepth(op.SelectionSet); depth > h.maxDepth {
+		return nil, nil, gqlerror.List{tooCostly(
+			fmt.Sprintf("query depth %d exceeds the maximum of %d; select fewer nested fields", depth, h.maxDepth),
+			map[string]any{"depth": depth, "maxDepth": h.maxDepth},
+		)}
+	}
+
+	if cost := selectionCost(op.SelectionSet, vars); cost > h.maxCost {
+		return nil, nil, gqlerror.List{tooCostly(
+			fmt.Sprintf("query cost %d exceeds the maximum of %d; request smaller pages or fewer nested connections", cost, h.maxCost),
+			map[string]any{"cost": cost, "maxCost": h.maxCost},
+		)}
+	}
+
+	return op, vars, nil
+}
+
+// tooCostly is the QUERY_TOO_COSTLY error rejecting a query before it runs.
+func tooCostly(message string, extensions map[string]any) *gqlerror.Error {
+	extensions["code"] = "QUERY_TOO_COSTLY"
+	return &gqlerror.Error{Message: message, Extensions: extensions}
+}
+
+func writeResponse(w http.ResponseWriter, status int, resp *response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -0,0 +1,510 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type accountServiceStub struct {
+	mu       sync.Mutex
+	accounts map[string]*domain.Account
+	batches  [][]string
+	listFn   func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error)
+}
+
+func (s *accountServiceStub) GetAccount(ctx context.Context, id string) (*domain.Account, error) {
+	if a, ok := s.accounts[id]; ok {
+		return a, nil
+	}
+	return nil, domain.ErrAccountNotFound
+}
+
+func (s *accountServiceStub) GetAccountsByIDs(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	s.mu.Lock()
+	s.batches = append(s.batches, ids)
+	s.mu.Unlock()
+
+	var out []*domain.Account
+	for _, id := range ids {
+		if a, ok := s.accounts[id]; ok {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func (s *accountServiceStub) ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+	return s.listFn(ctx, input)
+}
+
+type transferServiceStub struct {
+	transfers map[string]*domain.Transfer
+	byAccount map[string][]*domain.Transfer
+}
+
+func (s *transferServiceStub) GetTransfer(ctx context.Context, id string) (*domain.Transfer, error) {
+	if t, ok := s.transfers[id]; ok {
+		return t, nil
+	}
+	return nil, domain.ErrTransferNotFound
+}
+
+func (s *transferServiceStub) GetTransfersByIDs(ctx context.Context, ids []string) ([]*domain.Transfer, error) {
+	var out []*domain.Transfer
+	for _, id := range ids {
+		if t, ok := s.transfers[id]; ok {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func (s *transferServiceStub) ListTransfersByAccountCursor(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error) {
+	return &usecase.ListTransfersByAccountCursorResult{Transfers: s.byAccount[input.AccountID]}, nil
+}
+
+func (s *transferServiceStub) SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+	return &usecase.SearchTransfersResult{}, nil
+}
+
+type entryServiceStub struct {
+	byAccount map[string][]*domain.Entry
+}
+
+func (s *entryServiceStub) GetEntriesByAccountCursor(ctx context.Context, input usecase.GetEntriesByAccountCursorInput) (*usecase.GetEntriesByAccountCursorResult, error) {
+	return &usecase.GetEntriesByAccountCursorResult{Entries: s.byAccount[input.AccountID]}, nil
+}
+
+func (s *entryServiceStub) GetEntriesByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error) {
+	return nil, nil
+}
+
+func (s *entryServiceStub) GetHistoricalBalance(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
+type holdServiceStub struct{}
+
+func (s *holdServiceStub) GetHold(ctx context.Context, id string) (*domain.Hold, error) {
+	return nil, domain.ErrHoldNotFound
+}
+
+func (s *holdServiceStub) ListHoldsByAccountCursor(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error) {
+	return &usecase.ListHoldsByAccountCursorResult{}, nil
+}
+
+type auditServiceStub struct {
+	calls int
+}
+
+func (s *auditServiceStub) GetByResourceID(ctx context.Context, resourceType, resourceID string) ([]*domain.AuditLog, error) {
+	s.calls++
+	return []*domain.AuditLog{{ID: "audit-1", Action: "create_account", ResourceType: resourceType, ResourceID: resourceID}}, nil
+}
+
+// fixture is two accounts with three transfers between them, each with its
+// entry on the first account.
+type fixture struct {
+	accounts  *accountServiceStub
+	transfers *transferServiceStub
+	entries   *entryServiceStub
+	audit     *auditServiceStub
+}
+
+func newFixture() *fixture {
+	a := &domain.Account{ID: "acc-a", Name: "a", Currency: "USD", Balance: decimal.NewFromInt(70)}
+	b := &domain.Account{ID: "acc-b", Name: "b", Currency: "USD", Balance: decimal.NewFromInt(30)}
+
+	f := &fixture{
+		accounts: &accountServiceStub{accounts: map[string]*domain.Account{a.ID: a, b.ID: b}},
+		transfers: &transferServiceStub{
+			transfers: map[string]*domain.Transfer{},
+			byAccount: map[string][]*domain.Transfer{},
+		},
+		entries: &entryServiceStub{byAccount: map[string][]*domain.Entry{}},
+		audit:   &auditServiceStub{},
+	}
+
+	for _, id := range []string{"tr-1", "tr-2", "tr-3"} {
+		t := &domain.Transfer{ID: id, FromAccountID: a.ID, ToAccountID: b.ID, Amount: decimal.NewFromInt(10)}
+		f.transfers.transfers[id] = t
+		f.transfers.byAccount[a.ID] = append(f.transfers.byAccount[a.ID], t)
+		f.entries.byAccount[a.ID] = append(f.entries.byAccount[a.ID], &domain.Entry{
+			ID: "e-" + id, AccountID: a.ID, TransferID: id, Amount: decimal.NewFromInt(-10),
+		})
+	}
+
+	return f
+}
+
+func (f *fixture) handler(t *testing.T, cfg Config) *Handler {
+	t.Helper()
+
+	cfg.Accounts = f.accounts
+	cfg.Transfers = f.transfers
+	cfg.Entries = f.entries
+	cfg.Holds = &holdServiceStub{}
+	cfg.Audit = f.audit
+
+	h, err := NewHandler(cfg)
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+	return h
+}
+
+type gqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Path       []any          `json:"path"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execQuery(t *testing.T, h http.Handler, ctx context.Context, query string, vars map[string]any) (int, gqlResponse) {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]any{"query": query, "variables": vars})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", strings.NewReader(string(body))).WithContext(ctx)
+	rec := httptest.NewRecorder()
+
+	h.ServeHTTP(rec, req)
+
+	var resp gqlResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("decode response %q: %v", rec.Body.String(), err)
+	}
+	return rec.Code, resp
+}
+
+func withRole(role domain.Role) context.Context {
+	return context.WithValue(context.Background(), domain.UserContextKey, &domain.User{ID: "user-1", Role: role})
+}
+
+func TestHandler_NestedQueryBatchesAccountLookups(t *testing.T) {
+	f := newFixture()
+	h := f.handler(t, Config{})
+
+	code, resp := execQuery(t, h, context.Background(), `
+		query($id: ID!) {
+			account(id: $id) {
+				name
+				entries(first: 10) { nodes { amount transfer { id toAccount { name } } } }
+				transfers(first: 10) { edges { cursor node { fromAccount { name } toAccount { name } } } }
+			}
+		}`, map[string]any{"id": "acc-a"})
+
+	if code != http.StatusOK || len(resp.Errors) > 0 {
+		t.Fatalf("unexpected response %d: %+v", code, resp.Errors)
+	}
+
+	var data struct {
+		Account struct {
+			Name    string
+			Entries struct {
+				Nodes []struct {
+					Amount   string
+					Transfer struct {
+						ID        string
+						ToAccount struct{ Name string }
+					}
+				}
+			}
+			Transfers struct {
+				Edges []struct {
+					Cursor string
+					Node   struct {
+						FromAccount struct{ Name string }
+						ToAccount   struct{ Name string }
+					}
+				}
+			}
+		}
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("decode data: %v", err)
+	}
+
+	if len(data.Account.Entries.Nodes) != 3 || data.Account.Entries.Nodes[0].Transfer.ToAccount.Name != "b" {
+		t.Errorf("unexpected entries: %+v", data.Account.Entries)
+	}
+	if len(data.Account.Transfers.Edges) != 3 || data.Account.Transfers.Edges[0].Cursor != "tr-1" {
+		t.Errorf("unexpected transfers: %+v", data.Account.Transfers)
+	}
+
+	// Twelve account references across both connections, two distinct IDs.
+	for _, batch := range f.accounts.batches {
+		if len(batch) > 2 {
+			t.Errorf("expected de-duplicated batches, got %v", batch)
+		}
+	}
+	if len(f.accounts.batches) > 2 {
+		t.Errorf("expected account lookups to batch, got %d calls: %v", len(f.accounts.batches), f.accounts.batches)
+	}
+}
+
+func TestHandler_AccountsPagination(t *testing.T) {
+	f := newFixture()
+	var got usecase.ListAccountsCursorInput
+	f.accounts.listFn = func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+		got = input
+		return &usecase.ListAccountsCursorResult{
+			Accounts:   []*domain.Account{f.accounts.accounts["acc-b"]},
+			NextCursor: "acc-b",
+		}, nil
+	}
+	h := f.handler(t, Config{})
+
+	_, resp := execQuery(t, h, context.Background(),
+		`{ accounts(first: 1, after: "acc-a") { nodes { id } pageInfo { hasNextPage endCursor } } }`, nil)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+
+	if got.Cursor != "acc-a" || got.Limit != 1 {
+		t.Errorf("expected cursor acc-a and limit 1, got %+v", got)
+	}
+	want := `{"accounts":{"nodes":[{"id":"acc-b"}],"pageInfo":{"hasNextPage":true,"endCursor":"acc-b"}}}`
+	if string(resp.Data) != want {
+		t.Errorf("expected %s, got %s", want, resp.Data)
+	}
+}
+
+func TestHandler_UnknownAccountIsNull(t *testing.T) {
+	h := newFixture().handler(t, Config{})
+
+	_, resp := execQuery(t, h, context.Background(), `{ account(id: "missing") { id } }`, nil)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+	if string(resp.Data) != `{"account":null}` {
+		t.Errorf("expected null account, got %s", resp.Data)
+	}
+}
+
+func TestHandler_RejectsCostlyQuery(t *testing.T) {
+	f := newFixture()
+	f.accounts.listFn = func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+		t.Fatal("a rejected query must not reach the use cases")
+		return nil, nil
+	}
+	h := f.handler(t, Config{})
+
+	_, resp := execQuery(t, h, context.Background(),
+		`{ accounts(first: 100) { nodes { transfers(first: 100) { nodes { id } } } } }`, nil)
+
+	if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "QUERY_TOO_COSTLY" {
+		t.Fatalf("expected QUERY_TOO_COSTLY, got %+v", resp.Errors)
+	}
+}
+
+func TestHandler_AuditTrailRequiresAdmin(t *testing.T) {
+	query := `{ account(id: "acc-a") { name auditTrail { action } } }`
+
+	t.Run("viewer", func(t *testing.T) {
+		f := newFixture()
+		_, resp := execQuery(t, f.handler(t, Config{AuthEnabled: true}), withRole(domain.RoleViewer), query, nil)
+
+		if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "FORBIDDEN" {
+			t.Fatalf("expected FORBIDDEN, got %+v", resp.Errors)
+		}
+		// The non-null auditTrail nulls its nullable parent, not the query.
+		if string(resp.Data) != `{"account":null}` {
+			t.Errorf("expected a null account, got %s", resp.Data)
+		}
+		if f.audit.calls != 0 {
+			t.Error("expected the audit trail not to be read")
+		}
+	})
+
+	t.Run("admin", func(t *testing.T) {
+		f := newFixture()
+		_, resp := execQuery(t, f.handler(t, Config{AuthEnabled: true}), withRole(domain.RoleAdmin), query, nil)
+
+		if len(resp.Errors) > 0 {
+			t.Fatalf("unexpected errors: %+v", resp.Errors)
+		}
+		if !strings.Contains(string(resp.Data), `"create_account"`) {
+			t.Errorf("expected the audit trail, got %s", resp.Data)
+		}
+	})
+
+	t.Run("auth disabled", func(t *testing.T) {
+		f := newFixture()
+		_, resp := execQuery(t, f.handler(t, Config{}), context.Background(), query, nil)
+
+		if len(resp.Errors) > 0 {
+			t.Fatalf("unexpected errors: %+v", resp.Errors)
+		}
+	})
+}
+
+func TestHandler_InvalidBody(t *testing.T) {
+	h := newFixture().handler(t, Config{})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", strings.NewReader("{"))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestHandler_RejectsDeepQuery(t *testing.T) {
+	h := newFixture().handler(t, Config{MaxDepth: 3})
+
+	_, resp := execQuery(t, h, context.Background(),
+		`{ account(id: "acc-a") { transfers { nodes { id } } } }`, nil)
+
+	if len(resp.Errors) != 1 || resp.Errors[0].Extensions["code"] != "QUERY_TOO_COSTLY" || resp.Errors[0].Extensions["depth"] != float64(4) {
+		t.Fatalf("expected QUERY_TOO_COSTLY for depth 4, got %+v", resp.Errors)
+	}
+	if resp.Data != nil {
+		t.Errorf("expected no data, got %s", resp.Data)
+	}
+}
+
+func TestHandler_FragmentsAliasesAndDirectives(t *testing.T) {
+	h := newFixture().handler(t, Config{})
+
+	_, resp := execQuery(t, h, context.Background(), `
+		query($skip: Boolean!) {
+			a: account(id: "acc-a") { ...names currency @skip(if: $skip) }
+			b: account(id: "acc-b") { ... on Account { id name } __typename }
+		}
+		fragment names on Account { id name }`, map[string]any{"skip": true})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+
+	want := `{"a":{"id":"acc-a","name":"a"},"b":{"id":"acc-b","name":"b","__typename":"Account"}}`
+	if string(resp.Data) != want {
+		t.Errorf("expected %s, got %s", want, resp.Data)
+	}
+}
+
+func TestHandler_VariablesDecodeIntoArguments(t *testing.T) {
+	f := newFixture()
+	var got usecase.SearchTransfersInput
+	h := f.handler(t, Config{})
+	h.resolver.transfers = searchRecorder{transferServiceStub: f.transfers, got: &got}
+
+	_, resp := execQuery(t, h, context.Background(), `
+		query($filter: TransferFilter, $first: Int) {
+			transfers(filter: $filter, orderBy: {field: AMOUNT, ascending: true}, first: $first) { nodes { id } }
+		}`, map[string]any{
+		"filter": map[string]any{"accountId": "acc-a", "direction": "OUTGOING", "minAmount": "5", "createdAtFrom": "2025-01-02T03:04:05Z"},
+		"first":  5,
+	})
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+
+	if got.Filter.AccountID != "acc-a" || got.Filter.Direction != domain.TransferDirectionOutgoing || got.Limit != 5 {
+		t.Errorf("unexpected search input: %+v", got)
+	}
+	if got.Filter.MinAmount == nil || !got.Filter.MinAmount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("expected min amount 5, got %v", got.Filter.MinAmount)
+	}
+	if got.Filter.CreatedAtFrom == nil || !got.Filter.CreatedAtFrom.Equal(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("unexpected createdAtFrom: %v", got.Filter.CreatedAtFrom)
+	}
+	if got.Sort.Field != domain.TransferSortAmount || !got.Sort.Ascending {
+		t.Errorf("unexpected sort: %+v", got.Sort)
+	}
+}
+
+type searchRecorder struct {
+	*transferServiceStub
+	got *usecase.SearchTransfersInput
+}
+
+func (s searchRecorder) SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+	*s.got = input
+	return &usecase.SearchTransfersResult{}, nil
+}
+
+func TestHandler_Introspection(t *testing.T) {
+	h := newFixture().handler(t, Config{})
+
+	_, resp := execQuery(t, h, context.Background(), `{
+		__schema { queryType { name } mutationType { name } directives { name } }
+		__type(name: "Account") {
+			kind
+			fields { name args { name defaultValue } type { kind ofType { kind ofType { kind ofType { name } } } } }
+		}
+	}`, nil)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("unexpected errors: %+v", resp.Errors)
+	}
+
+	var data struct {
+		Schema struct {
+			QueryType    struct{ Name string }
+			MutationType *struct{ Name string }
+			Directives   []struct{ Name string }
+		} `json:"__schema"`
+		Type struct {
+			Kind   string
+			Fields []struct {
+				Name string
+				Args []struct {
+					Name         string
+					DefaultValue *string
+				}
+				Type struct {
+					Kind   string
+					OfType *struct {
+						Kind   string
+						OfType *struct {
+							Kind   string
+							OfType *struct{ Name string }
+						}
+					}
+				}
+			}
+		} `json:"__type"`
+	}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		t.Fatalf("decode data: %v", err)
+	}
+
+	if data.Schema.QueryType.Name != "Query" || data.Schema.MutationType != nil {
+		t.Errorf("unexpected root types: %+v", data.Schema)
+	}
+	if !slices.ContainsFunc(data.Schema.Directives, func(d struct{ Name string }) bool { return d.Name == "hasRole" }) {
+		t.Errorf("expected the hasRole directive, got %+v", data.Schema.Directives)
+	}
+
+	if data.Type.Kind != "OBJECT" {
+		t.Errorf("expected OBJECT, got %s", data.Type.Kind)
+	}
+	for _, f := range data.Type.Fields {
+		switch f.Name {
+		case "entries":
+			if len(f.Args) != 2 || f.Args[0].Name != "first" || f.Args[0].DefaultValue == nil || *f.Args[0].DefaultValue != "20" {
+				t.Errorf("unexpected entries args: %+v", f.Args)
+			}
+		case "auditTrail":
+			// [AuditLog!]!
+			typ := f.Type
+			if typ.Kind != "NON_NULL" || typ.OfType.Kind != "LIST" || typ.OfType.OfType.Kind != "NON_NULL" || typ.OfType.OfType.OfType.Name != "AuditLog" {
+				t.Errorf("unexpected auditTrail type: %+v", typ)
+			}
+		}
+	}
+}
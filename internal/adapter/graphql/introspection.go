@@ -0,0 +1,308 @@
+package graphql
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// The introspection resolvers answer __schema and __type from the loaded
+// schema, field by field as the types of the prelude define them.
+
+type includeDeprecatedArgs struct {
+	IncludeDeprecated bool
+}
+
+type schemaResolver struct {
+	schema *ast.Schema
+}
+
+func (s *schemaResolver) Description() *string { return optional(s.schema.Description) }
+
+func (s *schemaResolver) Types() []*typeResolver {
+	out := make([]*typeResolver, 0, len(s.schema.Types))
+	for _, def := range s.schema.Types {
+		out = append(out, newTypeResolver(s.schema, def))
+	}
+	slices.SortFunc(out, func(a, b *typeResolver) int { return strings.Compare(a.def.Name, b.def.Name) })
+	return out
+}
+
+func (s *schemaResolver) QueryType() *typeResolver {
+	return newTypeResolver(s.schema, s.schema.Query)
+}
+
+func (s *schemaResolver) MutationType() *typeResolver {
+	return newTypeResolver(s.schema, s.schema.Mutation)
+}
+
+func (s *schemaResolver) SubscriptionType() *typeResolver {
+	return newTypeResolver(s.schema, s.schema.Subscription)
+}
+
+func (s *schemaResolver) Directives() []*directiveResolver {
+	out := make([]*directiveResolver, 0, len(s.schema.Directives))
+	for _, d := range s.schema.Directives {
+		out = append(out, &directiveResolver{schema: s.schema, d: d})
+	}
+	slices.SortFunc(out, func(a, b *directiveResolver) int { return strings.Compare(a.d.Name, b.d.Name) })
+	return out
+}
+
+// typeResolver is a named type, or a list or non-null wrapper of one.
+type typeResolver struct {
+	schema *ast.Schema
+	// wrapper is the list or non-null type; nil for a named type.
+	wrapper *ast.Type
+	def     *ast.Definition
+}
+
+// newTypeResolver returns nil for a nil def, so an unknown type is null.
+func newTypeResolver(schema *ast.Schema, def *ast.Definition) *typeResolver {
+	if def == nil {
+		return nil
+	}
+	return &typeResolver{schema: schema, def: def}
+}
+
+func typeResolverFor(schema *ast.Schema, t *ast.Type) *typeResolver {
+	if t.NonNull || t.Elem != nil {
+		return &typeResolver{schema: schema, wrapper: t}
+	}
+	return newTypeResolver(schema, schema.Types[t.NamedType])
+}
+
+func (t *typeResolver) Kind() string {
+	switch {
+	case t.wrapper == nil:
+		return string(t.def.Kind)
+	case t.wrapper.NonNull:
+		return "NON_NULL"
+	}
+	return "LIST"
+}
+
+func (t *typeResolver) Name() *string {
+	if t.wrapper != nil {
+		return nil
+	}
+	return &t.def.Name
+}
+
+func (t *typeResolver) Description() *string {
+	if t.wrapper != nil {
+		return nil
+	}
+	return optional(t.def.Description)
+}
+
+func (t *typeResolver) SpecifiedByURL() *string {
+	if t.wrapper != nil || t.def.Kind != ast.Scalar {
+		return nil
+	}
+	return directiveArg(t.def.Directives, "specifiedBy", "url")
+}
+
+func (t *typeResolver) Fields(args includeDeprecatedArgs) *[]*fieldResolver {
+	if t.wrapper != nil || (t.def.Kind != ast.Object && t.def.Kind != ast.Interface) {
+		return nil
+	}
+
+	out := []*fieldResolver{}
+	for _, f := range t.def.Fields {
+		if strings.HasPrefix(f.Name, "__") || (!args.IncludeDeprecated && deprecation(f.Directives) != nil) {
+			continue
+		}
+		out = append(out, &fieldResolver{schema: t.schema, f: f})
+	}
+	return &out
+}
+
+func (t *typeResolver) Interfaces() *[]*typeResolver {
+	if t.wrapper != nil || (t.def.Kind != ast.Object && t.def.Kind != ast.Interface) {
+		return nil
+	}
+	return t.named(t.schema.GetImplements(t.def))
+}
+
+func (t *typeResolver) PossibleTypes() *[]*typeResolver {
+	if t.wrapper != nil || !t.def.IsAbstractType() {
+		return nil
+	}
+	return t.named(t.schema.GetPossibleTypes(t.def))
+}
+
+func (t *typeResolver) named(defs []*ast.Definition) *[]*typeResolver {
+	out := make([]*typeResolver, len(defs))
+	for i, def := range defs {
+		out[i] = newTypeResolver(t.schema, def)
+	}
+	return &out
+}
+
+func (t *typeResolver) EnumValues(args includeDeprecatedArgs) *[]*enumValueResolver {
+	if t.wrapper != nil || t.def.Kind != ast.Enum {
+		return nil
+	}
+
+	out := []*enumValueResolver{}
+	for _, v := range t.def.EnumValues {
+		if args.IncludeDeprecated || deprecation(v.Directives) == nil {
+			out = append(out, &enumValueResolver{v: v})
+		}
+	}
+	return &out
+}
+
+func (t *typeResolver) InputFields(args includeDeprecatedArgs) *[]*inputValueResolver {
+	if t.wrapper != nil || t.def.Kind != ast.InputObject {
+		return nil
+	}
+
+	out := []*inputValueResolver{}
+	for _, f := range t.def.Fields {
+		if args.IncludeDeprecated || deprecation(f.Directives) == nil {
+			out = append(out, &inputValueResolver{
+				schema: t.schema, name: f.Name, description: f.Description,
+				typ: f.Type, defaultValue: f.DefaultValue, directives: f.Directives,
+			})
+		}
+	}
+	return &out
+}
+
+func (t *typeResolver) OfType() *typeResolver {
+	switch {
+	case t.wrapper == nil:
+		return nil
+	case t.wrapper.NonNull:
+		nullable := *t.wrapper
+		nullable.NonNull = false
+		return typeResolverFor(t.schema, &nullable)
+	}
+	return typeResolverFor(t.schema, t.wrapper.Elem)
+}
+
+func (t *typeResolver) IsOneOf() *bool {
+	if t.wrapper != nil || t.def.Kind != ast.InputObject {
+		return nil
+	}
+	oneOf := t.def.Directives.ForName("oneOf") != nil
+	return &oneOf
+}
+
+type fieldResolver struct {
+	schema *ast.Schema
+	f      *ast.FieldDefinition
+}
+
+func (f *fieldResolver) Name() string         { return f.f.Name }
+func (f *fieldResolver) Description() *string { return optional(f.f.Description) }
+func (f *fieldResolver) Type() *typeResolver  { return typeResolverFor(f.schema, f.f.Type) }
+func (f *fieldResolver) IsDeprecated() bool   { return deprecation(f.f.Directives) != nil }
+
+func (f *fieldResolver) DeprecationReason() *string { return deprecation(f.f.Directives) }
+
+func (f *fieldResolver) Args(args includeDeprecatedArgs) []*inputValueResolver {
+	return argumentResolvers(f.schema, f.f.Arguments, args.IncludeDeprecated)
+}
+
+// inputValueResolver is an argument or an input object field.
+type inputValueResolver struct {
+	schema       *ast.Schema
+	name         string
+	description  string
+	typ          *ast.Type
+	defaultValue *ast.Value
+	directives   ast.DirectiveList
+}
+
+func argumentResolvers(schema *ast.Schema, defs ast.ArgumentDefinitionList, includeDeprecated bool) []*inputValueResolver {
+	out := []*inputValueResolver{}
+	for _, a := range defs {
+		if includeDeprecated || deprecation(a.Directives) == nil {
+			out = append(out, &inputValueResolver{
+				schema: schema, name: a.Name, description: a.Description,
+				typ: a.Type, defaultValue: a.DefaultValue, directives: a.Directives,
+			})
+		}
+	}
+	return out
+}
+
+func (v *inputValueResolver) Name() string         { return v.name }
+func (v *inputValueResolver) Description() *string { return optional(v.description) }
+func (v *inputValueResolver) Type() *typeResolver  { return typeResolverFor(v.schema, v.typ) }
+func (v *inputValueResolver) IsDeprecated() bool   { return deprecation(v.directives) != nil }
+
+func (v *inputValueResolver) DeprecationReason() *string { return deprecation(v.directives) }
+
+func (v *inputValueResolver) DefaultValue() *string {
+	if v.defaultValue == nil {
+		return nil
+	}
+	s := v.defaultValue.String()
+	return &s
+}
+
+type enumValueResolver struct {
+	v *ast.EnumValueDefinition
+}
+
+func (e *enumValueResolver) Name() string               { return e.v.Name }
+func (e *enumValueResolver) Description() *string       { return optional(e.v.Description) }
+func (e *enumValueResolver) IsDeprecated() bool         { return deprecation(e.v.Directives) != nil }
+func (e *enumValueResolver) DeprecationReason() *string { return deprecation(e.v.Directives) }
+
+type directiveResolver struct {
+	schema *ast.Schema
+	d      *ast.DirectiveDefinition
+}
+
+func (d *directiveResolver) Name() string         { return d.d.Name }
+func (d *directiveResolver) Description() *string { return optional(d.d.Description) }
+func (d *directiveResolver) IsRepeatable() bool   { return d.d.IsRepeatable }
+
+func (d *directiveResolver) Locations() []string {
+	out := make([]string, len(d.d.Locations))
+	for i, l := range d.d.Locations {
+		out[i] = string(l)
+	}
+	return out
+}
+
+func (d *directiveResolver) Args(args includeDeprecatedArgs) []*inputValueResolver {
+	return argumentResolvers(d.schema, d.d.Arguments, args.IncludeDeprecated)
+}
+
+// deprecation is the reason of a @deprecated among directives, or nil.
+func deprecation(directives ast.DirectiveList) *string {
+	if directives.ForName("deprecated") == nil {
+		return nil
+	}
+	if reason := directiveArg(directives, "deprecated", "reason"); reason != nil {
+		return reason
+	}
+	reason := "No longer supported"
+	return &reason
+}
+
+func directiveArg(directives ast.DirectiveList, directive, arg string) *string {
+	d := directives.ForName(directive)
+	if d == nil {
+		return nil
+	}
+	a := d.Arguments.ForName(arg)
+	if a == nil || a.Value == nil {
+		return nil
+	}
+	return &a.Value.Raw
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"context"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// loaderWait is how long a loader collects keys before fetching them. List
+// items resolve concurrently, so a page's lookups land in one batch.
+const loaderWait = 2 * time.Millisecond
+
+type loadersKey struct{}
+
+// loaders batch and cache the by-ID lookups of one request, so resolving
+// the accounts of a page of transfers costs one query instead of one per
+// transfer.
+type loaders struct {
+	accounts  *dataloader.Loader[string, *domain.Account]
+	transfers *dataloader.Loader[string, *domain.Transfer]
+}
+
+func newLoaders(accounts AccountService, transfers TransferService) *loaders {
+	return &loaders{
+		accounts: dataloader.NewBatchedLoader(
+			batchByID(accounts.GetAccountsByIDs, func(a *domain.Account) string { return a.ID }),
+			dataloader.WithWait[string, *domain.Account](loaderWait),
+		),
+		transfers: dataloader.NewBatchedLoader(
+			batchByID(transfers.GetTransfersByIDs, func(t *domain.Transfer) string { return t.ID }),
+			dataloader.WithWait[string, *domain.Transfer](loaderWait),
+		),
+	}
+}
+
+// batchByID adapts a fetch of many IDs, returned in any order, to a batch
+// function answering keys in order. Unknown IDs load as nil.
+func batchByID[V any](fetch func(context.Context, []string) ([]V, error), id func(V) string) dataloader.BatchFunc[string, V] {
+	return func(ctx context.Context, keys []string) []*dataloader.Result[V] {
+		results := make([]*dataloader.Result[V], len(keys))
+
+		values, err := fetch(ctx, keys)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[V]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[string]V, len(values))
+		for _, v := range values {
+			byID[id(v)] = v
+		}
+
+		for i, key := range keys {
+			results[i] = &dataloader.Result[V]{Data: byID[key]}
+		}
+
+		return results
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,290 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// AccountService defines the account reads the GraphQL API needs.
+type AccountService interface {
+	GetAccount(ctx context.Context, id string) (*domain.Account, error)
+	GetAccountsByIDs(ctx context.Context, ids []string) ([]*domain.Account, error)
+	ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error)
+}
+
+// TransferService defines the transfer reads the GraphQL API needs.
+type TransferService interface {
+	GetTransfer(ctx context.Context, id string) (*domain.Transfer, error)
+	GetTransfersByIDs(ctx context.Context, ids []string) ([]*domain.Transfer, error)
+	ListTransfersByAccountCursor(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error)
+	SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error)
+}
+
+// EntryService defines the entry reads the GraphQL API needs.
+type EntryService interface {
+	GetEntriesByAccountCursor(ctx context.Context, input usecase.GetEntriesByAccountCursorInput) (*usecase.GetEntriesByAccountCursorResult, error)
+	GetEntriesByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error)
+	GetHistoricalBalance(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error)
+}
+
+// HoldService defines the hold reads the GraphQL API needs.
+type HoldService interface {
+	GetHold(ctx context.Context, id string) (*domain.Hold, error)
+	ListHoldsByAccountCursor(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error)
+}
+
+// AuditService defines the audit trail reads the GraphQL API needs.
+type AuditService interface {
+	GetByResourceID(ctx context.Context, resourceType, resourceID string) ([]*domain.AuditLog, error)
+}
+
+// Resolver is the root resolver of schema.graphql.
+type Resolver struct {
+	accounts    AccountService
+	transfers   TransferService
+	entries     EntryService
+	holds       HoldService
+	audit       AuditService
+	authEnabled bool
+}
+
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+func (a pageArgs) limit() int {
+	return int(a.First)
+}
+
+func (a pageArgs) cursor() string {
+	if a.After == nil {
+		return ""
+	}
+	return *a.After
+}
+
+// Account resolves Query.account; an unknown ID resolves to null.
+func (r *Resolver) Account(ctx context.Context, args struct{ ID ID }) (*accountResolver, error) {
+	account, err := r.accounts.GetAccount(ctx, string(args.ID))
+	if errors.Is(err, domain.ErrAccountNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResolver{r: r, a: account}, nil
+}
+
+// Accounts resolves Query.accounts.
+func (r *Resolver) Accounts(ctx context.Context, args pageArgs) (*connection[*accountResolver], error) {
+	result, err := r.accounts.ListAccountsCursor(ctx, usecase.ListAccountsCursorInput{
+		Cursor: args.cursor(),
+		Limit:  args.limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return idConnection(r.accountResolvers(result.Accounts), result.NextCursor), nil
+}
+
+// Transfer resolves Query.transfer; an unknown ID resolves to null.
+func (r *Resolver) Transfer(ctx context.Context, args struct{ ID ID }) (*transferResolver, error) {
+	transfer, err := r.transfers.GetTransfer(ctx, string(args.ID))
+	if errors.Is(err, domain.ErrTransferNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &transferResolver{r: r, t: transfer}, nil
+}
+
+type transferFilterInput struct {
+	AccountID      *ID
+	Direction      *string
+	MinAmount      *string
+	MaxAmount      *string
+	EventAtFrom    *Time
+	EventAtTo      *Time
+	CreatedAtFrom  *Time
+	CreatedAtTo    *Time
+	Currency       *string
+	ReversalStatus *string
+	Metadata       *JSON
+}
+
+type transferOrderInput struct {
+	Field     string
+	Ascending bool
+}
+
+// Transfers resolves Query.transfers, a transfer search.
+func (r *Resolver) Transfers(ctx context.Context, args struct {
+	Filter  *transferFilterInput
+	OrderBy *transferOrderInput
+	First   int32
+	After   *string
+}) (*connection[*transferResolver], error) {
+	filter, err := args.Filter.toDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	var sort domain.TransferSort
+	if args.OrderBy != nil {
+		sort.Field = domain.TransferSortField(strings.ToLower(args.OrderBy.Field))
+		sort.Ascending = args.OrderBy.Ascending
+	}
+
+	page := pageArgs{First: args.First, After: args.After}
+	result, err := r.transfers.SearchTransfers(ctx, usecase.SearchTransfersInput{
+		Filter: filter,
+		Sort:   sort,
+		Cursor: page.cursor(),
+		Limit:  page.limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Search cursors encode the sort key, so every edge gets its own.
+	order, err := sort.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &connection[*transferResolver]{
+		nodes:     r.transferResolvers(result.Transfers),
+		cursors:   make([]string, len(result.Transfers)),
+		endCursor: result.NextCursor,
+	}
+	for i, t := range result.Transfers {
+		conn.cursors[i] = domain.NewTransferCursor(order, t).Encode()
+	}
+
+	return conn, nil
+}
+
+func (f *transferFilterInput) toDomain() (domain.TransferSearchFilter, error) {
+	var filter domain.TransferSearchFilter
+	if f == nil {
+		return filter, nil
+	}
+
+	if f.AccountID != nil {
+		filter.AccountID = string(*f.AccountID)
+	}
+	if f.Direction != nil {
+		filter.Direction = domain.TransferDirection(strings.ToLower(*f.Direction))
+	}
+	if f.ReversalStatus != nil {
+		filter.ReversalStatus = domain.TransferReversalStatus(strings.ToLower(*f.ReversalStatus))
+	}
+	if f.Currency != nil {
+		filter.Currency = *f.Currency
+	}
+	if f.Metadata != nil {
+		filter.Metadata = *f.Metadata
+	}
+
+	for _, bound := range []struct {
+		in  *string
+		out **decimal.Decimal
+	}{{f.MinAmount, &filter.MinAmount}, {f.MaxAmount, &filter.MaxAmount}} {
+		if bound.in == nil {
+			continue
+		}
+		d, err := decimal.NewFromString(*bound.in)
+		if err != nil {
+			return filter, fmt.Errorf("%w: invalid amount %q", domain.ErrInvalidTransferSearch, *bound.in)
+		}
+		*bound.out = &d
+	}
+
+	filter.EventAtFrom = timePtr(f.EventAtFrom)
+	filter.EventAtTo = timePtr(f.EventAtTo)
+	filter.CreatedAtFrom = timePtr(f.CreatedAtFrom)
+	filter.CreatedAtTo = timePtr(f.CreatedAtTo)
+
+	return filter, nil
+}
+
+// Hold resolves Query.hold; an unknown ID resolves to null.
+func (r *Resolver) Hold(ctx context.Context, args struct{ ID ID }) (*holdResolver, error) {
+	hold, err := r.holds.GetHold(ctx, string(args.ID))
+	if errors.Is(err, domain.ErrHoldNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &holdResolver{r: r, h: hold}, nil
+}
+
+func (r *Resolver) accountResolvers(accounts []*domain.Account) []*accountResolver {
+	out := make([]*accountResolver, len(accounts))
+	for i, a := range accounts {
+		out[i] = &accountResolver{r: r, a: a}
+	}
+	return out
+}
+
+func (r *Resolver) transferResolvers(transfers []*domain.Transfer) []*transferResolver {
+	out := make([]*transferResolver, len(transfers))
+	for i, t := range transfers {
+		out[i] = &transferResolver{r: r, t: t}
+	}
+	return out
+}
+
+func (r *Resolver) entryResolvers(entries []*domain.Entry) []*entryResolver {
+	out := make([]*entryResolver, len(entries))
+	for i, e := range entries {
+		out[i] = &entryResolver{r: r, e: e}
+	}
+	return out
+}
+
+func (r *Resolver) holdResolvers(holds []*domain.Hold) []*holdResolver {
+	out := make([]*holdResolver, len(holds))
+	for i, h := range holds {
+		out[i] = &holdResolver{r: r, h: h}
+	}
+	return out
+}
+
+// auditTrail is the audit trail of one resource. The schema restricts it
+// to admins with @hasRole.
+func (r *Resolver) auditTrail(ctx context.Context, resourceType, resourceID string) ([]*auditLogResolver, error) {
+	logs, err := r.audit.GetByResourceID(ctx, resourceType, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*auditLogResolver, len(logs))
+	for i, l := range logs {
+		out[i] = &auditLogResolver{l: l}
+	}
+
+	return out, nil
+}
+
+func timePtr(t *Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return &t.Time
+}
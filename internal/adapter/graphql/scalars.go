@@ -0,0 +1,100 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ID is the ID scalar. Inputs may be strings or integers.
+type ID string
+
+// UnmarshalGraphQL accepts a string or an integer.
+func (id *ID) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case string:
+		*id = ID(v)
+	case int64, json.Number:
+		*id = ID(fmt.Sprint(v))
+	default:
+		return fmt.Errorf("wrong type for ID: %T", input)
+	}
+
+	return nil
+}
+
+// Time is the Time scalar, written as an RFC3339 timestamp.
+type Time struct {
+	time.Time
+}
+
+// UnmarshalGraphQL accepts an RFC3339 timestamp or Unix seconds.
+func (t *Time) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case string:
+		parsed, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return err
+		}
+		t.Time = parsed
+	case int64:
+		t.Time = time.Unix(v, 0)
+	case float64:
+		t.Time = time.Unix(int64(v), 0)
+	default:
+		return fmt.Errorf("wrong type for Time: %T", input)
+	}
+
+	return nil
+}
+
+// Int64 is the Int64 scalar: account and entry versions outgrow GraphQL's
+// 32-bit Int.
+type Int64 int64
+
+// UnmarshalGraphQL accepts an integer or a numeric string.
+func (i *Int64) UnmarshalGraphQL(input any) error {
+	switch v := input.(type) {
+	case int64:
+		*i = Int64(v)
+	case float64:
+		*i = Int64(v)
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return err
+		}
+		*i = Int64(n)
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*i = Int64(n)
+	default:
+		return fmt.Errorf("wrong type for Int64: %T", input)
+	}
+
+	return nil
+}
+
+// JSON is the JSON scalar, for transfer and hold metadata.
+type JSON map[string]any
+
+// UnmarshalGraphQL accepts an object literal or a variable holding one.
+func (j *JSON) UnmarshalGraphQL(input any) error {
+	m, ok := input.(map[string]any)
+	if !ok {
+		return fmt.Errorf("wrong type for JSON: %T", input)
+	}
+
+	*j = m
+
+	return nil
+}
+
+// MarshalJSON writes the object as is.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any(j))
+}
@@ -0,0 +1,272 @@
+package graphql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// connection is a page of a cursor connection. Edge cursors are the keyset
+// cursor of their node; endCursor is the use case's NextCursor.
+type connection[N any] struct {
+	nodes     []N
+	cursors   []string
+	endCursor string
+}
+
+// idConnection builds a connection over a listing keyed by ULID, whose
+// cursors are node IDs.
+func idConnection[N interface{ ID() ID }](nodes []N, next string) *connection[N] {
+	cursors := make([]string, len(nodes))
+	for i, n := range nodes {
+		cursors[i] = string(n.ID())
+	}
+
+	return &connection[N]{nodes: nodes, cursors: cursors, endCursor: next}
+}
+
+func (c *connection[N]) Edges() []*edge[N] {
+	edges := make([]*edge[N], len(c.nodes))
+	for i, n := range c.nodes {
+		edges[i] = &edge[N]{cursor: c.cursors[i], node: n}
+	}
+	return edges
+}
+
+func (c *connection[N]) Nodes() []N { return c.nodes }
+
+func (c *connection[N]) PageInfo() *pageInfo {
+	return &pageInfo{endCursor: c.endCursor}
+}
+
+type edge[N any] struct {
+	cursor string
+	node   N
+}
+
+func (e *edge[N]) Cursor() string { return e.cursor }
+
+func (e *edge[N]) Node() N { return e.node }
+
+type pageInfo struct {
+	endCursor string
+}
+
+func (p *pageInfo) HasNextPage() bool { return p.endCursor != "" }
+
+func (p *pageInfo) EndCursor() *string {
+	if p.endCursor == "" {
+		return nil
+	}
+	return &p.endCursor
+}
+
+type accountResolver struct {
+	r *Resolver
+	a *domain.Account
+}
+
+func (a *accountResolver) ID() ID                     { return ID(a.a.ID) }
+func (a *accountResolver) Name() string               { return a.a.Name }
+func (a *accountResolver) Currency() string           { return a.a.Currency }
+func (a *accountResolver) Balance() string            { return a.a.Balance.String() }
+func (a *accountResolver) EncumberedBalance() string  { return a.a.EncumberedBalance.String() }
+func (a *accountResolver) AvailableBalance() string   { return a.a.AvailableBalance().String() }
+func (a *accountResolver) AllowNegativeBalance() bool { return a.a.AllowNegativeBalance }
+func (a *accountResolver) AllowPositiveBalance() bool { return a.a.AllowPositiveBalance }
+func (a *accountResolver) Version() Int64             { return Int64(a.a.Version) }
+func (a *accountResolver) CreatedAt() Time            { return Time{Time: a.a.CreatedAt} }
+func (a *accountResolver) UpdatedAt() Time            { return Time{Time: a.a.UpdatedAt} }
+
+func (a *accountResolver) Entries(ctx context.Context, args pageArgs) (*connection[*entryResolver], error) {
+	result, err := a.r.entries.GetEntriesByAccountCursor(ctx, usecase.GetEntriesByAccountCursorInput{
+		AccountID: a.a.ID,
+		Cursor:    args.cursor(),
+		Limit:     args.limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return idConnection(a.r.entryResolvers(result.Entries), result.NextCursor), nil
+}
+
+func (a *accountResolver) Transfers(ctx context.Context, args pageArgs) (*connection[*transferResolver], error) {
+	result, err := a.r.transfers.ListTransfersByAccountCursor(ctx, usecase.ListTransfersByAccountCursorInput{
+		AccountID: a.a.ID,
+		Cursor:    args.cursor(),
+		Limit:     args.limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return idConnection(a.r.transferResolvers(result.Transfers), result.NextCursor), nil
+}
+
+func (a *accountResolver) Holds(ctx context.Context, args struct {
+	Status *string
+	First  int32
+	After  *string
+}) (*connection[*holdResolver], error) {
+	var filter domain.HoldFilter
+	if args.Status != nil {
+		filter.Status = domain.HoldStatus(strings.ToLower(*args.Status))
+	}
+
+	page := pageArgs{First: args.First, After: args.After}
+	result, err := a.r.holds.ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{
+		AccountID: a.a.ID,
+		Filter:    filter,
+		Cursor:    page.cursor(),
+		Limit:     page.limit(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return idConnection(a.r.holdResolvers(result.Holds), result.NextCursor), nil
+}
+
+func (a *accountResolver) BalanceAt(ctx context.Context, args struct{ At Time }) (string, error) {
+	balance, err := a.r.entries.GetHistoricalBalance(ctx, a.a.ID, args.At.Time)
+	if err != nil {
+		return "", err
+	}
+
+	return balance.String(), nil
+}
+
+func (a *accountResolver) AuditTrail(ctx context.Context) ([]*auditLogResolver, error) {
+	return a.r.auditTrail(ctx, "account", a.a.ID)
+}
+
+type transferResolver struct {
+	r *Resolver
+	t *domain.Transfer
+}
+
+func (t *transferResolver) ID() ID            { return ID(t.t.ID) }
+func (t *transferResolver) FromAccountId() ID { return ID(t.t.FromAccountID) }
+func (t *transferResolver) ToAccountId() ID   { return ID(t.t.ToAccountID) }
+func (t *transferResolver) Amount() string    { return t.t.Amount.String() }
+func (t *transferResolver) CreatedAt() Time   { return Time{Time: t.t.CreatedAt} }
+func (t *transferResolver) EventAt() Time     { return Time{Time: t.t.EventAt} }
+func (t *transferResolver) Metadata() *JSON   { return jsonPtr(t.t.Metadata) }
+
+func (t *transferResolver) ReversedTransferId() *ID {
+	if t.t.ReversedTransferID == nil {
+		return nil
+	}
+	id := ID(*t.t.ReversedTransferID)
+	return &id
+}
+
+func (t *transferResolver) FromAccount(ctx context.Context) (*accountResolver, error) {
+	return t.r.loadAccount(ctx, t.t.FromAccountID)
+}
+
+func (t *transferResolver) ToAccount(ctx context.Context) (*accountResolver, error) {
+	return t.r.loadAccount(ctx, t.t.ToAccountID)
+}
+
+func (t *transferResolver) Entries(ctx context.Context) ([]*entryResolver, error) {
+	entries, err := t.r.entries.GetEntriesByTransfer(ctx, t.t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.r.entryResolvers(entries), nil
+}
+
+func (t *transferResolver) AuditTrail(ctx context.Context) ([]*auditLogResolver, error) {
+	return t.r.auditTrail(ctx, "transfer", t.t.ID)
+}
+
+type entryResolver struct {
+	r *Resolver
+	e *domain.Entry
+}
+
+func (e *entryResolver) ID() ID                         { return ID(e.e.ID) }
+func (e *entryResolver) AccountId() ID                  { return ID(e.e.AccountID) }
+func (e *entryResolver) TransferId() ID                 { return ID(e.e.TransferID) }
+func (e *entryResolver) Amount() string                 { return e.e.Amount.String() }
+func (e *entryResolver) AccountPreviousBalance() string { return e.e.AccountPreviousBalance.String() }
+func (e *entryResolver) AccountCurrentBalance() string  { return e.e.AccountCurrentBalance.String() }
+func (e *entryResolver) AccountVersion() Int64          { return Int64(e.e.AccountVersion) }
+func (e *entryResolver) CreatedAt() Time                { return Time{Time: e.e.CreatedAt} }
+
+func (e *entryResolver) Account(ctx context.Context) (*accountResolver, error) {
+	return e.r.loadAccount(ctx, e.e.AccountID)
+}
+
+func (e *entryResolver) Transfer(ctx context.Context) (*transferResolver, error) {
+	transfer, err := loadersFrom(ctx).transfers.Load(ctx, e.e.TransferID)()
+	if err != nil || transfer == nil {
+		return nil, err
+	}
+
+	return &transferResolver{r: e.r, t: transfer}, nil
+}
+
+type holdResolver struct {
+	r *Resolver
+	h *domain.Hold
+}
+
+func (h *holdResolver) ID() ID          { return ID(h.h.ID) }
+func (h *holdResolver) AccountId() ID   { return ID(h.h.AccountID) }
+func (h *holdResolver) Amount() string  { return h.h.Amount.String() }
+func (h *holdResolver) Status() string  { return strings.ToUpper(string(h.h.Status)) }
+func (h *holdResolver) Metadata() *JSON { return jsonPtr(h.h.Metadata) }
+func (h *holdResolver) CreatedAt() Time { return Time{Time: h.h.CreatedAt} }
+func (h *holdResolver) UpdatedAt() Time { return Time{Time: h.h.UpdatedAt} }
+
+func (h *holdResolver) ExpiresAt() *Time {
+	if h.h.ExpiresAt == nil {
+		return nil
+	}
+	return &Time{Time: *h.h.ExpiresAt}
+}
+
+func (h *holdResolver) Account(ctx context.Context) (*accountResolver, error) {
+	return h.r.loadAccount(ctx, h.h.AccountID)
+}
+
+func (h *holdResolver) AuditTrail(ctx context.Context) ([]*auditLogResolver, error) {
+	return h.r.auditTrail(ctx, "hold", h.h.ID)
+}
+
+type auditLogResolver struct {
+	l *domain.AuditLog
+}
+
+func (l *auditLogResolver) ID() ID               { return ID(l.l.ID) }
+func (l *auditLogResolver) UserId() string       { return l.l.UserID }
+func (l *auditLogResolver) Action() string       { return l.l.Action }
+func (l *auditLogResolver) ResourceType() string { return l.l.ResourceType }
+func (l *auditLogResolver) ResourceId() string   { return l.l.ResourceID }
+func (l *auditLogResolver) Status() string       { return l.l.Status }
+func (l *auditLogResolver) ErrorMessage() string { return l.l.ErrorMessage }
+func (l *auditLogResolver) CreatedAt() Time      { return Time{Time: l.l.CreatedAt} }
+
+// loadAccount resolves an account reference through the request's loader.
+func (r *Resolver) loadAccount(ctx context.Context, id string) (*accountResolver, error) {
+	account, err := loadersFrom(ctx).accounts.Load(ctx, id)()
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	return &accountResolver{r: r, a: account}, nil
+}
+
+func jsonPtr(m map[string]any) *JSON {
+	if m == nil {
+		return nil
+	}
+	j := JSON(m)
+	return &j
+}
@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"encoding/json"
+	"math"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -8,6 +10,7 @@ import (
 
 	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
 	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
 )
 
 // AccountToPb converts domain.Account to protobuf Account
@@ -29,6 +32,16 @@ func AccountToPb(a *domain.Account) *pb.Account {
 	}
 }
 
+// AccountsToPb converts domain accounts to protobuf Accounts
+func AccountsToPb(accounts []*domain.Account) []*pb.Account {
+	pbAccounts := make([]*pb.Account, len(accounts))
+	for i, a := range accounts {
+		pbAccounts[i] = AccountToPb(a)
+	}
+
+	return pbAccounts
+}
+
 // TransferToPb converts domain.Transfer to protobuf Transfer
 func TransferToPb(t *domain.Transfer) *pb.Transfer {
 	if t == nil {
@@ -56,9 +69,29 @@ func TransferToPb(t *domain.Transfer) *pb.Transfer {
 		pbTransfer.ReversedTransferId = t.ReversedTransferID
 	}
 
+	for _, f := range t.Fees {
+		pbTransfer.Fees = append(pbTransfer.Fees, &pb.TransferFee{
+			PolicyId:      f.PolicyID,
+			TransferId:    f.TransferID,
+			FromAccountId: f.FromAccountID,
+			ToAccountId:   f.ToAccountID,
+			Amount:        f.Amount.String(),
+		})
+	}
+
 	return pbTransfer
 }
 
+// TransfersToPb converts domain transfers to protobuf Transfers
+func TransfersToPb(transfers []*domain.Transfer) []*pb.Transfer {
+	pbTransfers := make([]*pb.Transfer, len(transfers))
+	for i, t := range transfers {
+		pbTransfers[i] = TransferToPb(t)
+	}
+
+	return pbTransfers
+}
+
 // EntryToPb converts domain.Entry to protobuf Entry
 func EntryToPb(e *domain.Entry) *pb.Entry {
 	if e == nil {
@@ -76,6 +109,16 @@ func EntryToPb(e *domain.Entry) *pb.Entry {
 	}
 }
 
+// EntriesToPb converts domain entries to protobuf Entries
+func EntriesToPb(entries []*domain.Entry) []*pb.Entry {
+	pbEntries := make([]*pb.Entry, len(entries))
+	for i, e := range entries {
+		pbEntries[i] = EntryToPb(e)
+	}
+
+	return pbEntries
+}
+
 // HoldToPb converts domain.Hold to protobuf Hold
 func HoldToPb(h *domain.Hold) *pb.Hold {
 	if h == nil {
@@ -106,6 +149,92 @@ func HoldToPb(h *domain.Hold) *pb.Hold {
 	return pbHold
 }
 
+// HoldsToPb converts domain holds to protobuf Holds
+func HoldsToPb(holds []*domain.Hold) []*pb.Hold {
+	pbHolds := make([]*pb.Hold, len(holds))
+	for i, h := range holds {
+		pbHolds[i] = HoldToPb(h)
+	}
+
+	return pbHolds
+}
+
+// WebhookToPb converts domain.WebhookSubscription to protobuf Webhook,
+// leaving out its secret
+func WebhookToPb(s *domain.WebhookSubscription) *pb.Webhook {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.Webhook{
+		Id:            s.ID,
+		Url:           s.URL,
+		EventTypes:    s.EventTypes,
+		AggregateType: s.AggregateType,
+		AggregateId:   s.AggregateID,
+		Description:   s.Description,
+		Active:        s.Active,
+		CreatedAt:     timestamppb.New(s.CreatedAt),
+		UpdatedAt:     timestamppb.New(s.UpdatedAt),
+	}
+}
+
+// WebhookDeliveryToPb converts domain.WebhookDelivery to protobuf
+// WebhookDelivery; the body is only included when withBody is set
+func WebhookDeliveryToPb(d *domain.WebhookDelivery, withBody bool) *pb.WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+
+	pbDelivery := &pb.WebhookDelivery{
+		Id:             d.ID,
+		WebhookId:      d.SubscriptionID,
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Status:         string(d.Status),
+		Attempts:       int32(min(d.Attempts, math.MaxInt32)),
+		LastError:      d.LastError,
+		LastStatusCode: intPtrToInt32Ptr(d.LastStatusCode),
+		CreatedAt:      timestamppb.New(d.CreatedAt),
+	}
+	if d.Status == domain.WebhookDeliveryPending {
+		pbDelivery.NextAttemptAt = timestamppb.New(d.NextAttemptAt)
+	}
+	if d.DeliveredAt != nil {
+		pbDelivery.DeliveredAt = timestamppb.New(*d.DeliveredAt)
+	}
+	if d.DeadLetteredAt != nil {
+		pbDelivery.DeadLetteredAt = timestamppb.New(*d.DeadLetteredAt)
+	}
+	if withBody {
+		pbDelivery.Body = d.Body
+	}
+
+	return pbDelivery
+}
+
+// WebhookDeliveryAttemptToPb converts domain.WebhookDeliveryAttempt to
+// protobuf WebhookDeliveryAttempt
+func WebhookDeliveryAttemptToPb(a *domain.WebhookDeliveryAttempt) *pb.WebhookDeliveryAttempt {
+	return &pb.WebhookDeliveryAttempt{
+		Attempt:     int32(min(a.Attempt, math.MaxInt32)),
+		StatusCode:  intPtrToInt32Ptr(a.StatusCode),
+		Error:       a.Error,
+		DurationMs:  a.Duration.Milliseconds(),
+		AttemptedAt: timestamppb.New(a.AttemptedAt),
+	}
+}
+
+func intPtrToInt32Ptr(n *int) *int32 {
+	if n == nil {
+		return nil
+	}
+
+	v := int32(min(max(*n, math.MinInt32), math.MaxInt32))
+
+	return &v
+}
+
 // ParseDecimal parses a decimal string with validation
 func ParseDecimal(s string) (decimal.Decimal, error) {
 	return decimal.NewFromString(s)
@@ -131,3 +260,151 @@ func MetadataToMap(pbMetadata map[string]string) map[string]any {
 	}
 	return metadata
 }
+
+// OutboxEventToPb converts a published domain.OutboxEvent to protobuf
+// Event, encoding the payload as JSON
+func OutboxEventToPb(e *domain.OutboxEvent) (*pb.Event, error) {
+	payload, err := json.Marshal(e.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	pbEvent := &pb.Event{
+		Id:                e.ID,
+		AggregateType:     e.AggregateType,
+		AggregateId:       e.AggregateID,
+		EventType:         e.EventType,
+		EventVersion:      e.EventVersion,
+		AggregateSequence: e.AggregateSequence,
+		Payload:           payload,
+		CreatedAt:         timestamppb.New(e.CreatedAt),
+	}
+	if e.PublishedAt != nil {
+		pbEvent.PublishedAt = timestamppb.New(*e.PublishedAt)
+	}
+
+	return pbEvent, nil
+}
+
+// AuditLogToPb converts domain.AuditLog to protobuf AuditLog, encoding the
+// before and after states as JSON
+func AuditLogToPb(a *domain.AuditLog) (*pb.AuditLog, error) {
+	before, err := auditStateToJSON(a.BeforeState)
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := auditStateToJSON(a.AfterState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuditLog{
+		Id:           a.ID,
+		UserId:       a.UserID,
+		Action:       a.Action,
+		ResourceType: a.ResourceType,
+		ResourceId:   a.ResourceID,
+		IpAddress:    a.IPAddress,
+		UserAgent:    a.UserAgent,
+		RequestId:    a.RequestID,
+		BeforeState:  before,
+		AfterState:   after,
+		Status:       a.Status,
+		ErrorMessage: a.ErrorMessage,
+		CreatedAt:    timestamppb.New(a.CreatedAt),
+		PrevHash:     a.PrevHash,
+		Hash:         a.Hash,
+		ChainSeq:     a.ChainSeq,
+	}, nil
+}
+
+// AuditLogsToPb converts domain audit logs to protobuf AuditLogs
+func AuditLogsToPb(logs []*domain.AuditLog) ([]*pb.AuditLog, error) {
+	pbLogs := make([]*pb.AuditLog, len(logs))
+	for i, l := range logs {
+		pbLog, err := AuditLogToPb(l)
+		if err != nil {
+			return nil, err
+		}
+		pbLogs[i] = pbLog
+	}
+
+	return pbLogs, nil
+}
+
+func auditStateToJSON(state domain.JSON) ([]byte, error) {
+	if state == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(state)
+}
+
+// ReconciliationReportToPb converts usecase.ReconciliationReport to
+// protobuf ReconciliationReport
+func ReconciliationReportToPb(r *usecase.ReconciliationReport) *pb.ReconciliationReport {
+	if r == nil {
+		return nil
+	}
+
+	pbReport := &pb.ReconciliationReport{
+		CheckedAt:          timestamppb.New(r.CheckedAt),
+		TotalAccounts:      int32(min(r.TotalAccounts, math.MaxInt32)),
+		ReconciledAccounts: int32(min(r.ReconciledAccounts, math.MaxInt32)),
+		LedgerConsistent:   r.LedgerConsistent,
+	}
+
+	for _, d := range r.Discrepancies {
+		pbReport.Discrepancies = append(pbReport.Discrepancies, &pb.AccountReconciliation{
+			AccountId:         d.AccountID,
+			RecordedBalance:   d.RecordedBalance.String(),
+			CalculatedBalance: d.CalculatedBalance.String(),
+			Difference:        d.Difference.String(),
+			Reconciled:        d.IsReconciled,
+			LastChecked:       timestamppb.New(d.LastChecked),
+		})
+	}
+
+	for _, c := range r.ChainBreaks {
+		chain := &pb.EntryChain{AccountId: c.AccountID, Valid: c.Valid}
+		for _, b := range c.Breaks {
+			chain.Breaks = append(chain.Breaks, &pb.EntryChainBreak{
+				EntryId:  b.EntryID,
+				Sequence: int32(min(b.Sequence, math.MaxInt32)),
+				Reason:   b.Reason,
+			})
+		}
+		pbReport.ChainBreaks = append(pbReport.ChainBreaks, chain)
+	}
+
+	return pbReport
+}
+
+// UserToPb converts domain.User to protobuf User. The password hash is
+// never copied.
+func UserToPb(u *domain.User) *pb.User {
+	if u == nil {
+		return nil
+	}
+
+	return &pb.User{
+		Id:        u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		Role:      string(u.Role),
+		Active:    u.Active,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+		UpdatedAt: timestamppb.New(u.UpdatedAt),
+	}
+}
+
+// UsersToPb converts domain users to protobuf Users
+func UsersToPb(users []*domain.User) []*pb.User {
+	pbUsers := make([]*pb.User, len(users))
+	for i, u := range users {
+		pbUsers[i] = UserToPb(u)
+	}
+
+	return pbUsers
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
 )
 
 func TestAccountToPb(t *testing.T) {
@@ -59,6 +61,13 @@ func TestTransferToPb(t *testing.T) {
 		EventAt:            now.Add(time.Minute),
 		Metadata:           map[string]any{"note": "test", "ignored": 123},
 		ReversedTransferID: &reversed,
+		Fees: []domain.TransferFee{{
+			PolicyID:      "fp-1",
+			TransferID:    "tx-fee",
+			FromAccountID: "acc-1",
+			ToAccountID:   "acc-rev",
+			Amount:        decimal.RequireFromString("0.25"),
+		}},
 	}
 
 	got := TransferToPb(transfer)
@@ -78,6 +87,10 @@ func TestTransferToPb(t *testing.T) {
 		t.Fatalf("expected reversed transfer ID to be set")
 	}
 
+	if len(got.Fees) != 1 || got.Fees[0].PolicyId != "fp-1" || got.Fees[0].Amount != "0.25" {
+		t.Fatalf("unexpected fees: %+v", got.Fees)
+	}
+
 	if TransferToPb(nil) != nil {
 		t.Fatal("expected nil transfer to return nil")
 	}
@@ -114,6 +127,22 @@ func TestEntryToPb(t *testing.T) {
 	}
 }
 
+func TestEntriesToPb(t *testing.T) {
+	entries := []*domain.Entry{
+		{ID: "e-1", AccountID: "acc-1", Amount: decimal.NewFromInt(-5)},
+		{ID: "e-2", AccountID: "acc-2", Amount: decimal.NewFromInt(5)},
+	}
+
+	got := EntriesToPb(entries)
+	if len(got) != 2 || got[0].Id != "e-1" || got[1].Amount != "5" {
+		t.Fatalf("unexpected entries: %+v", got)
+	}
+
+	if got := EntriesToPb(nil); got == nil || len(got) != 0 {
+		t.Fatalf("expected an empty, non-nil slice, got %v", got)
+	}
+}
+
 func TestHoldToPb(t *testing.T) {
 	now := time.Now().UTC()
 	expiration := now.Add(time.Hour)
@@ -181,3 +210,124 @@ func TestMetadataToMap(t *testing.T) {
 		t.Fatal("expected nil map to return nil")
 	}
 }
+
+func TestAuditLogToPb(t *testing.T) {
+	now := time.Now().UTC().Round(time.Millisecond)
+	log := &domain.AuditLog{
+		ID:           "audit-1",
+		UserID:       "user-1",
+		Action:       string(domain.AuditActionTransferCreate),
+		ResourceType: "transfer",
+		ResourceID:   "tx-1",
+		RequestID:    "req-1",
+		AfterState:   domain.JSON{"amount": "10"},
+		Status:       string(domain.AuditStatusSuccess),
+		CreatedAt:    now,
+		PrevHash:     "prev",
+		Hash:         "hash",
+		ChainSeq:     7,
+	}
+
+	got, err := AuditLogToPb(log)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Id != log.ID || got.Action != log.Action || got.ResourceId != log.ResourceID || got.RequestId != log.RequestID {
+		t.Fatalf("unexpected audit log fields: %+v", got)
+	}
+
+	if got.PrevHash != "prev" || got.Hash != "hash" || got.ChainSeq != 7 {
+		t.Fatalf("expected chain fields to match: %+v", got)
+	}
+
+	if got.BeforeState != nil {
+		t.Fatalf("expected no before state, got %s", got.BeforeState)
+	}
+
+	var after map[string]any
+	if err := json.Unmarshal(got.AfterState, &after); err != nil || after["amount"] != "10" {
+		t.Fatalf("expected after state JSON, got %s (%v)", got.AfterState, err)
+	}
+
+	if !got.CreatedAt.AsTime().Equal(now) {
+		t.Fatal("expected timestamps to match")
+	}
+}
+
+func TestAuditLogToPb_UnencodableState(t *testing.T) {
+	log := &domain.AuditLog{ID: "audit-1", BeforeState: domain.JSON{"bad": make(chan int)}}
+
+	if _, err := AuditLogsToPb([]*domain.AuditLog{log}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestReconciliationReportToPb(t *testing.T) {
+	now := time.Now().UTC().Round(time.Millisecond)
+	report := &usecase.ReconciliationReport{
+		CheckedAt:          now,
+		TotalAccounts:      3,
+		ReconciledAccounts: 2,
+		LedgerConsistent:   true,
+		Discrepancies: []*usecase.ReconciliationResult{{
+			AccountID:         "acc-1",
+			RecordedBalance:   decimal.NewFromInt(100),
+			CalculatedBalance: decimal.NewFromInt(90),
+			Difference:        decimal.NewFromInt(10),
+			LastChecked:       now,
+		}},
+		ChainBreaks: []*usecase.EntryChainResult{{
+			AccountID: "acc-2",
+			Breaks:    []usecase.EntryChainBreak{{EntryID: "e-3", Sequence: 2, Reason: "gap"}},
+		}},
+	}
+
+	got := ReconciliationReportToPb(report)
+	if got == nil {
+		t.Fatal("expected protobuf report")
+	}
+
+	if got.TotalAccounts != 3 || got.ReconciledAccounts != 2 || !got.LedgerConsistent || !got.CheckedAt.AsTime().Equal(now) {
+		t.Fatalf("unexpected report fields: %+v", got)
+	}
+
+	if len(got.Discrepancies) != 1 || got.Discrepancies[0].Difference != "10" || got.Discrepancies[0].Reconciled {
+		t.Fatalf("unexpected discrepancies: %+v", got.Discrepancies)
+	}
+
+	if len(got.ChainBreaks) != 1 || got.ChainBreaks[0].Valid || got.ChainBreaks[0].Breaks[0].EntryId != "e-3" || got.ChainBreaks[0].Breaks[0].Sequence != 2 {
+		t.Fatalf("unexpected chain breaks: %+v", got.ChainBreaks)
+	}
+
+	if ReconciliationReportToPb(nil) != nil {
+		t.Fatal("expected nil report to return nil")
+	}
+}
+
+func TestUserToPb(t *testing.T) {
+	now := time.Now().UTC().Round(time.Millisecond)
+	user := &domain.User{
+		ID:             "user-1",
+		Email:          "ops@example.com",
+		Name:           "Ops",
+		HashedPassword: "secret-hash",
+		Role:           domain.RoleOperator,
+		Active:         true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+
+	got := UserToPb(user)
+	if got.Id != "user-1" || got.Email != "ops@example.com" || got.Role != "operator" || !got.Active || !got.CreatedAt.AsTime().Equal(now) {
+		t.Fatalf("unexpected user: %+v", got)
+	}
+
+	if UserToPb(nil) != nil {
+		t.Fatal("expected nil user to return nil")
+	}
+
+	if users := UsersToPb([]*domain.User{user}); len(users) != 1 || users[0].Id != "user-1" {
+		t.Fatalf("unexpected users: %+v", users)
+	}
+}
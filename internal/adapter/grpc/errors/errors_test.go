@@ -3,11 +3,14 @@ package errors_test
 import (
 	"context"
 	stdErrors "errors"
+	"fmt"
 	"testing"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	grpcerrors "github.com/iho/goledger/internal/adapter/grpc/errors"
 	"github.com/iho/goledger/internal/domain"
 )
@@ -25,14 +28,24 @@ func TestMapDomainError(t *testing.T) {
 		{"account not found", domain.ErrAccountNotFound, codes.NotFound, "account not found"},
 		{"transfer not found", domain.ErrTransferNotFound, codes.NotFound, "transfer not found"},
 		{"hold not found", domain.ErrHoldNotFound, codes.NotFound, "hold not found"},
-		{"invalid amount", domain.ErrInvalidAmount, codes.InvalidArgument, "invalid amount: must be positive"},
-		{"same account", domain.ErrSameAccount, codes.InvalidArgument, "cannot transfer to the same account"},
-		{"currency mismatch", domain.ErrCurrencyMismatch, codes.InvalidArgument, "currency mismatch between accounts"},
-		{"negative balance", domain.ErrNegativeBalanceNotAllowed, codes.FailedPrecondition, "operation would result in negative balance"},
-		{"positive balance", domain.ErrPositiveBalanceNotAllowed, codes.FailedPrecondition, "operation would result in positive balance"},
-		{"insufficient funds", domain.ErrInsufficientFunds, codes.FailedPrecondition, "insufficient funds"},
+		{"invalid amount", domain.ErrInvalidAmount, codes.InvalidArgument, "amount must be positive"},
+		{"same account", domain.ErrSameAccount, codes.InvalidArgument, "cannot transfer to same account"},
+		{"currency mismatch", domain.ErrCurrencyMismatch, codes.InvalidArgument, "cannot transfer between different currencies"},
+		{"negative balance", domain.ErrNegativeBalanceNotAllowed, codes.FailedPrecondition, "account does not allow negative balance"},
+		{"positive balance", domain.ErrPositiveBalanceNotAllowed, codes.FailedPrecondition, "account does not allow positive balance"},
+		{"insufficient funds", domain.ErrInsufficientFunds, codes.FailedPrecondition, "insufficient funds for hold"},
 		{"hold not active", domain.ErrHoldNotActive, codes.FailedPrecondition, "hold is not active"},
 		{"transfer already reversed", domain.ErrTransferAlreadyReversed, codes.FailedPrecondition, "transfer has already been reversed"},
+		{"invalid hold filter", domain.ErrInvalidHoldFilter, codes.InvalidArgument, "invalid hold filter"},
+		{"invalid transfer search", domain.ErrInvalidTransferSearch, codes.InvalidArgument, "invalid transfer search"},
+		{"invalid cursor", domain.ErrInvalidCursor, codes.InvalidArgument, "invalid cursor"},
+		{"invalid entry export", domain.ErrInvalidEntryExport, codes.InvalidArgument, "invalid entry export"},
+		{"user not found", domain.ErrUserNotFound, codes.NotFound, "user not found"},
+		{"user already exists", domain.ErrUserAlreadyExists, codes.AlreadyExists, "user with this email already exists"},
+		{"incorrect password", domain.ErrIncorrectPassword, codes.InvalidArgument, "current password is incorrect"},
+		{"user inactive", domain.ErrUserInactive, codes.PermissionDenied, "user account is inactive"},
+		{"last admin", domain.ErrLastAdmin, codes.FailedPrecondition, "cannot remove the last active admin"},
+		{"version mismatch", domain.ErrVersionMismatch, codes.FailedPrecondition, "account version does not match the expected version"},
 		{"deadline exceeded", context.DeadlineExceeded, codes.DeadlineExceeded, "operation timed out"},
 		{"canceled", context.Canceled, codes.Canceled, "operation was canceled"},
 		{"unknown error", stdErrors.New("boom"), codes.Internal, "an internal error occurred"},
@@ -76,3 +89,45 @@ func TestMapErrorAlias(t *testing.T) {
 		t.Fatalf("expected MapError to match MapDomainError")
 	}
 }
+
+func TestMapDomainError_Details(t *testing.T) {
+	err := domain.NewFieldError("direction", fmt.Errorf("%w: unknown direction %q", domain.ErrInvalidTransferSearch, "sideways"))
+
+	st := status.Convert(grpcerrors.MapDomainError(err))
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %s", st.Code())
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+
+	if info == nil || info.GetReason() != "INVALID_TRANSFER_SEARCH" || info.GetDomain() != apierror.Domain {
+		t.Fatalf("expected INVALID_TRANSFER_SEARCH error info, got %v", info)
+	}
+
+	if badRequest == nil || len(badRequest.GetFieldViolations()) != 1 {
+		t.Fatalf("expected one field violation, got %v", badRequest)
+	}
+	if v := badRequest.GetFieldViolations()[0]; v.GetField() != "direction" || v.GetDescription() != err.Error() {
+		t.Fatalf("unexpected field violation %v", v)
+	}
+}
+
+func TestMapDomainError_InternalHasNoFieldViolations(t *testing.T) {
+	st := status.Convert(grpcerrors.MapDomainError(stdErrors.New("pq: connection refused")))
+
+	if len(st.Details()) != 1 {
+		t.Fatalf("expected only error info, got %v", st.Details())
+	}
+	if info, ok := st.Details()[0].(*errdetails.ErrorInfo); !ok || info.GetReason() != "INTERNAL" {
+		t.Fatalf("expected INTERNAL error info, got %v", st.Details()[0])
+	}
+}
@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/textproto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	grpcMiddleware "github.com/iho/goledger/internal/adapter/grpc/middleware"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	httpMiddleware "github.com/iho/goledger/internal/adapter/http/middleware"
+)
+
+// registrations lists every service exposed through the gateway. A service
+// the server doesn't register (EventService with EVENT_STREAM_ENABLED=false)
+// answers 501 Not Implemented.
+var registrations = []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+	pb.RegisterAccountServiceHandler,
+	pb.RegisterTransferServiceHandler,
+	pb.RegisterHoldServiceHandler,
+	pb.RegisterEntryServiceHandler,
+	pb.RegisterLedgerServiceHandler,
+	pb.RegisterStatementServiceHandler,
+	pb.RegisterWebhookServiceHandler,
+	pb.RegisterAuditServiceHandler,
+	pb.RegisterUserServiceHandler,
+	pb.RegisterEventServiceHandler,
+}
+
+// NewHandler returns an HTTP handler serving the REST/JSON routes declared by
+// the google.api.http annotations in proto/goledger/v1. Each request is
+// transcoded into an RPC on conn, so it passes the gRPC server's auth, RBAC
+// and idempotency interceptors exactly like a native gRPC call.
+//
+// JSON uses the proto field names (snake_case, as in /api/v1) and always
+// includes zero values.
+func NewHandler(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+
+	for _, register := range registrations {
+		if err := register(ctx, mux, conn); err != nil {
+			return nil, fmt.Errorf("failed to register gateway handler: %w", err)
+		}
+	}
+
+	return mux, nil
+}
+
+// incomingHeaderMatcher forwards the HTTP Idempotency-Key header as the
+// x-idempotency-key metadata the gRPC interceptor reads. Authorization is
+// always forwarded by the gateway itself.
+func incomingHeaderMatcher(key string) (string, bool) {
+	if textproto.CanonicalMIMEHeaderKey(key) == httpMiddleware.IdempotencyKeyHeader {
+		return grpcMiddleware.IdempotencyKeyHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
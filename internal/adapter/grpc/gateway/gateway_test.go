@@ -0,0 +1,141 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/iho/goledger/internal/adapter/grpc/gateway"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+)
+
+type accountServerStub struct {
+	pb.UnimplementedAccountServiceServer
+}
+
+func (accountServerStub) GetAccount(ctx context.Context, req *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	if req.Id != "acc-1" {
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+
+	return &pb.GetAccountResponse{Account: &pb.Account{Id: req.Id, Name: "Main", Currency: "USD", Balance: "0"}}, nil
+}
+
+type holdServerStub struct {
+	pb.UnimplementedHoldServiceServer
+	md metadata.MD
+}
+
+func (s *holdServerStub) VoidHold(ctx context.Context, req *pb.VoidHoldRequest) (*pb.VoidHoldResponse, error) {
+	s.md, _ = metadata.FromIncomingContext(ctx)
+	if req.HoldId != "hold-1" {
+		return nil, status.Error(codes.NotFound, "hold not found")
+	}
+
+	return &pb.VoidHoldResponse{}, nil
+}
+
+func newGateway(t *testing.T, holds *holdServerStub) http.Handler {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterAccountServiceServer(srv, accountServerStub{})
+	pb.RegisterHoldServiceServer(srv, holds)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	h, err := gateway.NewHandler(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("NewHandler: %v", err)
+	}
+
+	return h
+}
+
+func TestGateway_GetAccount(t *testing.T) {
+	h := newGateway(t, &holdServerStub{})
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/accounts/acc-1", http.NoBody))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		Account map[string]any `json:"account"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if resp.Account["id"] != "acc-1" || resp.Account["currency"] != "USD" {
+		t.Fatalf("unexpected account: %v", resp.Account)
+	}
+	// Proto field names and zero values, as in /api/v1.
+	if _, ok := resp.Account["allow_negative_balance"]; !ok {
+		t.Fatalf("expected allow_negative_balance to be present, got %v", resp.Account)
+	}
+}
+
+func TestGateway_MapsStatusCodes(t *testing.T) {
+	h := newGateway(t, &holdServerStub{})
+
+	tests := []struct {
+		method string
+		path   string
+		want   int
+	}{
+		{http.MethodGet, "/v1/accounts/missing", http.StatusNotFound},
+		{http.MethodGet, "/v1/accounts", http.StatusNotImplemented},
+		{http.MethodGet, "/v1/nope", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, http.NoBody))
+		if rec.Code != tt.want {
+			t.Errorf("%s %s: expected %d, got %d", tt.method, tt.path, tt.want, rec.Code)
+		}
+	}
+}
+
+func TestGateway_ForwardsAuthAndIdempotencyHeaders(t *testing.T) {
+	holds := &holdServerStub{}
+	h := newGateway(t, holds)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/holds/hold-1:void", http.NoBody)
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Set("Idempotency-Key", "key-1")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if got := holds.md.Get("authorization"); len(got) != 1 || got[0] != "Bearer token" {
+		t.Fatalf("expected authorization metadata, got %v", got)
+	}
+	if got := holds.md.Get("x-idempotency-key"); len(got) != 1 || got[0] != "key-1" {
+		t.Fatalf("expected x-idempotency-key metadata, got %v", got)
+	}
+}
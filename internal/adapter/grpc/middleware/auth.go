@@ -2,13 +2,13 @@ package middleware
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/infrastructure/auth"
 )
@@ -17,57 +17,89 @@ import (
 type ContextKey string
 
 const (
-	// UserContextKey is the context key for the authenticated user
-	UserContextKey ContextKey = "user"
+	// UserContextKey is the context key for the authenticated user. It is
+	// domain.UserContextKey, so use cases can read the user with
+	// domain.UserFromContext as they do for HTTP requests.
+	UserContextKey = domain.UserContextKey
 
 	// AuthorizationHeader is the metadata key for authorization
 	AuthorizationHeader = "authorization"
 )
 
-// AuthInterceptor creates a gRPC authentication interceptor
-func AuthInterceptor(jwtManager *auth.JWTManager) grpc.UnaryServerInterceptor {
+// AuthInterceptor creates a gRPC authentication interceptor. The user in
+// context is the stored one, so deactivated users are rejected and role
+// changes apply to tokens already issued.
+func AuthInterceptor(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req any,
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		// Extract metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-
-		// Extract authorization token
-		values := md.Get(AuthorizationHeader)
-		if len(values) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization token")
+		ctx, err := authenticate(ctx, authenticator)
+		if err != nil {
+			return nil, err
 		}
 
-		accessToken := values[0]
-
-		// Remove "Bearer " prefix if present
-		accessToken = strings.TrimPrefix(accessToken, "Bearer ")
+		// Call the handler with the new context
+		return handler(ctx, req)
+	}
+}
 
-		// Verify token
-		claims, err := jwtManager.Verify(accessToken)
+// StreamAuthInterceptor is AuthInterceptor for streaming RPCs.
+func StreamAuthInterceptor(authenticator *auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context(), authenticator)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+			return err
 		}
 
-		// Create user from claims
-		user := &domain.User{
-			ID:    claims.UserID,
-			Email: claims.Email,
-			Role:  claims.Role,
-		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		// Add user to context
-		ctx = context.WithValue(ctx, UserContextKey, user)
+// authenticate verifies the bearer token in the incoming metadata and
+// returns ctx carrying its user as currently stored.
+func authenticate(ctx context.Context, authenticator *auth.Authenticator) (context.Context, error) {
+	// Extract metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, apierror.Status(fmt.Errorf("%w: missing metadata", domain.ErrUnauthorized)).Err()
+	}
 
-		// Call the handler with the new context
-		return handler(ctx, req)
+	// Extract authorization token
+	values := md.Get(AuthorizationHeader)
+	if len(values) == 0 {
+		return nil, apierror.Status(fmt.Errorf("%w: missing authorization token", domain.ErrUnauthorized)).Err()
+	}
+
+	accessToken := values[0]
+	// Remove "Bearer " prefix if present
+	accessToken = strings.TrimPrefix(accessToken, "Bearer ")
+
+	// Verify token and load its user
+	user, err := authenticator.Authenticate(ctx, accessToken)
+	if err != nil {
+		return nil, apierror.Status(err).Err()
 	}
+
+	// Add user to context
+	return context.WithValue(ctx, UserContextKey, user), nil
+}
+
+// contextServerStream overrides the context of a grpc.ServerStream.
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
 }
 
 // RequireRole creates a gRPC interceptor that checks for a specific role
@@ -78,29 +110,38 @@ func RequireRoleInterceptor(minRole domain.Role) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
-		user, ok := GetUserFromContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "unauthorized")
-		}
-
-		// Check role permissions
-		switch minRole {
-		case domain.RoleAdmin:
-			if user.Role != domain.RoleAdmin {
-				return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
-			}
-		case domain.RoleOperator:
-			if user.Role != domain.RoleAdmin && user.Role != domain.RoleOperator {
-				return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
-			}
-		case domain.RoleViewer:
-			// All authenticated users can view
+		if err := checkRole(ctx, minRole); err != nil {
+			return nil, err
 		}
 
 		return handler(ctx, req)
 	}
 }
 
+// checkRole reports whether the user in ctx has at least minRole.
+func checkRole(ctx context.Context, minRole domain.Role) error {
+	user, ok := GetUserFromContext(ctx)
+	if !ok {
+		return apierror.Status(domain.ErrUnauthorized).Err()
+	}
+
+	// Check role permissions
+	switch minRole {
+	case domain.RoleAdmin:
+		if user.Role != domain.RoleAdmin {
+			return apierror.Status(domain.ErrInsufficientRole).Err()
+		}
+	case domain.RoleOperator:
+		if user.Role != domain.RoleAdmin && user.Role != domain.RoleOperator {
+			return apierror.Status(domain.ErrInsufficientRole).Err()
+		}
+	case domain.RoleViewer:
+		// All authenticated users can view
+	}
+
+	return nil
+}
+
 // GetUserFromContext extracts the authenticated user from context
 func GetUserFromContext(ctx context.Context) (*domain.User, bool) {
 	user, ok := ctx.Value(UserContextKey).(*domain.User)
@@ -126,6 +167,28 @@ func MethodRoleInterceptor(roles map[string]domain.Role) grpc.UnaryServerInterce
 	}
 }
 
+// StreamMethodRoleInterceptor is MethodRoleInterceptor for streaming RPCs.
+// Must run after StreamAuthInterceptor.
+func StreamMethodRoleInterceptor(roles map[string]domain.Role) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		minRole, ok := roles[info.FullMethod]
+		if !ok {
+			minRole = domain.RoleViewer
+		}
+
+		if err := checkRole(ss.Context(), minRole); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
 // ChainUnaryServer chains multiple unary interceptors
 func ChainUnaryServer(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
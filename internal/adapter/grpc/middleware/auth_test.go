@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -19,7 +20,8 @@ func TestAuthInterceptor(t *testing.T) {
 	t.Parallel()
 
 	jwtManager := auth.NewJWTManager("secret", time.Hour)
-	interceptor := middleware.AuthInterceptor(jwtManager)
+	users := stubUsers{"user-1": {ID: "user-1", Email: "user@example.com", Role: domain.RoleAdmin, Active: true}}
+	interceptor := middleware.AuthInterceptor(auth.NewAuthenticator(jwtManager, users, 0))
 
 	t.Run("missing metadata", func(t *testing.T) {
 		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
@@ -29,6 +31,9 @@ func TestAuthInterceptor(t *testing.T) {
 		if status.Code(err) != codes.Unauthenticated {
 			t.Fatalf("expected unauthenticated, got %v", err)
 		}
+		if reason := errorInfoReason(err); reason != "UNAUTHENTICATED" {
+			t.Fatalf("expected reason UNAUTHENTICATED, got %q", reason)
+		}
 	})
 
 	t.Run("invalid token", func(t *testing.T) {
@@ -40,6 +45,9 @@ func TestAuthInterceptor(t *testing.T) {
 		if status.Code(err) != codes.Unauthenticated {
 			t.Fatalf("expected unauthenticated for invalid token, got %v", err)
 		}
+		if reason := errorInfoReason(err); reason != "INVALID_TOKEN" {
+			t.Fatalf("expected reason INVALID_TOKEN, got %q", reason)
+		}
 	})
 
 	t.Run("valid token injects user", func(t *testing.T) {
@@ -78,6 +86,59 @@ func TestAuthInterceptor(t *testing.T) {
 	})
 }
 
+func TestAuthInterceptorUsesStoredUser(t *testing.T) {
+	t.Parallel()
+
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	users := stubUsers{"admin-1": {ID: "admin-1", Role: domain.RoleAdmin, Active: true}}
+	chain := middleware.ChainUnaryServer(
+		middleware.AuthInterceptor(auth.NewAuthenticator(jwtManager, users, 0)),
+		middleware.MethodRoleInterceptor(map[string]domain.Role{"/test.Service/Admin": domain.RoleAdmin}),
+	)
+
+	token, err := jwtManager.Generate(&domain.User{ID: "admin-1", Role: domain.RoleAdmin})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Admin"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	if _, err := chain(ctx, nil, info, handler); err != nil {
+		t.Fatalf("admin should pass: %v", err)
+	}
+
+	t.Run("demoted admin's token loses admin methods", func(t *testing.T) {
+		users["admin-1"] = domain.User{ID: "admin-1", Role: domain.RoleViewer, Active: true}
+
+		if _, err := chain(ctx, nil, info, handler); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected permission denied, got %v", err)
+		}
+	})
+
+	t.Run("deactivated user's existing token is rejected", func(t *testing.T) {
+		users["admin-1"] = domain.User{ID: "admin-1", Role: domain.RoleAdmin, Active: false}
+
+		_, err := chain(ctx, nil, info, handler)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected permission denied, got %v", err)
+		}
+		if reason := errorInfoReason(err); reason != "USER_INACTIVE" {
+			t.Fatalf("expected reason USER_INACTIVE, got %q", reason)
+		}
+	})
+
+	t.Run("deleted user's existing token is rejected", func(t *testing.T) {
+		delete(users, "admin-1")
+
+		if _, err := chain(ctx, nil, info, handler); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", err)
+		}
+	})
+}
+
 func TestRequireRoleInterceptor(t *testing.T) {
 	t.Parallel()
 
@@ -105,6 +166,9 @@ func TestRequireRoleInterceptor(t *testing.T) {
 		if status.Code(err) != codes.PermissionDenied {
 			t.Fatalf("expected permission denied, got %v", err)
 		}
+		if reason := errorInfoReason(err); reason != "INSUFFICIENT_ROLE" {
+			t.Fatalf("expected reason INSUFFICIENT_ROLE, got %q", reason)
+		}
 	})
 
 	t.Run("operator access", func(t *testing.T) {
@@ -120,6 +184,9 @@ func TestRequireRoleInterceptor(t *testing.T) {
 		if status.Code(err) != codes.Unauthenticated {
 			t.Fatalf("expected unauthenticated, got %v", err)
 		}
+		if reason := errorInfoReason(err); reason != "UNAUTHENTICATED" {
+			t.Fatalf("expected reason UNAUTHENTICATED, got %q", reason)
+		}
 	})
 }
 
@@ -163,3 +230,90 @@ func TestChainUnaryServer(t *testing.T) {
 		}
 	}
 }
+
+func TestStreamInterceptors(t *testing.T) {
+	t.Parallel()
+
+	jwtManager := auth.NewJWTManager("secret", time.Hour)
+	users := stubUsers{"viewer-1": {ID: "viewer-1", Role: domain.RoleViewer, Active: true}}
+	authInterceptor := middleware.StreamAuthInterceptor(auth.NewAuthenticator(jwtManager, users, 0))
+	roleInterceptor := middleware.StreamMethodRoleInterceptor(map[string]domain.Role{
+		"/test.Service/AdminStream": domain.RoleAdmin,
+	})
+
+	token, err := jwtManager.Generate(&domain.User{ID: "viewer-1", Role: domain.RoleViewer})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	// run chains both interceptors around a handler that reports whether it
+	// ran and which user it saw.
+	run := func(ctx context.Context, method string) (*domain.User, error) {
+		var seen *domain.User
+		handler := func(srv any, ss grpc.ServerStream) error {
+			seen, _ = middleware.GetUserFromContext(ss.Context())
+			return nil
+		}
+		info := &grpc.StreamServerInfo{FullMethod: method, IsServerStream: true}
+
+		err := authInterceptor(nil, &fakeServerStream{ctx: ctx}, info, func(srv any, ss grpc.ServerStream) error {
+			return roleInterceptor(srv, ss, info, handler)
+		})
+		return seen, err
+	}
+
+	t.Run("missing token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+		if _, err := run(ctx, "/test.Service/Stream"); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", err)
+		}
+	})
+
+	authed := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	t.Run("viewer may open unlisted streams", func(t *testing.T) {
+		user, err := run(authed, "/test.Service/Stream")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user == nil || user.ID != "viewer-1" {
+			t.Fatalf("expected the authenticated user in the stream context, got %+v", user)
+		}
+	})
+
+	t.Run("viewer denied admin streams", func(t *testing.T) {
+		if _, err := run(authed, "/test.Service/AdminStream"); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("expected permission denied, got %v", err)
+		}
+	})
+}
+
+// stubUsers is an auth.UserLoader over a map of stored users.
+type stubUsers map[string]domain.User
+
+func (s stubUsers) GetUser(_ context.Context, id string) (*domain.User, error) {
+	user, ok := s[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	return &user, nil
+}
+
+// errorInfoReason returns the ErrorInfo reason err's status carries.
+func errorInfoReason(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -8,10 +8,11 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/domain"
 )
 
 const (
@@ -52,7 +53,7 @@ func IdempotencyInterceptor(store IdempotencyStore) grpc.UnaryServerInterceptor
 
 		idempotencyKey := keys[0]
 		if idempotencyKey == "" {
-			return nil, status.Error(codes.InvalidArgument, "idempotency key cannot be empty")
+			return nil, apierror.Status(apierror.BadField(IdempotencyKeyHeader, "idempotency key cannot be empty")).Err()
 		}
 
 		// Generate a unique key combining method and idempotency key
@@ -61,7 +62,7 @@ func IdempotencyInterceptor(store IdempotencyStore) grpc.UnaryServerInterceptor
 		// Generate request fingerprint for validation
 		requestHash, err := hashRequest(req)
 		if err != nil {
-			return nil, status.Error(codes.Internal, "failed to generate request hash")
+			return nil, apierror.Status(fmt.Errorf("hash request: %w", err)).Err()
 		}
 
 		// Check if we've seen this request before
@@ -81,13 +82,13 @@ func IdempotencyInterceptor(store IdempotencyStore) grpc.UnaryServerInterceptor
 			// Request was already processed
 			if string(cachedHash) != requestHash {
 				// Same idempotency key but different request body
-				return nil, status.Error(codes.InvalidArgument, "idempotency key reused with different request body")
+				return nil, apierror.Status(domain.ErrIdempotencyKeyReused).Err()
 			}
 
 			// Same request, return idempotent response
 			// Note: For a full implementation, we'd cache and return the actual response
 			// For now, we indicate duplicate request was detected
-			return nil, status.Error(codes.AlreadyExists, "request already processed (idempotent)")
+			return nil, apierror.Status(domain.ErrRequestAlreadyProcessed).Err()
 		}
 
 		// Process the request (first time seeing this idempotency key)
@@ -74,6 +74,9 @@ func TestIdempotencyInterceptor_HandleDuplicateRequest(t *testing.T) {
 	if status.Code(err) != codes.AlreadyExists {
 		t.Fatalf("expected AlreadyExists, got %v", err)
 	}
+	if reason := errorInfoReason(err); reason != "REQUEST_ALREADY_PROCESSED" {
+		t.Fatalf("expected reason REQUEST_ALREADY_PROCESSED, got %q", reason)
+	}
 }
 
 func TestIdempotencyInterceptor_DetectsBodyMismatch(t *testing.T) {
@@ -95,6 +98,9 @@ func TestIdempotencyInterceptor_DetectsBodyMismatch(t *testing.T) {
 	if status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument, got %v", err)
 	}
+	if reason := errorInfoReason(err); reason != "IDEMPOTENCY_KEY_REUSED" {
+		t.Fatalf("expected reason IDEMPOTENCY_KEY_REUSED, got %q", reason)
+	}
 }
 
 func TestIdempotencyInterceptor_FirstRequest(t *testing.T) {
@@ -178,6 +184,9 @@ func TestIdempotencyInterceptor_EmptyKey(t *testing.T) {
 	if status.Code(err) != codes.InvalidArgument {
 		t.Fatalf("expected InvalidArgument for empty key, got %v", err)
 	}
+	if reason := errorInfoReason(err); reason != "INVALID_REQUEST" {
+		t.Fatalf("expected reason INVALID_REQUEST, got %q", reason)
+	}
 }
 
 func hashRequestForTest(t *testing.T, req any) string {
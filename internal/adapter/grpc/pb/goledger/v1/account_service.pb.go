@@ -7,6 +7,7 @@
 package goledgerv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -222,9 +223,13 @@ func (x *GetAccountResponse) GetAccount() *Account {
 }
 
 type ListAccountsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Deprecated: Marked as deprecated in goledger/v1/account_service.proto.
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"` // use cursor
+	// cursor switches to keyset pagination: empty for the first page, then
+	// the previous response's next_cursor
+	Cursor        *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -266,6 +271,7 @@ func (x *ListAccountsRequest) GetLimit() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in goledger/v1/account_service.proto.
 func (x *ListAccountsRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
@@ -273,9 +279,17 @@ func (x *ListAccountsRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListAccountsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 type ListAccountsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // keyset pagination only; empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -317,11 +331,18 @@ func (x *ListAccountsResponse) GetAccounts() []*Account {
 	return nil
 }
 
+func (x *ListAccountsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_goledger_v1_account_service_proto protoreflect.FileDescriptor
 
 const file_goledger_v1_account_service_proto_rawDesc = "" +
 	"\n" +
-	"!goledger/v1/account_service.proto\x12\vgoledger.v1\x1a\x17goledger/v1/types.proto\"\xb2\x01\n" +
+	"!goledger/v1/account_service.proto\x12\vgoledger.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17goledger/v1/types.proto\"\xb2\x01\n" +
 	"\x14CreateAccountRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x124\n" +
@@ -332,17 +353,21 @@ const file_goledger_v1_account_service_proto_rawDesc = "" +
 	"\x11GetAccountRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
 	"\x12GetAccountResponse\x12.\n" +
-	"\aaccount\x18\x01 \x01(\v2\x14.goledger.v1.AccountR\aaccount\"C\n" +
+	"\aaccount\x18\x01 \x01(\v2\x14.goledger.v1.AccountR\aaccount\"o\n" +
 	"\x13ListAccountsRequest\x12\x14\n" +
-	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x05R\x06offset\"H\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x02 \x01(\x05B\x02\x18\x01R\x06offset\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"i\n" +
 	"\x14ListAccountsResponse\x120\n" +
-	"\baccounts\x18\x01 \x03(\v2\x14.goledger.v1.AccountR\baccounts2\x8c\x02\n" +
-	"\x0eAccountService\x12V\n" +
-	"\rCreateAccount\x12!.goledger.v1.CreateAccountRequest\x1a\".goledger.v1.CreateAccountResponse\x12M\n" +
+	"\baccounts\x18\x01 \x03(\v2\x14.goledger.v1.AccountR\baccounts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor2\xd6\x02\n" +
+	"\x0eAccountService\x12o\n" +
+	"\rCreateAccount\x12!.goledger.v1.CreateAccountRequest\x1a\".goledger.v1.CreateAccountResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12h\n" +
 	"\n" +
-	"GetAccount\x12\x1e.goledger.v1.GetAccountRequest\x1a\x1f.goledger.v1.GetAccountResponse\x12S\n" +
-	"\fListAccounts\x12 .goledger.v1.ListAccountsRequest\x1a!.goledger.v1.ListAccountsResponseB\xbc\x01\n" +
+	"GetAccount\x12\x1e.goledger.v1.GetAccountRequest\x1a\x1f.goledger.v1.GetAccountResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/accounts/{id}\x12i\n" +
+	"\fListAccounts\x12 .goledger.v1.ListAccountsRequest\x1a!.goledger.v1.ListAccountsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accountsB\xbc\x01\n" +
 	"\x0fcom.goledger.v1B\x13AccountServiceProtoP\x01ZGgithub.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1;goledgerv1\xa2\x02\x03GXX\xaa\x02\vGoledger.V1\xca\x02\vGoledger\\V1\xe2\x02\x17Goledger\\V1\\GPBMetadata\xea\x02\fGoledger::V1b\x06proto3"
 
 var (
@@ -390,6 +415,7 @@ func file_goledger_v1_account_service_proto_init() {
 		return
 	}
 	file_goledger_v1_types_proto_init()
+	file_goledger_v1_account_service_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
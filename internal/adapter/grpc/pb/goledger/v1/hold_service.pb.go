@@ -7,8 +7,10 @@
 package goledgerv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,11 +24,14 @@ const (
 )
 
 type HoldFundsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // decimal as string
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount    string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"` // decimal as string
+	// The version the account must be at (Account.version); a stale version
+	// fails with FAILED_PRECONDITION and no hold is placed.
+	ExpectedVersion *int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *HoldFundsRequest) Reset() {
@@ -73,6 +78,13 @@ func (x *HoldFundsRequest) GetAmount() string {
 	return ""
 }
 
+func (x *HoldFundsRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type HoldFundsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
@@ -293,18 +305,116 @@ func (x *CaptureHoldResponse) GetTransfer() *Transfer {
 	return nil
 }
 
-type ListHoldsByAccountRequest struct {
+type GetHoldRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldRequest) Reset() {
+	*x = GetHoldRequest{}
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldRequest) ProtoMessage() {}
+
+func (x *GetHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldRequest.ProtoReflect.Descriptor instead.
+func (*GetHoldRequest) Descriptor() ([]byte, []int) {
+	return file_goledger_v1_hold_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetHoldRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hold          *Hold                  `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHoldResponse) Reset() {
+	*x = GetHoldResponse{}
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHoldResponse) ProtoMessage() {}
+
+func (x *GetHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHoldResponse.ProtoReflect.Descriptor instead.
+func (*GetHoldResponse) Descriptor() ([]byte, []int) {
+	return file_goledger_v1_hold_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHoldResponse) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+// ListHoldsByAccountRequest uses keyset pagination when cursor (the last
+// hold ID seen, empty for the first page) or a filter is set, and legacy
+// offset pagination otherwise
+type ListHoldsByAccountRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Limit     int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Deprecated: Marked as deprecated in goledger/v1/hold_service.proto.
+	Offset int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // use cursor
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`  // active, voided or captured; empty = any
+	// expires_after and expires_before bound the expiry, [after, before), and
+	// exclude holds without one
+	ExpiresAfter  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_after,json=expiresAfter,proto3" json:"expires_after,omitempty"`
+	ExpiresBefore *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_before,json=expiresBefore,proto3" json:"expires_before,omitempty"`
+	Cursor        *string                `protobuf:"bytes,7,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListHoldsByAccountRequest) Reset() {
 	*x = ListHoldsByAccountRequest{}
-	mi := &file_goledger_v1_hold_service_proto_msgTypes[6]
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -316,7 +426,7 @@ func (x *ListHoldsByAccountRequest) String() string {
 func (*ListHoldsByAccountRequest) ProtoMessage() {}
 
 func (x *ListHoldsByAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goledger_v1_hold_service_proto_msgTypes[6]
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -329,7 +439,7 @@ func (x *ListHoldsByAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHoldsByAccountRequest.ProtoReflect.Descriptor instead.
 func (*ListHoldsByAccountRequest) Descriptor() ([]byte, []int) {
-	return file_goledger_v1_hold_service_proto_rawDescGZIP(), []int{6}
+	return file_goledger_v1_hold_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListHoldsByAccountRequest) GetAccountId() string {
@@ -346,6 +456,7 @@ func (x *ListHoldsByAccountRequest) GetLimit() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in goledger/v1/hold_service.proto.
 func (x *ListHoldsByAccountRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
@@ -353,16 +464,45 @@ func (x *ListHoldsByAccountRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListHoldsByAccountRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListHoldsByAccountRequest) GetExpiresAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAfter
+	}
+	return nil
+}
+
+func (x *ListHoldsByAccountRequest) GetExpiresBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresBefore
+	}
+	return nil
+}
+
+func (x *ListHoldsByAccountRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 type ListHoldsByAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Holds         []*Hold                `protobuf:"bytes,1,rep,name=holds,proto3" json:"holds,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // keyset pagination only; empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListHoldsByAccountResponse) Reset() {
 	*x = ListHoldsByAccountResponse{}
-	mi := &file_goledger_v1_hold_service_proto_msgTypes[7]
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -374,7 +514,7 @@ func (x *ListHoldsByAccountResponse) String() string {
 func (*ListHoldsByAccountResponse) ProtoMessage() {}
 
 func (x *ListHoldsByAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goledger_v1_hold_service_proto_msgTypes[7]
+	mi := &file_goledger_v1_hold_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,7 +527,7 @@ func (x *ListHoldsByAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListHoldsByAccountResponse.ProtoReflect.Descriptor instead.
 func (*ListHoldsByAccountResponse) Descriptor() ([]byte, []int) {
-	return file_goledger_v1_hold_service_proto_rawDescGZIP(), []int{7}
+	return file_goledger_v1_hold_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListHoldsByAccountResponse) GetHolds() []*Hold {
@@ -397,15 +537,24 @@ func (x *ListHoldsByAccountResponse) GetHolds() []*Hold {
 	return nil
 }
 
+func (x *ListHoldsByAccountResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_goledger_v1_hold_service_proto protoreflect.FileDescriptor
 
 const file_goledger_v1_hold_service_proto_rawDesc = "" +
 	"\n" +
-	"\x1egoledger/v1/hold_service.proto\x12\vgoledger.v1\x1a\x17goledger/v1/types.proto\"I\n" +
+	"\x1egoledger/v1/hold_service.proto\x12\vgoledger.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17goledger/v1/types.proto\"\x8e\x01\n" +
 	"\x10HoldFundsRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\tR\x06amount\":\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\":\n" +
 	"\x11HoldFundsResponse\x12%\n" +
 	"\x04hold\x18\x01 \x01(\v2\x11.goledger.v1.HoldR\x04hold\"*\n" +
 	"\x0fVoidHoldRequest\x12\x17\n" +
@@ -415,19 +564,31 @@ const file_goledger_v1_hold_service_proto_rawDesc = "" +
 	"\ahold_id\x18\x01 \x01(\tR\x06holdId\x12\"\n" +
 	"\rto_account_id\x18\x02 \x01(\tR\vtoAccountId\"H\n" +
 	"\x13CaptureHoldResponse\x121\n" +
-	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer\"h\n" +
+	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer\" \n" +
+	"\x0eGetHoldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x0fGetHoldResponse\x12%\n" +
+	"\x04hold\x18\x01 \x01(\v2\x11.goledger.v1.HoldR\x04hold\"\xb0\x02\n" +
 	"\x19ListHoldsByAccountRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"E\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x03 \x01(\x05B\x02\x18\x01R\x06offset\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12?\n" +
+	"\rexpires_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fexpiresAfter\x12A\n" +
+	"\x0eexpires_before\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rexpiresBefore\x12\x1b\n" +
+	"\x06cursor\x18\a \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"f\n" +
 	"\x1aListHoldsByAccountResponse\x12'\n" +
-	"\x05holds\x18\x01 \x03(\v2\x11.goledger.v1.HoldR\x05holds2\xdb\x02\n" +
-	"\vHoldService\x12J\n" +
-	"\tHoldFunds\x12\x1d.goledger.v1.HoldFundsRequest\x1a\x1e.goledger.v1.HoldFundsResponse\x12G\n" +
-	"\bVoidHold\x12\x1c.goledger.v1.VoidHoldRequest\x1a\x1d.goledger.v1.VoidHoldResponse\x12P\n" +
-	"\vCaptureHold\x12\x1f.goledger.v1.CaptureHoldRequest\x1a .goledger.v1.CaptureHoldResponse\x12e\n" +
-	"\x12ListHoldsByAccount\x12&.goledger.v1.ListHoldsByAccountRequest\x1a'.goledger.v1.ListHoldsByAccountResponseB\xb9\x01\n" +
+	"\x05holds\x18\x01 \x03(\v2\x11.goledger.v1.HoldR\x05holds\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor2\xc6\x04\n" +
+	"\vHoldService\x12`\n" +
+	"\tHoldFunds\x12\x1d.goledger.v1.HoldFundsRequest\x1a\x1e.goledger.v1.HoldFundsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/holds\x12l\n" +
+	"\bVoidHold\x12\x1c.goledger.v1.VoidHoldRequest\x1a\x1d.goledger.v1.VoidHoldResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/holds/{hold_id}:void\x12x\n" +
+	"\vCaptureHold\x12\x1f.goledger.v1.CaptureHoldRequest\x1a .goledger.v1.CaptureHoldResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/holds/{hold_id}:capture\x12\\\n" +
+	"\aGetHold\x12\x1b.goledger.v1.GetHoldRequest\x1a\x1c.goledger.v1.GetHoldResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/holds/{id}\x12\x8e\x01\n" +
+	"\x12ListHoldsByAccount\x12&.goledger.v1.ListHoldsByAccountRequest\x1a'.goledger.v1.ListHoldsByAccountResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/accounts/{account_id}/holdsB\xb9\x01\n" +
 	"\x0fcom.goledger.v1B\x10HoldServiceProtoP\x01ZGgithub.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1;goledgerv1\xa2\x02\x03GXX\xaa\x02\vGoledger.V1\xca\x02\vGoledger\\V1\xe2\x02\x17Goledger\\V1\\GPBMetadata\xea\x02\fGoledger::V1b\x06proto3"
 
 var (
@@ -442,7 +603,7 @@ func file_goledger_v1_hold_service_proto_rawDescGZIP() []byte {
 	return file_goledger_v1_hold_service_proto_rawDescData
 }
 
-var file_goledger_v1_hold_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_goledger_v1_hold_service_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_goledger_v1_hold_service_proto_goTypes = []any{
 	(*HoldFundsRequest)(nil),           // 0: goledger.v1.HoldFundsRequest
 	(*HoldFundsResponse)(nil),          // 1: goledger.v1.HoldFundsResponse
@@ -450,28 +611,36 @@ var file_goledger_v1_hold_service_proto_goTypes = []any{
 	(*VoidHoldResponse)(nil),           // 3: goledger.v1.VoidHoldResponse
 	(*CaptureHoldRequest)(nil),         // 4: goledger.v1.CaptureHoldRequest
 	(*CaptureHoldResponse)(nil),        // 5: goledger.v1.CaptureHoldResponse
-	(*ListHoldsByAccountRequest)(nil),  // 6: goledger.v1.ListHoldsByAccountRequest
-	(*ListHoldsByAccountResponse)(nil), // 7: goledger.v1.ListHoldsByAccountResponse
-	(*Hold)(nil),                       // 8: goledger.v1.Hold
-	(*Transfer)(nil),                   // 9: goledger.v1.Transfer
+	(*GetHoldRequest)(nil),             // 6: goledger.v1.GetHoldRequest
+	(*GetHoldResponse)(nil),            // 7: goledger.v1.GetHoldResponse
+	(*ListHoldsByAccountRequest)(nil),  // 8: goledger.v1.ListHoldsByAccountRequest
+	(*ListHoldsByAccountResponse)(nil), // 9: goledger.v1.ListHoldsByAccountResponse
+	(*Hold)(nil),                       // 10: goledger.v1.Hold
+	(*Transfer)(nil),                   // 11: goledger.v1.Transfer
+	(*timestamppb.Timestamp)(nil),      // 12: google.protobuf.Timestamp
 }
 var file_goledger_v1_hold_service_proto_depIdxs = []int32{
-	8, // 0: goledger.v1.HoldFundsResponse.hold:type_name -> goledger.v1.Hold
-	9, // 1: goledger.v1.CaptureHoldResponse.transfer:type_name -> goledger.v1.Transfer
-	8, // 2: goledger.v1.ListHoldsByAccountResponse.holds:type_name -> goledger.v1.Hold
-	0, // 3: goledger.v1.HoldService.HoldFunds:input_type -> goledger.v1.HoldFundsRequest
-	2, // 4: goledger.v1.HoldService.VoidHold:input_type -> goledger.v1.VoidHoldRequest
-	4, // 5: goledger.v1.HoldService.CaptureHold:input_type -> goledger.v1.CaptureHoldRequest
-	6, // 6: goledger.v1.HoldService.ListHoldsByAccount:input_type -> goledger.v1.ListHoldsByAccountRequest
-	1, // 7: goledger.v1.HoldService.HoldFunds:output_type -> goledger.v1.HoldFundsResponse
-	3, // 8: goledger.v1.HoldService.VoidHold:output_type -> goledger.v1.VoidHoldResponse
-	5, // 9: goledger.v1.HoldService.CaptureHold:output_type -> goledger.v1.CaptureHoldResponse
-	7, // 10: goledger.v1.HoldService.ListHoldsByAccount:output_type -> goledger.v1.ListHoldsByAccountResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	10, // 0: goledger.v1.HoldFundsResponse.hold:type_name -> goledger.v1.Hold
+	11, // 1: goledger.v1.CaptureHoldResponse.transfer:type_name -> goledger.v1.Transfer
+	10, // 2: goledger.v1.GetHoldResponse.hold:type_name -> goledger.v1.Hold
+	12, // 3: goledger.v1.ListHoldsByAccountRequest.expires_after:type_name -> google.protobuf.Timestamp
+	12, // 4: goledger.v1.ListHoldsByAccountRequest.expires_before:type_name -> google.protobuf.Timestamp
+	10, // 5: goledger.v1.ListHoldsByAccountResponse.holds:type_name -> goledger.v1.Hold
+	0,  // 6: goledger.v1.HoldService.HoldFunds:input_type -> goledger.v1.HoldFundsRequest
+	2,  // 7: goledger.v1.HoldService.VoidHold:input_type -> goledger.v1.VoidHoldRequest
+	4,  // 8: goledger.v1.HoldService.CaptureHold:input_type -> goledger.v1.CaptureHoldRequest
+	6,  // 9: goledger.v1.HoldService.GetHold:input_type -> goledger.v1.GetHoldRequest
+	8,  // 10: goledger.v1.HoldService.ListHoldsByAccount:input_type -> goledger.v1.ListHoldsByAccountRequest
+	1,  // 11: goledger.v1.HoldService.HoldFunds:output_type -> goledger.v1.HoldFundsResponse
+	3,  // 12: goledger.v1.HoldService.VoidHold:output_type -> goledger.v1.VoidHoldResponse
+	5,  // 13: goledger.v1.HoldService.CaptureHold:output_type -> goledger.v1.CaptureHoldResponse
+	7,  // 14: goledger.v1.HoldService.GetHold:output_type -> goledger.v1.GetHoldResponse
+	9,  // 15: goledger.v1.HoldService.ListHoldsByAccount:output_type -> goledger.v1.ListHoldsByAccountResponse
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_goledger_v1_hold_service_proto_init() }
@@ -480,13 +649,15 @@ func file_goledger_v1_hold_service_proto_init() {
 		return
 	}
 	file_goledger_v1_types_proto_init()
+	file_goledger_v1_hold_service_proto_msgTypes[0].OneofWrappers = []any{}
+	file_goledger_v1_hold_service_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goledger_v1_hold_service_proto_rawDesc), len(file_goledger_v1_hold_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
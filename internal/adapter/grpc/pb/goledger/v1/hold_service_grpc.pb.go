@@ -22,6 +22,7 @@ const (
 	HoldService_HoldFunds_FullMethodName          = "/goledger.v1.HoldService/HoldFunds"
 	HoldService_VoidHold_FullMethodName           = "/goledger.v1.HoldService/VoidHold"
 	HoldService_CaptureHold_FullMethodName        = "/goledger.v1.HoldService/CaptureHold"
+	HoldService_GetHold_FullMethodName            = "/goledger.v1.HoldService/GetHold"
 	HoldService_ListHoldsByAccount_FullMethodName = "/goledger.v1.HoldService/ListHoldsByAccount"
 )
 
@@ -37,7 +38,9 @@ type HoldServiceClient interface {
 	VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error)
 	// CaptureHold captures a hold as a transfer
 	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
-	// ListHoldsByAccount lists holds for an account
+	// GetHold retrieves a hold by ID
+	GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error)
+	// ListHoldsByAccount lists holds for an account, newest first
 	ListHoldsByAccount(ctx context.Context, in *ListHoldsByAccountRequest, opts ...grpc.CallOption) (*ListHoldsByAccountResponse, error)
 }
 
@@ -79,6 +82,16 @@ func (c *holdServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequ
 	return out, nil
 }
 
+func (c *holdServiceClient) GetHold(ctx context.Context, in *GetHoldRequest, opts ...grpc.CallOption) (*GetHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHoldResponse)
+	err := c.cc.Invoke(ctx, HoldService_GetHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *holdServiceClient) ListHoldsByAccount(ctx context.Context, in *ListHoldsByAccountRequest, opts ...grpc.CallOption) (*ListHoldsByAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListHoldsByAccountResponse)
@@ -101,7 +114,9 @@ type HoldServiceServer interface {
 	VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error)
 	// CaptureHold captures a hold as a transfer
 	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
-	// ListHoldsByAccount lists holds for an account
+	// GetHold retrieves a hold by ID
+	GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error)
+	// ListHoldsByAccount lists holds for an account, newest first
 	ListHoldsByAccount(context.Context, *ListHoldsByAccountRequest) (*ListHoldsByAccountResponse, error)
 	mustEmbedUnimplementedHoldServiceServer()
 }
@@ -122,6 +137,9 @@ func (UnimplementedHoldServiceServer) VoidHold(context.Context, *VoidHoldRequest
 func (UnimplementedHoldServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CaptureHold not implemented")
 }
+func (UnimplementedHoldServiceServer) GetHold(context.Context, *GetHoldRequest) (*GetHoldResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetHold not implemented")
+}
 func (UnimplementedHoldServiceServer) ListHoldsByAccount(context.Context, *ListHoldsByAccountRequest) (*ListHoldsByAccountResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListHoldsByAccount not implemented")
 }
@@ -200,6 +218,24 @@ func _HoldService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HoldService_GetHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HoldServiceServer).GetHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HoldService_GetHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HoldServiceServer).GetHold(ctx, req.(*GetHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HoldService_ListHoldsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListHoldsByAccountRequest)
 	if err := dec(in); err != nil {
@@ -237,6 +273,10 @@ var HoldService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CaptureHold",
 			Handler:    _HoldService_CaptureHold_Handler,
 		},
+		{
+			MethodName: "GetHold",
+			Handler:    _HoldService_GetHold_Handler,
+		},
 		{
 			MethodName: "ListHoldsByAccount",
 			Handler:    _HoldService_ListHoldsByAccount_Handler,
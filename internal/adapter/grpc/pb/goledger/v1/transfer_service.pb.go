@@ -7,6 +7,7 @@
 package goledgerv1
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -30,8 +31,11 @@ type CreateTransferRequest struct {
 	EventAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=event_at,json=eventAt,proto3,oneof" json:"event_at,omitempty"`
 	Metadata       map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	IdempotencyKey *string                `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The version the from account must be at (Account.version); a stale
+	// version fails with FAILED_PRECONDITION and nothing is transferred.
+	ExpectedVersion *int64 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateTransferRequest) Reset() {
@@ -106,6 +110,13 @@ func (x *CreateTransferRequest) GetIdempotencyKey() string {
 	return ""
 }
 
+func (x *CreateTransferRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type CreateTransferResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
@@ -343,10 +354,14 @@ func (x *GetTransferResponse) GetTransfer() *Transfer {
 }
 
 type ListTransfersByAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Limit     int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Deprecated: Marked as deprecated in goledger/v1/transfer_service.proto.
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"` // use cursor
+	// cursor switches to keyset pagination: empty for the first page, then
+	// the previous response's next_cursor
+	Cursor        *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -395,6 +410,7 @@ func (x *ListTransfersByAccountRequest) GetLimit() int32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in goledger/v1/transfer_service.proto.
 func (x *ListTransfersByAccountRequest) GetOffset() int32 {
 	if x != nil {
 		return x.Offset
@@ -402,9 +418,17 @@ func (x *ListTransfersByAccountRequest) GetOffset() int32 {
 	return 0
 }
 
+func (x *ListTransfersByAccountRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
 type ListTransfersByAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // keyset pagination only; empty on the last page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -446,6 +470,225 @@ func (x *ListTransfersByAccountResponse) GetTransfers() []*Transfer {
 	return nil
 }
 
+func (x *ListTransfersByAccountResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// SearchTransfersRequest filters are all optional. Amounts are [min, max]
+// and times [from, to).
+type SearchTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// account_id matches transfers from or to the account
+	AccountId      string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Direction      string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`                  // incoming or outgoing; requires account_id
+	MinAmount      string                 `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` // decimal as string
+	MaxAmount      string                 `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // decimal as string
+	EventAtFrom    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=event_at_from,json=eventAtFrom,proto3" json:"event_at_from,omitempty"`
+	EventAtTo      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=event_at_to,json=eventAtTo,proto3" json:"event_at_to,omitempty"`
+	CreatedAtFrom  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at_from,json=createdAtFrom,proto3" json:"created_at_from,omitempty"`
+	CreatedAtTo    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at_to,json=createdAtTo,proto3" json:"created_at_to,omitempty"`
+	Currency       string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	ReversalStatus string                 `protobuf:"bytes,10,opt,name=reversal_status,json=reversalStatus,proto3" json:"reversal_status,omitempty"` // reversed, not_reversed or reversal
+	// metadata matches transfers whose metadata contains these pairs
+	Metadata      map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sort          string            `protobuf:"bytes,12,opt,name=sort,proto3" json:"sort,omitempty"`            // created_at (default), event_at or amount
+	Ascending     bool              `protobuf:"varint,13,opt,name=ascending,proto3" json:"ascending,omitempty"` // default newest/largest first
+	Cursor        string            `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor,omitempty"`        // next_cursor of the previous page
+	Limit         int32             `protobuf:"varint,15,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTransfersRequest) Reset() {
+	*x = SearchTransfersRequest{}
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTransfersRequest) ProtoMessage() {}
+
+func (x *SearchTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTransfersRequest.ProtoReflect.Descriptor instead.
+func (*SearchTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_goledger_v1_transfer_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SearchTransfersRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetEventAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventAtFrom
+	}
+	return nil
+}
+
+func (x *SearchTransfersRequest) GetEventAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventAtTo
+	}
+	return nil
+}
+
+func (x *SearchTransfersRequest) GetCreatedAtFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtFrom
+	}
+	return nil
+}
+
+func (x *SearchTransfersRequest) GetCreatedAtTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAtTo
+	}
+	return nil
+}
+
+func (x *SearchTransfersRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetReversalStatus() string {
+	if x != nil {
+		return x.ReversalStatus
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SearchTransfersRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *SearchTransfersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *SearchTransfersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTransfersResponse) Reset() {
+	*x = SearchTransfersResponse{}
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTransfersResponse) ProtoMessage() {}
+
+func (x *SearchTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTransfersResponse.ProtoReflect.Descriptor instead.
+func (*SearchTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_goledger_v1_transfer_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SearchTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *SearchTransfersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ReverseTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
@@ -456,7 +699,7 @@ type ReverseTransferRequest struct {
 
 func (x *ReverseTransferRequest) Reset() {
 	*x = ReverseTransferRequest{}
-	mi := &file_goledger_v1_transfer_service_proto_msgTypes[8]
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -468,7 +711,7 @@ func (x *ReverseTransferRequest) String() string {
 func (*ReverseTransferRequest) ProtoMessage() {}
 
 func (x *ReverseTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goledger_v1_transfer_service_proto_msgTypes[8]
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -481,7 +724,7 @@ func (x *ReverseTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReverseTransferRequest.ProtoReflect.Descriptor instead.
 func (*ReverseTransferRequest) Descriptor() ([]byte, []int) {
-	return file_goledger_v1_transfer_service_proto_rawDescGZIP(), []int{8}
+	return file_goledger_v1_transfer_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ReverseTransferRequest) GetTransferId() string {
@@ -507,7 +750,7 @@ type ReverseTransferResponse struct {
 
 func (x *ReverseTransferResponse) Reset() {
 	*x = ReverseTransferResponse{}
-	mi := &file_goledger_v1_transfer_service_proto_msgTypes[9]
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +762,7 @@ func (x *ReverseTransferResponse) String() string {
 func (*ReverseTransferResponse) ProtoMessage() {}
 
 func (x *ReverseTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goledger_v1_transfer_service_proto_msgTypes[9]
+	mi := &file_goledger_v1_transfer_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +775,7 @@ func (x *ReverseTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReverseTransferResponse.ProtoReflect.Descriptor instead.
 func (*ReverseTransferResponse) Descriptor() ([]byte, []int) {
-	return file_goledger_v1_transfer_service_proto_rawDescGZIP(), []int{9}
+	return file_goledger_v1_transfer_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ReverseTransferResponse) GetTransfer() *Transfer {
@@ -546,19 +789,21 @@ var File_goledger_v1_transfer_service_proto protoreflect.FileDescriptor
 
 const file_goledger_v1_transfer_service_proto_rawDesc = "" +
 	"\n" +
-	"\"goledger/v1/transfer_service.proto\x12\vgoledger.v1\x1a\x17goledger/v1/types.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x03\n" +
+	"\"goledger/v1/transfer_service.proto\x12\vgoledger.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17goledger/v1/types.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd6\x03\n" +
 	"\x15CreateTransferRequest\x12&\n" +
 	"\x0ffrom_account_id\x18\x01 \x01(\tR\rfromAccountId\x12\"\n" +
 	"\rto_account_id\x18\x02 \x01(\tR\vtoAccountId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\tR\x06amount\x12:\n" +
 	"\bevent_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\aeventAt\x88\x01\x01\x12L\n" +
 	"\bmetadata\x18\x05 \x03(\v20.goledger.v1.CreateTransferRequest.MetadataEntryR\bmetadata\x12,\n" +
-	"\x0fidempotency_key\x18\x06 \x01(\tH\x01R\x0eidempotencyKey\x88\x01\x01\x1a;\n" +
+	"\x0fidempotency_key\x18\x06 \x01(\tH\x01R\x0eidempotencyKey\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\a \x01(\x03H\x02R\x0fexpectedVersion\x88\x01\x01\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\v\n" +
 	"\t_event_atB\x12\n" +
-	"\x10_idempotency_key\"K\n" +
+	"\x10_idempotency_keyB\x13\n" +
+	"\x11_expected_version\"K\n" +
 	"\x16CreateTransferResponse\x121\n" +
 	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer\"\xb7\x02\n" +
 	"\x1aCreateBatchTransferRequest\x12@\n" +
@@ -574,14 +819,45 @@ const file_goledger_v1_transfer_service_proto_rawDesc = "" +
 	"\x12GetTransferRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
 	"\x13GetTransferResponse\x121\n" +
-	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer\"l\n" +
+	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer\"\x98\x01\n" +
 	"\x1dListTransfersByAccountRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"U\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1a\n" +
+	"\x06offset\x18\x03 \x01(\x05B\x02\x18\x01R\x06offset\x12\x1b\n" +
+	"\x06cursor\x18\x04 \x01(\tH\x00R\x06cursor\x88\x01\x01B\t\n" +
+	"\a_cursor\"v\n" +
 	"\x1eListTransfersByAccountResponse\x123\n" +
-	"\ttransfers\x18\x01 \x03(\v2\x15.goledger.v1.TransferR\ttransfers\"\xc5\x01\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x15.goledger.v1.TransferR\ttransfers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc4\x05\n" +
+	"\x16SearchTransfersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x03 \x01(\tR\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x04 \x01(\tR\tmaxAmount\x12>\n" +
+	"\revent_at_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\veventAtFrom\x12:\n" +
+	"\vevent_at_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\teventAtTo\x12B\n" +
+	"\x0fcreated_at_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedAtFrom\x12>\n" +
+	"\rcreated_at_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedAtTo\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12'\n" +
+	"\x0freversal_status\x18\n" +
+	" \x01(\tR\x0ereversalStatus\x12M\n" +
+	"\bmetadata\x18\v \x03(\v21.goledger.v1.SearchTransfersRequest.MetadataEntryR\bmetadata\x12\x12\n" +
+	"\x04sort\x18\f \x01(\tR\x04sort\x12\x1c\n" +
+	"\tascending\x18\r \x01(\bR\tascending\x12\x16\n" +
+	"\x06cursor\x18\x0e \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x0f \x01(\x05R\x05limit\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"o\n" +
+	"\x17SearchTransfersResponse\x123\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x15.goledger.v1.TransferR\ttransfers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xc5\x01\n" +
 	"\x16ReverseTransferRequest\x12\x1f\n" +
 	"\vtransfer_id\x18\x01 \x01(\tR\n" +
 	"transferId\x12M\n" +
@@ -590,13 +866,14 @@ const file_goledger_v1_transfer_service_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"L\n" +
 	"\x17ReverseTransferResponse\x121\n" +
-	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer2\xf9\x03\n" +
-	"\x0fTransferService\x12Y\n" +
-	"\x0eCreateTransfer\x12\".goledger.v1.CreateTransferRequest\x1a#.goledger.v1.CreateTransferResponse\x12h\n" +
-	"\x13CreateBatchTransfer\x12'.goledger.v1.CreateBatchTransferRequest\x1a(.goledger.v1.CreateBatchTransferResponse\x12P\n" +
-	"\vGetTransfer\x12\x1f.goledger.v1.GetTransferRequest\x1a .goledger.v1.GetTransferResponse\x12q\n" +
-	"\x16ListTransfersByAccount\x12*.goledger.v1.ListTransfersByAccountRequest\x1a+.goledger.v1.ListTransfersByAccountResponse\x12\\\n" +
-	"\x0fReverseTransfer\x12#.goledger.v1.ReverseTransferRequest\x1a$.goledger.v1.ReverseTransferResponseB\xbd\x01\n" +
+	"\btransfer\x18\x01 \x01(\v2\x15.goledger.v1.TransferR\btransfer2\xa4\x06\n" +
+	"\x0fTransferService\x12s\n" +
+	"\x0eCreateTransfer\x12\".goledger.v1.CreateTransferRequest\x1a#.goledger.v1.CreateTransferResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/transfers\x12\x88\x01\n" +
+	"\x13CreateBatchTransfer\x12'.goledger.v1.CreateBatchTransferRequest\x1a(.goledger.v1.CreateBatchTransferResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/transfers:batch\x12l\n" +
+	"\vGetTransfer\x12\x1f.goledger.v1.GetTransferRequest\x1a .goledger.v1.GetTransferResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/transfers/{id}\x12\x9e\x01\n" +
+	"\x16ListTransfersByAccount\x12*.goledger.v1.ListTransfersByAccountRequest\x1a+.goledger.v1.ListTransfersByAccountResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/accounts/{account_id}/transfers\x12s\n" +
+	"\x0fSearchTransfers\x12#.goledger.v1.SearchTransfersRequest\x1a$.goledger.v1.SearchTransfersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/transfers\x12\x8c\x01\n" +
+	"\x0fReverseTransfer\x12#.goledger.v1.ReverseTransferRequest\x1a$.goledger.v1.ReverseTransferResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/transfers/{transfer_id}:reverseB\xbd\x01\n" +
 	"\x0fcom.goledger.v1B\x14TransferServiceProtoP\x01ZGgithub.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1;goledgerv1\xa2\x02\x03GXX\xaa\x02\vGoledger.V1\xca\x02\vGoledger\\V1\xe2\x02\x17Goledger\\V1\\GPBMetadata\xea\x02\fGoledger::V1b\x06proto3"
 
 var (
@@ -611,7 +888,7 @@ func file_goledger_v1_transfer_service_proto_rawDescGZIP() []byte {
 	return file_goledger_v1_transfer_service_proto_rawDescData
 }
 
-var file_goledger_v1_transfer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_goledger_v1_transfer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_goledger_v1_transfer_service_proto_goTypes = []any{
 	(*CreateTransferRequest)(nil),          // 0: goledger.v1.CreateTransferRequest
 	(*CreateTransferResponse)(nil),         // 1: goledger.v1.CreateTransferResponse
@@ -621,41 +898,52 @@ var file_goledger_v1_transfer_service_proto_goTypes = []any{
 	(*GetTransferResponse)(nil),            // 5: goledger.v1.GetTransferResponse
 	(*ListTransfersByAccountRequest)(nil),  // 6: goledger.v1.ListTransfersByAccountRequest
 	(*ListTransfersByAccountResponse)(nil), // 7: goledger.v1.ListTransfersByAccountResponse
-	(*ReverseTransferRequest)(nil),         // 8: goledger.v1.ReverseTransferRequest
-	(*ReverseTransferResponse)(nil),        // 9: goledger.v1.ReverseTransferResponse
-	nil,                                    // 10: goledger.v1.CreateTransferRequest.MetadataEntry
-	nil,                                    // 11: goledger.v1.CreateBatchTransferRequest.MetadataEntry
-	nil,                                    // 12: goledger.v1.ReverseTransferRequest.MetadataEntry
-	(*timestamppb.Timestamp)(nil),          // 13: google.protobuf.Timestamp
-	(*Transfer)(nil),                       // 14: goledger.v1.Transfer
+	(*SearchTransfersRequest)(nil),         // 8: goledger.v1.SearchTransfersRequest
+	(*SearchTransfersResponse)(nil),        // 9: goledger.v1.SearchTransfersResponse
+	(*ReverseTransferRequest)(nil),         // 10: goledger.v1.ReverseTransferRequest
+	(*ReverseTransferResponse)(nil),        // 11: goledger.v1.ReverseTransferResponse
+	nil,                                    // 12: goledger.v1.CreateTransferRequest.MetadataEntry
+	nil,                                    // 13: goledger.v1.CreateBatchTransferRequest.MetadataEntry
+	nil,                                    // 14: goledger.v1.SearchTransfersRequest.MetadataEntry
+	nil,                                    // 15: goledger.v1.ReverseTransferRequest.MetadataEntry
+	(*timestamppb.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+	(*Transfer)(nil),                       // 17: goledger.v1.Transfer
 }
 var file_goledger_v1_transfer_service_proto_depIdxs = []int32{
-	13, // 0: goledger.v1.CreateTransferRequest.event_at:type_name -> google.protobuf.Timestamp
-	10, // 1: goledger.v1.CreateTransferRequest.metadata:type_name -> goledger.v1.CreateTransferRequest.MetadataEntry
-	14, // 2: goledger.v1.CreateTransferResponse.transfer:type_name -> goledger.v1.Transfer
+	16, // 0: goledger.v1.CreateTransferRequest.event_at:type_name -> google.protobuf.Timestamp
+	12, // 1: goledger.v1.CreateTransferRequest.metadata:type_name -> goledger.v1.CreateTransferRequest.MetadataEntry
+	17, // 2: goledger.v1.CreateTransferResponse.transfer:type_name -> goledger.v1.Transfer
 	0,  // 3: goledger.v1.CreateBatchTransferRequest.transfers:type_name -> goledger.v1.CreateTransferRequest
-	13, // 4: goledger.v1.CreateBatchTransferRequest.event_at:type_name -> google.protobuf.Timestamp
-	11, // 5: goledger.v1.CreateBatchTransferRequest.metadata:type_name -> goledger.v1.CreateBatchTransferRequest.MetadataEntry
-	14, // 6: goledger.v1.CreateBatchTransferResponse.transfers:type_name -> goledger.v1.Transfer
-	14, // 7: goledger.v1.GetTransferResponse.transfer:type_name -> goledger.v1.Transfer
-	14, // 8: goledger.v1.ListTransfersByAccountResponse.transfers:type_name -> goledger.v1.Transfer
-	12, // 9: goledger.v1.ReverseTransferRequest.metadata:type_name -> goledger.v1.ReverseTransferRequest.MetadataEntry
-	14, // 10: goledger.v1.ReverseTransferResponse.transfer:type_name -> goledger.v1.Transfer
-	0,  // 11: goledger.v1.TransferService.CreateTransfer:input_type -> goledger.v1.CreateTransferRequest
-	2,  // 12: goledger.v1.TransferService.CreateBatchTransfer:input_type -> goledger.v1.CreateBatchTransferRequest
-	4,  // 13: goledger.v1.TransferService.GetTransfer:input_type -> goledger.v1.GetTransferRequest
-	6,  // 14: goledger.v1.TransferService.ListTransfersByAccount:input_type -> goledger.v1.ListTransfersByAccountRequest
-	8,  // 15: goledger.v1.TransferService.ReverseTransfer:input_type -> goledger.v1.ReverseTransferRequest
-	1,  // 16: goledger.v1.TransferService.CreateTransfer:output_type -> goledger.v1.CreateTransferResponse
-	3,  // 17: goledger.v1.TransferService.CreateBatchTransfer:output_type -> goledger.v1.CreateBatchTransferResponse
-	5,  // 18: goledger.v1.TransferService.GetTransfer:output_type -> goledger.v1.GetTransferResponse
-	7,  // 19: goledger.v1.TransferService.ListTransfersByAccount:output_type -> goledger.v1.ListTransfersByAccountResponse
-	9,  // 20: goledger.v1.TransferService.ReverseTransfer:output_type -> goledger.v1.ReverseTransferResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	16, // 4: goledger.v1.CreateBatchTransferRequest.event_at:type_name -> google.protobuf.Timestamp
+	13, // 5: goledger.v1.CreateBatchTransferRequest.metadata:type_name -> goledger.v1.CreateBatchTransferRequest.MetadataEntry
+	17, // 6: goledger.v1.CreateBatchTransferResponse.transfers:type_name -> goledger.v1.Transfer
+	17, // 7: goledger.v1.GetTransferResponse.transfer:type_name -> goledger.v1.Transfer
+	17, // 8: goledger.v1.ListTransfersByAccountResponse.transfers:type_name -> goledger.v1.Transfer
+	16, // 9: goledger.v1.SearchTransfersRequest.event_at_from:type_name -> google.protobuf.Timestamp
+	16, // 10: goledger.v1.SearchTransfersRequest.event_at_to:type_name -> google.protobuf.Timestamp
+	16, // 11: goledger.v1.SearchTransfersRequest.created_at_from:type_name -> google.protobuf.Timestamp
+	16, // 12: goledger.v1.SearchTransfersRequest.created_at_to:type_name -> google.protobuf.Timestamp
+	14, // 13: goledger.v1.SearchTransfersRequest.metadata:type_name -> goledger.v1.SearchTransfersRequest.MetadataEntry
+	17, // 14: goledger.v1.SearchTransfersResponse.transfers:type_name -> goledger.v1.Transfer
+	15, // 15: goledger.v1.ReverseTransferRequest.metadata:type_name -> goledger.v1.ReverseTransferRequest.MetadataEntry
+	17, // 16: goledger.v1.ReverseTransferResponse.transfer:type_name -> goledger.v1.Transfer
+	0,  // 17: goledger.v1.TransferService.CreateTransfer:input_type -> goledger.v1.CreateTransferRequest
+	2,  // 18: goledger.v1.TransferService.CreateBatchTransfer:input_type -> goledger.v1.CreateBatchTransferRequest
+	4,  // 19: goledger.v1.TransferService.GetTransfer:input_type -> goledger.v1.GetTransferRequest
+	6,  // 20: goledger.v1.TransferService.ListTransfersByAccount:input_type -> goledger.v1.ListTransfersByAccountRequest
+	8,  // 21: goledger.v1.TransferService.SearchTransfers:input_type -> goledger.v1.SearchTransfersRequest
+	10, // 22: goledger.v1.TransferService.ReverseTransfer:input_type -> goledger.v1.ReverseTransferRequest
+	1,  // 23: goledger.v1.TransferService.CreateTransfer:output_type -> goledger.v1.CreateTransferResponse
+	3,  // 24: goledger.v1.TransferService.CreateBatchTransfer:output_type -> goledger.v1.CreateBatchTransferResponse
+	5,  // 25: goledger.v1.TransferService.GetTransfer:output_type -> goledger.v1.GetTransferResponse
+	7,  // 26: goledger.v1.TransferService.ListTransfersByAccount:output_type -> goledger.v1.ListTransfersByAccountResponse
+	9,  // 27: goledger.v1.TransferService.SearchTransfers:output_type -> goledger.v1.SearchTransfersResponse
+	11, // 28: goledger.v1.TransferService.ReverseTransfer:output_type -> goledger.v1.ReverseTransferResponse
+	23, // [23:29] is the sub-list for method output_type
+	17, // [17:23] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_goledger_v1_transfer_service_proto_init() }
@@ -666,13 +954,14 @@ func file_goledger_v1_transfer_service_proto_init() {
 	file_goledger_v1_types_proto_init()
 	file_goledger_v1_transfer_service_proto_msgTypes[0].OneofWrappers = []any{}
 	file_goledger_v1_transfer_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_goledger_v1_transfer_service_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goledger_v1_transfer_service_proto_rawDesc), len(file_goledger_v1_transfer_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
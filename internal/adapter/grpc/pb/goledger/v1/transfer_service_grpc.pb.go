@@ -23,6 +23,7 @@ const (
 	TransferService_CreateBatchTransfer_FullMethodName    = "/goledger.v1.TransferService/CreateBatchTransfer"
 	TransferService_GetTransfer_FullMethodName            = "/goledger.v1.TransferService/GetTransfer"
 	TransferService_ListTransfersByAccount_FullMethodName = "/goledger.v1.TransferService/ListTransfersByAccount"
+	TransferService_SearchTransfers_FullMethodName        = "/goledger.v1.TransferService/SearchTransfers"
 	TransferService_ReverseTransfer_FullMethodName        = "/goledger.v1.TransferService/ReverseTransfer"
 )
 
@@ -40,6 +41,9 @@ type TransferServiceClient interface {
 	GetTransfer(ctx context.Context, in *GetTransferRequest, opts ...grpc.CallOption) (*GetTransferResponse, error)
 	// ListTransfersByAccount lists transfers for an account
 	ListTransfersByAccount(ctx context.Context, in *ListTransfersByAccountRequest, opts ...grpc.CallOption) (*ListTransfersByAccountResponse, error)
+	// SearchTransfers finds transfers across accounts by filters, in the
+	// requested order, with opaque keyset cursors
+	SearchTransfers(ctx context.Context, in *SearchTransfersRequest, opts ...grpc.CallOption) (*SearchTransfersResponse, error)
 	// ReverseTransfer creates a reversal transfer
 	ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error)
 }
@@ -92,6 +96,16 @@ func (c *transferServiceClient) ListTransfersByAccount(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *transferServiceClient) SearchTransfers(ctx context.Context, in *SearchTransfersRequest, opts ...grpc.CallOption) (*SearchTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTransfersResponse)
+	err := c.cc.Invoke(ctx, TransferService_SearchTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transferServiceClient) ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReverseTransferResponse)
@@ -116,6 +130,9 @@ type TransferServiceServer interface {
 	GetTransfer(context.Context, *GetTransferRequest) (*GetTransferResponse, error)
 	// ListTransfersByAccount lists transfers for an account
 	ListTransfersByAccount(context.Context, *ListTransfersByAccountRequest) (*ListTransfersByAccountResponse, error)
+	// SearchTransfers finds transfers across accounts by filters, in the
+	// requested order, with opaque keyset cursors
+	SearchTransfers(context.Context, *SearchTransfersRequest) (*SearchTransfersResponse, error)
 	// ReverseTransfer creates a reversal transfer
 	ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error)
 	mustEmbedUnimplementedTransferServiceServer()
@@ -140,6 +157,9 @@ func (UnimplementedTransferServiceServer) GetTransfer(context.Context, *GetTrans
 func (UnimplementedTransferServiceServer) ListTransfersByAccount(context.Context, *ListTransfersByAccountRequest) (*ListTransfersByAccountResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTransfersByAccount not implemented")
 }
+func (UnimplementedTransferServiceServer) SearchTransfers(context.Context, *SearchTransfersRequest) (*SearchTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SearchTransfers not implemented")
+}
 func (UnimplementedTransferServiceServer) ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ReverseTransfer not implemented")
 }
@@ -236,6 +256,24 @@ func _TransferService_ListTransfersByAccount_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TransferService_SearchTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).SearchTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_SearchTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).SearchTransfers(ctx, req.(*SearchTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TransferService_ReverseTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReverseTransferRequest)
 	if err := dec(in); err != nil {
@@ -277,6 +315,10 @@ var TransferService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTransfersByAccount",
 			Handler:    _TransferService_ListTransfersByAccount_Handler,
 		},
+		{
+			MethodName: "SearchTransfers",
+			Handler:    _TransferService_SearchTransfers_Handler,
+		},
 		{
 			MethodName: "ReverseTransfer",
 			Handler:    _TransferService_ReverseTransfer_Handler,
@@ -150,8 +150,10 @@ type Transfer struct {
 	EventAt            *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=event_at,json=eventAt,proto3" json:"event_at,omitempty"`
 	Metadata           map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ReversedTransferId *string                `protobuf:"bytes,8,opt,name=reversed_transfer_id,json=reversedTransferId,proto3,oneof" json:"reversed_transfer_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Fee legs charged by fee policies; only populated on creation.
+	Fees          []*TransferFee `protobuf:"bytes,9,rep,name=fees,proto3" json:"fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Transfer) Reset() {
@@ -240,6 +242,90 @@ func (x *Transfer) GetReversedTransferId() string {
 	return ""
 }
 
+func (x *Transfer) GetFees() []*TransferFee {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// TransferFee is one fee leg charged on a transfer
+type TransferFee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	TransferId    string                 `protobuf:"bytes,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	FromAccountId string                 `protobuf:"bytes,3,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId   string                 `protobuf:"bytes,4,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount        string                 `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"` // decimal as string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferFee) Reset() {
+	*x = TransferFee{}
+	mi := &file_goledger_v1_types_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferFee) ProtoMessage() {}
+
+func (x *TransferFee) ProtoReflect() protoreflect.Message {
+	mi := &file_goledger_v1_types_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferFee.ProtoReflect.Descriptor instead.
+func (*TransferFee) Descriptor() ([]byte, []int) {
+	return file_goledger_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TransferFee) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *TransferFee) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *TransferFee) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *TransferFee) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *TransferFee) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
 // Entry represents a ledger entry
 type Entry struct {
 	state                  protoimpl.MessageState `protogen:"open.v1"`
@@ -257,7 +343,7 @@ type Entry struct {
 
 func (x *Entry) Reset() {
 	*x = Entry{}
-	mi := &file_goledger_v1_types_proto_msgTypes[2]
+	mi := &file_goledger_v1_types_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +355,7 @@ func (x *Entry) String() string {
 func (*Entry) ProtoMessage() {}
 
 func (x *Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_goledger_v1_types_proto_msgTypes[2]
+	mi := &file_goledger_v1_types_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +368,7 @@ func (x *Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Entry.ProtoReflect.Descriptor instead.
 func (*Entry) Descriptor() ([]byte, []int) {
-	return file_goledger_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_goledger_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Entry) GetId() string {
@@ -358,7 +444,7 @@ type Hold struct {
 
 func (x *Hold) Reset() {
 	*x = Hold{}
-	mi := &file_goledger_v1_types_proto_msgTypes[3]
+	mi := &file_goledger_v1_types_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -370,7 +456,7 @@ func (x *Hold) String() string {
 func (*Hold) ProtoMessage() {}
 
 func (x *Hold) ProtoReflect() protoreflect.Message {
-	mi := &file_goledger_v1_types_proto_msgTypes[3]
+	mi := &file_goledger_v1_types_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,7 +469,7 @@ func (x *Hold) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hold.ProtoReflect.Descriptor instead.
 func (*Hold) Descriptor() ([]byte, []int) {
-	return file_goledger_v1_types_proto_rawDescGZIP(), []int{3}
+	return file_goledger_v1_types_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Hold) GetId() string {
@@ -460,7 +546,7 @@ const file_goledger_v1_types_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xbe\x03\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xec\x03\n" +
 	"\bTransfer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
 	"\x0ffrom_account_id\x18\x02 \x01(\tR\rfromAccountId\x12\"\n" +
@@ -470,11 +556,19 @@ const file_goledger_v1_types_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x125\n" +
 	"\bevent_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aeventAt\x12?\n" +
 	"\bmetadata\x18\a \x03(\v2#.goledger.v1.Transfer.MetadataEntryR\bmetadata\x125\n" +
-	"\x14reversed_transfer_id\x18\b \x01(\tH\x00R\x12reversedTransferId\x88\x01\x01\x1a;\n" +
+	"\x14reversed_transfer_id\x18\b \x01(\tH\x00R\x12reversedTransferId\x88\x01\x01\x12,\n" +
+	"\x04fees\x18\t \x03(\v2\x18.goledger.v1.TransferFeeR\x04fees\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x17\n" +
-	"\x15_reversed_transfer_id\"\xc5\x02\n" +
+	"\x15_reversed_transfer_id\"\xaf\x01\n" +
+	"\vTransferFee\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x1f\n" +
+	"\vtransfer_id\x18\x02 \x01(\tR\n" +
+	"transferId\x12&\n" +
+	"\x0ffrom_account_id\x18\x03 \x01(\tR\rfromAccountId\x12\"\n" +
+	"\rto_account_id\x18\x04 \x01(\tR\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\tR\x06amount\"\xc5\x02\n" +
 	"\x05Entry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -519,32 +613,34 @@ func file_goledger_v1_types_proto_rawDescGZIP() []byte {
 	return file_goledger_v1_types_proto_rawDescData
 }
 
-var file_goledger_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_goledger_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_goledger_v1_types_proto_goTypes = []any{
 	(*Account)(nil),               // 0: goledger.v1.Account
 	(*Transfer)(nil),              // 1: goledger.v1.Transfer
-	(*Entry)(nil),                 // 2: goledger.v1.Entry
-	(*Hold)(nil),                  // 3: goledger.v1.Hold
-	nil,                           // 4: goledger.v1.Transfer.MetadataEntry
-	nil,                           // 5: goledger.v1.Hold.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*TransferFee)(nil),           // 2: goledger.v1.TransferFee
+	(*Entry)(nil),                 // 3: goledger.v1.Entry
+	(*Hold)(nil),                  // 4: goledger.v1.Hold
+	nil,                           // 5: goledger.v1.Transfer.MetadataEntry
+	nil,                           // 6: goledger.v1.Hold.MetadataEntry
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_goledger_v1_types_proto_depIdxs = []int32{
-	6,  // 0: goledger.v1.Account.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 1: goledger.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 2: goledger.v1.Transfer.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 3: goledger.v1.Transfer.event_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: goledger.v1.Transfer.metadata:type_name -> goledger.v1.Transfer.MetadataEntry
-	6,  // 5: goledger.v1.Entry.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 6: goledger.v1.Hold.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 7: goledger.v1.Hold.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 8: goledger.v1.Hold.expires_at:type_name -> google.protobuf.Timestamp
-	5,  // 9: goledger.v1.Hold.metadata:type_name -> goledger.v1.Hold.MetadataEntry
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	7,  // 0: goledger.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: goledger.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: goledger.v1.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 3: goledger.v1.Transfer.event_at:type_name -> google.protobuf.Timestamp
+	5,  // 4: goledger.v1.Transfer.metadata:type_name -> goledger.v1.Transfer.MetadataEntry
+	2,  // 5: goledger.v1.Transfer.fees:type_name -> goledger.v1.TransferFee
+	7,  // 6: goledger.v1.Entry.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: goledger.v1.Hold.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 8: goledger.v1.Hold.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: goledger.v1.Hold.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 10: goledger.v1.Hold.metadata:type_name -> goledger.v1.Hold.MetadataEntry
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_goledger_v1_types_proto_init() }
@@ -553,14 +649,14 @@ func file_goledger_v1_types_proto_init() {
 		return
 	}
 	file_goledger_v1_types_proto_msgTypes[1].OneofWrappers = []any{}
-	file_goledger_v1_types_proto_msgTypes[3].OneofWrappers = []any{}
+	file_goledger_v1_types_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goledger_v1_types_proto_rawDesc), len(file_goledger_v1_types_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
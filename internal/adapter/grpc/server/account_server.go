@@ -15,6 +15,7 @@ type AccountService interface {
 	CreateAccount(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error)
 	GetAccount(ctx context.Context, id string) (*domain.Account, error)
 	ListAccounts(ctx context.Context, input usecase.ListAccountsInput) ([]*domain.Account, error)
+	ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error)
 }
 
 // AccountServer implements the gRPC AccountService
@@ -59,8 +60,24 @@ func (s *AccountServer) GetAccount(ctx context.Context, req *pb.GetAccountReques
 	}, nil
 }
 
-// ListAccounts lists accounts with pagination
+// ListAccounts lists accounts with pagination: keyset when the request has
+// a cursor, otherwise the deprecated offset
 func (s *AccountServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
+	if req.Cursor != nil {
+		result, err := s.accountUC.ListAccountsCursor(ctx, usecase.ListAccountsCursorInput{
+			Cursor: req.GetCursor(),
+			Limit:  int(req.Limit),
+		})
+		if err != nil {
+			return nil, grpcErrors.MapDomainError(err)
+		}
+
+		return &pb.ListAccountsResponse{
+			Accounts:   converter.AccountsToPb(result.Accounts),
+			NextCursor: result.NextCursor,
+		}, nil
+	}
+
 	accounts, err := s.accountUC.ListAccounts(ctx, usecase.ListAccountsInput{
 		Limit:  int(req.Limit),
 		Offset: int(req.Offset),
@@ -69,12 +86,7 @@ func (s *AccountServer) ListAccounts(ctx context.Context, req *pb.ListAccountsRe
 		return nil, grpcErrors.MapDomainError(err)
 	}
 
-	pbAccounts := make([]*pb.Account, len(accounts))
-	for i, account := range accounts {
-		pbAccounts[i] = converter.AccountToPb(account)
-	}
-
 	return &pb.ListAccountsResponse{
-		Accounts: pbAccounts,
+		Accounts: converter.AccountsToPb(accounts),
 	}, nil
 }
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/grpc/converter"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/domain"
+)
+
+// defaultAuditLimit matches the HTTP audit endpoints' default page size.
+const defaultAuditLimit = 100
+
+// AuditService defines the functionality required by AuditServer.
+type AuditService interface {
+	List(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditLog, error)
+	GetByResourceID(ctx context.Context, resourceType, resourceID string) ([]*domain.AuditLog, error)
+}
+
+// AuditServer implements the gRPC AuditService
+type AuditServer struct {
+	pb.UnimplementedAuditServiceServer
+	auditRepo AuditService
+}
+
+// NewAuditServer creates a new AuditServer
+func NewAuditServer(auditRepo AuditService) *AuditServer {
+	return &AuditServer{
+		auditRepo: auditRepo,
+	}
+}
+
+// ListAuditLogs lists audit logs matching a filter
+func (s *AuditServer) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsResponse, error) {
+	filter := domain.AuditFilter{
+		UserID:       req.UserId,
+		Action:       req.Action,
+		ResourceType: req.ResourceType,
+		ResourceID:   req.ResourceId,
+		StartDate:    converter.ParseTimestamp(req.StartDate),
+		EndDate:      converter.ParseTimestamp(req.EndDate),
+		Limit:        int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+
+	if req.Cursor != nil {
+		if cursor := req.GetCursor(); cursor != "" {
+			after, err := domain.DecodeTimeCursor(cursor)
+			if err != nil {
+				return nil, grpcErrors.MapDomainError(err)
+			}
+			filter.After = &after
+		}
+	} else {
+		filter.Offset = int(req.Offset)
+	}
+
+	logs, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	pbLogs, err := converter.AuditLogsToPb(logs)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(fmt.Errorf("encode audit logs: %w", err))
+	}
+
+	resp := &pb.ListAuditLogsResponse{AuditLogs: pbLogs}
+	if req.Cursor != nil {
+		resp.NextCursor = domain.NextAuditCursor(logs, filter.Limit)
+	}
+
+	return resp, nil
+}
+
+// GetResourceAuditLogs returns the audit trail for one resource
+func (s *AuditServer) GetResourceAuditLogs(ctx context.Context, req *pb.GetResourceAuditLogsRequest) (*pb.GetResourceAuditLogsResponse, error) {
+	if req.ResourceType == "" {
+		return nil, grpcErrors.MapDomainError(apierror.BadField("resource_type", "resource_type is required"))
+	}
+	if req.ResourceId == "" {
+		return nil, grpcErrors.MapDomainError(apierror.BadField("resource_id", "resource_id is required"))
+	}
+
+	logs, err := s.auditRepo.GetByResourceID(ctx, req.ResourceType, req.ResourceId)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	pbLogs, err := converter.AuditLogsToPb(logs)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(fmt.Errorf("encode audit logs: %w", err))
+	}
+
+	return &pb.GetResourceAuditLogsResponse{
+		AuditLogs: pbLogs,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/grpc/converter"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// EntryService defines the functionality required by EntryServer.
+type EntryService interface {
+	GetEntriesByAccount(ctx context.Context, input usecase.GetEntriesByAccountInput) ([]*domain.Entry, error)
+	GetEntriesByAccountCursor(ctx context.Context, input usecase.GetEntriesByAccountCursorInput) (*usecase.GetEntriesByAccountCursorResult, error)
+	GetEntriesByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error)
+	GetHistoricalBalance(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error)
+	ExportEntriesByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error
+}
+
+// entryExportBatchSize is the maximum number of entries in one
+// ExportEntriesByAccountResponse.
+const entryExportBatchSize = 500
+
+// EntryServer implements the gRPC EntryService
+type EntryServer struct {
+	pb.UnimplementedEntryServiceServer
+	entryUC EntryService
+}
+
+// NewEntryServer creates a new EntryServer
+func NewEntryServer(entryUC EntryService) *EntryServer {
+	return &EntryServer{
+		entryUC: entryUC,
+	}
+}
+
+// ListEntriesByAccount lists an account's entries
+func (s *EntryServer) ListEntriesByAccount(ctx context.Context, req *pb.ListEntriesByAccountRequest) (*pb.ListEntriesByAccountResponse, error) {
+	if req.Cursor != nil {
+		result, err := s.entryUC.GetEntriesByAccountCursor(ctx, usecase.GetEntriesByAccountCursorInput{
+			AccountID: req.AccountId,
+			Cursor:    req.GetCursor(),
+			Limit:     int(req.Limit),
+		})
+		if err != nil {
+			return nil, grpcErrors.MapDomainError(err)
+		}
+
+		return &pb.ListEntriesByAccountResponse{
+			Entries:    converter.EntriesToPb(result.Entries),
+			NextCursor: result.NextCursor,
+		}, nil
+	}
+
+	entries, err := s.entryUC.GetEntriesByAccount(ctx, usecase.GetEntriesByAccountInput{
+		AccountID: req.AccountId,
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	})
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.ListEntriesByAccountResponse{
+		Entries: converter.EntriesToPb(entries),
+	}, nil
+}
+
+// ListEntriesByTransfer lists the entries a transfer posted
+func (s *EntryServer) ListEntriesByTransfer(ctx context.Context, req *pb.ListEntriesByTransferRequest) (*pb.ListEntriesByTransferResponse, error) {
+	entries, err := s.entryUC.GetEntriesByTransfer(ctx, req.TransferId)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.ListEntriesByTransferResponse{
+		Entries: converter.EntriesToPb(entries),
+	}, nil
+}
+
+// GetHistoricalBalance returns an account's balance at a point in time
+func (s *EntryServer) GetHistoricalBalance(ctx context.Context, req *pb.GetHistoricalBalanceRequest) (*pb.GetHistoricalBalanceResponse, error) {
+	at := converter.ParseTimestamp(req.At)
+	if at == nil {
+		return nil, grpcErrors.MapDomainError(apierror.BadField("at", "at is required"))
+	}
+
+	balance, err := s.entryUC.GetHistoricalBalance(ctx, req.AccountId, *at)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.GetHistoricalBalanceResponse{
+		AccountId: req.AccountId,
+		Balance:   balance.String(),
+		At:        timestamppb.New(*at),
+	}, nil
+}
+
+// ExportEntriesByAccount streams an account's entries in batches of at most
+// entryExportBatchSize, oldest first
+func (s *EntryServer) ExportEntriesByAccount(req *pb.ExportEntriesByAccountRequest, stream pb.EntryService_ExportEntriesByAccountServer) error {
+	filter := domain.EntryExportFilter{
+		AccountID: req.AccountId,
+		From:      converter.ParseTimestamp(req.From),
+		To:        converter.ParseTimestamp(req.To),
+	}
+
+	var (
+		batch   = make([]*pb.Entry, 0, entryExportBatchSize)
+		sendErr error
+	)
+	send := func() error {
+		sendErr = stream.Send(&pb.ExportEntriesByAccountResponse{Entries: batch})
+		batch = make([]*pb.Entry, 0, entryExportBatchSize)
+		return sendErr
+	}
+
+	err := s.entryUC.ExportEntriesByAccount(stream.Context(), filter, func(e *domain.Entry) error {
+		batch = append(batch, converter.EntryToPb(e))
+		if len(batch) == entryExportBatchSize {
+			return send()
+		}
+		return nil
+	})
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return grpcErrors.MapDomainError(err)
+	}
+
+	if len(batch) > 0 {
+		return send()
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+
+	"github.com/iho/goledger/internal/adapter/grpc/converter"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// EventStreamService defines the functionality required by EventServer.
+type EventStreamService interface {
+	Subscribe(ctx context.Context, filter domain.EventStreamFilter, cursor domain.EventStreamCursor) (*usecase.EventSubscription, error)
+}
+
+// EventServer implements the gRPC EventService
+type EventServer struct {
+	pb.UnimplementedEventServiceServer
+	streamUC EventStreamService
+}
+
+// NewEventServer creates a new EventServer
+func NewEventServer(streamUC EventStreamService) *EventServer {
+	return &EventServer{
+		streamUC: streamUC,
+	}
+}
+
+// SubscribeEvents streams published events until the client goes away or
+// the subscription ends
+func (s *EventServer) SubscribeEvents(req *pb.SubscribeEventsRequest, stream pb.EventService_SubscribeEventsServer) error {
+	filter := domain.EventStreamFilter{
+		AccountID:     req.AccountId,
+		AggregateType: req.AggregateType,
+		EventTypes:    req.EventTypes,
+	}
+	cursor := domain.EventStreamCursor{
+		EventID:           req.AfterEventId,
+		AggregateSequence: req.AfterSequence,
+	}
+
+	sub, err := s.streamUC.Subscribe(stream.Context(), filter, cursor)
+	if err != nil {
+		return grpcErrors.MapDomainError(err)
+	}
+
+	for event := range sub.Events() {
+		pbEvent, err := converter.OutboxEventToPb(event)
+		if err != nil {
+			return grpcErrors.MapDomainError(err)
+		}
+
+		if err := stream.Send(pbEvent); err != nil {
+			return err
+		}
+	}
+
+	return grpcErrors.MapDomainError(sub.Err())
+}
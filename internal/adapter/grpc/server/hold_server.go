@@ -5,21 +5,23 @@ import (
 
 	"github.com/shopspring/decimal"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/grpc/converter"
 	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
 	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
 	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/usecase"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // HoldService defines the functionality required by HoldServer.
 type HoldService interface {
 	HoldFunds(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error)
+	HoldFundsAtVersion(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error)
 	VoidHold(ctx context.Context, holdID string) error
 	CaptureHold(ctx context.Context, holdID, toAccountID string) (*domain.Transfer, error)
 	ListHoldsByAccount(ctx context.Context, input usecase.ListHoldsByAccountInput) ([]*domain.Hold, error)
+	GetHold(ctx context.Context, id string) (*domain.Hold, error)
+	ListHoldsByAccountCursor(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error)
 }
 
 // HoldServer implements the gRPC HoldService
@@ -39,10 +41,15 @@ func NewHoldServer(holdUC HoldService) *HoldServer {
 func (s *HoldServer) HoldFunds(ctx context.Context, req *pb.HoldFundsRequest) (*pb.HoldFundsResponse, error) {
 	amount, err := converter.ParseDecimal(req.Amount)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+		return nil, grpcErrors.MapDomainError(apierror.BadField("amount", "invalid amount format"))
 	}
 
-	hold, err := s.holdUC.HoldFunds(ctx, req.AccountId, amount)
+	var hold *domain.Hold
+	if req.ExpectedVersion != nil {
+		hold, err = s.holdUC.HoldFundsAtVersion(ctx, req.AccountId, amount, *req.ExpectedVersion)
+	} else {
+		hold, err = s.holdUC.HoldFunds(ctx, req.AccountId, amount)
+	}
 	if err != nil {
 		return nil, grpcErrors.MapDomainError(err)
 	}
@@ -73,8 +80,43 @@ func (s *HoldServer) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest
 	}, nil
 }
 
-// ListHoldsByAccount lists holds for an account
+// GetHold retrieves a hold by ID
+func (s *HoldServer) GetHold(ctx context.Context, req *pb.GetHoldRequest) (*pb.GetHoldResponse, error) {
+	hold, err := s.holdUC.GetHold(ctx, req.Id)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.GetHoldResponse{
+		Hold: converter.HoldToPb(hold),
+	}, nil
+}
+
+// ListHoldsByAccount lists holds for an account. A cursor or filter selects
+// keyset pagination, as on GET /accounts/{id}/holds
 func (s *HoldServer) ListHoldsByAccount(ctx context.Context, req *pb.ListHoldsByAccountRequest) (*pb.ListHoldsByAccountResponse, error) {
+	filter := domain.HoldFilter{
+		Status:        domain.HoldStatus(req.Status),
+		ExpiresAfter:  converter.ParseTimestamp(req.ExpiresAfter),
+		ExpiresBefore: converter.ParseTimestamp(req.ExpiresBefore),
+	}
+	if req.Cursor != nil || filter != (domain.HoldFilter{}) {
+		result, err := s.holdUC.ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{
+			AccountID: req.AccountId,
+			Filter:    filter,
+			Cursor:    req.GetCursor(),
+			Limit:     int(req.Limit),
+		})
+		if err != nil {
+			return nil, grpcErrors.MapDomainError(err)
+		}
+
+		return &pb.ListHoldsByAccountResponse{
+			Holds:      converter.HoldsToPb(result.Holds),
+			NextCursor: result.NextCursor,
+		}, nil
+	}
+
 	holds, err := s.holdUC.ListHoldsByAccount(ctx, usecase.ListHoldsByAccountInput{
 		AccountID: req.AccountId,
 		Limit:     int(req.Limit),
@@ -84,12 +126,7 @@ func (s *HoldServer) ListHoldsByAccount(ctx context.Context, req *pb.ListHoldsBy
 		return nil, grpcErrors.MapDomainError(err)
 	}
 
-	pbHolds := make([]*pb.Hold, len(holds))
-	for i, hold := range holds {
-		pbHolds[i] = converter.HoldToPb(hold)
-	}
-
 	return &pb.ListHoldsByAccountResponse{
-		Holds: pbHolds,
+		Holds: converter.HoldsToPb(holds),
 	}, nil
 }
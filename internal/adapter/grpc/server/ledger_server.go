@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iho/goledger/internal/adapter/grpc/converter"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// LedgerService defines the functionality required by LedgerServer.
+type LedgerService interface {
+	CheckLedgerConsistency(ctx context.Context) error
+	GenerateReconciliationReport(ctx context.Context) (*usecase.ReconciliationReport, error)
+}
+
+// LedgerServer implements the gRPC LedgerService
+type LedgerServer struct {
+	pb.UnimplementedLedgerServiceServer
+	reconciliationUC LedgerService
+}
+
+// NewLedgerServer creates a new LedgerServer
+func NewLedgerServer(reconciliationUC LedgerService) *LedgerServer {
+	return &LedgerServer{
+		reconciliationUC: reconciliationUC,
+	}
+}
+
+// CheckLedgerConsistency verifies that balances match entries in every
+// currency
+func (s *LedgerServer) CheckLedgerConsistency(ctx context.Context, _ *pb.CheckLedgerConsistencyRequest) (*pb.CheckLedgerConsistencyResponse, error) {
+	err := s.reconciliationUC.CheckLedgerConsistency(ctx)
+	if errors.Is(err, usecase.ErrInconsistentLedger) {
+		return &pb.CheckLedgerConsistencyResponse{
+			Consistent: false,
+			Message:    err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.CheckLedgerConsistencyResponse{
+		Consistent: true,
+	}, nil
+}
+
+// GenerateReconciliationReport reconciles every account and verifies its
+// entry chain
+func (s *LedgerServer) GenerateReconciliationReport(ctx context.Context, _ *pb.GenerateReconciliationReportRequest) (*pb.GenerateReconciliationReportResponse, error) {
+	report, err := s.reconciliationUC.GenerateReconciliationReport(ctx)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.GenerateReconciliationReportResponse{
+		Report: converter.ReconciliationReportToPb(report),
+	}, nil
+}
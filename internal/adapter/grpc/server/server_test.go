@@ -1,13 +1,18 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
 	"github.com/iho/goledger/internal/adapter/grpc/server"
@@ -21,6 +26,7 @@ type accountUseCaseStub struct {
 	createFn func(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error)
 	getFn    func(ctx context.Context, id string) (*domain.Account, error)
 	listFn   func(ctx context.Context, input usecase.ListAccountsInput) ([]*domain.Account, error)
+	cursorFn func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error)
 }
 
 func (s *accountUseCaseStub) CreateAccount(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error) {
@@ -32,6 +38,9 @@ func (s *accountUseCaseStub) GetAccount(ctx context.Context, id string) (*domain
 func (s *accountUseCaseStub) ListAccounts(ctx context.Context, input usecase.ListAccountsInput) ([]*domain.Account, error) {
 	return s.listFn(ctx, input)
 }
+func (s *accountUseCaseStub) ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+	return s.cursorFn(ctx, input)
+}
 
 func TestAccountServer_CreateAccount_Success(t *testing.T) {
 	now := time.Now().UTC()
@@ -111,6 +120,30 @@ func TestAccountServer_ListAccounts(t *testing.T) {
 	}
 }
 
+func TestAccountServer_ListAccounts_Cursor(t *testing.T) {
+	var got usecase.ListAccountsCursorInput
+	srv := server.NewAccountServer(&accountUseCaseStub{
+		cursorFn: func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+			got = input
+			return &usecase.ListAccountsCursorResult{Accounts: []*domain.Account{{ID: "acc-1"}}, NextCursor: "acc-1"}, nil
+		},
+	})
+
+	// An empty cursor asks for the first keyset page.
+	cursor := ""
+	resp, err := srv.ListAccounts(context.Background(), &pb.ListAccountsRequest{Limit: 1, Cursor: &cursor})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Cursor != "" || got.Limit != 1 {
+		t.Fatalf("unexpected input: %+v", got)
+	}
+	if len(resp.Accounts) != 1 || resp.NextCursor != "acc-1" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
 // --- Transfer Server Tests ---
 
 type transferUseCaseStub struct {
@@ -119,6 +152,8 @@ type transferUseCaseStub struct {
 	getFn         func(ctx context.Context, id string) (*domain.Transfer, error)
 	listFn        func(ctx context.Context, input usecase.ListTransfersByAccountInput) ([]*domain.Transfer, error)
 	reverseFn     func(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error)
+	searchFn      func(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error)
+	cursorFn      func(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error)
 }
 
 func (s *transferUseCaseStub) CreateTransfer(ctx context.Context, input usecase.CreateTransferInput) (*domain.Transfer, error) {
@@ -133,9 +168,15 @@ func (s *transferUseCaseStub) GetTransfer(ctx context.Context, id string) (*doma
 func (s *transferUseCaseStub) ListTransfersByAccount(ctx context.Context, input usecase.ListTransfersByAccountInput) ([]*domain.Transfer, error) {
 	return s.listFn(ctx, input)
 }
+func (s *transferUseCaseStub) ListTransfersByAccountCursor(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error) {
+	return s.cursorFn(ctx, input)
+}
 func (s *transferUseCaseStub) ReverseTransfer(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error) {
 	return s.reverseFn(ctx, input)
 }
+func (s *transferUseCaseStub) SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+	return s.searchFn(ctx, input)
+}
 
 func TestTransferServer_CreateTransfer_Success(t *testing.T) {
 	transfer := &domain.Transfer{
@@ -236,18 +277,76 @@ func TestTransferServer_ReverseTransfer_Error(t *testing.T) {
 	}
 }
 
+func TestTransferServer_SearchTransfers(t *testing.T) {
+	var captured usecase.SearchTransfersInput
+	transferUC := &transferUseCaseStub{
+		searchFn: func(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+			captured = input
+			return &usecase.SearchTransfersResult{
+				Transfers:  []*domain.Transfer{{ID: "tx-1", Amount: decimal.NewFromInt(15000)}},
+				NextCursor: "next",
+			}, nil
+		},
+	}
+
+	srv := server.NewTransferServer(transferUC)
+	resp, err := srv.SearchTransfers(context.Background(), &pb.SearchTransfersRequest{
+		MinAmount: "10000",
+		Currency:  "EUR",
+		Metadata:  map[string]string{"order_id": "X"},
+		Sort:      "amount",
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.Filter.MinAmount == nil || !captured.Filter.MinAmount.Equal(decimal.NewFromInt(10000)) || captured.Filter.MaxAmount != nil {
+		t.Fatalf("unexpected amount range: %+v", captured.Filter)
+	}
+	if captured.Filter.Currency != "EUR" || captured.Filter.Metadata["order_id"] != "X" {
+		t.Fatalf("unexpected filter: %+v", captured.Filter)
+	}
+	if captured.Sort != (domain.TransferSort{Field: domain.TransferSortAmount}) || captured.Limit != 10 {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+	if len(resp.Transfers) != 1 || resp.NextCursor != "next" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestTransferServer_SearchTransfers_InvalidAmount(t *testing.T) {
+	srv := server.NewTransferServer(&transferUseCaseStub{
+		searchFn: func(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+			t.Fatal("SearchTransfers should not be called on invalid input")
+			return nil, nil
+		},
+	})
+
+	_, err := srv.SearchTransfers(context.Background(), &pb.SearchTransfersRequest{MaxAmount: "lots"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
 // --- Hold Server Tests ---
 
 type holdUseCaseStub struct {
-	holdFn    func(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error)
-	voidFn    func(ctx context.Context, holdID string) error
-	captureFn func(ctx context.Context, holdID, toAccountID string) (*domain.Transfer, error)
-	listFn    func(ctx context.Context, input usecase.ListHoldsByAccountInput) ([]*domain.Hold, error)
+	holdFn      func(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error)
+	atVersionFn func(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error)
+	voidFn      func(ctx context.Context, holdID string) error
+	captureFn   func(ctx context.Context, holdID, toAccountID string) (*domain.Transfer, error)
+	listFn      func(ctx context.Context, input usecase.ListHoldsByAccountInput) ([]*domain.Hold, error)
+	getFn       func(ctx context.Context, id string) (*domain.Hold, error)
+	cursorFn    func(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error)
 }
 
 func (s *holdUseCaseStub) HoldFunds(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error) {
 	return s.holdFn(ctx, accountID, amount)
 }
+func (s *holdUseCaseStub) HoldFundsAtVersion(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error) {
+	return s.atVersionFn(ctx, accountID, amount, expectedVersion)
+}
 func (s *holdUseCaseStub) VoidHold(ctx context.Context, holdID string) error {
 	return s.voidFn(ctx, holdID)
 }
@@ -257,6 +356,37 @@ func (s *holdUseCaseStub) CaptureHold(ctx context.Context, holdID, toAccountID s
 func (s *holdUseCaseStub) ListHoldsByAccount(ctx context.Context, input usecase.ListHoldsByAccountInput) ([]*domain.Hold, error) {
 	return s.listFn(ctx, input)
 }
+func (s *holdUseCaseStub) GetHold(ctx context.Context, id string) (*domain.Hold, error) {
+	return s.getFn(ctx, id)
+}
+func (s *holdUseCaseStub) ListHoldsByAccountCursor(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error) {
+	return s.cursorFn(ctx, input)
+}
+
+func TestHoldServer_HoldFunds_ExpectedVersion(t *testing.T) {
+	var gotVersion int64
+	holdUC := &holdUseCaseStub{
+		holdFn: func(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error) {
+			t.Fatal("HoldFunds should not be called with expected_version set")
+			return nil, nil
+		},
+		atVersionFn: func(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error) {
+			gotVersion = expectedVersion
+			return nil, domain.ErrVersionMismatch
+		},
+	}
+
+	srv := server.NewHoldServer(holdUC)
+	version := int64(2)
+	_, err := srv.HoldFunds(context.Background(), &pb.HoldFundsRequest{AccountId: "acc-1", Amount: "10", ExpectedVersion: &version})
+
+	if gotVersion != 2 {
+		t.Fatalf("expected the hold to be conditioned on version 2, got %d", gotVersion)
+	}
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
 
 func TestHoldServer_HoldFunds_InvalidAmount(t *testing.T) {
 	holdUC := &holdUseCaseStub{
@@ -310,3 +440,481 @@ func TestHoldServer_ListHoldsByAccount(t *testing.T) {
 		t.Fatalf("expected hold to be returned, got %+v", resp.Holds)
 	}
 }
+
+func TestHoldServer_ListHoldsByAccount_Cursor(t *testing.T) {
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var got usecase.ListHoldsByAccountCursorInput
+	holdUC := &holdUseCaseStub{
+		cursorFn: func(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error) {
+			got = input
+			return &usecase.ListHoldsByAccountCursorResult{
+				Holds:      []*domain.Hold{{ID: "hold-2", AccountID: "acc-1", Amount: decimal.NewFromInt(5), Status: domain.HoldStatusActive}},
+				NextCursor: "hold-2",
+			}, nil
+		},
+	}
+
+	srv := server.NewHoldServer(holdUC)
+	cursor := "hold-3"
+	resp, err := srv.ListHoldsByAccount(context.Background(), &pb.ListHoldsByAccountRequest{
+		AccountId:    "acc-1",
+		Limit:        1,
+		Status:       "active",
+		ExpiresAfter: timestamppb.New(after),
+		Cursor:       &cursor,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got.Cursor != "hold-3" || got.Limit != 1 || got.Filter.Status != domain.HoldStatusActive {
+		t.Fatalf("unexpected input: %+v", got)
+	}
+	if got.Filter.ExpiresAfter == nil || !got.Filter.ExpiresAfter.Equal(after) || got.Filter.ExpiresBefore != nil {
+		t.Fatalf("unexpected expiry filter: %+v", got.Filter)
+	}
+	if len(resp.Holds) != 1 || resp.NextCursor != "hold-2" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestHoldServer_GetHold_NotFound(t *testing.T) {
+	holdUC := &holdUseCaseStub{
+		getFn: func(ctx context.Context, id string) (*domain.Hold, error) {
+			return nil, domain.ErrHoldNotFound
+		},
+	}
+
+	srv := server.NewHoldServer(holdUC)
+	_, err := srv.GetHold(context.Background(), &pb.GetHoldRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+}
+
+// --- Statement Server Tests ---
+
+type statementUseCaseStub struct {
+	generateFn func(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error)
+}
+
+func (s *statementUseCaseStub) GenerateStatement(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error) {
+	return s.generateFn(ctx, input)
+}
+
+type statementStreamStub struct {
+	grpc.ServerStream
+	chunks []*pb.StatementChunk
+}
+
+func (s *statementStreamStub) Context() context.Context { return context.Background() }
+
+func (s *statementStreamStub) Send(chunk *pb.StatementChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestStatementServer_GetStatement_StreamsChunks(t *testing.T) {
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	lines := make([]domain.StatementLine, 0, 500)
+	for i := 0; i < 500; i++ {
+		lines = append(lines, domain.StatementLine{
+			EntryID:               fmt.Sprintf("entry-%03d", i),
+			TransferID:            fmt.Sprintf("tr-%03d", i),
+			CounterpartyAccountID: "acc-other",
+			BookedAt:              from.Add(time.Duration(i) * time.Minute),
+			ValueAt:               from.Add(time.Duration(i) * time.Minute),
+			Amount:                decimal.NewFromInt(1),
+			Balance:               decimal.NewFromInt(int64(i + 1)),
+		})
+	}
+
+	var captured usecase.GenerateStatementInput
+	srv := server.NewStatementServer(&statementUseCaseStub{
+		generateFn: func(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error) {
+			captured = input
+			return &domain.Statement{
+				AccountID:   input.AccountID,
+				Currency:    "USD",
+				PeriodStart: from,
+				PeriodEnd:   to,
+				Lines:       lines,
+			}, nil
+		},
+	})
+
+	stream := &statementStreamStub{}
+	err := srv.GetStatement(&pb.GetStatementRequest{
+		AccountId: "acc-1",
+		From:      timestamppb.New(from),
+		To:        timestamppb.New(to),
+		Format:    pb.StatementFormat_STATEMENT_FORMAT_CSV,
+	}, stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.AccountID != "acc-1" || !captured.From.Equal(from) || !captured.To.Equal(to) {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+
+	if len(stream.chunks) < 2 {
+		t.Fatalf("expected the statement to be split into several chunks, got %d", len(stream.chunks))
+	}
+
+	if stream.chunks[0].ContentType != "text/csv" || stream.chunks[1].ContentType != "" {
+		t.Fatalf("expected content type on the first chunk only")
+	}
+
+	var body bytes.Buffer
+	for _, c := range stream.chunks {
+		body.Write(c.Data)
+	}
+
+	if rows := strings.Count(body.String(), "\n"); rows != 503 { // header + opening + 500 + closing
+		t.Fatalf("expected 503 CSV rows, got %d", rows)
+	}
+}
+
+func TestStatementServer_GetStatement_MissingPeriod(t *testing.T) {
+	srv := server.NewStatementServer(&statementUseCaseStub{})
+
+	err := srv.GetStatement(&pb.GetStatementRequest{AccountId: "acc-1"}, &statementStreamStub{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+// --- Entry Server Tests ---
+
+type entryUseCaseStub struct {
+	byAccountFn func(ctx context.Context, input usecase.GetEntriesByAccountInput) ([]*domain.Entry, error)
+	cursorFn    func(ctx context.Context, input usecase.GetEntriesByAccountCursorInput) (*usecase.GetEntriesByAccountCursorResult, error)
+	balanceFn   func(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error)
+	exportFn    func(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error
+}
+
+func (s *entryUseCaseStub) GetEntriesByAccount(ctx context.Context, input usecase.GetEntriesByAccountInput) ([]*domain.Entry, error) {
+	return s.byAccountFn(ctx, input)
+}
+func (s *entryUseCaseStub) GetEntriesByAccountCursor(ctx context.Context, input usecase.GetEntriesByAccountCursorInput) (*usecase.GetEntriesByAccountCursorResult, error) {
+	return s.cursorFn(ctx, input)
+}
+func (s *entryUseCaseStub) GetEntriesByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error) {
+	return nil, nil
+}
+func (s *entryUseCaseStub) GetHistoricalBalance(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error) {
+	return s.balanceFn(ctx, accountID, at)
+}
+func (s *entryUseCaseStub) ExportEntriesByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+	return s.exportFn(ctx, filter, emit)
+}
+
+type entryExportStreamStub struct {
+	grpc.ServerStream
+	batches [][]*pb.Entry
+}
+
+func (s *entryExportStreamStub) Context() context.Context { return context.Background() }
+
+func (s *entryExportStreamStub) Send(resp *pb.ExportEntriesByAccountResponse) error {
+	s.batches = append(s.batches, resp.Entries)
+	return nil
+}
+
+func TestEntryServer_ListEntriesByAccount(t *testing.T) {
+	var captured usecase.GetEntriesByAccountInput
+	srv := server.NewEntryServer(&entryUseCaseStub{
+		byAccountFn: func(ctx context.Context, input usecase.GetEntriesByAccountInput) ([]*domain.Entry, error) {
+			captured = input
+			return []*domain.Entry{{ID: "e-1", AccountID: input.AccountID, Amount: decimal.NewFromInt(5)}}, nil
+		},
+	})
+
+	resp, err := srv.ListEntriesByAccount(context.Background(), &pb.ListEntriesByAccountRequest{AccountId: "acc-1", Limit: 10, Offset: 20})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.AccountID != "acc-1" || captured.Limit != 10 || captured.Offset != 20 {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+
+	if len(resp.Entries) != 1 || resp.Entries[0].Id != "e-1" {
+		t.Fatalf("expected entry to be returned, got %+v", resp.Entries)
+	}
+}
+
+func TestEntryServer_GetHistoricalBalance(t *testing.T) {
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	srv := server.NewEntryServer(&entryUseCaseStub{
+		balanceFn: func(ctx context.Context, accountID string, got time.Time) (decimal.Decimal, error) {
+			if !got.Equal(at) {
+				t.Fatalf("expected %s, got %s", at, got)
+			}
+			return decimal.RequireFromString("42.50"), nil
+		},
+	})
+
+	resp, err := srv.GetHistoricalBalance(context.Background(), &pb.GetHistoricalBalanceRequest{AccountId: "acc-1", At: timestamppb.New(at)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if resp.Balance != "42.5" || resp.AccountId != "acc-1" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	if _, err := srv.GetHistoricalBalance(context.Background(), &pb.GetHistoricalBalanceRequest{AccountId: "acc-1"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument without at, got %v", err)
+	}
+}
+
+func TestEntryServer_ExportEntriesByAccount_StreamsBatches(t *testing.T) {
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	var captured domain.EntryExportFilter
+	srv := server.NewEntryServer(&entryUseCaseStub{
+		exportFn: func(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+			captured = filter
+			for i := range 1200 {
+				if err := emit(&domain.Entry{ID: fmt.Sprintf("e-%04d", i), AccountID: filter.AccountID}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+
+	stream := &entryExportStreamStub{}
+	err := srv.ExportEntriesByAccount(&pb.ExportEntriesByAccountRequest{AccountId: "acc-1", From: timestamppb.New(from)}, stream)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.AccountID != "acc-1" || captured.From == nil || !captured.From.Equal(from) || captured.To != nil {
+		t.Fatalf("unexpected filter: %+v", captured)
+	}
+
+	if len(stream.batches) != 3 || len(stream.batches[0]) != 500 || len(stream.batches[2]) != 200 {
+		t.Fatalf("expected batches of 500, 500 and 200, got %d batches", len(stream.batches))
+	}
+
+	if stream.batches[2][199].Id != "e-1199" {
+		t.Fatalf("expected the last entry to be e-1199, got %s", stream.batches[2][199].Id)
+	}
+}
+
+func TestEntryServer_ExportEntriesByAccount_NotFound(t *testing.T) {
+	srv := server.NewEntryServer(&entryUseCaseStub{
+		exportFn: func(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+			return domain.ErrAccountNotFound
+		},
+	})
+
+	stream := &entryExportStreamStub{}
+	err := srv.ExportEntriesByAccount(&pb.ExportEntriesByAccountRequest{AccountId: "missing"}, stream)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	if len(stream.batches) != 0 {
+		t.Fatalf("expected nothing to be sent, got %d batches", len(stream.batches))
+	}
+}
+
+// --- Ledger Server Tests ---
+
+type reconciliationUseCaseStub struct {
+	consistencyErr error
+	report         *usecase.ReconciliationReport
+}
+
+func (s *reconciliationUseCaseStub) CheckLedgerConsistency(ctx context.Context) error {
+	return s.consistencyErr
+}
+func (s *reconciliationUseCaseStub) GenerateReconciliationReport(ctx context.Context) (*usecase.ReconciliationReport, error) {
+	return s.report, nil
+}
+
+func TestLedgerServer_CheckLedgerConsistency(t *testing.T) {
+	resp, err := server.NewLedgerServer(&reconciliationUseCaseStub{}).CheckLedgerConsistency(context.Background(), &pb.CheckLedgerConsistencyRequest{})
+	if err != nil || !resp.Consistent {
+		t.Fatalf("expected a consistent ledger, got %+v, %v", resp, err)
+	}
+
+	inconsistent := fmt.Errorf("%w: USD: balance=10 entries=0 difference=10", usecase.ErrInconsistentLedger)
+	resp, err = server.NewLedgerServer(&reconciliationUseCaseStub{consistencyErr: inconsistent}).CheckLedgerConsistency(context.Background(), &pb.CheckLedgerConsistencyRequest{})
+	if err != nil {
+		t.Fatalf("expected an inconsistent ledger to be reported, got %v", err)
+	}
+	if resp.Consistent || !strings.Contains(resp.Message, "USD") {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	_, err = server.NewLedgerServer(&reconciliationUseCaseStub{consistencyErr: fmt.Errorf("connection refused")}).CheckLedgerConsistency(context.Background(), &pb.CheckLedgerConsistencyRequest{})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal, got %v", err)
+	}
+}
+
+// --- Audit Server Tests ---
+
+type auditRepoStub struct {
+	listFn func(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditLog, error)
+}
+
+func (s *auditRepoStub) List(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditLog, error) {
+	return s.listFn(ctx, filter)
+}
+func (s *auditRepoStub) GetByResourceID(ctx context.Context, resourceType, resourceID string) ([]*domain.AuditLog, error) {
+	return nil, nil
+}
+
+func TestAuditServer_ListAuditLogs(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var captured domain.AuditFilter
+	srv := server.NewAuditServer(&auditRepoStub{
+		listFn: func(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditLog, error) {
+			captured = filter
+			return []*domain.AuditLog{{ID: "audit-1", Action: "transfer.create", Hash: "h1"}}, nil
+		},
+	})
+
+	resp, err := srv.ListAuditLogs(context.Background(), &pb.ListAuditLogsRequest{UserId: "user-1", StartDate: timestamppb.New(start)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.UserID != "user-1" || captured.StartDate == nil || !captured.StartDate.Equal(start) || captured.EndDate != nil || captured.Limit != 100 {
+		t.Fatalf("unexpected filter: %+v", captured)
+	}
+
+	if len(resp.AuditLogs) != 1 || resp.AuditLogs[0].Hash != "h1" {
+		t.Fatalf("unexpected audit logs: %+v", resp.AuditLogs)
+	}
+}
+
+func TestAuditServer_ListAuditLogs_Cursor(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var captured domain.AuditFilter
+	srv := server.NewAuditServer(&auditRepoStub{
+		listFn: func(ctx context.Context, filter domain.AuditFilter) ([]*domain.AuditLog, error) {
+			captured = filter
+			return []*domain.AuditLog{{ID: "audit-1", CreatedAt: at}}, nil
+		},
+	})
+
+	cursor := domain.TimeCursor{At: at.Add(time.Hour), ID: "audit-2"}.Encode()
+	resp, err := srv.ListAuditLogs(context.Background(), &pb.ListAuditLogsRequest{Limit: 1, Offset: 5, Cursor: &cursor})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.After == nil || captured.After.ID != "audit-2" || captured.Offset != 0 {
+		t.Fatalf("unexpected filter: %+v", captured)
+	}
+	next, err := domain.DecodeTimeCursor(resp.NextCursor)
+	if err != nil || next.ID != "audit-1" || !next.At.Equal(at) {
+		t.Fatalf("unexpected next cursor %q: %+v, %v", resp.NextCursor, next, err)
+	}
+
+	bad := "nope"
+	if _, err := srv.ListAuditLogs(context.Background(), &pb.ListAuditLogsRequest{Cursor: &bad}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestAuditServer_GetResourceAuditLogs_MissingResource(t *testing.T) {
+	_, err := server.NewAuditServer(&auditRepoStub{}).GetResourceAuditLogs(context.Background(), &pb.GetResourceAuditLogsRequest{ResourceType: "transfer"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+// --- User Server Tests ---
+
+type userUseCaseStub struct {
+	updateFn         func(ctx context.Context, input usecase.UpdateUserInput) (*domain.User, error)
+	deleteFn         func(ctx context.Context, id string) error
+	changePasswordFn func(ctx context.Context, input usecase.ChangePasswordInput) error
+}
+
+func (s *userUseCaseStub) CreateUser(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error) {
+	return nil, nil
+}
+func (s *userUseCaseStub) GetUser(ctx context.Context, id string) (*domain.User, error) {
+	return nil, nil
+}
+func (s *userUseCaseStub) ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	return nil, nil
+}
+func (s *userUseCaseStub) UpdateUser(ctx context.Context, input usecase.UpdateUserInput) (*domain.User, error) {
+	return s.updateFn(ctx, input)
+}
+func (s *userUseCaseStub) DeleteUser(ctx context.Context, id string) error {
+	return s.deleteFn(ctx, id)
+}
+func (s *userUseCaseStub) ChangePassword(ctx context.Context, input usecase.ChangePasswordInput) error {
+	return s.changePasswordFn(ctx, input)
+}
+
+func TestUserServer_UpdateUser_OnlySetFields(t *testing.T) {
+	var captured usecase.UpdateUserInput
+	srv := server.NewUserServer(&userUseCaseStub{
+		updateFn: func(ctx context.Context, input usecase.UpdateUserInput) (*domain.User, error) {
+			captured = input
+			return &domain.User{ID: input.ID, Role: *input.Role}, nil
+		},
+	})
+
+	role := "viewer"
+	resp, err := srv.UpdateUser(context.Background(), &pb.UpdateUserRequest{Id: "user-1", Role: &role})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.Role == nil || *captured.Role != domain.RoleViewer || captured.Name != nil || captured.Active != nil || captured.Password != nil {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+
+	if resp.User.Id != "user-1" || resp.User.Role != "viewer" {
+		t.Fatalf("unexpected user: %+v", resp.User)
+	}
+}
+
+func TestUserServer_DeleteUser_LastAdmin(t *testing.T) {
+	srv := server.NewUserServer(&userUseCaseStub{
+		deleteFn: func(ctx context.Context, id string) error { return domain.ErrLastAdmin },
+	})
+
+	_, err := srv.DeleteUser(context.Background(), &pb.DeleteUserRequest{Id: "admin-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+}
+
+func TestUserServer_ChangePassword(t *testing.T) {
+	var captured usecase.ChangePasswordInput
+	srv := server.NewUserServer(&userUseCaseStub{
+		changePasswordFn: func(ctx context.Context, input usecase.ChangePasswordInput) error {
+			captured = input
+			return nil
+		},
+	})
+
+	req := &pb.ChangePasswordRequest{CurrentPassword: "OldPassw0rd!", NewPassword: "NewPassw0rd!"}
+	if _, err := srv.ChangePassword(context.Background(), req); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without a user, got %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), domain.UserContextKey, &domain.User{ID: "user-1"})
+	if _, err := srv.ChangePassword(ctx, req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if captured.UserID != "user-1" || captured.CurrentPassword != "OldPassw0rd!" || captured.NewPassword != "NewPassw0rd!" {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/statement"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// statementChunkSize is the maximum payload of one StatementChunk.
+const statementChunkSize = 32 * 1024
+
+// StatementService defines the functionality required by StatementServer.
+type StatementService interface {
+	GenerateStatement(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error)
+}
+
+// StatementServer implements the gRPC StatementService
+type StatementServer struct {
+	pb.UnimplementedStatementServiceServer
+	statementUC StatementService
+}
+
+// NewStatementServer creates a new StatementServer
+func NewStatementServer(statementUC StatementService) *StatementServer {
+	return &StatementServer{
+		statementUC: statementUC,
+	}
+}
+
+// GetStatement renders the statement and streams it in chunks
+func (s *StatementServer) GetStatement(req *pb.GetStatementRequest, stream pb.StatementService_GetStatementServer) error {
+	if req.From == nil {
+		return grpcErrors.MapDomainError(apierror.BadField("from", "from is required"))
+	}
+	if req.To == nil {
+		return grpcErrors.MapDomainError(apierror.BadField("to", "to is required"))
+	}
+
+	format, err := statementFormatFromPb(req.Format)
+	if err != nil {
+		return grpcErrors.MapDomainError(apierror.BadField("format", err.Error()))
+	}
+
+	stmt, err := s.statementUC.GenerateStatement(stream.Context(), usecase.GenerateStatementInput{
+		AccountID: req.AccountId,
+		From:      req.From.AsTime(),
+		To:        req.To.AsTime(),
+	})
+	if err != nil {
+		return grpcErrors.MapDomainError(err)
+	}
+
+	w := &chunkWriter{
+		stream: stream,
+		first: &pb.StatementChunk{
+			ContentType: format.ContentType(),
+			FileName:    format.FileName(stmt),
+		},
+	}
+
+	if err := statement.Write(w, format, stmt); err != nil {
+		return err
+	}
+
+	return w.flush()
+}
+
+func statementFormatFromPb(f pb.StatementFormat) (statement.Format, error) {
+	switch f {
+	case pb.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED, pb.StatementFormat_STATEMENT_FORMAT_JSON:
+		return statement.FormatJSON, nil
+	case pb.StatementFormat_STATEMENT_FORMAT_CSV:
+		return statement.FormatCSV, nil
+	case pb.StatementFormat_STATEMENT_FORMAT_CAMT053:
+		return statement.FormatCamt053, nil
+	default:
+		return "", fmt.Errorf("unknown statement format %v", f)
+	}
+}
+
+// chunkWriter buffers writes and sends them as StatementChunks of at most
+// statementChunkSize bytes. The first chunk carries the content metadata.
+type chunkWriter struct {
+	stream pb.StatementService_GetStatementServer
+	first  *pb.StatementChunk
+	buf    []byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+
+	for len(w.buf) >= statementChunkSize {
+		if err := w.send(w.buf[:statementChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[statementChunkSize:]
+	}
+
+	return len(p), nil
+}
+
+// flush sends whatever is buffered; it always sends at least one chunk so
+// the client receives the content metadata.
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 && w.first == nil {
+		return nil
+	}
+
+	return w.send(w.buf)
+}
+
+func (w *chunkWriter) send(data []byte) error {
+	chunk := &pb.StatementChunk{}
+	if w.first != nil {
+		chunk, w.first = w.first, nil
+	}
+	chunk.Data = append([]byte(nil), data...)
+
+	return w.stream.Send(chunk)
+}
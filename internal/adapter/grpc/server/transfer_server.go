@@ -2,10 +2,9 @@ package server
 
 import (
 	"context"
+	"fmt"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/grpc/converter"
 	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
 	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
@@ -19,6 +18,8 @@ type TransferService interface {
 	CreateBatchTransfer(ctx context.Context, input usecase.CreateBatchTransferInput) ([]*domain.Transfer, error)
 	GetTransfer(ctx context.Context, id string) (*domain.Transfer, error)
 	ListTransfersByAccount(ctx context.Context, input usecase.ListTransfersByAccountInput) ([]*domain.Transfer, error)
+	ListTransfersByAccountCursor(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error)
+	SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error)
 	ReverseTransfer(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error)
 }
 
@@ -39,15 +40,16 @@ func NewTransferServer(transferUC TransferService) *TransferServer {
 func (s *TransferServer) CreateTransfer(ctx context.Context, req *pb.CreateTransferRequest) (*pb.CreateTransferResponse, error) {
 	amount, err := converter.ParseDecimal(req.Amount)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid amount format")
+		return nil, grpcErrors.MapDomainError(apierror.BadField("amount", "invalid amount format"))
 	}
 
 	input := usecase.CreateTransferInput{
-		FromAccountID: req.FromAccountId,
-		ToAccountID:   req.ToAccountId,
-		Amount:        amount,
-		EventAt:       converter.ParseTimestamp(req.EventAt),
-		Metadata:      converter.MetadataToMap(req.Metadata),
+		FromAccountID:       req.FromAccountId,
+		ToAccountID:         req.ToAccountId,
+		Amount:              amount,
+		EventAt:             converter.ParseTimestamp(req.EventAt),
+		Metadata:            converter.MetadataToMap(req.Metadata),
+		ExpectedFromVersion: req.ExpectedVersion,
 	}
 
 	transfer, err := s.transferUC.CreateTransfer(ctx, input)
@@ -66,15 +68,16 @@ func (s *TransferServer) CreateBatchTransfer(ctx context.Context, req *pb.Create
 	for i, t := range req.Transfers {
 		amount, err := converter.ParseDecimal(t.Amount)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid amount format at index %d", i)
+			return nil, grpcErrors.MapDomainError(apierror.BadField(fmt.Sprintf("transfers[%d].amount", i), fmt.Sprintf("invalid amount format at index %d", i)))
 		}
 
 		transfers[i] = usecase.CreateTransferInput{
-			FromAccountID: t.FromAccountId,
-			ToAccountID:   t.ToAccountId,
-			Amount:        amount,
-			EventAt:       converter.ParseTimestamp(t.EventAt),
-			Metadata:      converter.MetadataToMap(t.Metadata),
+			FromAccountID:       t.FromAccountId,
+			ToAccountID:         t.ToAccountId,
+			Amount:              amount,
+			EventAt:             converter.ParseTimestamp(t.EventAt),
+			Metadata:            converter.MetadataToMap(t.Metadata),
+			ExpectedFromVersion: t.ExpectedVersion,
 		}
 	}
 
@@ -113,6 +116,22 @@ func (s *TransferServer) GetTransfer(ctx context.Context, req *pb.GetTransferReq
 
 // ListTransfersByAccount lists transfers for an account
 func (s *TransferServer) ListTransfersByAccount(ctx context.Context, req *pb.ListTransfersByAccountRequest) (*pb.ListTransfersByAccountResponse, error) {
+	if req.Cursor != nil {
+		result, err := s.transferUC.ListTransfersByAccountCursor(ctx, usecase.ListTransfersByAccountCursorInput{
+			AccountID: req.AccountId,
+			Cursor:    req.GetCursor(),
+			Limit:     int(req.Limit),
+		})
+		if err != nil {
+			return nil, grpcErrors.MapDomainError(err)
+		}
+
+		return &pb.ListTransfersByAccountResponse{
+			Transfers:  converter.TransfersToPb(result.Transfers),
+			NextCursor: result.NextCursor,
+		}, nil
+	}
+
 	transfers, err := s.transferUC.ListTransfersByAccount(ctx, usecase.ListTransfersByAccountInput{
 		AccountID: req.AccountId,
 		Limit:     int(req.Limit),
@@ -122,13 +141,54 @@ func (s *TransferServer) ListTransfersByAccount(ctx context.Context, req *pb.Lis
 		return nil, grpcErrors.MapDomainError(err)
 	}
 
-	pbTransfers := make([]*pb.Transfer, len(transfers))
-	for i, transfer := range transfers {
-		pbTransfers[i] = converter.TransferToPb(transfer)
+	return &pb.ListTransfersByAccountResponse{
+		Transfers: converter.TransfersToPb(transfers),
+	}, nil
+}
+
+// SearchTransfers finds transfers matching the request's filters
+func (s *TransferServer) SearchTransfers(ctx context.Context, req *pb.SearchTransfersRequest) (*pb.SearchTransfersResponse, error) {
+	filter := domain.TransferSearchFilter{
+		AccountID:      req.AccountId,
+		Direction:      domain.TransferDirection(req.Direction),
+		EventAtFrom:    converter.ParseTimestamp(req.EventAtFrom),
+		EventAtTo:      converter.ParseTimestamp(req.EventAtTo),
+		CreatedAtFrom:  converter.ParseTimestamp(req.CreatedAtFrom),
+		CreatedAtTo:    converter.ParseTimestamp(req.CreatedAtTo),
+		Currency:       req.Currency,
+		ReversalStatus: domain.TransferReversalStatus(req.ReversalStatus),
+		Metadata:       converter.MetadataToMap(req.Metadata),
 	}
 
-	return &pb.ListTransfersByAccountResponse{
-		Transfers: pbTransfers,
+	if req.MinAmount != "" {
+		amount, err := converter.ParseDecimal(req.MinAmount)
+		if err != nil {
+			return nil, grpcErrors.MapDomainError(apierror.BadField("min_amount", "invalid min_amount format"))
+		}
+		filter.MinAmount = &amount
+	}
+
+	if req.MaxAmount != "" {
+		amount, err := converter.ParseDecimal(req.MaxAmount)
+		if err != nil {
+			return nil, grpcErrors.MapDomainError(apierror.BadField("max_amount", "invalid max_amount format"))
+		}
+		filter.MaxAmount = &amount
+	}
+
+	result, err := s.transferUC.SearchTransfers(ctx, usecase.SearchTransfersInput{
+		Filter: filter,
+		Sort:   domain.TransferSort{Field: domain.TransferSortField(req.Sort), Ascending: req.Ascending},
+		Cursor: req.Cursor,
+		Limit:  int(req.Limit),
+	})
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.SearchTransfersResponse{
+		Transfers:  converter.TransfersToPb(result.Transfers),
+		NextCursor: result.NextCursor,
 	}, nil
 }
 
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+
+	"github.com/iho/goledger/internal/adapter/grpc/converter"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// defaultUserLimit matches the HTTP user list's default page size.
+const defaultUserLimit = 50
+
+// UserService defines the functionality required by UserServer.
+type UserService interface {
+	CreateUser(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error)
+	GetUser(ctx context.Context, id string) (*domain.User, error)
+	ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error)
+	UpdateUser(ctx context.Context, input usecase.UpdateUserInput) (*domain.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	ChangePassword(ctx context.Context, input usecase.ChangePasswordInput) error
+}
+
+// UserServer implements the gRPC UserService
+type UserServer struct {
+	pb.UnimplementedUserServiceServer
+	userUC UserService
+}
+
+// NewUserServer creates a new UserServer
+func NewUserServer(userUC UserService) *UserServer {
+	return &UserServer{
+		userUC: userUC,
+	}
+}
+
+// CreateUser creates a new user
+func (s *UserServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	user, err := s.userUC.CreateUser(ctx, usecase.CreateUserInput{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+		Role:     domain.Role(req.Role),
+	})
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.CreateUserResponse{
+		User: converter.UserToPb(user),
+	}, nil
+}
+
+// GetUser retrieves a user by ID
+func (s *UserServer) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	user, err := s.userUC.GetUser(ctx, req.Id)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.GetUserResponse{
+		User: converter.UserToPb(user),
+	}, nil
+}
+
+// ListUsers lists users with pagination
+func (s *UserServer) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultUserLimit
+	}
+
+	users, err := s.userUC.ListUsers(ctx, limit, int(req.Offset))
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.ListUsersResponse{
+		Users: converter.UsersToPb(users),
+	}, nil
+}
+
+// UpdateUser changes the fields set on the request
+func (s *UserServer) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	input := usecase.UpdateUserInput{
+		ID:       req.Id,
+		Name:     req.Name,
+		Active:   req.Active,
+		Password: req.Password,
+	}
+	if req.Role != nil {
+		role := domain.Role(*req.Role)
+		input.Role = &role
+	}
+
+	user, err := s.userUC.UpdateUser(ctx, input)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.UpdateUserResponse{
+		User: converter.UserToPb(user),
+	}, nil
+}
+
+// DeleteUser removes a user
+func (s *UserServer) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	if err := s.userUC.DeleteUser(ctx, req.Id); err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.DeleteUserResponse{}, nil
+}
+
+// ChangePassword changes the calling user's own password
+func (s *UserServer) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return nil, grpcErrors.MapDomainError(domain.ErrUnauthorized)
+	}
+
+	err := s.userUC.ChangePassword(ctx, usecase.ChangePasswordInput{
+		UserID:          user.ID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.ChangePasswordResponse{}, nil
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/grpc/converter"
+	grpcErrors "github.com/iho/goledger/internal/adapter/grpc/errors"
+	pb "github.com/iho/goledger/internal/adapter/grpc/pb/goledger/v1"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// WebhookService defines the functionality required by WebhookServer.
+type WebhookService interface {
+	CreateSubscription(ctx context.Context, input usecase.CreateWebhookSubscriptionInput) (*domain.WebhookSubscription, error)
+	GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, id string, input usecase.UpdateWebhookSubscriptionInput) (*domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) error
+	ListDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, []*domain.WebhookDeliveryAttempt, error)
+	Redeliver(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error)
+}
+
+// WebhookServer implements the gRPC WebhookService
+type WebhookServer struct {
+	pb.UnimplementedWebhookServiceServer
+	webhookUC WebhookService
+}
+
+// NewWebhookServer creates a new WebhookServer
+func NewWebhookServer(webhookUC WebhookService) *WebhookServer {
+	return &WebhookServer{
+		webhookUC: webhookUC,
+	}
+}
+
+// CreateWebhook creates a subscription and returns its signing secret
+func (s *WebhookServer) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	sub, err := s.webhookUC.CreateSubscription(ctx, usecase.CreateWebhookSubscriptionInput{
+		URL:           req.Url,
+		EventTypes:    req.EventTypes,
+		AggregateType: req.AggregateType,
+		AggregateID:   req.AggregateId,
+		Description:   req.Description,
+		Secret:        req.Secret,
+	})
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.CreateWebhookResponse{
+		Webhook: converter.WebhookToPb(sub),
+		Secret:  sub.Secret,
+	}, nil
+}
+
+// GetWebhook gets a subscription by ID
+func (s *WebhookServer) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	sub, err := s.webhookUC.GetSubscription(ctx, req.Id)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.GetWebhookResponse{Webhook: converter.WebhookToPb(sub)}, nil
+}
+
+// ListWebhooks lists subscriptions
+func (s *WebhookServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	subs, err := s.webhookUC.ListSubscriptions(ctx, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	pbWebhooks := make([]*pb.Webhook, len(subs))
+	for i, sub := range subs {
+		pbWebhooks[i] = converter.WebhookToPb(sub)
+	}
+
+	return &pb.ListWebhooksResponse{Webhooks: pbWebhooks}, nil
+}
+
+// UpdateWebhook changes the fields set in the request
+func (s *WebhookServer) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	input := usecase.UpdateWebhookSubscriptionInput{
+		URL:           req.Url,
+		AggregateType: req.AggregateType,
+		AggregateID:   req.AggregateId,
+		Description:   req.Description,
+		Active:        req.Active,
+	}
+	if req.EventTypes != nil {
+		input.EventTypes = &req.EventTypes.EventTypes
+	}
+
+	sub, err := s.webhookUC.UpdateSubscription(ctx, req.Id, input)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.UpdateWebhookResponse{Webhook: converter.WebhookToPb(sub)}, nil
+}
+
+// DeleteWebhook deletes a subscription and its deliveries
+func (s *WebhookServer) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	if err := s.webhookUC.DeleteSubscription(ctx, req.Id); err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.DeleteWebhookResponse{}, nil
+}
+
+// ListWebhookDeliveries lists a subscription's deliveries
+func (s *WebhookServer) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	deliveryStatus := domain.WebhookDeliveryStatus(req.Status)
+	switch deliveryStatus {
+	case "", domain.WebhookDeliveryPending, domain.WebhookDeliveryDelivered, domain.WebhookDeliveryDeadLettered:
+	default:
+		return nil, grpcErrors.MapDomainError(apierror.BadField("status", "status must be pending, delivered or dead_lettered"))
+	}
+
+	deliveries, err := s.webhookUC.ListDeliveries(ctx, req.WebhookId, deliveryStatus, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		pbDeliveries[i] = converter.WebhookDeliveryToPb(d, false)
+	}
+
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: pbDeliveries}, nil
+}
+
+// GetWebhookDelivery gets a delivery with its body and attempt log
+func (s *WebhookServer) GetWebhookDelivery(ctx context.Context, req *pb.GetWebhookDeliveryRequest) (*pb.GetWebhookDeliveryResponse, error) {
+	delivery, attempts, err := s.webhookUC.GetDelivery(ctx, req.WebhookId, req.DeliveryId)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	pbAttempts := make([]*pb.WebhookDeliveryAttempt, len(attempts))
+	for i, a := range attempts {
+		pbAttempts[i] = converter.WebhookDeliveryAttemptToPb(a)
+	}
+
+	return &pb.GetWebhookDeliveryResponse{
+		Delivery: converter.WebhookDeliveryToPb(delivery, true),
+		Attempts: pbAttempts,
+	}, nil
+}
+
+// RedeliverWebhook queues a delivery for immediate sending
+func (s *WebhookServer) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	delivery, err := s.webhookUC.Redeliver(ctx, req.WebhookId, req.DeliveryId)
+	if err != nil {
+		return nil, grpcErrors.MapDomainError(err)
+	}
+
+	return &pb.RedeliverWebhookResponse{Delivery: converter.WebhookDeliveryToPb(delivery, false)}, nil
+}
@@ -0,0 +1,35 @@
+package dto
+
+import "github.com/iho/goledger/internal/domain"
+
+// EventSchemaResponse describes the payload schema of one event type and
+// version.
+type EventSchemaResponse struct {
+	EventType string `json:"event_type"`
+	Version   int32  `json:"version"`
+	// SchemaURL is the event's CloudEvents dataschema, when a schema base
+	// URL is configured.
+	SchemaURL string             `json:"schema_url,omitempty"`
+	Schema    *domain.JSONSchema `json:"schema"`
+}
+
+// ListEventSchemasResponse lists every published event schema.
+type ListEventSchemasResponse struct {
+	Schemas []*EventSchemaResponse `json:"schemas"`
+}
+
+// EventSchemaFromDomain converts an event schema to a response, setting
+// the schema's $id to its dataschema URL.
+func EventSchemaFromDomain(es domain.EventSchema, opts domain.CloudEventOptions) *EventSchemaResponse {
+	schemaURL := opts.SchemaURL(es.EventType, es.Version)
+
+	schema := *es.Schema
+	schema.ID = schemaURL
+
+	return &EventSchemaResponse{
+		EventType: es.EventType,
+		Version:   es.Version,
+		SchemaURL: schemaURL,
+		Schema:    &schema,
+	}
+}
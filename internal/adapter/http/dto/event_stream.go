@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// StreamEventResponse is the data of one event on the event stream.
+type StreamEventResponse struct {
+	ID                string         `json:"id"`
+	AggregateType     string         `json:"aggregate_type"`
+	AggregateID       string         `json:"aggregate_id"`
+	EventType         string         `json:"event_type"`
+	EventVersion      int32          `json:"event_version"`
+	AggregateSequence int64          `json:"aggregate_sequence"`
+	Payload           map[string]any `json:"payload"`
+	CreatedAt         time.Time      `json:"created_at"`
+	PublishedAt       *time.Time     `json:"published_at,omitempty"`
+}
+
+// StreamEventFromDomain converts a published outbox event to a response.
+func StreamEventFromDomain(e *domain.OutboxEvent) *StreamEventResponse {
+	return &StreamEventResponse{
+		ID:                e.ID,
+		AggregateType:     e.AggregateType,
+		AggregateID:       e.AggregateID,
+		EventType:         e.EventType,
+		EventVersion:      e.EventVersion,
+		AggregateSequence: e.AggregateSequence,
+		Payload:           e.Payload,
+		CreatedAt:         e.CreatedAt,
+		PublishedAt:       e.PublishedAt,
+	}
+}
@@ -23,6 +23,13 @@ type HoldResponse struct {
 	UpdatedAt time.Time       `json:"updated_at"`
 }
 
+// ListHoldsResponse represents a keyset-paginated page of holds.
+// NextCursor is empty when there are no more results.
+type ListHoldsResponse struct {
+	Holds      []HoldResponse `json:"holds"`
+	NextCursor string         `json:"next_cursor,omitempty"`
+}
+
 type CaptureHoldRequest struct {
 	ToAccountID string `json:"to_account_id"`
 }
@@ -38,3 +45,12 @@ func HoldFromDomain(h *domain.Hold) HoldResponse {
 		UpdatedAt: h.UpdatedAt,
 	}
 }
+
+func HoldsFromDomain(holds []*domain.Hold) []HoldResponse {
+	result := make([]HoldResponse, len(holds))
+	for i, h := range holds {
+		result[i] = HoldFromDomain(h)
+	}
+
+	return result
+}
@@ -0,0 +1,97 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// DeadLetterFilterRequest selects dead-lettered outbox events. At least one
+// criterion must be set, or All must be true.
+type DeadLetterFilterRequest struct {
+	EventIDs    []string   `json:"event_ids,omitempty"`
+	EventType   string     `json:"event_type,omitempty"`
+	CreatedFrom *time.Time `json:"created_from,omitempty"`
+	CreatedTo   *time.Time `json:"created_to,omitempty"`
+	All         bool       `json:"all,omitempty"`
+}
+
+// ToDomain converts the request to a domain.DeadLetterFilter.
+func (r *DeadLetterFilterRequest) ToDomain() domain.DeadLetterFilter {
+	return domain.DeadLetterFilter{
+		IDs:         r.EventIDs,
+		EventType:   r.EventType,
+		CreatedFrom: r.CreatedFrom,
+		CreatedTo:   r.CreatedTo,
+		All:         r.All,
+	}
+}
+
+// ArchiveDeadLettersRequest selects dead-lettered events to archive.
+type ArchiveDeadLettersRequest struct {
+	DeadLetterFilterRequest
+	Reason string `json:"reason"`
+}
+
+// OutboxEventResponse represents an outbox event in API responses.
+type OutboxEventResponse struct {
+	ID                string         `json:"id"`
+	AggregateType     string         `json:"aggregate_type"`
+	AggregateID       string         `json:"aggregate_id"`
+	EventType         string         `json:"event_type"`
+	EventVersion      int32          `json:"event_version"`
+	AggregateSequence int64          `json:"aggregate_sequence"`
+	Payload           map[string]any `json:"payload,omitempty"`
+	Attempts          int            `json:"attempts"`
+	LastError         string         `json:"last_error,omitempty"`
+	CreatedAt         time.Time      `json:"created_at"`
+	DeadLetteredAt    *time.Time     `json:"dead_lettered_at,omitempty"`
+}
+
+// OutboxEventFromDomain converts a domain outbox event to a response.
+func OutboxEventFromDomain(e *domain.OutboxEvent) *OutboxEventResponse {
+	return &OutboxEventResponse{
+		ID:                e.ID,
+		AggregateType:     e.AggregateType,
+		AggregateID:       e.AggregateID,
+		EventType:         e.EventType,
+		EventVersion:      e.EventVersion,
+		AggregateSequence: e.AggregateSequence,
+		Payload:           e.Payload,
+		Attempts:          e.Attempts,
+		LastError:         e.LastError,
+		CreatedAt:         e.CreatedAt,
+		DeadLetteredAt:    e.DeadLetteredAt,
+	}
+}
+
+// OutboxEventsFromDomain converts domain outbox events to responses.
+func OutboxEventsFromDomain(events []*domain.OutboxEvent) []*OutboxEventResponse {
+	result := make([]*OutboxEventResponse, len(events))
+	for i, e := range events {
+		result[i] = OutboxEventFromDomain(e)
+	}
+
+	return result
+}
+
+type ListOutboxEventsResponse struct {
+	NextCursor string                 `json:"next_cursor,omitempty"`
+	Events     []*OutboxEventResponse `json:"events"`
+}
+
+// DeadLetterActionResponse reports which events a replay or archive touched.
+type DeadLetterActionResponse struct {
+	Count    int      `json:"count"`
+	EventIDs []string `json:"event_ids"`
+}
+
+// DeadLetterActionFromDomain builds a DeadLetterActionResponse.
+func DeadLetterActionFromDomain(events []*domain.OutboxEvent) DeadLetterActionResponse {
+	ids := make([]string, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+
+	return DeadLetterActionResponse{Count: len(ids), EventIDs: ids}
+}
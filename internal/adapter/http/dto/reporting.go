@@ -0,0 +1,102 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// DailyVolumeResponse is an account's debits and credits on one day.
+type DailyVolumeResponse struct {
+	Day         string `json:"day"`
+	Currency    string `json:"currency"`
+	DebitTotal  string `json:"debit_total"`
+	CreditTotal string `json:"credit_total"`
+	EntryCount  int64  `json:"entry_count"`
+}
+
+// ListDailyVolumesResponse lists an account's daily volumes, oldest first.
+type ListDailyVolumesResponse struct {
+	AccountID string                 `json:"account_id"`
+	Volumes   []*DailyVolumeResponse `json:"volumes"`
+}
+
+// ListDailyVolumesFromDomain builds a ListDailyVolumesResponse.
+func ListDailyVolumesFromDomain(accountID string, volumes []*domain.AccountDailyVolume) ListDailyVolumesResponse {
+	result := make([]*DailyVolumeResponse, len(volumes))
+	for i, v := range volumes {
+		result[i] = &DailyVolumeResponse{
+			Day:         v.Day.Format(time.DateOnly),
+			Currency:    v.Currency,
+			DebitTotal:  v.DebitTotal.String(),
+			CreditTotal: v.CreditTotal.String(),
+			EntryCount:  v.EntryCount,
+		}
+	}
+
+	return ListDailyVolumesResponse{AccountID: accountID, Volumes: result}
+}
+
+// CounterpartyTotalResponse is what an account exchanged with one
+// counterparty.
+type CounterpartyTotalResponse struct {
+	CounterpartyAccountID string `json:"counterparty_account_id"`
+	Currency              string `json:"currency"`
+	SentTotal             string `json:"sent_total"`
+	ReceivedTotal         string `json:"received_total"`
+	EntryCount            int64  `json:"entry_count"`
+}
+
+// ListCounterpartyTotalsResponse lists an account's counterparty totals.
+type ListCounterpartyTotalsResponse struct {
+	AccountID      string                       `json:"account_id"`
+	Counterparties []*CounterpartyTotalResponse `json:"counterparties"`
+}
+
+// ListCounterpartyTotalsFromDomain builds a ListCounterpartyTotalsResponse.
+func ListCounterpartyTotalsFromDomain(accountID string, totals []*domain.CounterpartyTotal) ListCounterpartyTotalsResponse {
+	result := make([]*CounterpartyTotalResponse, len(totals))
+	for i, c := range totals {
+		result[i] = &CounterpartyTotalResponse{
+			CounterpartyAccountID: c.CounterpartyAccountID,
+			Currency:              c.Currency,
+			SentTotal:             c.SentTotal.String(),
+			ReceivedTotal:         c.ReceivedTotal.String(),
+			EntryCount:            c.EntryCount,
+		}
+	}
+
+	return ListCounterpartyTotalsResponse{AccountID: accountID, Counterparties: result}
+}
+
+// BalanceSnapshotResponse is an account's projected balance.
+type BalanceSnapshotResponse struct {
+	AccountID      string    `json:"account_id"`
+	Currency       string    `json:"currency"`
+	Balance        string    `json:"balance"`
+	AccountVersion int64     `json:"account_version"`
+	LastEntryID    string    `json:"last_entry_id"`
+	LastEntryAt    time.Time `json:"last_entry_at"`
+}
+
+// ListBalanceSnapshotsResponse lists projected balances.
+type ListBalanceSnapshotsResponse struct {
+	Balances []*BalanceSnapshotResponse `json:"balances"`
+}
+
+// ListBalanceSnapshotsFromDomain builds a ListBalanceSnapshotsResponse.
+func ListBalanceSnapshotsFromDomain(balances []*domain.AccountBalanceSnapshot) ListBalanceSnapshotsResponse {
+	result := make([]*BalanceSnapshotResponse, len(balances))
+	for i, b := range balances {
+		result[i] = &BalanceSnapshotResponse{
+			AccountID:      b.AccountID,
+			Currency:       b.Currency,
+			Balance:        b.Balance.String(),
+			AccountVersion: b.AccountVersion,
+			LastEntryID:    b.LastEntryID,
+			LastEntryAt:    b.LastEntryAt,
+		}
+	}
+
+	return ListBalanceSnapshotsResponse{Balances: result}
+}
@@ -54,11 +54,35 @@ type TransferResponse struct {
 	ToAccountID        string         `json:"to_account_id"`
 	Amount             string         `json:"amount"`
 	ReversedTransferID *string        `json:"reversed_transfer_id,omitempty"`
+	// Fees is the fee breakdown charged by fee policies; only present on
+	// the creation response.
+	Fees []TransferFeeResponse `json:"fees,omitempty"`
+}
+
+// TransferFeeResponse represents one fee leg charged on a transfer.
+type TransferFeeResponse struct {
+	PolicyID      string `json:"policy_id"`
+	TransferID    string `json:"transfer_id"`
+	FromAccountID string `json:"from_account_id"`
+	ToAccountID   string `json:"to_account_id"`
+	Amount        string `json:"amount"`
 }
 
 // TransferFromDomain converts domain transfer to response.
 func TransferFromDomain(t *domain.Transfer) *TransferResponse {
+	var fees []TransferFeeResponse
+	for _, f := range t.Fees {
+		fees = append(fees, TransferFeeResponse{
+			PolicyID:      f.PolicyID,
+			TransferID:    f.TransferID,
+			FromAccountID: f.FromAccountID,
+			ToAccountID:   f.ToAccountID,
+			Amount:        f.Amount.String(),
+		})
+	}
+
 	return &TransferResponse{
+		Fees:               fees,
 		ID:                 t.ID,
 		FromAccountID:      t.FromAccountID,
 		ToAccountID:        t.ToAccountID,
@@ -116,10 +140,12 @@ func EntriesFromDomain(entries []*domain.Entry) []*EntryResponse {
 	return result
 }
 
-// ListAccountsResponse represents a list of accounts.
+// ListAccountsResponse represents a list of accounts. NextCursor is set
+// on keyset-paginated pages that have more results.
 type ListAccountsResponse struct {
-	Accounts []*AccountResponse `json:"accounts"`
-	Total    int64              `json:"total"`
+	NextCursor string             `json:"next_cursor,omitempty"`
+	Accounts   []*AccountResponse `json:"accounts"`
+	Total      int64              `json:"total"`
 }
 
 // ListTransfersResponse represents a list of transfers.
@@ -134,9 +160,11 @@ type ListTransfersCursorResponse struct {
 	Transfers  []*TransferResponse `json:"transfers"`
 }
 
-// ListEntriesResponse represents a list of entries.
+// ListEntriesResponse represents a keyset-paginated page of entries.
+// NextCursor is empty when there are no more results.
 type ListEntriesResponse struct {
-	Entries []*EntryResponse `json:"entries"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+	Entries    []*EntryResponse `json:"entries"`
 }
 
 // AuditLogResponse represents an audit log entry in API responses.
@@ -184,6 +212,13 @@ func AuditLogFromDomain(a *domain.AuditLog) *AuditLogResponse {
 	}
 }
 
+// ListAuditLogsResponse represents a keyset-paginated page of audit logs.
+// NextCursor is empty when there are no more results.
+type ListAuditLogsResponse struct {
+	NextCursor string              `json:"next_cursor,omitempty"`
+	AuditLogs  []*AuditLogResponse `json:"audit_logs"`
+}
+
 // AuditLogsFromDomain converts domain audit logs to responses.
 func AuditLogsFromDomain(logs []*domain.AuditLog) []*AuditLogResponse {
 	result := make([]*AuditLogResponse, len(logs))
@@ -46,6 +46,13 @@ func TestTransferFromDomain(t *testing.T) {
 		EventAt:            now,
 		Metadata:           map[string]any{"key": "value"},
 		ReversedTransferID: &reversed,
+		Fees: []domain.TransferFee{{
+			PolicyID:      "fp-1",
+			TransferID:    "tr-fee",
+			FromAccountID: "A",
+			ToAccountID:   "REV",
+			Amount:        decimal.RequireFromString("0.5"),
+		}},
 	}
 
 	resp := TransferFromDomain(transfer)
@@ -53,6 +60,10 @@ func TestTransferFromDomain(t *testing.T) {
 		t.Fatalf("unexpected transfer response: %+v", resp)
 	}
 
+	if len(resp.Fees) != 1 || resp.Fees[0].TransferID != "tr-fee" || resp.Fees[0].Amount != "0.5" {
+		t.Fatalf("unexpected fees: %+v", resp.Fees)
+	}
+
 	list := TransfersFromDomain([]*domain.Transfer{transfer})
 	if len(list) != 1 || list[0].ID != transfer.ID {
 		t.Fatalf("TransfersFromDomain returned %+v", list)
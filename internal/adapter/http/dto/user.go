@@ -0,0 +1,65 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+type CreateUserRequest struct {
+	Email    string      `json:"email"`
+	Name     string      `json:"name"`
+	Password string      `json:"password"`
+	Role     domain.Role `json:"role"`
+}
+
+// UpdateUserRequest changes only the fields present in the body. Password
+// resets the user's password without the current one.
+type UpdateUserRequest struct {
+	Name     *string      `json:"name,omitempty"`
+	Role     *domain.Role `json:"role,omitempty"`
+	Active   *bool        `json:"active,omitempty"`
+	Password *string      `json:"password,omitempty"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// UserResponse never carries the password hash.
+type UserResponse struct {
+	ID        string      `json:"id"`
+	Email     string      `json:"email"`
+	Name      string      `json:"name"`
+	Role      domain.Role `json:"role"`
+	Active    bool        `json:"active"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+type ListUsersResponse struct {
+	Users []*UserResponse `json:"users"`
+}
+
+// UserFromDomain converts a user, leaving out its password hash.
+func UserFromDomain(u *domain.User) *UserResponse {
+	return &UserResponse{
+		ID:        u.ID,
+		Email:     u.Email,
+		Name:      u.Name,
+		Role:      u.Role,
+		Active:    u.Active,
+		CreatedAt: u.CreatedAt,
+		UpdatedAt: u.UpdatedAt,
+	}
+}
+
+func UsersFromDomain(users []*domain.User) []*UserResponse {
+	result := make([]*UserResponse, len(users))
+	for i, u := range users {
+		result[i] = UserFromDomain(u)
+	}
+
+	return result
+}
@@ -0,0 +1,147 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+type CreateWebhookRequest struct {
+	URL           string   `json:"url"`
+	EventTypes    []string `json:"event_types,omitempty"`
+	AggregateType string   `json:"aggregate_type,omitempty"`
+	AggregateID   string   `json:"aggregate_id,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	// Secret is generated when omitted.
+	Secret string `json:"secret,omitempty"`
+}
+
+// UpdateWebhookRequest changes only the fields present in the body.
+type UpdateWebhookRequest struct {
+	URL           *string   `json:"url,omitempty"`
+	EventTypes    *[]string `json:"event_types,omitempty"`
+	AggregateType *string   `json:"aggregate_type,omitempty"`
+	AggregateID   *string   `json:"aggregate_id,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	Active        *bool     `json:"active,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID            string   `json:"id"`
+	URL           string   `json:"url"`
+	EventTypes    []string `json:"event_types"`
+	AggregateType *string  `json:"aggregate_type,omitempty"`
+	AggregateID   *string  `json:"aggregate_id,omitempty"`
+	Description   string   `json:"description,omitempty"`
+	Active        bool     `json:"active"`
+	// Secret is only returned when the subscription is created.
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListWebhooksResponse struct {
+	Webhooks []*WebhookResponse `json:"webhooks"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	SubscriptionID string          `json:"subscription_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"last_error,omitempty"`
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	DeadLetteredAt *time.Time      `json:"dead_lettered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	Body           json.RawMessage `json:"body,omitempty"`
+	// AttemptLog is only returned for a single delivery.
+	AttemptLog []*WebhookDeliveryAttemptResponse `json:"attempt_log,omitempty"`
+}
+
+type WebhookDeliveryAttemptResponse struct {
+	Attempt     int       `json:"attempt"`
+	StatusCode  *int      `json:"status_code,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMs  int64     `json:"duration_ms"`
+	AttemptedAt time.Time `json:"attempted_at"`
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []*WebhookDeliveryResponse `json:"deliveries"`
+}
+
+// WebhookFromDomain converts a subscription, leaving out its secret.
+func WebhookFromDomain(s *domain.WebhookSubscription) *WebhookResponse {
+	return &WebhookResponse{
+		ID:            s.ID,
+		URL:           s.URL,
+		EventTypes:    s.EventTypes,
+		AggregateType: s.AggregateType,
+		AggregateID:   s.AggregateID,
+		Description:   s.Description,
+		Active:        s.Active,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
+
+func WebhooksFromDomain(subs []*domain.WebhookSubscription) []*WebhookResponse {
+	result := make([]*WebhookResponse, len(subs))
+	for i, s := range subs {
+		result[i] = WebhookFromDomain(s)
+	}
+	return result
+}
+
+// WebhookDeliveryFromDomain converts a delivery; the body is only included
+// when withBody is set, to keep listings small.
+func WebhookDeliveryFromDomain(d *domain.WebhookDelivery, withBody bool) *WebhookDeliveryResponse {
+	resp := &WebhookDeliveryResponse{
+		ID:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		LastError:      d.LastError,
+		LastStatusCode: d.LastStatusCode,
+		DeliveredAt:    d.DeliveredAt,
+		DeadLetteredAt: d.DeadLetteredAt,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.Status == domain.WebhookDeliveryPending {
+		next := d.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+	if withBody {
+		resp.Body = d.Body
+	}
+	return resp
+}
+
+func WebhookDeliveriesFromDomain(deliveries []*domain.WebhookDelivery) []*WebhookDeliveryResponse {
+	result := make([]*WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = WebhookDeliveryFromDomain(d, false)
+	}
+	return result
+}
+
+func WebhookDeliveryAttemptsFromDomain(attempts []*domain.WebhookDeliveryAttempt) []*WebhookDeliveryAttemptResponse {
+	result := make([]*WebhookDeliveryAttemptResponse, len(attempts))
+	for i, a := range attempts {
+		result[i] = &WebhookDeliveryAttemptResponse{
+			Attempt:     a.Attempt,
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+			AttemptedAt: a.AttemptedAt,
+		}
+	}
+	return result
+}
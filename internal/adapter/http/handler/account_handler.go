@@ -17,6 +17,7 @@ type AccountService interface {
 	CreateAccount(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error)
 	GetAccount(ctx context.Context, id string) (*domain.Account, error)
 	ListAccounts(ctx context.Context, input usecase.ListAccountsInput) ([]*domain.Account, error)
+	ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error)
 }
 
 // AccountHandler handles account-related HTTP requests.
@@ -39,7 +40,7 @@ func (h *AccountHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	account, err := h.accountUC.CreateAccount(r.Context(), req.ToUseCaseInput())
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to create account", err.Error())
+		writeDomainError(w, "failed to create account", err)
 		return
 	}
 
@@ -56,18 +57,38 @@ func (h *AccountHandler) Get(w http.ResponseWriter, r *http.Request) {
 
 	account, err := h.accountUC.GetAccount(r.Context(), id)
 	if err != nil {
-		status := mapDomainError(err)
-		writeError(w, status, "failed to get account", err.Error())
-
+		writeDomainError(w, "failed to get account", err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dto.AccountFromDomain(account))
+	writeJSONWithETag(w, r, accountETag(account), dto.AccountFromDomain(account))
 }
 
-// List lists accounts.
+// List lists accounts. Prefer the "cursor" query param (keyset
+// pagination, stable under concurrent writes); "offset" is kept for
+// backward compatibility.
 func (h *AccountHandler) List(w http.ResponseWriter, r *http.Request) {
 	limit := parseIntQuery(r, "limit", 20)
+
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" || r.URL.Query().Has("cursor") {
+		result, err := h.accountUC.ListAccountsCursor(r.Context(), usecase.ListAccountsCursorInput{
+			Cursor: cursor,
+			Limit:  limit,
+		})
+		if err != nil {
+			writeDomainError(w, "failed to list accounts", err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, dto.ListAccountsResponse{
+			NextCursor: result.NextCursor,
+			Accounts:   dto.AccountsFromDomain(result.Accounts),
+			Total:      int64(len(result.Accounts)),
+		})
+
+		return
+	}
+
 	offset := parseIntQuery(r, "offset", 0)
 
 	accounts, err := h.accountUC.ListAccounts(r.Context(), usecase.ListAccountsInput{
@@ -75,7 +96,7 @@ func (h *AccountHandler) List(w http.ResponseWriter, r *http.Request) {
 		Offset: offset,
 	})
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to list accounts", err.Error())
+		writeDomainError(w, "failed to list accounts", err)
 		return
 	}
 
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/http/dto"
 	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/usecase"
@@ -19,6 +20,7 @@ type accountServiceStub struct {
 	createFn func(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error)
 	getFn    func(ctx context.Context, id string) (*domain.Account, error)
 	listFn   func(ctx context.Context, input usecase.ListAccountsInput) ([]*domain.Account, error)
+	cursorFn func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error)
 }
 
 func (s *accountServiceStub) CreateAccount(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error) {
@@ -33,6 +35,10 @@ func (s *accountServiceStub) ListAccounts(ctx context.Context, input usecase.Lis
 	return s.listFn(ctx, input)
 }
 
+func (s *accountServiceStub) ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+	return s.cursorFn(ctx, input)
+}
+
 func TestAccountHandler_Create_Success(t *testing.T) {
 	account := &domain.Account{
 		ID:                   "acc-1",
@@ -119,6 +125,34 @@ func TestAccountHandler_Create_ServiceError(t *testing.T) {
 	}
 }
 
+func TestAccountHandler_Create_InvalidCurrency(t *testing.T) {
+	handler := NewAccountHandler(&accountServiceStub{
+		createFn: func(ctx context.Context, input usecase.CreateAccountInput) (*domain.Account, error) {
+			return nil, domain.ValidateCurrency(input.Currency)
+		},
+		getFn:  func(ctx context.Context, id string) (*domain.Account, error) { return nil, nil },
+		listFn: func(ctx context.Context, input usecase.ListAccountsInput) ([]*domain.Account, error) { return nil, nil },
+	})
+
+	body, _ := json.Marshal(dto.CreateAccountRequest{Name: "test", Currency: "XYZ"})
+	req := httptest.NewRequest(http.MethodPost, "/accounts", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	handler.Create(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+
+	var problem apierror.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("failed to decode problem: %v", err)
+	}
+	if problem.Code != "INVALID_CURRENCY" || len(problem.InvalidParams) != 1 || problem.InvalidParams[0].Name != "currency" {
+		t.Fatalf("unexpected problem %+v", problem)
+	}
+}
+
 func TestAccountHandler_Get(t *testing.T) {
 	account := &domain.Account{ID: "acc-1", Name: "test"}
 	handler := NewAccountHandler(&accountServiceStub{
@@ -194,6 +228,70 @@ func TestAccountHandler_List(t *testing.T) {
 	}
 }
 
+func TestAccountHandler_List_Cursor(t *testing.T) {
+	handler := NewAccountHandler(&accountServiceStub{
+		cursorFn: func(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+			if input.Cursor != "acc-9" || input.Limit != 2 {
+				t.Fatalf("expected cursor=acc-9 limit=2, got %+v", input)
+			}
+			return &usecase.ListAccountsCursorResult{
+				Accounts:   []*domain.Account{{ID: "acc-8"}, {ID: "acc-7"}},
+				NextCursor: "acc-7",
+			}, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/accounts?limit=2&cursor=acc-9", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	handler.List(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var resp dto.ListAccountsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Accounts) != 2 || resp.NextCursor != "acc-7" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestAccountHandler_Get_ConditionalOnVersion(t *testing.T) {
+	handler := NewAccountHandler(&accountServiceStub{
+		getFn: func(ctx context.Context, id string) (*domain.Account, error) {
+			return &domain.Account{ID: id, Version: 5}, nil
+		},
+	})
+
+	for _, tc := range []struct {
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		{"", http.StatusOK},
+		{`"4"`, http.StatusOK},
+		{`"5"`, http.StatusNotModified},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/accounts/acc-1", http.NoBody)
+		if tc.ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", tc.ifNoneMatch)
+		}
+		req = setChiURLParam(req, "id", "acc-1")
+		rec := httptest.NewRecorder()
+
+		handler.Get(rec, req)
+
+		if rec.Code != tc.wantStatus {
+			t.Fatalf("If-None-Match %q: expected %d, got %d", tc.ifNoneMatch, tc.wantStatus, rec.Code)
+		}
+		if etag := rec.Header().Get("ETag"); etag != `"5"` {
+			t.Fatalf("expected ETag \"5\", got %q", etag)
+		}
+	}
+}
+
 func setChiURLParam(r *http.Request, key, value string) *http.Request {
 	routeCtx := chi.NewRouteContext()
 	routeCtx.URLParams.Add(key, value)
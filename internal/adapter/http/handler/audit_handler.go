@@ -30,6 +30,8 @@ func NewAuditHandler(auditRepo AuditService) *AuditHandler {
 
 // List returns audit logs filtered by query parameters: user_id, action,
 // resource_type, resource_id, start_date, end_date (RFC3339), limit, offset.
+// With a "cursor" param (empty for the first page) it pages by keyset
+// instead of offset and returns {audit_logs, next_cursor}.
 func (h *AuditHandler) List(w http.ResponseWriter, r *http.Request) {
 	filter, err := filterFromQuery(r)
 	if err != nil {
@@ -37,9 +39,32 @@ func (h *AuditHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	cursor := r.URL.Query().Get("cursor")
+	paged := cursor != "" || r.URL.Query().Has("cursor")
+	if paged {
+		filter.Offset = 0
+		if cursor != "" {
+			after, err := domain.DecodeTimeCursor(cursor)
+			if err != nil {
+				writeDomainError(w, "invalid cursor", err)
+				return
+			}
+			filter.After = &after
+		}
+	}
+
 	logs, err := h.auditRepo.List(r.Context(), filter)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to list audit logs", err.Error())
+		writeDomainError(w, "failed to list audit logs", err)
+		return
+	}
+
+	if paged {
+		writeJSON(w, http.StatusOK, dto.ListAuditLogsResponse{
+			NextCursor: domain.NextAuditCursor(logs, filter.Limit),
+			AuditLogs:  dto.AuditLogsFromDomain(logs),
+		})
+
 		return
 	}
 
@@ -60,7 +85,7 @@ func (h *AuditHandler) Export(w http.ResponseWriter, r *http.Request) {
 
 	logs, err := h.auditRepo.List(r.Context(), filter)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to list audit logs", err.Error())
+		writeDomainError(w, "failed to list audit logs", err)
 		return
 	}
 
@@ -93,7 +118,7 @@ func (h *AuditHandler) GetByResource(w http.ResponseWriter, r *http.Request) {
 
 	logs, err := h.auditRepo.GetByResourceID(r.Context(), resourceType, resourceID)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to get audit logs", err.Error())
+		writeDomainError(w, "failed to get audit logs", err)
 		return
 	}
 
@@ -116,7 +141,7 @@ func (h *AuditHandler) GetByUser(w http.ResponseWriter, r *http.Request) {
 
 	logs, err := h.auditRepo.List(r.Context(), filter)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to get audit logs", err.Error())
+		writeDomainError(w, "failed to get audit logs", err)
 		return
 	}
 
@@ -113,7 +113,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 	// Generate JWT token
 	token, err := h.jwtManager.Generate(user)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to generate token", err.Error())
+		writeDomainError(w, "failed to generate token", err)
 		return
 	}
 
@@ -1,12 +1,17 @@
 package handler
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/usecase"
 )
 
@@ -20,7 +25,9 @@ func NewEntryHandler(entryUC *usecase.EntryUseCase) *EntryHandler {
 	return &EntryHandler{entryUC: entryUC}
 }
 
-// ListByAccount lists entries for an account.
+// ListByAccount lists entries for an account. Prefer the "cursor" query
+// param (keyset pagination, stable under concurrent writes); "offset" is
+// kept for backward compatibility.
 func (h *EntryHandler) ListByAccount(w http.ResponseWriter, r *http.Request) {
 	accountID := chi.URLParam(r, "id")
 	if accountID == "" {
@@ -29,6 +36,26 @@ func (h *EntryHandler) ListByAccount(w http.ResponseWriter, r *http.Request) {
 	}
 
 	limit := parseIntQuery(r, "limit", 20)
+
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" || r.URL.Query().Has("cursor") {
+		result, err := h.entryUC.GetEntriesByAccountCursor(r.Context(), usecase.GetEntriesByAccountCursorInput{
+			AccountID: accountID,
+			Cursor:    cursor,
+			Limit:     limit,
+		})
+		if err != nil {
+			writeDomainError(w, "failed to list entries", err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, dto.ListEntriesResponse{
+			NextCursor: result.NextCursor,
+			Entries:    dto.EntriesFromDomain(result.Entries),
+		})
+
+		return
+	}
+
 	offset := parseIntQuery(r, "offset", 0)
 
 	entries, err := h.entryUC.GetEntriesByAccount(r.Context(), usecase.GetEntriesByAccountInput{
@@ -37,13 +64,140 @@ func (h *EntryHandler) ListByAccount(w http.ResponseWriter, r *http.Request) {
 		Offset:    offset,
 	})
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to list entries", err.Error())
+		writeDomainError(w, "failed to list entries", err)
 		return
 	}
 
 	writeJSON(w, http.StatusOK, dto.EntriesFromDomain(entries))
 }
 
+// entryExportWriteTimeout is how long an export may take to write one batch
+// of entries to the client.
+const entryExportWriteTimeout = 30 * time.Second
+
+// Export streams an account's entries, oldest first, as NDJSON (the
+// default) or CSV (format=csv), optionally bounded by "from" and "to"
+// (RFC3339) to [from, to). Rows are flushed in batches as they are read, so
+// exports of any size run in bounded memory. Errors found before the first
+// row get a normal error response; later ones truncate the body. Exports are
+// cancelled after domain.EntryExportTimeout.
+func (h *EntryHandler) Export(w http.ResponseWriter, r *http.Request) {
+	accountID := chi.URLParam(r, "id")
+	if accountID == "" {
+		writeError(w, http.StatusBadRequest, "missing account ID", "")
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = "ndjson"
+	}
+	if format != "ndjson" && format != "csv" {
+		writeError(w, http.StatusBadRequest, "invalid format (use ndjson or csv)", "")
+		return
+	}
+
+	filter := domain.EntryExportFilter{AccountID: accountID}
+	if v := query.Get("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeDomainError(w, "invalid 'from' format (use RFC3339)", apierror.BadField("from", err.Error()))
+			return
+		}
+		filter.From = &t
+	}
+	if v := query.Get("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			writeDomainError(w, "invalid 'to' format (use RFC3339)", apierror.BadField("to", err.Error()))
+			return
+		}
+		filter.To = &t
+	}
+
+	// A large export outlives the server's write timeout by design. Instead
+	// each batch gets entryExportWriteTimeout to reach the client, so a
+	// stalled reader fails the export and releases its snapshot.
+	rc := http.NewResponseController(w)
+	extendDeadline := func() {
+		_ = rc.SetWriteDeadline(time.Now().Add(entryExportWriteTimeout))
+	}
+	extendDeadline()
+
+	var (
+		cw      *csv.Writer
+		enc     *json.Encoder
+		started bool
+		n       int
+	)
+	start := func() {
+		started = true
+		if format == "csv" {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Header().Set("Content-Disposition", `attachment; filename="entries_`+accountID+`.csv"`)
+			w.WriteHeader(http.StatusOK)
+			cw = csv.NewWriter(w)
+			_ = cw.Write([]string{
+				"id", "created_at", "account_id", "transfer_id", "amount",
+				"account_previous_balance", "account_current_balance", "account_version",
+			})
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+		enc = json.NewEncoder(w)
+	}
+	flush := func() error {
+		if cw != nil {
+			cw.Flush()
+			if err := cw.Error(); err != nil {
+				return err
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+		extendDeadline()
+		return nil
+	}
+
+	err := h.entryUC.ExportEntriesByAccount(r.Context(), filter, func(e *domain.Entry) error {
+		if !started {
+			start()
+		}
+
+		if cw != nil {
+			if err := cw.Write([]string{
+				e.ID, e.CreatedAt.Format(time.RFC3339Nano), e.AccountID, e.TransferID, e.Amount.String(),
+				e.AccountPreviousBalance.String(), e.AccountCurrentBalance.String(), strconv.FormatInt(e.AccountVersion, 10),
+			}); err != nil {
+				return err
+			}
+		} else if err := enc.Encode(dto.EntryFromDomain(e)); err != nil {
+			return err
+		}
+
+		n++
+		if n%domain.EntryExportBatchSize == 0 {
+			return flush()
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !started {
+			writeDomainError(w, "failed to export entries", err)
+		}
+		return
+	}
+
+	if !started {
+		start()
+	}
+	_ = flush()
+}
+
 // ListByTransfer lists entries for a transfer.
 func (h *EntryHandler) ListByTransfer(w http.ResponseWriter, r *http.Request) {
 	transferID := chi.URLParam(r, "id")
@@ -54,7 +208,7 @@ func (h *EntryHandler) ListByTransfer(w http.ResponseWriter, r *http.Request) {
 
 	entries, err := h.entryUC.GetEntriesByTransfer(r.Context(), transferID)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to list entries", err.Error())
+		writeDomainError(w, "failed to list entries", err)
 		return
 	}
 
@@ -77,13 +231,13 @@ func (h *EntryHandler) GetHistoricalBalance(w http.ResponseWriter, r *http.Reque
 
 	at, err := time.Parse(time.RFC3339, atStr)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "invalid 'at' format (use RFC3339)", err.Error())
+		writeDomainError(w, "invalid 'at' format (use RFC3339)", apierror.BadField("at", err.Error()))
 		return
 	}
 
 	balance, err := h.entryUC.GetHistoricalBalance(r.Context(), accountID, at)
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to get historical balance", err.Error())
+		writeDomainError(w, "failed to get historical balance", err)
 		return
 	}
 
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func entryExportRequest(target string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "acc-1")
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func exportingEntryRepo(t *testing.T, captured *domain.EntryExportFilter, entries ...*domain.Entry) *mocks.MockEntryRepository {
+	repo := mocks.NewMockEntryRepository(gomock.NewController(t))
+	repo.EXPECT().ExportByAccount(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+			*captured = filter
+			for _, e := range entries {
+				if err := emit(e); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	return repo
+}
+
+func TestEntryHandler_Export_NDJSON(t *testing.T) {
+	var captured domain.EntryExportFilter
+	repo := exportingEntryRepo(t, &captured,
+		&domain.Entry{ID: "e1", AccountID: "acc-1", Amount: decimal.NewFromInt(10), AccountVersion: 1},
+		&domain.Entry{ID: "e2", AccountID: "acc-1", Amount: decimal.NewFromInt(-4), AccountVersion: 2},
+	)
+	h := NewEntryHandler(usecase.NewEntryUseCase(repo))
+
+	rec := httptest.NewRecorder()
+	h.Export(rec, entryExportRequest("/api/v1/accounts/acc-1/entries/export?from=2026-09-01T00:00:00Z"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Fatalf("expected NDJSON content type, got %q", ct)
+	}
+
+	if captured.From == nil || !captured.From.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) || captured.To != nil {
+		t.Fatalf("unexpected filter: %+v", captured)
+	}
+
+	var ids []string
+	scanner := bufio.NewScanner(rec.Body)
+	for scanner.Scan() {
+		var e dto.EntryResponse
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("invalid NDJSON line %q: %v", scanner.Text(), err)
+		}
+		ids = append(ids, e.ID)
+	}
+
+	if len(ids) != 2 || ids[0] != "e1" || ids[1] != "e2" {
+		t.Fatalf("expected e1, e2, got %v", ids)
+	}
+}
+
+// deadlineRecorder is a ResponseRecorder that records the write deadlines
+// set through http.ResponseController.
+type deadlineRecorder struct {
+	*httptest.ResponseRecorder
+	deadlines []time.Time
+}
+
+func (r *deadlineRecorder) SetWriteDeadline(deadline time.Time) error {
+	r.deadlines = append(r.deadlines, deadline)
+	return nil
+}
+
+func TestEntryHandler_Export_ExtendsWriteDeadlinePerBatch(t *testing.T) {
+	entries := make([]*domain.Entry, 2*domain.EntryExportBatchSize+1)
+	for i := range entries {
+		entries[i] = &domain.Entry{ID: "e", AccountID: "acc-1", AccountVersion: int64(i + 1)}
+	}
+	var captured domain.EntryExportFilter
+	h := NewEntryHandler(usecase.NewEntryUseCase(exportingEntryRepo(t, &captured, entries...)))
+
+	before := time.Now()
+	rec := &deadlineRecorder{ResponseRecorder: httptest.NewRecorder()}
+	h.Export(rec, entryExportRequest("/api/v1/accounts/acc-1/entries/export"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	// One deadline up front, then one after each of the two full batches
+	// and the final flush.
+	if len(rec.deadlines) != 4 {
+		t.Fatalf("expected 4 write deadlines, got %d", len(rec.deadlines))
+	}
+	for _, deadline := range rec.deadlines {
+		if deadline.Before(before.Add(entryExportWriteTimeout)) || deadline.After(time.Now().Add(entryExportWriteTimeout)) {
+			t.Fatalf("expected deadlines %s after each batch, got %s", entryExportWriteTimeout, deadline)
+		}
+	}
+}
+
+func TestEntryHandler_Export_CSV(t *testing.T) {
+	var captured domain.EntryExportFilter
+	repo := exportingEntryRepo(t, &captured,
+		&domain.Entry{ID: "e1", AccountID: "acc-1", Amount: decimal.NewFromInt(10), AccountVersion: 1},
+	)
+	h := NewEntryHandler(usecase.NewEntryUseCase(repo))
+
+	rec := httptest.NewRecorder()
+	h.Export(rec, entryExportRequest("/api/v1/accounts/acc-1/entries/export?format=csv"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/csv" {
+		t.Fatalf("expected text/csv, got %q", ct)
+	}
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+
+	if len(records) != 2 || records[0][0] != "id" || records[1][0] != "e1" || records[1][4] != "10" {
+		t.Fatalf("unexpected CSV: %v", records)
+	}
+}
+
+func TestEntryHandler_Export_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		repo   func(t *testing.T) usecase.EntryRepository
+		status int
+	}{
+		{
+			name:   "unknown format",
+			target: "/api/v1/accounts/acc-1/entries/export?format=xml",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "malformed from",
+			target: "/api/v1/accounts/acc-1/entries/export?from=yesterday",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "empty range",
+			target: "/api/v1/accounts/acc-1/entries/export?from=2026-09-01T00:00:00Z&to=2026-09-01T00:00:00Z",
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "unknown account",
+			target: "/api/v1/accounts/acc-1/entries/export",
+			repo: func(t *testing.T) usecase.EntryRepository {
+				repo := mocks.NewMockEntryRepository(gomock.NewController(t))
+				repo.EXPECT().ExportByAccount(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.ErrAccountNotFound)
+				return repo
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var repo usecase.EntryRepository = mocks.NewMockEntryRepository(gomock.NewController(t))
+			if tt.repo != nil {
+				repo = tt.repo(t)
+			}
+			h := NewEntryHandler(usecase.NewEntryUseCase(repo))
+
+			rec := httptest.NewRecorder()
+			h.Export(rec, entryExportRequest(tt.target))
+
+			if rec.Code != tt.status {
+				t.Fatalf("expected %d, got %d: %s", tt.status, rec.Code, rec.Body.String())
+			}
+
+			if !strings.Contains(rec.Header().Get("Content-Type"), "json") {
+				t.Fatalf("expected a JSON error body, got %q", rec.Header().Get("Content-Type"))
+			}
+		})
+	}
+}
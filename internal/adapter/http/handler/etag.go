@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// errMultipleIfMatch rejects an If-Match listing several entity tags; a
+// mutation is conditioned on the one version the client last read.
+var errMultipleIfMatch = errors.New("only one entity tag may be named in If-Match")
+
+// accountETag is the account's version, which every balance change bumps.
+func accountETag(a *domain.Account) string {
+	return `"` + strconv.FormatInt(a.Version, 10) + `"`
+}
+
+// transferETag is the transfer's ID: transfers never change once created,
+// reversals being transfers of their own.
+func transferETag(t *domain.Transfer) string {
+	return `"` + t.ID + `"`
+}
+
+// holdETag is the hold's status: a hold changes only when it is voided or
+// captured, and then never again.
+func holdETag(h *domain.Hold) string {
+	return `"` + string(h.Status) + `"`
+}
+
+// writeJSONWithETag writes data with an ETag, or a bodiless 304 Not
+// Modified if the request's If-None-Match already names it.
+func writeJSONWithETag(w http.ResponseWriter, r *http.Request, etag string, data any) {
+	w.Header().Set("ETag", etag)
+
+	if inm := r.Header.Get("If-None-Match"); inm != "" && etagListMatches(inm, etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, data)
+}
+
+// etagListMatches reports whether the comma-separated entity tags in list
+// include etag, comparing weakly as If-None-Match does.
+func etagListMatches(list, etag string) bool {
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ifMatchVersion reads the account version a mutation is conditioned on
+// from If-Match: nil when the header is absent or "*", which any existing
+// account matches. A tag that is not an account ETag can never match, so it
+// yields domain.ErrVersionMismatch; weak tags too, as If-Match compares
+// strongly.
+func ifMatchVersion(r *http.Request) (*int64, error) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+	if strings.Contains(header, ",") {
+		return nil, errMultipleIfMatch
+	}
+
+	unquoted, ok := strings.CutPrefix(header, `"`)
+	if !ok {
+		return nil, domain.ErrVersionMismatch
+	}
+	unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	if !ok {
+		return nil, domain.ErrVersionMismatch
+	}
+
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return nil, domain.ErrVersionMismatch
+	}
+
+	return &version, nil
+}
+
+// hasIfMatch reports whether the request names an entity tag in If-Match,
+// so a handler need only load the resource to compare when it does.
+func hasIfMatch(r *http.Request) bool {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	return header != "" && header != "*"
+}
+
+// checkIfMatch compares If-Match strongly with etag, the resource's current
+// ETag. An absent header or "*" matches; any other tag that differs yields
+// domain.ErrVersionMismatch.
+func checkIfMatch(r *http.Request, etag string) error {
+	if !hasIfMatch(r) {
+		return nil
+	}
+
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if strings.Contains(header, ",") {
+		return errMultipleIfMatch
+	}
+	if header != etag {
+		return domain.ErrVersionMismatch
+	}
+
+	return nil
+}
+
+// writeIfMatchError answers a mutation whose If-Match ifMatchVersion or
+// checkIfMatch rejected.
+func writeIfMatchError(w http.ResponseWriter, message string, err error) {
+	if errors.Is(err, errMultipleIfMatch) {
+		writeError(w, http.StatusBadRequest, message, err.Error())
+		return
+	}
+
+	writeDomainError(w, message, err)
+}
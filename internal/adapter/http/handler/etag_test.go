@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		header  string
+		want    *int64
+		wantErr error
+	}{
+		{name: "absent"},
+		{name: "any", header: "*"},
+		{name: "version", header: `"42"`, want: int64Ptr(42)},
+		{name: "weak", header: `W/"42"`, wantErr: domain.ErrVersionMismatch},
+		{name: "unquoted", header: "42", wantErr: domain.ErrVersionMismatch},
+		{name: "not a version", header: `"tx-1"`, wantErr: domain.ErrVersionMismatch},
+		{name: "list", header: `"1", "2"`, wantErr: errMultipleIfMatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/transfers", http.NoBody)
+			if tt.header != "" {
+				req.Header.Set("If-Match", tt.header)
+			}
+
+			got, err := ifMatchVersion(req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Fatalf("expected version %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWriteJSONWithETag(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		wantStatus  int
+	}{
+		{name: "no condition", wantStatus: http.StatusOK},
+		{name: "stale", ifNoneMatch: `"6"`, wantStatus: http.StatusOK},
+		{name: "current", ifNoneMatch: `"6", "7"`, wantStatus: http.StatusNotModified},
+		{name: "weak current", ifNoneMatch: `W/"7"`, wantStatus: http.StatusNotModified},
+		{name: "any", ifNoneMatch: "*", wantStatus: http.StatusNotModified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/accounts/acc-1", http.NoBody)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			rec := httptest.NewRecorder()
+
+			writeJSONWithETag(rec, req, `"7"`, map[string]string{"id": "acc-1"})
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if got := rec.Header().Get("ETag"); got != `"7"` {
+				t.Fatalf("expected ETag \"7\", got %q", got)
+			}
+			if tt.wantStatus == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Fatalf("expected no body on 304, got %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+func int64Ptr(v int64) *int64 { return &v }
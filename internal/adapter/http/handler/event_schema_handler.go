@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+)
+
+// EventSchemaHandler publishes the JSON Schemas of event payloads, so
+// consumers can validate events and generate types from them.
+type EventSchemaHandler struct {
+	cloudEvents domain.CloudEventOptions
+}
+
+// NewEventSchemaHandler creates a new EventSchemaHandler. opts sets each
+// schema's $id to the dataschema URL events carry.
+func NewEventSchemaHandler(opts domain.CloudEventOptions) *EventSchemaHandler {
+	return &EventSchemaHandler{cloudEvents: opts}
+}
+
+// List handles GET /events/schemas.
+func (h *EventSchemaHandler) List(w http.ResponseWriter, r *http.Request) {
+	schemas := domain.EventSchemas()
+
+	resp := dto.ListEventSchemasResponse{Schemas: make([]*dto.EventSchemaResponse, 0, len(schemas))}
+	for _, es := range schemas {
+		resp.Schemas = append(resp.Schemas, dto.EventSchemaFromDomain(es, h.cloudEvents))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Get handles GET /events/schemas/{type}/{version}, the dataschema URL of
+// an event. It responds with the bare JSON Schema document.
+func (h *EventSchemaHandler) Get(w http.ResponseWriter, r *http.Request) {
+	version, err := strconv.ParseInt(chi.URLParam(r, "version"), 10, 32)
+	if err != nil {
+		writeDomainError(w, "invalid schema version", apierror.BadField("version", err.Error()))
+		return
+	}
+
+	es, err := domain.LookupEventSchema(chi.URLParam(r, "type"), int32(version))
+	if err != nil {
+		writeDomainError(w, "event schema not found", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(dto.EventSchemaFromDomain(es, h.cloudEvents).Schema)
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+)
+
+func newEventSchemaRouter() http.Handler {
+	h := NewEventSchemaHandler(domain.CloudEventOptions{SchemaBaseURL: "https://ledger.example.com/api/v1/events/schemas"})
+
+	r := chi.NewRouter()
+	r.Get("/events/schemas", h.List)
+	r.Get("/events/schemas/{type}/{version}", h.Get)
+
+	return r
+}
+
+func TestEventSchemaHandler_List(t *testing.T) {
+	rec := httptest.NewRecorder()
+	newEventSchemaRouter().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events/schemas", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var resp dto.ListEventSchemasResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+
+	if len(resp.Schemas) != len(domain.EventSchemas()) || resp.Schemas[0].SchemaURL != resp.Schemas[0].Schema.ID {
+		t.Fatalf("unexpected schemas: %s", rec.Body)
+	}
+}
+
+func TestEventSchemaHandler_Get(t *testing.T) {
+	router := newEventSchemaRouter()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/events/schemas/hold.created/1", nil))
+
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/schema+json" {
+		t.Fatalf("unexpected response: %d %s", rec.Code, rec.Header().Get("Content-Type"))
+	}
+
+	var schema map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &schema); err != nil {
+		t.Fatalf("invalid schema: %v", err)
+	}
+
+	if schema["$id"] != "https://ledger.example.com/api/v1/events/schemas/hold.created/1" || schema["title"] != "HoldCreatedEvent" {
+		t.Fatalf("unexpected schema: %s", rec.Body)
+	}
+
+	for target, want := range map[string]int{
+		"/events/schemas/hold.created/2": http.StatusNotFound,
+		"/events/schemas/hold.created/x": http.StatusBadRequest,
+	} {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+		if rec.Code != want {
+			t.Fatalf("%s: expected %d, got %d", target, want, rec.Code)
+		}
+	}
+}
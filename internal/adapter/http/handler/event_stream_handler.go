@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// eventStreamHeartbeat is how often an idle stream sends a comment line, so
+// proxies and clients don't time the connection out.
+const eventStreamHeartbeat = 15 * time.Second
+
+// EventStreamService defines the behavior needed by EventStreamHandler.
+type EventStreamService interface {
+	Subscribe(ctx context.Context, filter domain.EventStreamFilter, cursor domain.EventStreamCursor) (*usecase.EventSubscription, error)
+}
+
+// EventStreamHandler serves the live event stream as Server-Sent Events.
+type EventStreamHandler struct {
+	streamUC EventStreamService
+}
+
+// NewEventStreamHandler creates a new EventStreamHandler.
+func NewEventStreamHandler(streamUC EventStreamService) *EventStreamHandler {
+	return &EventStreamHandler{streamUC: streamUC}
+}
+
+// Stream handles GET /events/stream?account_id=&aggregate_type=&event_type=&after=&after_sequence=.
+// event_type may repeat or be comma-separated. The stream resumes after the
+// event named by after, or after the account's event with after_sequence;
+// a Last-Event-ID header, sent by browsers on reconnect, overrides both.
+// Each SSE message carries the event ID as its id and the event type as its
+// event.
+// When the server ends the stream it sends a final "error" message; clients
+// should reconnect from the last ID they received.
+func (h *EventStreamHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := domain.EventStreamFilter{
+		AccountID:     query.Get("account_id"),
+		AggregateType: query.Get("aggregate_type"),
+	}
+	for _, v := range query["event_type"] {
+		for t := range strings.SplitSeq(v, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				filter.EventTypes = append(filter.EventTypes, t)
+			}
+		}
+	}
+
+	cursor := domain.EventStreamCursor{EventID: query.Get("after")}
+	if v := query.Get("after_sequence"); v != "" {
+		seq, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeDomainError(w, "invalid after_sequence", apierror.BadField("after_sequence", err.Error()))
+			return
+		}
+		cursor.AggregateSequence = &seq
+	}
+	// EventSource reconnects to the original URL with Last-Event-ID, which
+	// is then more recent than any cursor in the query.
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		cursor = domain.EventStreamCursor{EventID: lastEventID}
+	}
+
+	sub, err := h.streamUC.Subscribe(r.Context(), filter, cursor)
+	if err != nil {
+		writeDomainError(w, "failed to subscribe to events", err)
+		return
+	}
+
+	// The stream outlives the server's write timeout by design.
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				if err := sub.Err(); err != nil && r.Context().Err() == nil {
+					problem := apierror.ProblemFor(err)
+					problem.Error = problem.Detail
+					data, _ := json.Marshal(problem)
+					_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+					_ = rc.Flush()
+				}
+				return
+			}
+
+			data, err := json.Marshal(dto.StreamEventFromDomain(event))
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.EventType, data); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
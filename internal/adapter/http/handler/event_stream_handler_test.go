@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func TestEventStreamHandler_Stream_SendsEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	publishedAt := time.Now().UTC()
+	event := &domain.OutboxEvent{
+		ID:                "evt-1",
+		AggregateType:     domain.AggregateTypeAccount,
+		AggregateID:       "acc-1",
+		EventType:         domain.EventTypeAccountCreated,
+		AggregateSequence: 1,
+		Payload:           map[string]any{"account_id": "acc-1"},
+		Published:         true,
+		PublishedAt:       &publishedAt,
+	}
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	gomock.InOrder(
+		outboxRepo.EXPECT().ListPublishedAfter(gomock.Any(), domain.EventStreamFilter{}, gomock.Any(), gomock.Any()).Return(nil, nil),
+		outboxRepo.EXPECT().ListPublishedAfter(gomock.Any(), domain.EventStreamFilter{}, gomock.Any(), gomock.Any()).Return([]*domain.OutboxEvent{event}, nil),
+	)
+
+	streamUC := usecase.NewEventStreamUseCase(outboxRepo)
+	if err := streamUC.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(NewEventStreamHandler(streamUC).Stream))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?account_id=acc-1&event_type=account.*", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		t.Fatalf("unexpected response: %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+
+	// Headers are flushed once the subscription is registered.
+	if err := streamUC.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() && scanner.Text() != "" {
+		lines = append(lines, scanner.Text())
+	}
+
+	if len(lines) != 3 || lines[0] != "id: evt-1" || lines[1] != "event: account.created" || !strings.Contains(lines[2], `"aggregate_sequence":1`) {
+		t.Fatalf("unexpected SSE message: %q", lines)
+	}
+}
+
+func TestEventStreamHandler_Stream_BadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := NewEventStreamHandler(usecase.NewEventStreamUseCase(mocks.NewMockOutboxRepository(ctrl)))
+
+	for _, target := range []string{
+		"/events/stream?after_sequence=abc",
+		"/events/stream?after_sequence=3", // needs account_id
+	} {
+		rec := httptest.NewRecorder()
+		h.Stream(rec, httptest.NewRequest(http.MethodGet, target, nil))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", target, rec.Code)
+		}
+	}
+}
@@ -2,12 +2,10 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"strconv"
 
-	"github.com/iho/goledger/internal/adapter/http/dto"
-	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/adapter/apierror"
 )
 
 // writeJSON writes a JSON response.
@@ -17,35 +15,27 @@ func writeJSON(w http.ResponseWriter, status int, data any) {
 	_ = json.NewEncoder(w).Encode(data) // Error handled by http.ResponseWriter
 }
 
-// writeError writes an error response.
+// writeError writes a problem+json response for an error the handler
+// detected itself, such as an unparsable body, which no domain error
+// describes. Its code is the generic one for status; message names what
+// failed. The detail of a server error is not shown.
 func writeError(w http.ResponseWriter, status int, message, detail string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(dto.ErrorResponse{
-		Error: message,
-	}) // Error handled by http.ResponseWriter
+	spec := apierror.ForStatus(status)
+	if status >= http.StatusInternalServerError {
+		detail = spec.Detail
+	}
+
+	problem := apierror.NewProblem(spec, detail)
+	problem.Error = message
+	problem.Write(w)
 }
 
-// mapDomainError maps domain errors to HTTP status codes.
-func mapDomainError(err error) int {
-	switch {
-	case errors.Is(err, domain.ErrAccountNotFound):
-		return http.StatusNotFound
-	case errors.Is(err, domain.ErrTransferNotFound):
-		return http.StatusNotFound
-	case errors.Is(err, domain.ErrNegativeBalanceNotAllowed):
-		return http.StatusBadRequest
-	case errors.Is(err, domain.ErrPositiveBalanceNotAllowed):
-		return http.StatusBadRequest
-	case errors.Is(err, domain.ErrSameAccount):
-		return http.StatusBadRequest
-	case errors.Is(err, domain.ErrInvalidAmount):
-		return http.StatusBadRequest
-	case errors.Is(err, domain.ErrCurrencyMismatch):
-		return http.StatusBadRequest
-	default:
-		return http.StatusInternalServerError
-	}
+// writeDomainError writes the problem+json response apierror's table
+// assigns err; message names the operation that failed.
+func writeDomainError(w http.ResponseWriter, message string, err error) {
+	problem := apierror.ProblemFor(err)
+	problem.Error = message
+	problem.Write(w)
 }
 
 // parseIntQuery parses an integer query parameter with a default value.
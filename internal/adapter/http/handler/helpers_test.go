@@ -3,11 +3,14 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/http/dto"
 	"github.com/iho/goledger/internal/domain"
 )
@@ -29,30 +32,76 @@ func TestParseIntQuery(t *testing.T) {
 	}
 }
 
-func TestMapDomainError(t *testing.T) {
+func TestWriteDomainError(t *testing.T) {
 	tests := []struct {
 		name     string
 		err      error
 		expected int
+		code     string
 	}{
-		{"account not found", domain.ErrAccountNotFound, http.StatusNotFound},
-		{"transfer not found", domain.ErrTransferNotFound, http.StatusNotFound},
-		{"negative balance", domain.ErrNegativeBalanceNotAllowed, http.StatusBadRequest},
-		{"invalid amount", domain.ErrInvalidAmount, http.StatusBadRequest},
-		{"currency mismatch", domain.ErrCurrencyMismatch, http.StatusBadRequest},
-		{"unknown error", errors.New("boom"), http.StatusInternalServerError},
+		{"account not found", domain.ErrAccountNotFound, http.StatusNotFound, "ACCOUNT_NOT_FOUND"},
+		{"transfer not found", domain.ErrTransferNotFound, http.StatusNotFound, "TRANSFER_NOT_FOUND"},
+		{"negative balance", domain.ErrNegativeBalanceNotAllowed, http.StatusBadRequest, "NEGATIVE_BALANCE_NOT_ALLOWED"},
+		{"invalid amount", domain.ErrInvalidAmount, http.StatusBadRequest, "INVALID_AMOUNT"},
+		{"currency mismatch", domain.ErrCurrencyMismatch, http.StatusBadRequest, "CURRENCY_MISMATCH"},
+		{"invalid dead-letter filter", fmt.Errorf("%w: empty", domain.ErrInvalidDeadLetterFilter), http.StatusBadRequest, "INVALID_DEAD_LETTER_FILTER"},
+		{"hold not found", domain.ErrHoldNotFound, http.StatusNotFound, "HOLD_NOT_FOUND"},
+		{"invalid hold filter", domain.ErrInvalidHoldFilter, http.StatusBadRequest, "INVALID_HOLD_FILTER"},
+		{"invalid transfer search", fmt.Errorf("%w: malformed cursor", domain.ErrInvalidTransferSearch), http.StatusBadRequest, "INVALID_TRANSFER_SEARCH"},
+		{"invalid cursor", domain.ErrInvalidCursor, http.StatusBadRequest, "INVALID_CURSOR"},
+		{"invalid entry export", domain.ErrInvalidEntryExport, http.StatusBadRequest, "INVALID_ENTRY_EXPORT"},
+		{"user not found", domain.ErrUserNotFound, http.StatusNotFound, "USER_NOT_FOUND"},
+		{"user already exists", domain.ErrUserAlreadyExists, http.StatusConflict, "USER_ALREADY_EXISTS"},
+		{"weak password", fmt.Errorf("%w: too short", domain.ErrPasswordTooWeak), http.StatusBadRequest, "PASSWORD_TOO_WEAK"},
+		{"last admin", domain.ErrLastAdmin, http.StatusConflict, "LAST_ADMIN"},
+		{"version mismatch", domain.ErrVersionMismatch, http.StatusPreconditionFailed, "VERSION_MISMATCH"},
+		{"unknown error", errors.New("boom"), http.StatusInternalServerError, "INTERNAL"},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			if got := mapDomainError(tt.err); got != tt.expected {
-				t.Fatalf("expected %d, got %d", tt.expected, got)
+			rr := httptest.NewRecorder()
+			writeDomainError(rr, "failed", tt.err)
+
+			if rr.Code != tt.expected {
+				t.Fatalf("expected %d, got %d", tt.expected, rr.Code)
+			}
+
+			var problem apierror.Problem
+			if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to decode problem: %v", err)
+			}
+			if problem.Code != tt.code || problem.Status != tt.expected || problem.Error != "failed" {
+				t.Fatalf("unexpected problem %+v", problem)
 			}
 		})
 	}
 }
 
+func TestWriteDomainError_HidesInternalDetail(t *testing.T) {
+	rr := httptest.NewRecorder()
+	writeDomainError(rr, "failed to list accounts", errors.New("pq: password authentication failed"))
+
+	if strings.Contains(rr.Body.String(), "pq:") {
+		t.Fatalf("internal error leaked: %s", rr.Body.String())
+	}
+}
+
+func TestWriteDomainError_InvalidParams(t *testing.T) {
+	rr := httptest.NewRecorder()
+	writeDomainError(rr, "invalid amount", apierror.BadField("amount", "can't convert abc to decimal"))
+
+	var problem apierror.Problem
+	if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("failed to decode problem: %v", err)
+	}
+
+	if problem.Code != "INVALID_REQUEST" || len(problem.InvalidParams) != 1 || problem.InvalidParams[0].Name != "amount" {
+		t.Fatalf("unexpected problem %+v", problem)
+	}
+}
+
 func TestWriteJSON(t *testing.T) {
 	rr := httptest.NewRecorder()
 	payload := map[string]string{"status": "ok"}
@@ -94,4 +143,17 @@ func TestWriteError(t *testing.T) {
 	if resp.Error != "bad request" {
 		t.Fatalf("expected error message to propagate, got %+v", resp)
 	}
+
+	if ct := rr.Header().Get("Content-Type"); ct != apierror.ContentType {
+		t.Fatalf("expected content-type %s, got %s", apierror.ContentType, ct)
+	}
+
+	var problem apierror.Problem
+	if err := json.Unmarshal(rr.Body.Bytes(), &problem); err != nil {
+		t.Fatalf("failed to decode problem: %v", err)
+	}
+
+	if problem.Code != "INVALID_REQUEST" || problem.Status != http.StatusBadRequest || problem.Detail != "detail" {
+		t.Fatalf("unexpected problem %+v", problem)
+	}
 }
@@ -1,21 +1,35 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/shopspring/decimal"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/usecase"
 )
 
+// HoldService defines the behavior needed by HoldHandler.
+type HoldService interface {
+	HoldFunds(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error)
+	HoldFundsAtVersion(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error)
+	VoidHold(ctx context.Context, holdID string) error
+	CaptureHold(ctx context.Context, holdID, toAccountID string) (*domain.Transfer, error)
+	GetHold(ctx context.Context, id string) (*domain.Hold, error)
+	ListHoldsByAccountCursor(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error)
+}
+
 type HoldHandler struct {
-	holdUC *usecase.HoldUseCase
+	holdUC HoldService
 }
 
-func NewHoldHandler(holdUC *usecase.HoldUseCase) *HoldHandler {
+func NewHoldHandler(holdUC HoldService) *HoldHandler {
 	return &HoldHandler{holdUC: holdUC}
 }
 
@@ -28,13 +42,25 @@ func (h *HoldHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	amount, err := decimal.NewFromString(req.Amount)
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "invalid amount", err.Error())
+		writeDomainError(w, "invalid amount", apierror.BadField("amount", err.Error()))
 		return
 	}
 
-	hold, err := h.holdUC.HoldFunds(r.Context(), req.AccountID, amount)
+	// If-Match conditions the hold on the account's ETag.
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		writeIfMatchError(w, "failed to create hold", err)
+		return
+	}
+
+	var hold *domain.Hold
+	if expectedVersion != nil {
+		hold, err = h.holdUC.HoldFundsAtVersion(r.Context(), req.AccountID, amount, *expectedVersion)
+	} else {
+		hold, err = h.holdUC.HoldFunds(r.Context(), req.AccountID, amount)
+	}
 	if err != nil {
-		writeError(w, mapDomainError(err), "failed to create hold", err.Error())
+		writeDomainError(w, "failed to create hold", err)
 		return
 	}
 
@@ -48,8 +74,13 @@ func (h *HoldHandler) Void(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.checkIfMatch(r, id); err != nil {
+		writeIfMatchError(w, "failed to void hold", err)
+		return
+	}
+
 	if err := h.holdUC.VoidHold(r.Context(), id); err != nil {
-		writeError(w, mapDomainError(err), "failed to void hold", err.Error())
+		writeDomainError(w, "failed to void hold", err)
 		return
 	}
 
@@ -69,11 +100,105 @@ func (h *HoldHandler) Capture(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if err := h.checkIfMatch(r, id); err != nil {
+		writeIfMatchError(w, "failed to capture hold", err)
+		return
+	}
+
 	transfer, err := h.holdUC.CaptureHold(r.Context(), id, req.ToAccountID)
 	if err != nil {
-		writeError(w, mapDomainError(err), "failed to capture hold", err.Error())
+		writeDomainError(w, "failed to capture hold", err)
 		return
 	}
 
 	writeJSON(w, http.StatusOK, dto.TransferFromDomain(transfer))
 }
+
+// checkIfMatch compares If-Match with the ETag of hold id, its status. A
+// hold settles once, and the use case refuses to settle it again, so a
+// hold settled after the check still fails rather than changing twice.
+func (h *HoldHandler) checkIfMatch(r *http.Request, id string) error {
+	if !hasIfMatch(r) {
+		return nil
+	}
+
+	hold, err := h.holdUC.GetHold(r.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	return checkIfMatch(r, holdETag(hold))
+}
+
+// Get handles GET /holds/{id}.
+func (h *HoldHandler) Get(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "missing hold id", "")
+		return
+	}
+
+	hold, err := h.holdUC.GetHold(r.Context(), id)
+	if err != nil {
+		writeDomainError(w, "failed to get hold", err)
+		return
+	}
+
+	writeJSONWithETag(w, r, holdETag(hold), dto.HoldFromDomain(hold))
+}
+
+// ListByAccount handles GET /accounts/{id}/holds. Query parameters: status,
+// expires_after, expires_before (RFC3339), cursor and limit. Holds are
+// listed newest first; pass next_cursor back as cursor for the next page.
+func (h *HoldHandler) ListByAccount(w http.ResponseWriter, r *http.Request) {
+	accountID := chi.URLParam(r, "id")
+	if accountID == "" {
+		writeError(w, http.StatusBadRequest, "missing account ID", "")
+		return
+	}
+
+	filter, err := holdFilterFromQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid expiry filter (use RFC3339)", err.Error())
+		return
+	}
+
+	result, err := h.holdUC.ListHoldsByAccountCursor(r.Context(), usecase.ListHoldsByAccountCursorInput{
+		AccountID: accountID,
+		Filter:    filter,
+		Cursor:    r.URL.Query().Get("cursor"),
+		Limit:     parseIntQuery(r, "limit", 20),
+	})
+	if err != nil {
+		writeDomainError(w, "failed to list holds", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListHoldsResponse{
+		Holds:      dto.HoldsFromDomain(result.Holds),
+		NextCursor: result.NextCursor,
+	})
+}
+
+func holdFilterFromQuery(r *http.Request) (domain.HoldFilter, error) {
+	q := r.URL.Query()
+	filter := domain.HoldFilter{Status: domain.HoldStatus(q.Get("status"))}
+
+	if v := q.Get("expires_after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, err
+		}
+		filter.ExpiresAfter = &t
+	}
+
+	if v := q.Get("expires_before"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, err
+		}
+		filter.ExpiresBefore = &t
+	}
+
+	return filter, nil
+}
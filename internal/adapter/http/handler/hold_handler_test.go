@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type holdServiceStub struct {
+	getFn       func(ctx context.Context, id string) (*domain.Hold, error)
+	listFn      func(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error)
+	atVersionFn func(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error)
+	voided      []string
+	captured    []string
+}
+
+func (s *holdServiceStub) HoldFunds(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error) {
+	return nil, nil
+}
+
+func (s *holdServiceStub) HoldFundsAtVersion(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error) {
+	return s.atVersionFn(ctx, accountID, amount, expectedVersion)
+}
+
+func (s *holdServiceStub) VoidHold(ctx context.Context, holdID string) error {
+	s.voided = append(s.voided, holdID)
+	return nil
+}
+
+func (s *holdServiceStub) CaptureHold(ctx context.Context, holdID, toAccountID string) (*domain.Transfer, error) {
+	s.captured = append(s.captured, holdID)
+	return &domain.Transfer{ID: "tx-1", ToAccountID: toAccountID}, nil
+}
+
+func (s *holdServiceStub) GetHold(ctx context.Context, id string) (*domain.Hold, error) {
+	return s.getFn(ctx, id)
+}
+
+func (s *holdServiceStub) ListHoldsByAccountCursor(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error) {
+	return s.listFn(ctx, input)
+}
+
+func TestHoldHandler_Create_IfMatch(t *testing.T) {
+	var gotVersion int64
+	handler := NewHoldHandler(&holdServiceStub{
+		atVersionFn: func(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error) {
+			gotVersion = expectedVersion
+			return nil, domain.ErrVersionMismatch
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/holds", strings.NewReader(`{"account_id":"acc-1","amount":"10"}`))
+	req.Header.Set("If-Match", `"3"`)
+	rec := httptest.NewRecorder()
+
+	handler.Create(rec, req)
+
+	if gotVersion != 3 {
+		t.Fatalf("expected the hold to be conditioned on version 3, got %d", gotVersion)
+	}
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Fatalf("expected 412, got %d", rec.Code)
+	}
+}
+
+func TestHoldHandler_Settle_IfMatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		ifMatch    string
+		wantStatus int
+	}{
+		{name: "absent", wantStatus: http.StatusOK},
+		{name: "any", ifMatch: "*", wantStatus: http.StatusOK},
+		{name: "current status", ifMatch: `"active"`, wantStatus: http.StatusOK},
+		{name: "stale status", ifMatch: `"captured"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "weak", ifMatch: `W/"active"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "list", ifMatch: `"active", "voided"`, wantStatus: http.StatusBadRequest},
+	}
+
+	settle := map[string]func(h *HoldHandler, w http.ResponseWriter, r *http.Request){
+		"void":    (*HoldHandler).Void,
+		"capture": (*HoldHandler).Capture,
+	}
+
+	for action, call := range settle {
+		for _, tt := range tests {
+			t.Run(action+"/"+tt.name, func(t *testing.T) {
+				stub := &holdServiceStub{
+					getFn: func(ctx context.Context, id string) (*domain.Hold, error) {
+						return &domain.Hold{ID: id, Status: domain.HoldStatusActive}, nil
+					},
+				}
+				handler := NewHoldHandler(stub)
+
+				req := httptest.NewRequest(http.MethodPost, "/holds/hold-1/"+action, strings.NewReader(`{"to_account_id":"acc-2"}`))
+				if tt.ifMatch != "" {
+					req.Header.Set("If-Match", tt.ifMatch)
+				}
+				req = setChiURLParam(req, "id", "hold-1")
+				rec := httptest.NewRecorder()
+
+				call(handler, rec, req)
+
+				settled := len(stub.voided) + len(stub.captured)
+				if tt.wantStatus != http.StatusOK {
+					if rec.Code != tt.wantStatus {
+						t.Fatalf("expected %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+					}
+					if settled != 0 {
+						t.Fatal("expected the hold not to be settled")
+					}
+					return
+				}
+				if rec.Code >= http.StatusBadRequest || settled != 1 {
+					t.Fatalf("expected the hold to be settled, got %d: %s", rec.Code, rec.Body.String())
+				}
+			})
+		}
+	}
+}
+
+func TestHoldHandler_Void_IfMatchUnknownHold(t *testing.T) {
+	handler := NewHoldHandler(&holdServiceStub{
+		getFn: func(ctx context.Context, id string) (*domain.Hold, error) {
+			return nil, domain.ErrHoldNotFound
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/holds/hold-1/void", http.NoBody)
+	req.Header.Set("If-Match", `"active"`)
+	req = setChiURLParam(req, "id", "hold-1")
+	rec := httptest.NewRecorder()
+
+	handler.Void(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestHoldHandler_Get_ETag(t *testing.T) {
+	handler := NewHoldHandler(&holdServiceStub{
+		getFn: func(ctx context.Context, id string) (*domain.Hold, error) {
+			return &domain.Hold{ID: id, Status: domain.HoldStatusCaptured}, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/holds/hold-1", http.NoBody)
+	req.Header.Set("If-None-Match", `"active"`)
+	req = setChiURLParam(req, "id", "hold-1")
+	rec := httptest.NewRecorder()
+
+	handler.Get(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for a hold that changed, got %d", rec.Code)
+	}
+	if etag := rec.Header().Get("ETag"); etag != `"captured"` {
+		t.Fatalf("expected ETag \"captured\", got %q", etag)
+	}
+}
+
+func TestHoldHandler_Get_NotFound(t *testing.T) {
+	handler := NewHoldHandler(&holdServiceStub{
+		getFn: func(ctx context.Context, id string) (*domain.Hold, error) {
+			return nil, domain.ErrHoldNotFound
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/holds/hold-1", http.NoBody)
+	req = setChiURLParam(req, "id", "hold-1")
+	rec := httptest.NewRecorder()
+
+	handler.Get(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestHoldHandler_ListByAccount(t *testing.T) {
+	var captured usecase.ListHoldsByAccountCursorInput
+	handler := NewHoldHandler(&holdServiceStub{
+		listFn: func(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error) {
+			captured = input
+			return &usecase.ListHoldsByAccountCursorResult{
+				Holds:      []*domain.Hold{{ID: "hold-2", AccountID: "acc-1"}},
+				NextCursor: "hold-2",
+			}, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/accounts/acc-1/holds?status=active&expires_before=2026-10-01T00:00:00Z&cursor=hold-3&limit=1", http.NoBody)
+	req = setChiURLParam(req, "id", "acc-1")
+	rec := httptest.NewRecorder()
+
+	handler.ListByAccount(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	before := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	if captured.AccountID != "acc-1" || captured.Cursor != "hold-3" || captured.Limit != 1 ||
+		captured.Filter.Status != domain.HoldStatusActive || captured.Filter.ExpiresBefore == nil ||
+		!captured.Filter.ExpiresBefore.Equal(before) || captured.Filter.ExpiresAfter != nil {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+
+	var resp dto.ListHoldsResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(resp.Holds) != 1 || resp.NextCursor != "hold-2" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestHoldHandler_ListByAccount_InvalidExpiry(t *testing.T) {
+	handler := NewHoldHandler(&holdServiceStub{
+		listFn: func(ctx context.Context, input usecase.ListHoldsByAccountCursorInput) (*usecase.ListHoldsByAccountCursorResult, error) {
+			t.Fatal("ListHoldsByAccountCursor should not be called for an invalid filter")
+			return nil, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/accounts/acc-1/holds?expires_after=tomorrow", http.NoBody)
+	req = setChiURLParam(req, "id", "acc-1")
+	rec := httptest.NewRecorder()
+
+	handler.ListByAccount(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
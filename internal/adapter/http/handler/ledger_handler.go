@@ -28,7 +28,7 @@ func (h *LedgerHandler) CheckConsistency(w http.ResponseWriter, r *http.Request)
 			})
 			return
 		}
-		writeError(w, http.StatusInternalServerError, "failed to check consistency", err.Error())
+		writeDomainError(w, "failed to check consistency", err)
 		return
 	}
 
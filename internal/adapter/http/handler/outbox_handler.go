@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// OutboxHandler serves admin-only outbox dead-letter operations.
+type OutboxHandler struct {
+	outboxUC *usecase.OutboxUseCase
+}
+
+// NewOutboxHandler creates a new OutboxHandler.
+func NewOutboxHandler(outboxUC *usecase.OutboxUseCase) *OutboxHandler {
+	return &OutboxHandler{outboxUC: outboxUC}
+}
+
+// ListDeadLetters handles GET /outbox/dead-letters, most recently
+// dead-lettered first. Prefer the "cursor" query param (keyset pagination);
+// "offset" is kept for backward compatibility.
+func (h *OutboxHandler) ListDeadLetters(w http.ResponseWriter, r *http.Request) {
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" || r.URL.Query().Has("cursor") {
+		result, err := h.outboxUC.ListDeadLetteredCursor(r.Context(), cursor, parseIntQuery(r, "limit", 50))
+		if err != nil {
+			writeDomainError(w, "failed to list dead letters", err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, dto.ListOutboxEventsResponse{
+			NextCursor: result.NextCursor,
+			Events:     dto.OutboxEventsFromDomain(result.Events),
+		})
+
+		return
+	}
+
+	events, err := h.outboxUC.ListDeadLettered(r.Context(), parseIntQuery(r, "limit", 50), parseIntQuery(r, "offset", 0))
+	if err != nil {
+		writeDomainError(w, "failed to list dead letters", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListOutboxEventsResponse{Events: dto.OutboxEventsFromDomain(events)})
+}
+
+// ReplayDeadLetters handles POST /outbox/dead-letters/replay, requeueing
+// the matching events for the publisher.
+func (h *OutboxHandler) ReplayDeadLetters(w http.ResponseWriter, r *http.Request) {
+	var req dto.DeadLetterFilterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	events, err := h.outboxUC.ReplayDeadLettered(r.Context(), req.ToDomain())
+	if err != nil {
+		writeDomainError(w, "failed to replay dead letters", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.DeadLetterActionFromDomain(events))
+}
+
+// ArchiveDeadLetters handles POST /outbox/dead-letters/archive, moving the
+// matching events out of the outbox with a reason.
+func (h *OutboxHandler) ArchiveDeadLetters(w http.ResponseWriter, r *http.Request) {
+	var req dto.ArchiveDeadLettersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	events, err := h.outboxUC.ArchiveDeadLettered(r.Context(), req.ToDomain(), req.Reason)
+	if err != nil {
+		writeDomainError(w, "failed to archive dead letters", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.DeadLetterActionFromDomain(events))
+}
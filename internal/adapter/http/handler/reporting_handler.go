@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/statement"
+)
+
+// ReportingService defines the behavior needed by ReportingHandler.
+type ReportingService interface {
+	DailyVolumes(ctx context.Context, accountID string, from, to time.Time) ([]*domain.AccountDailyVolume, error)
+	CounterpartyTotals(ctx context.Context, accountID string, limit, offset int) ([]*domain.CounterpartyTotal, error)
+	Balances(ctx context.Context, limit, offset int) ([]*domain.AccountBalanceSnapshot, error)
+}
+
+// ReportingHandler serves the reporting read models. They trail the ledger
+// by the projection lag, so they suit reports, not balance checks.
+type ReportingHandler struct {
+	reportingUC ReportingService
+}
+
+// NewReportingHandler creates a new ReportingHandler.
+func NewReportingHandler(reportingUC ReportingService) *ReportingHandler {
+	return &ReportingHandler{reportingUC: reportingUC}
+}
+
+// DailyVolumes handles GET /reports/accounts/{id}/daily-volumes. Query
+// parameters: from, to (RFC3339 or YYYY-MM-DD, required).
+func (h *ReportingHandler) DailyVolumes(w http.ResponseWriter, r *http.Request) {
+	accountID := chi.URLParam(r, "id")
+	if accountID == "" {
+		writeError(w, http.StatusBadRequest, "missing account ID", "")
+		return
+	}
+
+	from, err := statement.ParseTime(r.URL.Query().Get("from"))
+	if err != nil {
+		writeDomainError(w, "invalid or missing 'from' (use RFC3339 or YYYY-MM-DD)", apierror.BadField("from", err.Error()))
+		return
+	}
+
+	to, err := statement.ParseTime(r.URL.Query().Get("to"))
+	if err != nil {
+		writeDomainError(w, "invalid or missing 'to' (use RFC3339 or YYYY-MM-DD)", apierror.BadField("to", err.Error()))
+		return
+	}
+
+	volumes, err := h.reportingUC.DailyVolumes(r.Context(), accountID, from, to)
+	if err != nil {
+		writeDomainError(w, "failed to list daily volumes", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListDailyVolumesFromDomain(accountID, volumes))
+}
+
+// CounterpartyTotals handles GET /reports/accounts/{id}/counterparties.
+func (h *ReportingHandler) CounterpartyTotals(w http.ResponseWriter, r *http.Request) {
+	accountID := chi.URLParam(r, "id")
+	if accountID == "" {
+		writeError(w, http.StatusBadRequest, "missing account ID", "")
+		return
+	}
+
+	totals, err := h.reportingUC.CounterpartyTotals(r.Context(), accountID, parseIntQuery(r, "limit", 50), parseIntQuery(r, "offset", 0))
+	if err != nil {
+		writeDomainError(w, "failed to list counterparty totals", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListCounterpartyTotalsFromDomain(accountID, totals))
+}
+
+// Balances handles GET /reports/balances.
+func (h *ReportingHandler) Balances(w http.ResponseWriter, r *http.Request) {
+	balances, err := h.reportingUC.Balances(r.Context(), parseIntQuery(r, "limit", 50), parseIntQuery(r, "offset", 0))
+	if err != nil {
+		writeDomainError(w, "failed to list balances", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListBalanceSnapshotsFromDomain(balances))
+}
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+)
+
+type reportingServiceStub struct {
+	from, to    time.Time
+	limit       int
+	volumes     []*domain.AccountDailyVolume
+	totals      []*domain.CounterpartyTotal
+	balances    []*domain.AccountBalanceSnapshot
+	volumeCalls int
+}
+
+func (s *reportingServiceStub) DailyVolumes(ctx context.Context, accountID string, from, to time.Time) ([]*domain.AccountDailyVolume, error) {
+	s.volumeCalls++
+	s.from, s.to = from, to
+	if err := domain.ValidateReportingPeriod(from, to); err != nil {
+		return nil, err
+	}
+	return s.volumes, nil
+}
+
+func (s *reportingServiceStub) CounterpartyTotals(ctx context.Context, accountID string, limit, offset int) ([]*domain.CounterpartyTotal, error) {
+	s.limit = limit
+	return s.totals, nil
+}
+
+func (s *reportingServiceStub) Balances(ctx context.Context, limit, offset int) ([]*domain.AccountBalanceSnapshot, error) {
+	s.limit = limit
+	return s.balances, nil
+}
+
+func TestReportingHandler_DailyVolumes(t *testing.T) {
+	day := time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC)
+	stub := &reportingServiceStub{volumes: []*domain.AccountDailyVolume{
+		{AccountID: "acc-1", Day: day, Currency: "USD", DebitTotal: decimal.NewFromInt(5), CreditTotal: decimal.RequireFromString("12.50"), EntryCount: 3},
+	}}
+	h := NewReportingHandler(stub)
+
+	rec := httptest.NewRecorder()
+	h.DailyVolumes(rec, statementRequest("/api/v1/reports/accounts/acc-1/daily-volumes?from=2026-09-01&to=2026-10-01"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if !stub.from.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) || !stub.to.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected period: %s to %s", stub.from, stub.to)
+	}
+
+	var resp dto.ListDailyVolumesResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if resp.AccountID != "acc-1" || len(resp.Volumes) != 1 || resp.Volumes[0].Day != "2026-09-02" || resp.Volumes[0].CreditTotal != "12.5" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestReportingHandler_DailyVolumes_InvalidPeriod(t *testing.T) {
+	tests := map[string]string{
+		"missing from":  "/api/v1/reports/accounts/acc-1/daily-volumes?to=2026-10-01",
+		"end not after": "/api/v1/reports/accounts/acc-1/daily-volumes?from=2026-10-01&to=2026-09-01",
+		"too long":      "/api/v1/reports/accounts/acc-1/daily-volumes?from=2024-01-01&to=2026-01-01",
+	}
+
+	for name, target := range tests {
+		t.Run(name, func(t *testing.T) {
+			h := NewReportingHandler(&reportingServiceStub{})
+
+			rec := httptest.NewRecorder()
+			h.DailyVolumes(rec, statementRequest(target))
+
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestReportingHandler_CounterpartyTotals(t *testing.T) {
+	stub := &reportingServiceStub{totals: []*domain.CounterpartyTotal{
+		{AccountID: "acc-1", CounterpartyAccountID: "acc-2", Currency: "USD", SentTotal: decimal.NewFromInt(40), ReceivedTotal: decimal.NewFromInt(5), EntryCount: 3},
+	}}
+	h := NewReportingHandler(stub)
+
+	rec := httptest.NewRecorder()
+	h.CounterpartyTotals(rec, statementRequest("/api/v1/reports/accounts/acc-1/counterparties?limit=10"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp dto.ListCounterpartyTotalsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if stub.limit != 10 || len(resp.Counterparties) != 1 || resp.Counterparties[0].SentTotal != "40" {
+		t.Fatalf("unexpected response: %+v (limit %d)", resp, stub.limit)
+	}
+}
+
+func TestReportingHandler_Balances(t *testing.T) {
+	stub := &reportingServiceStub{balances: []*domain.AccountBalanceSnapshot{
+		{AccountID: "acc-1", Currency: "USD", Balance: decimal.NewFromInt(65), AccountVersion: 4, LastEntryID: "e4"},
+	}}
+	h := NewReportingHandler(stub)
+
+	rec := httptest.NewRecorder()
+	h.Balances(rec, httptest.NewRequest(http.MethodGet, "/api/v1/reports/balances", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp dto.ListBalanceSnapshotsResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if stub.limit != 50 || len(resp.Balances) != 1 || resp.Balances[0].Balance != "65" || resp.Balances[0].AccountVersion != 4 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/statement"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// StatementService defines the behavior needed by StatementHandler.
+type StatementService interface {
+	GenerateStatement(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error)
+}
+
+// StatementHandler serves account statements.
+type StatementHandler struct {
+	statementUC StatementService
+}
+
+// NewStatementHandler creates a new StatementHandler.
+func NewStatementHandler(statementUC StatementService) *StatementHandler {
+	return &StatementHandler{statementUC: statementUC}
+}
+
+// Get returns the statement for an account over [from, to). Query
+// parameters: from, to (RFC3339 or YYYY-MM-DD, required) and format (json,
+// csv or camt053; default json).
+func (h *StatementHandler) Get(w http.ResponseWriter, r *http.Request) {
+	accountID := chi.URLParam(r, "id")
+	if accountID == "" {
+		writeError(w, http.StatusBadRequest, "missing account ID", "")
+		return
+	}
+
+	q := r.URL.Query()
+
+	format, err := statement.ParseFormat(q.Get("format"))
+	if err != nil {
+		writeDomainError(w, "invalid format", apierror.BadField("format", err.Error()))
+		return
+	}
+
+	from, err := statement.ParseTime(q.Get("from"))
+	if err != nil {
+		writeDomainError(w, "invalid or missing 'from' (use RFC3339 or YYYY-MM-DD)", apierror.BadField("from", err.Error()))
+		return
+	}
+
+	to, err := statement.ParseTime(q.Get("to"))
+	if err != nil {
+		writeDomainError(w, "invalid or missing 'to' (use RFC3339 or YYYY-MM-DD)", apierror.BadField("to", err.Error()))
+		return
+	}
+
+	stmt, err := h.statementUC.GenerateStatement(r.Context(), usecase.GenerateStatementInput{
+		AccountID: accountID,
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		writeDomainError(w, "failed to generate statement", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, format.FileName(stmt)))
+	w.WriteHeader(http.StatusOK)
+
+	_ = statement.Write(w, format, stmt) // Headers already sent
+}
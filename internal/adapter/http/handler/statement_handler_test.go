@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type statementServiceStub struct {
+	generateFn func(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error)
+}
+
+func (s *statementServiceStub) GenerateStatement(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error) {
+	return s.generateFn(ctx, input)
+}
+
+func statementRequest(target string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("id", "acc-1")
+	return req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, rctx))
+}
+
+func TestStatementHandler_Get_CSV(t *testing.T) {
+	var captured usecase.GenerateStatementInput
+	h := NewStatementHandler(&statementServiceStub{
+		generateFn: func(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error) {
+			captured = input
+			return &domain.Statement{
+				AccountID:      input.AccountID,
+				Currency:       "USD",
+				PeriodStart:    input.From,
+				PeriodEnd:      input.To,
+				OpeningBalance: decimal.NewFromInt(10),
+				ClosingBalance: decimal.NewFromInt(10),
+			}, nil
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	h.Get(rec, statementRequest("/api/v1/accounts/acc-1/statement?from=2026-09-01&to=2026-10-01&format=csv"))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if !captured.From.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) || captured.AccountID != "acc-1" {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/csv" {
+		t.Fatalf("expected text/csv, got %q", ct)
+	}
+
+	if !strings.Contains(rec.Header().Get("Content-Disposition"), "statement_acc-1_20260901_20261001.csv") {
+		t.Fatalf("unexpected Content-Disposition %q", rec.Header().Get("Content-Disposition"))
+	}
+
+	if !strings.HasPrefix(rec.Body.String(), "type,booked_at") {
+		t.Fatalf("unexpected body: %s", rec.Body.String())
+	}
+}
+
+func TestStatementHandler_Get_BadRequest(t *testing.T) {
+	h := NewStatementHandler(&statementServiceStub{
+		generateFn: func(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error) {
+			return nil, domain.ErrInvalidStatementPeriod
+		},
+	})
+
+	for _, target := range []string{
+		"/statement?to=2026-10-01",
+		"/statement?from=2026-09-01&to=2026-10-01&format=pdf",
+		"/statement?from=2026-10-01&to=2026-09-01",
+	} {
+		rec := httptest.NewRecorder()
+		h.Get(rec, statementRequest(target))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("%s: expected 400, got %d", target, rec.Code)
+		}
+	}
+}
+
+func TestStatementHandler_Get_AccountNotFound(t *testing.T) {
+	h := NewStatementHandler(&statementServiceStub{
+		generateFn: func(ctx context.Context, input usecase.GenerateStatementInput) (*domain.Statement, error) {
+			return nil, domain.ErrAccountNotFound
+		},
+	})
+
+	rec := httptest.NewRecorder()
+	h.Get(rec, statementRequest("/statement?from=2026-09-01&to=2026-10-01"))
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
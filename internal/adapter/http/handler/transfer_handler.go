@@ -3,10 +3,15 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/shopspring/decimal"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/adapter/http/dto"
 	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/usecase"
@@ -19,6 +24,7 @@ type TransferService interface {
 	GetTransfer(ctx context.Context, id string) (*domain.Transfer, error)
 	ListTransfersByAccount(ctx context.Context, input usecase.ListTransfersByAccountInput) ([]*domain.Transfer, error)
 	ListTransfersByAccountCursor(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error)
+	SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error)
 	ReverseTransfer(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error)
 }
 
@@ -42,15 +48,20 @@ func (h *TransferHandler) Create(w http.ResponseWriter, r *http.Request) {
 
 	input, err := req.ToUseCaseInput()
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "invalid amount", err.Error())
+		writeDomainError(w, "invalid amount", apierror.BadField("amount", err.Error()))
 		return
 	}
 
-	transfer, err := h.transferUC.CreateTransfer(r.Context(), input)
+	// If-Match conditions the transfer on the source account's ETag.
+	input.ExpectedFromVersion, err = ifMatchVersion(r)
 	if err != nil {
-		status := mapDomainError(err)
-		writeError(w, status, "failed to create transfer", err.Error())
+		writeIfMatchError(w, "failed to create transfer", err)
+		return
+	}
 
+	transfer, err := h.transferUC.CreateTransfer(r.Context(), input)
+	if err != nil {
+		writeDomainError(w, "failed to create transfer", err)
 		return
 	}
 
@@ -67,15 +78,13 @@ func (h *TransferHandler) CreateBatch(w http.ResponseWriter, r *http.Request) {
 
 	input, err := req.ToUseCaseInput()
 	if err != nil {
-		writeError(w, http.StatusBadRequest, "invalid amount", err.Error())
+		writeDomainError(w, "invalid amount", apierror.BadField("amount", err.Error()))
 		return
 	}
 
 	transfers, err := h.transferUC.CreateBatchTransfer(r.Context(), input)
 	if err != nil {
-		status := mapDomainError(err)
-		writeError(w, status, "failed to create transfers", err.Error())
-
+		writeDomainError(w, "failed to create transfers", err)
 		return
 	}
 
@@ -92,13 +101,11 @@ func (h *TransferHandler) Get(w http.ResponseWriter, r *http.Request) {
 
 	transfer, err := h.transferUC.GetTransfer(r.Context(), id)
 	if err != nil {
-		status := mapDomainError(err)
-		writeError(w, status, "failed to get transfer", err.Error())
-
+		writeDomainError(w, "failed to get transfer", err)
 		return
 	}
 
-	writeJSON(w, http.StatusOK, dto.TransferFromDomain(transfer))
+	writeJSONWithETag(w, r, transferETag(transfer), dto.TransferFromDomain(transfer))
 }
 
 // ListByAccount lists transfers for an account. Prefer the "cursor" query
@@ -120,7 +127,7 @@ func (h *TransferHandler) ListByAccount(w http.ResponseWriter, r *http.Request)
 			Limit:     limit,
 		})
 		if err != nil {
-			writeError(w, http.StatusInternalServerError, "failed to list transfers", err.Error())
+			writeDomainError(w, "failed to list transfers", err)
 			return
 		}
 
@@ -140,13 +147,113 @@ func (h *TransferHandler) ListByAccount(w http.ResponseWriter, r *http.Request)
 		Offset:    offset,
 	})
 	if err != nil {
-		writeError(w, http.StatusInternalServerError, "failed to list transfers", err.Error())
+		writeDomainError(w, "failed to list transfers", err)
 		return
 	}
 
 	writeJSON(w, http.StatusOK, dto.TransfersFromDomain(transfers))
 }
 
+// Search handles GET /transfers: transfers matching the query's filters, in
+// the requested order, with opaque keyset cursors.
+func (h *TransferHandler) Search(w http.ResponseWriter, r *http.Request) {
+	filter, sort, err := transferSearchFromQuery(r)
+	if err != nil {
+		writeDomainError(w, "invalid search parameters", err)
+		return
+	}
+
+	result, err := h.transferUC.SearchTransfers(r.Context(), usecase.SearchTransfersInput{
+		Filter: filter,
+		Sort:   sort,
+		Cursor: r.URL.Query().Get("cursor"),
+		Limit:  parseIntQuery(r, "limit", 20),
+	})
+	if err != nil {
+		writeDomainError(w, "failed to search transfers", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListTransfersCursorResponse{
+		Transfers:  dto.TransfersFromDomain(result.Transfers),
+		NextCursor: result.NextCursor,
+	})
+}
+
+// transferSearchFromQuery reads a search's filters and sort. Metadata is
+// matched from a JSON object in metadata and from metadata.<key>=<value>
+// string pairs.
+func transferSearchFromQuery(r *http.Request) (domain.TransferSearchFilter, domain.TransferSort, error) {
+	q := r.URL.Query()
+	filter := domain.TransferSearchFilter{
+		AccountID:      q.Get("account_id"),
+		Direction:      domain.TransferDirection(q.Get("direction")),
+		Currency:       q.Get("currency"),
+		ReversalStatus: domain.TransferReversalStatus(q.Get("reversal_status")),
+	}
+
+	var err error
+	if filter.MinAmount, err = parseDecimalQuery(q.Get("min_amount")); err != nil {
+		return filter, domain.TransferSort{}, apierror.BadField("min_amount", "min_amount: "+err.Error())
+	}
+	if filter.MaxAmount, err = parseDecimalQuery(q.Get("max_amount")); err != nil {
+		return filter, domain.TransferSort{}, apierror.BadField("max_amount", "max_amount: "+err.Error())
+	}
+
+	for name, dst := range map[string]**time.Time{
+		"event_at_from":   &filter.EventAtFrom,
+		"event_at_to":     &filter.EventAtTo,
+		"created_at_from": &filter.CreatedAtFrom,
+		"created_at_to":   &filter.CreatedAtTo,
+	} {
+		if v := q.Get(name); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, domain.TransferSort{}, apierror.BadField(name, fmt.Sprintf("%s: use RFC3339: %v", name, err))
+			}
+			*dst = &t
+		}
+	}
+
+	if v := q.Get("metadata"); v != "" {
+		if err := json.Unmarshal([]byte(v), &filter.Metadata); err != nil {
+			return filter, domain.TransferSort{}, apierror.BadField("metadata", "metadata: must be a JSON object: "+err.Error())
+		}
+	}
+	for key, values := range q {
+		if name, ok := strings.CutPrefix(key, "metadata."); ok && name != "" {
+			if filter.Metadata == nil {
+				filter.Metadata = map[string]any{}
+			}
+			filter.Metadata[name] = values[0]
+		}
+	}
+
+	sort := domain.TransferSort{Field: domain.TransferSortField(q.Get("sort"))}
+	switch q.Get("order") {
+	case "", "desc":
+	case "asc":
+		sort.Ascending = true
+	default:
+		return filter, sort, apierror.BadField("order", "order: must be asc or desc")
+	}
+
+	return filter, sort, nil
+}
+
+func parseDecimalQuery(v string) (*decimal.Decimal, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	d, err := decimal.NewFromString(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
 // Reverse creates a reversal transfer.
 func (h *TransferHandler) Reverse(w http.ResponseWriter, r *http.Request) {
 	transferID := chi.URLParam(r, "id")
@@ -161,11 +268,17 @@ func (h *TransferHandler) Reverse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// A transfer's ETag is its ID, which never changes, so If-Match needs
+	// no lookup; an unknown transfer fails the reversal itself.
+	if err := checkIfMatch(r, transferETag(&domain.Transfer{ID: transferID})); err != nil {
+		writeIfMatchError(w, "failed to reverse transfer", err)
+		return
+	}
+
 	input := req.ToUseCaseInput(transferID)
 	reversalTransfer, err := h.transferUC.ReverseTransfer(r.Context(), input)
 	if err != nil {
-		status := mapDomainError(err)
-		writeError(w, status, "failed to reverse transfer", err.Error())
+		writeDomainError(w, "failed to reverse transfer", err)
 		return
 	}
 
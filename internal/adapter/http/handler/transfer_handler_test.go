@@ -22,6 +22,7 @@ type transferServiceStub struct {
 	listFn        func(ctx context.Context, input usecase.ListTransfersByAccountInput) ([]*domain.Transfer, error)
 	listCursorFn  func(ctx context.Context, input usecase.ListTransfersByAccountCursorInput) (*usecase.ListTransfersByAccountCursorResult, error)
 	reverseFn     func(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error)
+	searchFn      func(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error)
 }
 
 func (s *transferServiceStub) CreateTransfer(ctx context.Context, input usecase.CreateTransferInput) (*domain.Transfer, error) {
@@ -47,6 +48,10 @@ func (s *transferServiceStub) ListTransfersByAccountCursor(ctx context.Context,
 	return &usecase.ListTransfersByAccountCursorResult{}, nil
 }
 
+func (s *transferServiceStub) SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+	return s.searchFn(ctx, input)
+}
+
 func (s *transferServiceStub) ReverseTransfer(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error) {
 	return s.reverseFn(ctx, input)
 }
@@ -100,6 +105,56 @@ func TestTransferHandler_Create_Success(t *testing.T) {
 	}
 }
 
+func TestTransferHandler_Create_IfMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifMatch     string
+		serviceErr  error
+		wantStatus  int
+		wantVersion *int64
+	}{
+		{name: "current version", ifMatch: `"4"`, wantStatus: http.StatusCreated, wantVersion: int64Ptr(4)},
+		{name: "stale version", ifMatch: `"3"`, serviceErr: domain.ErrVersionMismatch, wantStatus: http.StatusPreconditionFailed, wantVersion: int64Ptr(3)},
+		{name: "not an account ETag", ifMatch: `"tx-1"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "several versions", ifMatch: `"3", "4"`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var captured *usecase.CreateTransferInput
+			handler := NewTransferHandler(&transferServiceStub{
+				createFn: func(ctx context.Context, input usecase.CreateTransferInput) (*domain.Transfer, error) {
+					captured = &input
+					if tt.serviceErr != nil {
+						return nil, tt.serviceErr
+					}
+					return &domain.Transfer{ID: "tx-1"}, nil
+				},
+			})
+
+			body, _ := json.Marshal(dto.CreateTransferRequest{FromAccountID: "acc-1", ToAccountID: "acc-2", Amount: "100"})
+			req := httptest.NewRequest(http.MethodPost, "/transfers", bytes.NewReader(body))
+			req.Header.Set("If-Match", tt.ifMatch)
+			rec := httptest.NewRecorder()
+
+			handler.Create(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d", tt.wantStatus, rec.Code)
+			}
+			if tt.wantVersion == nil {
+				if captured != nil {
+					t.Fatal("expected the transfer not to be attempted")
+				}
+				return
+			}
+			if captured == nil || captured.ExpectedFromVersion == nil || *captured.ExpectedFromVersion != *tt.wantVersion {
+				t.Fatalf("expected ExpectedFromVersion %d, got %+v", *tt.wantVersion, captured)
+			}
+		})
+	}
+}
+
 func TestTransferHandler_Create_InvalidBody(t *testing.T) {
 	handler := NewTransferHandler(&transferServiceStub{
 		createFn: func(ctx context.Context, input usecase.CreateTransferInput) (*domain.Transfer, error) {
@@ -213,6 +268,9 @@ func TestTransferHandler_Get(t *testing.T) {
 	if rec.Code != http.StatusOK {
 		t.Fatalf("expected 200, got %d", rec.Code)
 	}
+	if etag := rec.Header().Get("ETag"); etag != `"tx-1"` {
+		t.Fatalf("expected ETag \"tx-1\", got %q", etag)
+	}
 }
 
 func TestTransferHandler_ListByAccount(t *testing.T) {
@@ -274,3 +332,118 @@ func TestTransferHandler_Reverse_Error(t *testing.T) {
 		t.Fatalf("expected 404, got %d", rec.Code)
 	}
 }
+
+func TestTransferHandler_Reverse_IfMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		ifMatch     string
+		wantStatus  int
+		wantReverse bool
+	}{
+		{name: "absent", wantStatus: http.StatusCreated, wantReverse: true},
+		{name: "transfer ETag", ifMatch: `"tx-1"`, wantStatus: http.StatusCreated, wantReverse: true},
+		{name: "other transfer", ifMatch: `"tx-2"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "account ETag", ifMatch: `"3"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "list", ifMatch: `"tx-1", "tx-2"`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reversed := false
+			handler := NewTransferHandler(&transferServiceStub{
+				reverseFn: func(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error) {
+					reversed = true
+					return &domain.Transfer{ID: "tx-rev", ReversedTransferID: &input.TransferID}, nil
+				},
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/transfers/tx-1/reverse", bytes.NewReader([]byte(`{}`)))
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			req = setChiURLParam(req, "id", "tx-1")
+			rec := httptest.NewRecorder()
+
+			handler.Reverse(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("expected %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if reversed != tt.wantReverse {
+				t.Fatalf("expected reversed=%v, got %v", tt.wantReverse, reversed)
+			}
+		})
+	}
+}
+
+func TestTransferHandler_Search(t *testing.T) {
+	var captured usecase.SearchTransfersInput
+	handler := NewTransferHandler(&transferServiceStub{
+		searchFn: func(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+			captured = input
+			return &usecase.SearchTransfersResult{Transfers: []*domain.Transfer{{ID: "tx-1"}}, NextCursor: "next"}, nil
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/transfers?account_id=acc-1&direction=outgoing&min_amount=10000&currency=EUR"+
+		"&created_at_from=2026-10-17T00:00:00Z&created_at_to=2026-10-18T00:00:00Z&reversal_status=not_reversed"+
+		"&metadata.order_id=X&sort=amount&order=asc&cursor=abc&limit=5", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	handler.Search(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	filter := captured.Filter
+	if filter.AccountID != "acc-1" || filter.Direction != domain.TransferDirectionOutgoing || filter.Currency != "EUR" ||
+		filter.ReversalStatus != domain.TransferReversalStatusNotReversed {
+		t.Fatalf("unexpected filter %+v", filter)
+	}
+	if filter.MinAmount == nil || !filter.MinAmount.Equal(decimal.NewFromInt(10000)) || filter.MaxAmount != nil {
+		t.Fatalf("unexpected amount range %v..%v", filter.MinAmount, filter.MaxAmount)
+	}
+	if filter.CreatedAtFrom == nil || filter.CreatedAtTo == nil || filter.EventAtFrom != nil {
+		t.Fatalf("unexpected time ranges %+v", filter)
+	}
+	if filter.Metadata["order_id"] != "X" {
+		t.Fatalf("unexpected metadata %v", filter.Metadata)
+	}
+	if captured.Sort != (domain.TransferSort{Field: domain.TransferSortAmount, Ascending: true}) || captured.Cursor != "abc" || captured.Limit != 5 {
+		t.Fatalf("unexpected input %+v", captured)
+	}
+
+	var resp dto.ListTransfersCursorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+	if len(resp.Transfers) != 1 || resp.NextCursor != "next" {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+}
+
+func TestTransferHandler_Search_InvalidQuery(t *testing.T) {
+	handler := NewTransferHandler(&transferServiceStub{
+		searchFn: func(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+			return nil, domain.ErrInvalidTransferSearch
+		},
+	})
+
+	for _, query := range []string{
+		"min_amount=lots",
+		"event_at_from=yesterday",
+		"metadata=not-json",
+		"order=sideways",
+		"cursor=garbage",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/transfers?"+query, http.NoBody)
+		rec := httptest.NewRecorder()
+
+		handler.Search(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", query, rec.Code)
+		}
+	}
+}
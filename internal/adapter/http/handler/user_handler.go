@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// UserService defines the behavior needed by UserHandler.
+type UserService interface {
+	CreateUser(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error)
+	GetUser(ctx context.Context, id string) (*domain.User, error)
+	ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error)
+	UpdateUser(ctx context.Context, input usecase.UpdateUserInput) (*domain.User, error)
+	DeleteUser(ctx context.Context, id string) error
+	ChangePassword(ctx context.Context, input usecase.ChangePasswordInput) error
+}
+
+// UserHandler handles user management HTTP requests.
+type UserHandler struct {
+	userUC UserService
+}
+
+// NewUserHandler creates a new UserHandler.
+func NewUserHandler(userUC UserService) *UserHandler {
+	return &UserHandler{userUC: userUC}
+}
+
+// Create handles POST /users.
+func (h *UserHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	user, err := h.userUC.CreateUser(r.Context(), usecase.CreateUserInput{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+		Role:     req.Role,
+	})
+	if err != nil {
+		writeDomainError(w, "failed to create user", err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, dto.UserFromDomain(user))
+}
+
+// List handles GET /users.
+func (h *UserHandler) List(w http.ResponseWriter, r *http.Request) {
+	users, err := h.userUC.ListUsers(r.Context(), parseIntQuery(r, "limit", 50), parseIntQuery(r, "offset", 0))
+	if err != nil {
+		writeDomainError(w, "failed to list users", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListUsersResponse{Users: dto.UsersFromDomain(users)})
+}
+
+// Get handles GET /users/{id}.
+func (h *UserHandler) Get(w http.ResponseWriter, r *http.Request) {
+	user, err := h.userUC.GetUser(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeDomainError(w, "failed to get user", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.UserFromDomain(user))
+}
+
+// Update handles PATCH /users/{id}: role changes, deactivation and
+// password resets.
+func (h *UserHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	user, err := h.userUC.UpdateUser(r.Context(), usecase.UpdateUserInput{
+		ID:       chi.URLParam(r, "id"),
+		Name:     req.Name,
+		Role:     req.Role,
+		Active:   req.Active,
+		Password: req.Password,
+	})
+	if err != nil {
+		writeDomainError(w, "failed to update user", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.UserFromDomain(user))
+}
+
+// Delete handles DELETE /users/{id}.
+func (h *UserHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.userUC.DeleteUser(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeDomainError(w, "failed to delete user", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ChangePassword handles POST /auth/password, changing the authenticated
+// user's own password.
+func (h *UserHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	user, ok := domain.UserFromContext(r.Context())
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "")
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	err := h.userUC.ChangePassword(r.Context(), usecase.ChangePasswordInput{
+		UserID:          user.ID,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		writeDomainError(w, "failed to change password", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type userServiceStub struct {
+	createFn         func(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error)
+	changePasswordFn func(ctx context.Context, input usecase.ChangePasswordInput) error
+}
+
+func (s *userServiceStub) CreateUser(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error) {
+	return s.createFn(ctx, input)
+}
+
+func (s *userServiceStub) GetUser(ctx context.Context, id string) (*domain.User, error) {
+	return nil, domain.ErrUserNotFound
+}
+
+func (s *userServiceStub) ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	return nil, nil
+}
+
+func (s *userServiceStub) UpdateUser(ctx context.Context, input usecase.UpdateUserInput) (*domain.User, error) {
+	return nil, nil
+}
+
+func (s *userServiceStub) DeleteUser(ctx context.Context, id string) error {
+	return nil
+}
+
+func (s *userServiceStub) ChangePassword(ctx context.Context, input usecase.ChangePasswordInput) error {
+	return s.changePasswordFn(ctx, input)
+}
+
+func TestUserHandler_Create(t *testing.T) {
+	h := NewUserHandler(&userServiceStub{
+		createFn: func(ctx context.Context, input usecase.CreateUserInput) (*domain.User, error) {
+			if input.Email == "taken@example.com" {
+				return nil, domain.ErrUserAlreadyExists
+			}
+			return &domain.User{ID: "user-1", Email: input.Email, Role: input.Role, Active: true, HashedPassword: "hash"}, nil
+		},
+	})
+
+	body := `{"email":"new@example.com","name":"New","password":"StrongPass1","role":"operator"}`
+	rec := httptest.NewRecorder()
+	h.Create(rec, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body)))
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp["id"] != "user-1" || resp["role"] != "operator" {
+		t.Fatalf("unexpected response: %v", resp)
+	}
+	if strings.Contains(rec.Body.String(), "hash") {
+		t.Fatalf("expected no password hash in response: %s", rec.Body.String())
+	}
+
+	body = `{"email":"taken@example.com","name":"Taken","password":"StrongPass1","role":"viewer"}`
+	rec = httptest.NewRecorder()
+	h.Create(rec, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body)))
+
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("expected 409, got %d", rec.Code)
+	}
+}
+
+func TestUserHandler_ChangePassword(t *testing.T) {
+	var captured usecase.ChangePasswordInput
+	h := NewUserHandler(&userServiceStub{
+		changePasswordFn: func(ctx context.Context, input usecase.ChangePasswordInput) error {
+			captured = input
+			if input.CurrentPassword != "OldStrong1" {
+				return domain.ErrIncorrectPassword
+			}
+			return nil
+		},
+	})
+
+	body := `{"current_password":"OldStrong1","new_password":"NewStrong1"}`
+
+	rec := httptest.NewRecorder()
+	h.ChangePassword(rec, httptest.NewRequest(http.MethodPost, "/auth/password", strings.NewReader(body)))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without an authenticated user, got %d", rec.Code)
+	}
+
+	ctx := context.WithValue(context.Background(), domain.UserContextKey, &domain.User{ID: "user-1"})
+
+	rec = httptest.NewRecorder()
+	h.ChangePassword(rec, httptest.NewRequest(http.MethodPost, "/auth/password", strings.NewReader(body)).WithContext(ctx))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if captured.UserID != "user-1" || captured.NewPassword != "NewStrong1" {
+		t.Fatalf("unexpected input: %+v", captured)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ChangePassword(rec, httptest.NewRequest(http.MethodPost, "/auth/password",
+		strings.NewReader(`{"current_password":"wrong","new_password":"NewStrong1"}`)).WithContext(ctx))
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected 400 for a wrong current password, got %d", rec.Code)
+	}
+}
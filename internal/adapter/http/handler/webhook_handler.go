@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+	"github.com/iho/goledger/internal/adapter/http/dto"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// WebhookHandler handles webhook subscription HTTP requests.
+type WebhookHandler struct {
+	webhookUC *usecase.WebhookUseCase
+}
+
+// NewWebhookHandler creates a new WebhookHandler.
+func NewWebhookHandler(webhookUC *usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{webhookUC: webhookUC}
+}
+
+// Create handles POST /webhooks. The response is the only one that
+// carries the subscription's signing secret.
+func (h *WebhookHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	sub, err := h.webhookUC.CreateSubscription(r.Context(), usecase.CreateWebhookSubscriptionInput{
+		URL:           req.URL,
+		EventTypes:    req.EventTypes,
+		AggregateType: req.AggregateType,
+		AggregateID:   req.AggregateID,
+		Description:   req.Description,
+		Secret:        req.Secret,
+	})
+	if err != nil {
+		writeDomainError(w, "failed to create webhook", err)
+		return
+	}
+
+	resp := dto.WebhookFromDomain(sub)
+	resp.Secret = sub.Secret
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// List handles GET /webhooks.
+func (h *WebhookHandler) List(w http.ResponseWriter, r *http.Request) {
+	subs, err := h.webhookUC.ListSubscriptions(r.Context(), parseIntQuery(r, "limit", 50), parseIntQuery(r, "offset", 0))
+	if err != nil {
+		writeDomainError(w, "failed to list webhooks", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListWebhooksResponse{Webhooks: dto.WebhooksFromDomain(subs)})
+}
+
+// Get handles GET /webhooks/{id}.
+func (h *WebhookHandler) Get(w http.ResponseWriter, r *http.Request) {
+	sub, err := h.webhookUC.GetSubscription(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		writeDomainError(w, "failed to get webhook", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.WebhookFromDomain(sub))
+}
+
+// Update handles PATCH /webhooks/{id}.
+func (h *WebhookHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req dto.UpdateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body", err.Error())
+		return
+	}
+
+	sub, err := h.webhookUC.UpdateSubscription(r.Context(), chi.URLParam(r, "id"), usecase.UpdateWebhookSubscriptionInput{
+		URL:           req.URL,
+		EventTypes:    req.EventTypes,
+		AggregateType: req.AggregateType,
+		AggregateID:   req.AggregateID,
+		Description:   req.Description,
+		Active:        req.Active,
+	})
+	if err != nil {
+		writeDomainError(w, "failed to update webhook", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.WebhookFromDomain(sub))
+}
+
+// Delete handles DELETE /webhooks/{id}.
+func (h *WebhookHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.webhookUC.DeleteSubscription(r.Context(), chi.URLParam(r, "id")); err != nil {
+		writeDomainError(w, "failed to delete webhook", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListDeliveries handles GET /webhooks/{id}/deliveries?status=.
+func (h *WebhookHandler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	status := domain.WebhookDeliveryStatus(r.URL.Query().Get("status"))
+	switch status {
+	case "", domain.WebhookDeliveryPending, domain.WebhookDeliveryDelivered, domain.WebhookDeliveryDeadLettered:
+	default:
+		writeDomainError(w, "invalid status", apierror.BadField("status", "status must be pending, delivered or dead_lettered"))
+		return
+	}
+
+	deliveries, err := h.webhookUC.ListDeliveries(r.Context(), chi.URLParam(r, "id"), status,
+		parseIntQuery(r, "limit", 50), parseIntQuery(r, "offset", 0))
+	if err != nil {
+		writeDomainError(w, "failed to list webhook deliveries", err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.ListWebhookDeliveriesResponse{Deliveries: dto.WebhookDeliveriesFromDomain(deliveries)})
+}
+
+// GetDelivery handles GET /webhooks/{id}/deliveries/{deliveryId}, returning
+// the delivery with its body and attempt log.
+func (h *WebhookHandler) GetDelivery(w http.ResponseWriter, r *http.Request) {
+	delivery, attempts, err := h.webhookUC.GetDelivery(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "deliveryId"))
+	if err != nil {
+		writeDomainError(w, "failed to get webhook delivery", err)
+		return
+	}
+
+	resp := dto.WebhookDeliveryFromDomain(delivery, true)
+	resp.AttemptLog = dto.WebhookDeliveryAttemptsFromDomain(attempts)
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Redeliver handles POST /webhooks/{id}/deliveries/{deliveryId}/redeliver.
+func (h *WebhookHandler) Redeliver(w http.ResponseWriter, r *http.Request) {
+	delivery, err := h.webhookUC.Redeliver(r.Context(), chi.URLParam(r, "id"), chi.URLParam(r, "deliveryId"))
+	if err != nil {
+		writeDomainError(w, "failed to redeliver webhook", err)
+		return
+	}
+
+	writeJSON(w, http.StatusAccepted, dto.WebhookDeliveryFromDomain(delivery, false))
+}
@@ -5,44 +5,40 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/domain"
 	"github.com/iho/goledger/internal/infrastructure/auth"
 )
 
-// AuthMiddleware creates an authentication middleware
-func AuthMiddleware(jwtManager *auth.JWTManager) func(http.Handler) http.Handler {
+// AuthMiddleware creates an authentication middleware. The user in context
+// is the stored one, so deactivated users are rejected and role changes
+// apply to tokens already issued.
+func AuthMiddleware(authenticator *auth.Authenticator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Extract token from Authorization header
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
-				http.Error(w, "missing authorization header", http.StatusUnauthorized)
+				writeProblem(w, apierror.ForError(domain.ErrUnauthorized), "missing authorization header")
 				return
 			}
 
 			// Parse Bearer token
 			parts := strings.SplitN(authHeader, " ", 2)
 			if len(parts) != 2 || parts[0] != "Bearer" {
-				http.Error(w, "invalid authorization header format", http.StatusUnauthorized)
+				writeProblem(w, apierror.ForError(domain.ErrUnauthorized), "invalid authorization header format")
 				return
 			}
 
 			tokenString := parts[1]
 
-			// Verify token
-			claims, err := jwtManager.Verify(tokenString)
+			// Verify token and load its user
+			user, err := authenticator.Authenticate(r.Context(), tokenString)
 			if err != nil {
-				http.Error(w, "invalid or expired token", http.StatusUnauthorized)
+				apierror.ProblemFor(err).Write(w)
 				return
 			}
 
-			// Create user from claims
-			user := &domain.User{
-				ID:    claims.UserID,
-				Email: claims.Email,
-				Role:  claims.Role,
-			}
-
 			// Add user to context
 			ctx := context.WithValue(r.Context(), domain.UserContextKey, user)
 			next.ServeHTTP(w, r.WithContext(ctx))
@@ -56,7 +52,7 @@ func RequireRole(minRole domain.Role) func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			user, ok := r.Context().Value(domain.UserContextKey).(*domain.User)
 			if !ok {
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				writeProblem(w, apierror.ForError(domain.ErrUnauthorized), "unauthorized")
 				return
 			}
 
@@ -64,12 +60,12 @@ func RequireRole(minRole domain.Role) func(http.Handler) http.Handler {
 			switch minRole {
 			case domain.RoleAdmin:
 				if user.Role != domain.RoleAdmin {
-					http.Error(w, "insufficient permissions", http.StatusForbidden)
+					writeProblem(w, apierror.ForError(domain.ErrInsufficientRole), "insufficient permissions")
 					return
 				}
 			case domain.RoleOperator:
 				if user.Role != domain.RoleAdmin && user.Role != domain.RoleOperator {
-					http.Error(w, "insufficient permissions", http.StatusForbidden)
+					writeProblem(w, apierror.ForError(domain.ErrInsufficientRole), "insufficient permissions")
 					return
 				}
 			case domain.RoleViewer:
@@ -82,7 +78,7 @@ func RequireRole(minRole domain.Role) func(http.Handler) http.Handler {
 }
 
 // OptionalAuth is a middleware that extracts user if present but doesn't require it
-func OptionalAuth(jwtManager *auth.JWTManager) func(http.Handler) http.Handler {
+func OptionalAuth(authenticator *auth.Authenticator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authHeader := r.Header.Get("Authorization")
@@ -94,13 +90,8 @@ func OptionalAuth(jwtManager *auth.JWTManager) func(http.Handler) http.Handler {
 
 			parts := strings.SplitN(authHeader, " ", 2)
 			if len(parts) == 2 && parts[0] == "Bearer" {
-				claims, err := jwtManager.Verify(parts[1])
+				user, err := authenticator.Authenticate(r.Context(), parts[1])
 				if err == nil {
-					user := &domain.User{
-						ID:    claims.UserID,
-						Email: claims.Email,
-						Role:  claims.Role,
-					}
 					ctx := context.WithValue(r.Context(), domain.UserContextKey, user)
 					next.ServeHTTP(w, r.WithContext(ctx))
 					return
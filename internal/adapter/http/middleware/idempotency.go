@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/iho/goledger/internal/adapter/apierror"
 	"github.com/iho/goledger/internal/usecase"
 )
 
@@ -42,7 +43,7 @@ func (m *IdempotencyMiddleware) Wrap(next http.Handler) http.Handler {
 		// Check if we have a cached response
 		exists, cachedResponse, err := m.store.CheckAndSet(r.Context(), key, nil, idempotencyTTL)
 		if err != nil {
-			http.Error(w, "idempotency check failed", http.StatusInternalServerError)
+			writeProblem(w, apierror.Internal, "idempotency check failed")
 			return
 		}
 
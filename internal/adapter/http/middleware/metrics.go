@@ -66,6 +66,12 @@ func (r *metricsRecorder) WriteHeader(code int) {
 	r.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, e.g. to
+// flush streamed responses.
+func (r *metricsRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 // normalizePath normalizes URL paths to avoid high cardinality.
 func normalizePath(path string) string {
 	// Normalize paths with IDs to reduce cardinality
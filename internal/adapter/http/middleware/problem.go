@@ -0,0 +1,13 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
+)
+
+// writeProblem rejects a request with the problem of spec, in the same
+// problem+json form the handlers answer with.
+func writeProblem(w http.ResponseWriter, spec apierror.Spec, detail string) {
+	apierror.NewProblem(spec, detail).Write(w)
+}
@@ -5,6 +5,8 @@ import (
 	"sync"
 
 	"golang.org/x/time/rate"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
 )
 
 // RateLimiter implements per-IP rate limiting
@@ -58,7 +60,7 @@ func (rl *RateLimiter) Limit(next http.Handler) http.Handler {
 		limiter := rl.getLimiter(ip)
 
 		if !limiter.Allow() {
-			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			writeProblem(w, apierror.ForStatus(http.StatusTooManyRequests), "rate limit exceeded")
 			return
 		}
 
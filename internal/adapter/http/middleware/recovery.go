@@ -4,6 +4,8 @@ import (
 	"log/slog"
 	"net/http"
 	"runtime/debug"
+
+	"github.com/iho/goledger/internal/adapter/apierror"
 )
 
 // Recovery middleware recovers from panics and logs the error.
@@ -18,9 +20,9 @@ func Recovery(next http.Handler) http.Handler {
 					"path", r.URL.Path,
 				)
 
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusInternalServerError)
-				_, _ = w.Write([]byte(`{"error":"internal server error"}`)) // Best effort error response
+				problem := apierror.NewProblem(apierror.Internal, apierror.Internal.Detail)
+				problem.Error = "internal server error"
+				problem.Write(w) // Best effort error response
 			}
 		}()
 
@@ -27,20 +27,39 @@ type RouterConfig struct {
 	HoldHandler      *handler.HoldHandler
 	AuthHandler      *handler.AuthHandler
 	AuditHandler     *handler.AuditHandler
+	StatementHandler *handler.StatementHandler
+	WebhookHandler   *handler.WebhookHandler
+	OutboxHandler    *handler.OutboxHandler
+	// UserHandler serves /api/v1/users and /auth/password; nil disables them.
+	UserHandler *handler.UserHandler
+	// ReportingHandler serves /api/v1/reports; nil disables it.
+	ReportingHandler *handler.ReportingHandler
+	// EventStreamHandler serves /api/v1/events/stream; nil disables it.
+	EventStreamHandler *handler.EventStreamHandler
+	// EventSchemaHandler serves /api/v1/events/schemas; nil disables it.
+	EventSchemaHandler *handler.EventSchemaHandler
+	// GatewayHandler serves /v1, the HTTP/JSON transcoding of the gRPC API
+	// (see internal/adapter/grpc/gateway); nil disables it. Auth, RBAC and
+	// idempotency are enforced by the gRPC interceptors behind it.
+	GatewayHandler http.Handler
+	// GraphQLHandler serves POST /api/v1/graphql (see
+	// internal/adapter/graphql); nil disables it.
+	GraphQLHandler   http.Handler
 	IdempotencyStore usecase.IdempotencyStore
 	RateLimiter      *middleware.RateLimiter
 	Logger           *slog.Logger
-	// JWTManager verifies bearer tokens. Required for auth enforcement.
-	JWTManager *auth.JWTManager
+	// Authenticator verifies bearer tokens and loads their users. Required
+	// for auth enforcement.
+	Authenticator *auth.Authenticator
 	// AuthEnabled turns on authentication/RBAC enforcement for the API. When
-	// false (or JWTManager is nil), routes behave as before: open, no user
+	// false (or Authenticator is nil), routes behave as before: open, no user
 	// in context. Mirrors the AUTH_ENABLED config flag.
 	AuthEnabled bool
 }
 
 // authRequired reports whether auth middleware should be applied.
 func (cfg RouterConfig) authRequired() bool {
-	return cfg.AuthEnabled && cfg.JWTManager != nil
+	return cfg.AuthEnabled && cfg.Authenticator != nil
 }
 
 // requireAuth returns AuthMiddleware if auth is enabled, otherwise a no-op
@@ -49,7 +68,7 @@ func requireAuth(cfg RouterConfig) func(http.Handler) http.Handler {
 	if !cfg.authRequired() {
 		return func(next http.Handler) http.Handler { return next }
 	}
-	return middleware.AuthMiddleware(cfg.JWTManager)
+	return middleware.AuthMiddleware(cfg.Authenticator)
 }
 
 // requireRole returns RequireRole(role) if auth is enabled, otherwise a
@@ -85,6 +104,11 @@ func NewRouter(cfg RouterConfig) http.Handler {
 	r.Get("/ready", cfg.HealthHandler.Readiness)
 	r.Handle("/metrics", promhttp.Handler())
 
+	// API v1 generated from the protos, alongside the hand-written /api/v1
+	if cfg.GatewayHandler != nil {
+		r.Mount("/v1", cfg.GatewayHandler)
+	}
+
 	// API v1
 	r.Route("/api/v1", func(r chi.Router) {
 		// Idempotency middleware for mutating requests
@@ -93,12 +117,20 @@ func NewRouter(cfg RouterConfig) http.Handler {
 			r.Use(idempotencyMiddleware.Wrap)
 		}
 
-		// Login is public; every other /api/v1 route requires authentication
-		// when AuthEnabled is set (see requireAuth/requireRole above).
+		// Login and the event schemas are public; every other /api/v1 route
+		// requires authentication when AuthEnabled is set (see
+		// requireAuth/requireRole above).
 		if cfg.AuthHandler != nil {
 			r.Post("/auth/login", cfg.AuthHandler.Login)
 		}
 
+		// Event schemas describe no ledger data, and tools resolving an
+		// event's dataschema URL can't authenticate.
+		if cfg.EventSchemaHandler != nil {
+			r.Get("/events/schemas", cfg.EventSchemaHandler.List)
+			r.Get("/events/schemas/{type}/{version}", cfg.EventSchemaHandler.Get)
+		}
+
 		r.Group(func(r chi.Router) {
 			r.Use(requireAuth(cfg))
 
@@ -106,6 +138,11 @@ func NewRouter(cfg RouterConfig) http.Handler {
 				r.Get("/auth/me", cfg.AuthHandler.GetCurrentUser)
 			}
 
+			// Any authenticated user may change their own password.
+			if cfg.UserHandler != nil {
+				r.Post("/auth/password", cfg.UserHandler.ChangePassword)
+			}
+
 			// Ledger endpoints - any authenticated role may view.
 			r.Get("/ledger/consistency", cfg.LedgerHandler.CheckConsistency)
 
@@ -115,26 +152,92 @@ func NewRouter(cfg RouterConfig) http.Handler {
 				r.Get("/", cfg.AccountHandler.List)
 				r.Get("/{id}", cfg.AccountHandler.Get)
 				r.Get("/{id}/entries", cfg.EntryHandler.ListByAccount)
+				r.Get("/{id}/entries/export", cfg.EntryHandler.Export)
 				r.Get("/{id}/transfers", cfg.TransferHandler.ListByAccount)
+				r.Get("/{id}/holds", cfg.HoldHandler.ListByAccount)
 				r.Get("/{id}/balance/history", cfg.EntryHandler.GetHistoricalBalance)
+				if cfg.StatementHandler != nil {
+					r.Get("/{id}/statement", cfg.StatementHandler.Get)
+				}
 			})
 
 			// Transfers - mutations require operator (or admin), viewing is open.
 			r.Route("/transfers", func(r chi.Router) {
 				r.With(requireRole(cfg, domain.RoleOperator)).Post("/", cfg.TransferHandler.Create)
 				r.With(requireRole(cfg, domain.RoleOperator)).Post("/batch", cfg.TransferHandler.CreateBatch)
+				r.Get("/", cfg.TransferHandler.Search)
 				r.Get("/{id}", cfg.TransferHandler.Get)
 				r.Get("/{id}/entries", cfg.EntryHandler.ListByTransfer)
 				r.With(requireRole(cfg, domain.RoleOperator)).Post("/{id}/reverse", cfg.TransferHandler.Reverse)
 			})
 
-			// Holds - mutations require operator (or admin).
+			// Holds - mutations require operator (or admin), viewing is open.
 			r.Route("/holds", func(r chi.Router) {
 				r.With(requireRole(cfg, domain.RoleOperator)).Post("/", cfg.HoldHandler.Create)
+				r.Get("/{id}", cfg.HoldHandler.Get)
 				r.With(requireRole(cfg, domain.RoleOperator)).Post("/{id}/void", cfg.HoldHandler.Void)
 				r.With(requireRole(cfg, domain.RoleOperator)).Post("/{id}/capture", cfg.HoldHandler.Capture)
 			})
 
+			// Reports - any authenticated role may view, like the ledger
+			// reads they summarize.
+			if cfg.ReportingHandler != nil {
+				r.Route("/reports", func(r chi.Router) {
+					r.Get("/balances", cfg.ReportingHandler.Balances)
+					r.Get("/accounts/{id}/daily-volumes", cfg.ReportingHandler.DailyVolumes)
+					r.Get("/accounts/{id}/counterparties", cfg.ReportingHandler.CounterpartyTotals)
+				})
+			}
+
+			// Event stream - any authenticated role may watch, like the
+			// account and transfer reads it mirrors.
+			if cfg.EventStreamHandler != nil {
+				r.Get("/events/stream", cfg.EventStreamHandler.Stream)
+			}
+
+			// GraphQL - read-only, so any authenticated role may query;
+			// admin-only fields are checked by their resolvers.
+			if cfg.GraphQLHandler != nil {
+				r.Post("/graphql", cfg.GraphQLHandler.ServeHTTP)
+			}
+
+			// Webhooks - admin-only, as subscriptions see every ledger event.
+			if cfg.WebhookHandler != nil {
+				r.Route("/webhooks", func(r chi.Router) {
+					r.Use(requireRole(cfg, domain.RoleAdmin))
+					r.Post("/", cfg.WebhookHandler.Create)
+					r.Get("/", cfg.WebhookHandler.List)
+					r.Get("/{id}", cfg.WebhookHandler.Get)
+					r.Patch("/{id}", cfg.WebhookHandler.Update)
+					r.Delete("/{id}", cfg.WebhookHandler.Delete)
+					r.Get("/{id}/deliveries", cfg.WebhookHandler.ListDeliveries)
+					r.Get("/{id}/deliveries/{deliveryId}", cfg.WebhookHandler.GetDelivery)
+					r.Post("/{id}/deliveries/{deliveryId}/redeliver", cfg.WebhookHandler.Redeliver)
+				})
+			}
+
+			// Outbox - admin-only dead-letter replay and archiving.
+			if cfg.OutboxHandler != nil {
+				r.Route("/outbox/dead-letters", func(r chi.Router) {
+					r.Use(requireRole(cfg, domain.RoleAdmin))
+					r.Get("/", cfg.OutboxHandler.ListDeadLetters)
+					r.Post("/replay", cfg.OutboxHandler.ReplayDeadLetters)
+					r.Post("/archive", cfg.OutboxHandler.ArchiveDeadLetters)
+				})
+			}
+
+			// Users - admin-only management of accounts, roles and access.
+			if cfg.UserHandler != nil {
+				r.Route("/users", func(r chi.Router) {
+					r.Use(requireRole(cfg, domain.RoleAdmin))
+					r.Post("/", cfg.UserHandler.Create)
+					r.Get("/", cfg.UserHandler.List)
+					r.Get("/{id}", cfg.UserHandler.Get)
+					r.Patch("/{id}", cfg.UserHandler.Update)
+					r.Delete("/{id}", cfg.UserHandler.Delete)
+				})
+			}
+
 			// Audit - admin-only read access for examiners.
 			if cfg.AuditHandler != nil {
 				r.Route("/audit", func(r chi.Router) {
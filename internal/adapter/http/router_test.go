@@ -14,6 +14,7 @@ import (
 	"github.com/iho/goledger/internal/adapter/http/handler"
 	apimiddleware "github.com/iho/goledger/internal/adapter/http/middleware"
 	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/auth"
 	"github.com/iho/goledger/internal/usecase"
 )
 
@@ -93,8 +94,12 @@ func TestNewRouter_RegistersKeyRoutes(t *testing.T) {
 		"POST /api/v1/accounts/",
 		"GET /api/v1/accounts/",
 		"GET /api/v1/accounts/{id}",
+		"GET /api/v1/accounts/{id}/entries/export",
 		"POST /api/v1/transfers/",
+		"GET /api/v1/transfers/",
 		"POST /api/v1/holds/",
+		"GET /api/v1/holds/{id}",
+		"GET /api/v1/accounts/{id}/holds",
 	}
 
 	for _, route := range expected {
@@ -104,6 +109,137 @@ func TestNewRouter_RegistersKeyRoutes(t *testing.T) {
 	}
 }
 
+func TestNewRouter_MountsGatewayHandler(t *testing.T) {
+	var gotPath string
+	router := NewRouter(newRouterConfig(func(cfg *RouterConfig) {
+		cfg.GatewayHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			w.WriteHeader(http.StatusTeapot)
+		})
+	}))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/holds/hold-1:void", http.NoBody))
+
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected the gateway to handle /v1, got %d", rec.Code)
+	}
+	if gotPath != "/v1/holds/hold-1:void" {
+		t.Fatalf("expected the full path to reach the gateway, got %q", gotPath)
+	}
+}
+
+func TestNewRouter_GraphQLRequiresAuth(t *testing.T) {
+	jwtManager := auth.NewJWTManager("test-secret", time.Hour)
+	users := stubUsers{"viewer-1": {ID: "viewer-1", Role: domain.RoleViewer, Active: true}}
+	router := NewRouter(newRouterConfig(func(cfg *RouterConfig) {
+		cfg.AuthEnabled = true
+		cfg.Authenticator = auth.NewAuthenticator(jwtManager, users, 0)
+		cfg.GraphQLHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+	}))
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/v1/graphql", http.NoBody))
+	if rec.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 without a token, got %d", rec.Code)
+	}
+
+	token, err := jwtManager.Generate(&domain.User{ID: "viewer-1", Role: domain.RoleViewer})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/graphql", http.NoBody)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	if rec.Code != http.StatusTeapot {
+		t.Fatalf("expected a viewer to reach the GraphQL handler, got %d", rec.Code)
+	}
+}
+
+func TestNewRouter_UserRoutesAreAdminOnly(t *testing.T) {
+	jwtManager := auth.NewJWTManager("test-secret", time.Hour)
+	users := stubUsers{"viewer-1": {ID: "viewer-1", Role: domain.RoleViewer, Active: true}}
+	router := NewRouter(newRouterConfig(func(cfg *RouterConfig) {
+		cfg.AuthEnabled = true
+		cfg.Authenticator = auth.NewAuthenticator(jwtManager, users, 0)
+		cfg.UserHandler = handler.NewUserHandler(nil)
+	}))
+
+	token, err := jwtManager.Generate(&domain.User{ID: "viewer-1", Role: domain.RoleViewer})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	for _, path := range []string{"/api/v1/users/", "/api/v1/users/user-1"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("%s: expected 403 for a viewer, got %d", path, rec.Code)
+		}
+	}
+}
+
+func TestNewRouter_AuthUsesStoredUser(t *testing.T) {
+	jwtManager := auth.NewJWTManager("test-secret", time.Hour)
+	users := stubUsers{"admin-1": {ID: "admin-1", Role: domain.RoleAdmin, Active: true}}
+	router := NewRouter(newRouterConfig(func(cfg *RouterConfig) {
+		cfg.AuthEnabled = true
+		cfg.Authenticator = auth.NewAuthenticator(jwtManager, users, 0)
+		cfg.UserHandler = handler.NewUserHandler(&stubUserService{})
+	}))
+
+	token, err := jwtManager.Generate(&domain.User{ID: "admin-1", Role: domain.RoleAdmin})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	get := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/users/", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := get(); rec.Code != http.StatusOK {
+		t.Fatalf("expected an admin to list users, got %d", rec.Code)
+	}
+
+	t.Run("demoted admin's token loses admin routes", func(t *testing.T) {
+		users["admin-1"] = domain.User{ID: "admin-1", Role: domain.RoleViewer, Active: true}
+
+		if rec := get(); rec.Code != http.StatusForbidden {
+			t.Fatalf("expected 403 after demotion, got %d", rec.Code)
+		}
+	})
+
+	t.Run("deactivated user's existing token is rejected", func(t *testing.T) {
+		users["admin-1"] = domain.User{ID: "admin-1", Role: domain.RoleAdmin, Active: false}
+
+		rec := get()
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected 403 for an inactive user, got %d", rec.Code)
+		}
+		if !strings.Contains(rec.Body.String(), `"code":"USER_INACTIVE"`) {
+			t.Fatalf("expected USER_INACTIVE, got %s", rec.Body.String())
+		}
+	})
+
+	t.Run("deleted user's existing token is rejected", func(t *testing.T) {
+		delete(users, "admin-1")
+
+		if rec := get(); rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for a deleted user, got %d", rec.Code)
+		}
+	})
+}
+
 func newRouterConfig(opts ...func(*RouterConfig)) RouterConfig {
 	accountHandler := handler.NewAccountHandler(&stubAccountService{})
 	transferHandler := handler.NewTransferHandler(&stubTransferService{})
@@ -145,6 +281,10 @@ func (stubAccountService) ListAccounts(ctx context.Context, input usecase.ListAc
 	return []*domain.Account{}, nil
 }
 
+func (stubAccountService) ListAccountsCursor(ctx context.Context, input usecase.ListAccountsCursorInput) (*usecase.ListAccountsCursorResult, error) {
+	return &usecase.ListAccountsCursorResult{}, nil
+}
+
 type stubTransferService struct{}
 
 func (stubTransferService) CreateTransfer(ctx context.Context, input usecase.CreateTransferInput) (*domain.Transfer, error) {
@@ -167,6 +307,10 @@ func (stubTransferService) ListTransfersByAccountCursor(ctx context.Context, inp
 	return &usecase.ListTransfersByAccountCursorResult{}, nil
 }
 
+func (stubTransferService) SearchTransfers(ctx context.Context, input usecase.SearchTransfersInput) (*usecase.SearchTransfersResult, error) {
+	return &usecase.SearchTransfersResult{}, nil
+}
+
 func (stubTransferService) ReverseTransfer(ctx context.Context, input usecase.ReverseTransferInput) (*domain.Transfer, error) {
 	return &domain.Transfer{ID: input.TransferID}, nil
 }
@@ -185,6 +329,10 @@ func (stubEntryRepository) GetByAccount(ctx context.Context, accountID string, l
 	return []*domain.Entry{}, nil
 }
 
+func (stubEntryRepository) GetByAccountCursor(ctx context.Context, accountID, cursor string, limit int) ([]*domain.Entry, error) {
+	return []*domain.Entry{}, nil
+}
+
 func (stubEntryRepository) GetBalanceAtTime(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error) {
 	return decimal.Zero, nil
 }
@@ -197,6 +345,22 @@ func (stubEntryRepository) GetAllByAccountOrdered(ctx context.Context, accountID
 	return []*domain.Entry{}, nil
 }
 
+func (stubEntryRepository) SumAmountsByAccountTx(ctx context.Context, tx usecase.Transaction, accountID string) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
+func (stubEntryRepository) GetAllByAccountOrderedTx(ctx context.Context, tx usecase.Transaction, accountID string) ([]*domain.Entry, error) {
+	return []*domain.Entry{}, nil
+}
+
+func (stubEntryRepository) GetByAccountInRange(ctx context.Context, accountID string, from, to time.Time) ([]*domain.Entry, error) {
+	return []*domain.Entry{}, nil
+}
+
+func (stubEntryRepository) ExportByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+	return nil
+}
+
 type stubLedgerRepository struct{}
 
 func (stubLedgerRepository) CheckConsistency(ctx context.Context) (totalBalance, totalAmount decimal.Decimal, err error) {
@@ -207,6 +371,10 @@ func (stubLedgerRepository) CheckConsistencyByCurrency(ctx context.Context) ([]u
 	return nil, nil
 }
 
+func (stubLedgerRepository) CheckConsistencyByCurrencyTx(ctx context.Context, tx usecase.Transaction) ([]usecase.CurrencyConsistency, error) {
+	return nil, nil
+}
+
 type stubIdempotencyStore struct {
 	checkCalled bool
 }
@@ -219,3 +387,22 @@ func (s *stubIdempotencyStore) CheckAndSet(ctx context.Context, key string, resp
 func (s *stubIdempotencyStore) Update(ctx context.Context, key string, response []byte, ttl time.Duration) error {
 	return nil
 }
+
+// stubUsers is an auth.UserLoader over a map of stored users.
+type stubUsers map[string]domain.User
+
+func (s stubUsers) GetUser(_ context.Context, id string) (*domain.User, error) {
+	user, ok := s[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	return &user, nil
+}
+
+type stubUserService struct {
+	handler.UserService
+}
+
+func (stubUserService) ListUsers(ctx context.Context, limit, offset int) ([]*domain.User, error) {
+	return []*domain.User{}, nil
+}
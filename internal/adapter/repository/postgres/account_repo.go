@@ -88,6 +88,22 @@ func (r *AccountRepository) GetByIDForUpdate(ctx context.Context, tx usecase.Tra
 	return rowToAccount(row), nil
 }
 
+// GetByIDs retrieves the accounts with the given IDs, in no particular
+// order. Unknown IDs are skipped.
+func (r *AccountRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	rows, err := r.queries.GetAccountsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*domain.Account, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, rowToAccount(row))
+	}
+
+	return accounts, nil
+}
+
 // GetByIDsForUpdate retrieves multiple accounts by IDs with FOR UPDATE locks.
 func (r *AccountRepository) GetByIDsForUpdate(ctx context.Context, tx usecase.Transaction, ids []string) ([]*domain.Account, error) {
 	pgxTx := tx.(*Tx).PgxTx()
@@ -166,6 +182,26 @@ func (r *AccountRepository) List(ctx context.Context, limit, offset int) ([]*dom
 	return accounts, nil
 }
 
+// ListCursor lists accounts using keyset pagination on ULID id. An empty
+// cursor starts from the most recent account; pass the ID of the last
+// account from the previous page to continue.
+func (r *AccountRepository) ListCursor(ctx context.Context, cursor string, limit int) ([]*domain.Account, error) {
+	rows, err := r.queries.ListAccountsCursor(ctx, generated.ListAccountsCursorParams{
+		Cursor: cursor,
+		Limit:  toInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*domain.Account, 0, len(rows))
+	for _, row := range rows {
+		accounts = append(accounts, rowToAccount(row))
+	}
+
+	return accounts, nil
+}
+
 func rowToAccount(row generated.Account) *domain.Account {
 	return &domain.Account{
 		ID:                   row.ID,
@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/postgres/generated"
+)
+
+// AccrualRepository implements usecase.AccrualRepository.
+type AccrualRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+// NewAccrualRepository creates a new AccrualRepository.
+func NewAccrualRepository(pool *pgxpool.Pool) *AccrualRepository {
+	return &AccrualRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// CreateRule creates a new accrual rule.
+func (r *AccrualRepository) CreateRule(ctx context.Context, rule *domain.AccrualRule) error {
+	_, err := r.queries.CreateAccrualRule(ctx, generated.CreateAccrualRuleParams{
+		ID:                    rule.ID,
+		Name:                  rule.Name,
+		Kind:                  string(rule.Kind),
+		Schedule:              string(rule.Schedule),
+		AccountID:             rule.AccountID,
+		AccountGroup:          rule.AccountGroup,
+		CounterpartyAccountID: rule.CounterpartyAccountID,
+		Rate:                  decimalToNumeric(rule.Rate),
+		Amount:                decimalToNumeric(rule.Amount),
+		Precision:             rule.Precision,
+		Active:                rule.Active,
+		CreatedAt:             timeToPgTimestamptz(rule.CreatedAt),
+		UpdatedAt:             timeToPgTimestamptz(rule.UpdatedAt),
+	})
+
+	return err
+}
+
+// GetRule retrieves an accrual rule by ID.
+func (r *AccrualRepository) GetRule(ctx context.Context, id string) (*domain.AccrualRule, error) {
+	row, err := r.queries.GetAccrualRuleByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAccrualRuleNotFound
+		}
+		return nil, err
+	}
+
+	return rowToAccrualRule(row), nil
+}
+
+// ListRules lists accrual rules, oldest first.
+func (r *AccrualRepository) ListRules(ctx context.Context, limit, offset int) ([]*domain.AccrualRule, error) {
+	rows, err := r.queries.ListAccrualRules(ctx, generated.ListAccrualRulesParams{
+		Limit:  toInt32(limit),
+		Offset: toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*domain.AccrualRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, rowToAccrualRule(row))
+	}
+
+	return rules, nil
+}
+
+// ListActiveRules lists every active accrual rule.
+func (r *AccrualRepository) ListActiveRules(ctx context.Context) ([]*domain.AccrualRule, error) {
+	rows, err := r.queries.ListActiveAccrualRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*domain.AccrualRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, rowToAccrualRule(row))
+	}
+
+	return rules, nil
+}
+
+// SetRuleActive enables or disables an accrual rule.
+func (r *AccrualRepository) SetRuleActive(ctx context.Context, id string, active bool, updatedAt time.Time) error {
+	return r.queries.SetAccrualRuleActive(ctx, generated.SetAccrualRuleActiveParams{
+		ID:        id,
+		Active:    active,
+		UpdatedAt: timeToPgTimestamptz(updatedAt),
+	})
+}
+
+// AddGroupMember adds an account to an account group. Adding an existing
+// member is a no-op.
+func (r *AccrualRepository) AddGroupMember(ctx context.Context, group, accountID string, createdAt time.Time) error {
+	return r.queries.AddAccountGroupMember(ctx, generated.AddAccountGroupMemberParams{
+		GroupName: group,
+		AccountID: accountID,
+		CreatedAt: timeToPgTimestamptz(createdAt),
+	})
+}
+
+// RemoveGroupMember removes an account from an account group.
+func (r *AccrualRepository) RemoveGroupMember(ctx context.Context, group, accountID string) error {
+	return r.queries.RemoveAccountGroupMember(ctx, generated.RemoveAccountGroupMemberParams{
+		GroupName: group,
+		AccountID: accountID,
+	})
+}
+
+// ListGroupMembers lists the account IDs in an account group.
+func (r *AccrualRepository) ListGroupMembers(ctx context.Context, group string) ([]string, error) {
+	return r.queries.ListAccountGroupMembers(ctx, group)
+}
+
+// ClaimRun inserts a pending run, reporting claimed=false when the period
+// was already claimed.
+func (r *AccrualRepository) ClaimRun(ctx context.Context, run *domain.AccrualRun) (bool, error) {
+	row, err := r.queries.ClaimAccrualRun(ctx, generated.ClaimAccrualRunParams{
+		ID:          run.ID,
+		RuleID:      run.RuleID,
+		AccountID:   run.AccountID,
+		PeriodStart: pgtype.Date{Time: run.PeriodStart, Valid: true},
+		CreatedAt:   timeToPgTimestamptz(run.CreatedAt),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	run.Status = domain.AccrualRunStatus(row.Status)
+	run.UpdatedAt = row.UpdatedAt.Time
+
+	return true, nil
+}
+
+// CompleteRun stores the final status, amounts and transfer of a run.
+func (r *AccrualRepository) CompleteRun(ctx context.Context, run *domain.AccrualRun) error {
+	return r.queries.CompleteAccrualRun(ctx, generated.CompleteAccrualRunParams{
+		ID:         run.ID,
+		Status:     string(run.Status),
+		Balance:    decimalToNumeric(run.Balance),
+		Amount:     decimalToNumeric(run.Amount),
+		TransferID: run.TransferID,
+		UpdatedAt:  timeToPgTimestamptz(run.UpdatedAt),
+	})
+}
+
+// ReleaseRun deletes a still-pending claim.
+func (r *AccrualRepository) ReleaseRun(ctx context.Context, id string) error {
+	return r.queries.DeleteAccrualRun(ctx, id)
+}
+
+// ListStalePendingRuns lists up to limit pending runs last updated before
+// staleBefore, oldest claim first.
+func (r *AccrualRepository) ListStalePendingRuns(ctx context.Context, staleBefore time.Time, limit int) ([]*domain.AccrualRun, error) {
+	rows, err := r.queries.ListStalePendingAccrualRuns(ctx, generated.ListStalePendingAccrualRunsParams{
+		Limit:       toInt32(limit),
+		StaleBefore: timeToPgTimestamptz(staleBefore),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*domain.AccrualRun, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, rowToAccrualRun(row))
+	}
+
+	return runs, nil
+}
+
+// ListRuns lists accrual runs, most recent period first. An empty ruleID
+// lists runs for every rule.
+func (r *AccrualRepository) ListRuns(ctx context.Context, ruleID string, limit, offset int) ([]*domain.AccrualRun, error) {
+	rows, err := r.queries.ListAccrualRuns(ctx, generated.ListAccrualRunsParams{
+		RuleID: ruleID,
+		Limit:  toInt32(limit),
+		Offset: toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*domain.AccrualRun, 0, len(rows))
+	for _, row := range rows {
+		runs = append(runs, rowToAccrualRun(row))
+	}
+
+	return runs, nil
+}
+
+func rowToAccrualRule(row generated.AccrualRule) *domain.AccrualRule {
+	return &domain.AccrualRule{
+		ID:                    row.ID,
+		Name:                  row.Name,
+		Kind:                  domain.AccrualKind(row.Kind),
+		Schedule:              domain.AccrualSchedule(row.Schedule),
+		AccountID:             row.AccountID,
+		AccountGroup:          row.AccountGroup,
+		CounterpartyAccountID: row.CounterpartyAccountID,
+		Rate:                  numericToDecimal(row.Rate),
+		Amount:                numericToDecimal(row.Amount),
+		Precision:             row.Precision,
+		Active:                row.Active,
+		CreatedAt:             row.CreatedAt.Time,
+		UpdatedAt:             row.UpdatedAt.Time,
+	}
+}
+
+func rowToAccrualRun(row generated.AccrualRun) *domain.AccrualRun {
+	return &domain.AccrualRun{
+		ID:          row.ID,
+		RuleID:      row.RuleID,
+		AccountID:   row.AccountID,
+		PeriodStart: row.PeriodStart.Time,
+		Status:      domain.AccrualRunStatus(row.Status),
+		Balance:     numericToDecimal(row.Balance),
+		Amount:      numericToDecimal(row.Amount),
+		TransferID:  row.TransferID,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
+	}
+}
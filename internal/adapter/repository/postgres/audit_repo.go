@@ -125,7 +125,13 @@ func (r *AuditRepository) List(ctx context.Context, filter domain.AuditFilter) (
 		argPos++
 	}
 
-	query += ` ORDER BY created_at DESC`
+	if filter.After != nil {
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", argPos, argPos+1)
+		args = append(args, filter.After.At, filter.After.ID)
+		argPos += 2
+	}
+
+	query += ` ORDER BY created_at DESC, id DESC`
 
 	if filter.Limit > 0 {
 		query += fmt.Sprintf(" LIMIT $%d", argPos)
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// backupTableOrder maps each table a backup may touch to the order its
+// rows are exported in. The order matters on restore: transfers must come
+// before the reversals referencing them, and audit logs must be inserted
+// in chain order so the hash chain trigger rebuilds identical hashes.
+// Table names are only ever interpolated into SQL from this map.
+var backupTableOrder = map[string]string{
+	"accounts":   "id",
+	"transfers":  "created_at, id",
+	"entries":    "account_id, account_version",
+	"holds":      "created_at, id",
+	"audit_logs": "chain_seq",
+
+	"fee_policies":          "created_at, id",
+	"accrual_rules":         "created_at, id",
+	"account_group_members": "group_name, account_id",
+	"accrual_runs":          "period_start, created_at, id",
+	"webhook_subscriptions": "created_at, id",
+}
+
+// BackupRepository implements usecase.BackupRepository.
+type BackupRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewBackupRepository creates a new BackupRepository.
+func NewBackupRepository(pool *pgxpool.Pool) *BackupRepository {
+	return &BackupRepository{pool: pool}
+}
+
+// Export streams tables as JSON rows from one read-only, repeatable-read
+// transaction, so the archive is a consistent snapshot even while the
+// ledger keeps taking writes.
+func (r *BackupRepository) Export(ctx context.Context, tables []string, emit func(table string, row []byte) error) (*usecase.LedgerSnapshot, error) {
+	for _, table := range tables {
+		if _, ok := backupTableOrder[table]; !ok {
+			return nil, fmt.Errorf("table %q cannot be backed up", table)
+		}
+	}
+
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	snapshot := &usecase.LedgerSnapshot{}
+
+	snapshot.SchemaVersion, err = schemaVersion(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.AuditChainHead, err = auditChainHead(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, table := range tables {
+		if err := exportTable(ctx, tx, table, emit); err != nil {
+			return nil, fmt.Errorf("failed to export %s: %w", table, err)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// exportTable returns without committing or exiting, so its deferred
+// rows.Close() always runs.
+func exportTable(ctx context.Context, tx pgx.Tx, table string, emit func(table string, row []byte) error) error {
+	query := fmt.Sprintf("SELECT row_to_json(t)::text FROM %s t ORDER BY %s", table, backupTableOrder[table])
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+
+		if err := emit(table, row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// SchemaVersion returns the applied migration version.
+func (r *BackupRepository) SchemaVersion(ctx context.Context) (int64, error) {
+	return schemaVersion(ctx, r.pool)
+}
+
+// CountRows returns the total number of rows in tables.
+func (r *BackupRepository) CountRows(ctx context.Context, tables []string) (int64, error) {
+	counts := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if _, ok := backupTableOrder[table]; !ok {
+			return 0, fmt.Errorf("table %q cannot be backed up", table)
+		}
+		counts = append(counts, fmt.Sprintf("(SELECT count(*) FROM %s)", table))
+	}
+
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	var total int64
+	err := r.pool.QueryRow(ctx, "SELECT "+strings.Join(counts, " + ")).Scan(&total)
+
+	return total, err
+}
+
+// Restore inserts rows in order. Columns are matched by name, so rows
+// exported before a nullable column was added still restore.
+func (r *BackupRepository) Restore(ctx context.Context, tx usecase.Transaction, table string, rows [][]byte) error {
+	if _, ok := backupTableOrder[table]; !ok {
+		return fmt.Errorf("table %q cannot be restored", table)
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	doc := make([]byte, 0, len(rows)*256)
+	doc = append(doc, '[')
+	doc = append(doc, bytes.Join(rows, []byte{','})...)
+	doc = append(doc, ']')
+
+	query := fmt.Sprintf(`INSERT INTO %[1]s
+		SELECT r.* FROM json_array_elements($1::json) WITH ORDINALITY AS e(doc, n)
+		CROSS JOIN LATERAL json_populate_record(NULL::%[1]s, e.doc) AS r
+		ORDER BY e.n`, table)
+
+	pgxTx := tx.(*Tx).PgxTx()
+	_, err := pgxTx.Exec(ctx, query, string(doc))
+
+	return err
+}
+
+// AuditChainHead returns the hash of the latest audit log (all zeros when
+// there are none) as seen by tx.
+func (r *BackupRepository) AuditChainHead(ctx context.Context, tx usecase.Transaction) (string, error) {
+	return auditChainHead(ctx, tx.(*Tx).PgxTx())
+}
+
+// VerifyAuditChain runs verify_audit_log_chain() within tx and returns every
+// break.
+func (r *BackupRepository) VerifyAuditChain(ctx context.Context, tx usecase.Transaction) ([]usecase.AuditChainBreak, error) {
+	rows, err := tx.(*Tx).PgxTx().Query(ctx, "SELECT audit_id, reason FROM verify_audit_log_chain()")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	breaks := make([]usecase.AuditChainBreak, 0)
+	for rows.Next() {
+		var b usecase.AuditChainBreak
+		if err := rows.Scan(&b.AuditID, &b.Reason); err != nil {
+			return nil, err
+		}
+		breaks = append(breaks, b)
+	}
+
+	return breaks, rows.Err()
+}
+
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func schemaVersion(ctx context.Context, q queryRower) (int64, error) {
+	var version int64
+	var dirty bool
+	if err := q.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations").Scan(&version, &dirty); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	if dirty {
+		return 0, fmt.Errorf("schema version %d is dirty; fix the failed migration first", version)
+	}
+
+	return version, nil
+}
+
+func auditChainHead(ctx context.Context, q queryRower) (string, error) {
+	var head string
+	err := q.QueryRow(ctx, "SELECT last_hash FROM audit_log_chain_state").Scan(&head)
+
+	return head, err
+}
@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// backupExcludedTables are the tables deliberately left out of ledger
+// archives, and why. Every table a migration creates must be either backed
+// up or listed here, so a new table can't silently go missing on restore.
+var backupExcludedTables = map[string]string{
+	"users":                            "credentials are provisioned per environment",
+	"audit_log_chain_state":            "rebuilt by the audit log hash chain trigger",
+	"outbox_events":                    "delivery state, not ledger state",
+	"outbox_events_archive":            "delivery state, not ledger state",
+	"outbox_aggregate_sequences":       "delivery state, not ledger state",
+	"outbox_dead_letter_archive":       "delivery state, not ledger state",
+	"webhook_deliveries":               "delivery state, not ledger state",
+	"webhook_delivery_attempts":        "delivery state, not ledger state",
+	"reporting.projection_checkpoints": "read model, rebuilt by the projector",
+	"reporting.account_daily_volumes":  "read model, rebuilt by the projector",
+	"reporting.counterparty_totals":    "read model, rebuilt by the projector",
+	"reporting.account_balances":       "read model, rebuilt by the projector",
+}
+
+func TestBackupTablesHaveExportOrder(t *testing.T) {
+	for _, table := range usecase.LedgerBackupTables {
+		if _, ok := backupTableOrder[table]; !ok {
+			t.Errorf("backed-up table %q has no export order", table)
+		}
+	}
+
+	if len(backupTableOrder) != len(usecase.LedgerBackupTables) {
+		t.Errorf("backupTableOrder has %d tables, LedgerBackupTables %d", len(backupTableOrder), len(usecase.LedgerBackupTables))
+	}
+}
+
+func TestEveryMigratedTableIsBackedUpOrExcluded(t *testing.T) {
+	files, err := filepath.Glob("../../../infrastructure/postgres/migrations/*.up.sql")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("failed to find migrations: %v", err)
+	}
+
+	created := regexp.MustCompile(`(?m)^CREATE TABLE (?:IF NOT EXISTS )?([a-z_.]+) \(`)
+	dropped := regexp.MustCompile(`(?m)^DROP TABLE (?:IF EXISTS )?([a-z_.]+);`)
+
+	tables := make(map[string]bool)
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, m := range created.FindAllStringSubmatch(string(b), -1) {
+			tables[m[1]] = true
+		}
+		for _, m := range dropped.FindAllStringSubmatch(string(b), -1) {
+			delete(tables, m[1])
+		}
+	}
+
+	for table := range tables {
+		backedUp := slices.Contains(usecase.LedgerBackupTables, table)
+		_, excluded := backupExcludedTables[table]
+
+		switch {
+		case backedUp && excluded:
+			t.Errorf("table %q is both backed up and excluded", table)
+		case !backedUp && !excluded:
+			t.Errorf("table %q is neither backed up nor listed in backupExcludedTables", table)
+		}
+	}
+
+	for table := range backupExcludedTables {
+		if !tables[table] {
+			t.Errorf("excluded table %q is not created by any migration", table)
+		}
+	}
+}
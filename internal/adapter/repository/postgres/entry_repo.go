@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 
@@ -88,9 +90,39 @@ func (r *EntryRepository) GetByAccount(ctx context.Context, accountID string, li
 	return entries, nil
 }
 
+// GetByAccountCursor lists an account's entries using keyset pagination on
+// ULID id. An empty cursor starts from the most recent entry; pass the ID of
+// the last entry from the previous page to continue.
+func (r *EntryRepository) GetByAccountCursor(ctx context.Context, accountID, cursor string, limit int) ([]*domain.Entry, error) {
+	rows, err := r.queries.GetEntriesByAccountCursor(ctx, generated.GetEntriesByAccountCursorParams{
+		AccountID: accountID,
+		Cursor:    cursor,
+		Limit:     toInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, rowToEntry(row))
+	}
+
+	return entries, nil
+}
+
 // SumAmountsByAccount returns the sum of all entry amounts for an account.
 func (r *EntryRepository) SumAmountsByAccount(ctx context.Context, accountID string) (decimal.Decimal, error) {
-	sum, err := r.queries.SumEntryAmountsByAccount(ctx, accountID)
+	return sumAmountsByAccount(ctx, r.queries, accountID)
+}
+
+// SumAmountsByAccountTx is SumAmountsByAccount within tx.
+func (r *EntryRepository) SumAmountsByAccountTx(ctx context.Context, tx usecase.Transaction, accountID string) (decimal.Decimal, error) {
+	return sumAmountsByAccount(ctx, generated.New(tx.(*Tx).PgxTx()), accountID)
+}
+
+func sumAmountsByAccount(ctx context.Context, queries *generated.Queries, accountID string) (decimal.Decimal, error) {
+	sum, err := queries.SumEntryAmountsByAccount(ctx, accountID)
 	if err != nil {
 		return decimal.Zero, err
 	}
@@ -101,7 +133,16 @@ func (r *EntryRepository) SumAmountsByAccount(ctx context.Context, accountID str
 // GetAllByAccountOrdered returns every entry for an account ordered by
 // account_version ascending.
 func (r *EntryRepository) GetAllByAccountOrdered(ctx context.Context, accountID string) ([]*domain.Entry, error) {
-	rows, err := r.queries.GetEntriesByAccountOrdered(ctx, accountID)
+	return getAllByAccountOrdered(ctx, r.queries, accountID)
+}
+
+// GetAllByAccountOrderedTx is GetAllByAccountOrdered within tx.
+func (r *EntryRepository) GetAllByAccountOrderedTx(ctx context.Context, tx usecase.Transaction, accountID string) ([]*domain.Entry, error) {
+	return getAllByAccountOrdered(ctx, generated.New(tx.(*Tx).PgxTx()), accountID)
+}
+
+func getAllByAccountOrdered(ctx context.Context, queries *generated.Queries, accountID string) ([]*domain.Entry, error) {
+	rows, err := queries.GetEntriesByAccountOrdered(ctx, accountID)
 	if err != nil {
 		return nil, err
 	}
@@ -114,6 +155,98 @@ func (r *EntryRepository) GetAllByAccountOrdered(ctx context.Context, accountID
 	return entries, nil
 }
 
+// GetByAccountInRange returns an account's entries booked in [from, to),
+// ordered by account_version ascending.
+func (r *EntryRepository) GetByAccountInRange(ctx context.Context, accountID string, from, to time.Time) ([]*domain.Entry, error) {
+	rows, err := r.queries.GetEntriesByAccountInRange(ctx, generated.GetEntriesByAccountInRangeParams{
+		AccountID: accountID,
+		FromTime:  timeToPgTimestamptz(from),
+		ToTime:    timeToPgTimestamptz(to),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, rowToEntry(row))
+	}
+
+	return entries, nil
+}
+
+// entryExportQuery is declared as a server-side cursor by ExportByAccount.
+const entryExportQuery = `DECLARE entry_export NO SCROLL CURSOR FOR
+SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at
+FROM entries
+WHERE account_id = $1
+  AND ($2::timestamptz IS NULL OR created_at >= $2)
+  AND ($3::timestamptz IS NULL OR created_at < $3)
+ORDER BY account_version`
+
+// ExportByAccount streams the entries matching filter to emit in chain
+// order. It reads through a server-side cursor, domain.EntryExportBatchSize
+// rows at a time, inside one read-only, repeatable-read transaction, so the
+// export is a consistent snapshot held in bounded memory.
+func (r *EntryRepository) ExportByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	// The export may end because ctx expired; the rollback must still run.
+	defer func() { _ = tx.Rollback(context.WithoutCancel(ctx)) }()
+
+	var exists bool
+	if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM accounts WHERE id = $1)", filter.AccountID).Scan(&exists); err != nil {
+		return err
+	}
+
+	if !exists {
+		return domain.ErrAccountNotFound
+	}
+
+	if _, err := tx.Exec(ctx, entryExportQuery, filter.AccountID, filter.From, filter.To); err != nil {
+		return err
+	}
+
+	fetch := fmt.Sprintf("FETCH %d FROM entry_export", domain.EntryExportBatchSize)
+
+	for {
+		rows, err := tx.Query(ctx, fetch)
+		if err != nil {
+			return err
+		}
+
+		n := 0
+		for rows.Next() {
+			var row generated.Entry
+			if err := rows.Scan(
+				&row.ID, &row.AccountID, &row.TransferID, &row.Amount,
+				&row.AccountPreviousBalance, &row.AccountCurrentBalance,
+				&row.AccountVersion, &row.CreatedAt,
+			); err != nil {
+				rows.Close()
+				return err
+			}
+
+			n++
+			if err := emit(rowToEntry(row)); err != nil {
+				rows.Close()
+				return err
+			}
+		}
+
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		if n < domain.EntryExportBatchSize {
+			return nil
+		}
+	}
+}
+
 // GetBalanceAtTime retrieves the balance at a specific time.
 func (r *EntryRepository) GetBalanceAtTime(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error) {
 	balance, err := r.queries.GetAccountBalanceAtTime(ctx, generated.GetAccountBalanceAtTimeParams{
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/postgres/generated"
+)
+
+// FeePolicyRepository implements usecase.FeePolicyRepository.
+type FeePolicyRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+// NewFeePolicyRepository creates a new FeePolicyRepository.
+func NewFeePolicyRepository(pool *pgxpool.Pool) *FeePolicyRepository {
+	return &FeePolicyRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// Create creates a new fee policy.
+func (r *FeePolicyRepository) Create(ctx context.Context, policy *domain.FeePolicy) error {
+	var metadataMatch []byte
+	if len(policy.MetadataMatch) > 0 {
+		var err error
+		metadataMatch, err = json.Marshal(policy.MetadataMatch)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := r.queries.CreateFeePolicy(ctx, generated.CreateFeePolicyParams{
+		ID:               policy.ID,
+		Name:             policy.Name,
+		FromAccountID:    policy.FromAccountID,
+		ToAccountID:      policy.ToAccountID,
+		Currency:         policy.Currency,
+		MetadataMatch:    metadataMatch,
+		Payer:            string(policy.Payer),
+		RevenueAccountID: policy.RevenueAccountID,
+		Percentage:       decimalToNumeric(policy.Percentage),
+		FixedAmount:      decimalToNumeric(policy.FixedAmount),
+		Precision:        policy.Precision,
+		Active:           policy.Active,
+		CreatedAt:        timeToPgTimestamptz(policy.CreatedAt),
+		UpdatedAt:        timeToPgTimestamptz(policy.UpdatedAt),
+	})
+
+	return err
+}
+
+// GetByID retrieves a fee policy by ID.
+func (r *FeePolicyRepository) GetByID(ctx context.Context, id string) (*domain.FeePolicy, error) {
+	row, err := r.queries.GetFeePolicyByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrFeePolicyNotFound
+		}
+		return nil, err
+	}
+
+	return rowToFeePolicy(row), nil
+}
+
+// List lists fee policies, oldest first.
+func (r *FeePolicyRepository) List(ctx context.Context, limit, offset int) ([]*domain.FeePolicy, error) {
+	rows, err := r.queries.ListFeePolicies(ctx, generated.ListFeePoliciesParams{
+		Limit:  toInt32(limit),
+		Offset: toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*domain.FeePolicy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, rowToFeePolicy(row))
+	}
+
+	return policies, nil
+}
+
+// ListActive lists every active fee policy.
+func (r *FeePolicyRepository) ListActive(ctx context.Context) ([]*domain.FeePolicy, error) {
+	rows, err := r.queries.ListActiveFeePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*domain.FeePolicy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, rowToFeePolicy(row))
+	}
+
+	return policies, nil
+}
+
+// SetActive enables or disables a fee policy.
+func (r *FeePolicyRepository) SetActive(ctx context.Context, id string, active bool, updatedAt time.Time) error {
+	return r.queries.SetFeePolicyActive(ctx, generated.SetFeePolicyActiveParams{
+		ID:        id,
+		Active:    active,
+		UpdatedAt: timeToPgTimestamptz(updatedAt),
+	})
+}
+
+func rowToFeePolicy(row generated.FeePolicy) *domain.FeePolicy {
+	var metadataMatch map[string]string
+	if row.MetadataMatch != nil {
+		if err := json.Unmarshal(row.MetadataMatch, &metadataMatch); err != nil {
+			metadataMatch = nil
+		}
+	}
+
+	return &domain.FeePolicy{
+		ID:               row.ID,
+		Name:             row.Name,
+		FromAccountID:    row.FromAccountID,
+		ToAccountID:      row.ToAccountID,
+		Currency:         row.Currency,
+		MetadataMatch:    metadataMatch,
+		Payer:            domain.FeePayer(row.Payer),
+		RevenueAccountID: row.RevenueAccountID,
+		Percentage:       numericToDecimal(row.Percentage),
+		FixedAmount:      numericToDecimal(row.FixedAmount),
+		Precision:        row.Precision,
+		Active:           row.Active,
+		CreatedAt:        row.CreatedAt.Time,
+		UpdatedAt:        row.UpdatedAt.Time,
+	}
+}
@@ -122,6 +122,36 @@ func (r *HoldRepository) ListByAccount(ctx context.Context, accountID string, li
 	return holds, nil
 }
 
+// ListByAccountCursor lists holds for an account matching filter, using
+// keyset pagination on ULID id. An empty cursor starts from the most recent
+// hold; pass the ID of the last hold from the previous page to continue.
+func (r *HoldRepository) ListByAccountCursor(ctx context.Context, accountID string, filter domain.HoldFilter, cursor string, limit int) ([]*domain.Hold, error) {
+	var status *string
+	if filter.Status != "" {
+		s := string(filter.Status)
+		status = &s
+	}
+
+	rows, err := r.queries.ListHoldsByAccountCursor(ctx, generated.ListHoldsByAccountCursorParams{
+		AccountID:     accountID,
+		Limit:         toInt32(limit),
+		Cursor:        cursor,
+		Status:        status,
+		ExpiresAfter:  timePtrToPgTimestamptz(filter.ExpiresAfter),
+		ExpiresBefore: timePtrToPgTimestamptz(filter.ExpiresBefore),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holds := make([]*domain.Hold, 0, len(rows))
+	for _, row := range rows {
+		holds = append(holds, rowToHold(row))
+	}
+
+	return holds, nil
+}
+
 func rowToHold(row generated.Hold) *domain.Hold {
 	var expiresAt *time.Time
 	if row.ExpiresAt.Valid {
@@ -44,7 +44,15 @@ func (r *LedgerRepository) CheckConsistency(ctx context.Context) (totalDebits, t
 
 // CheckConsistencyByCurrency checks ledger consistency grouped by currency.
 func (r *LedgerRepository) CheckConsistencyByCurrency(ctx context.Context) ([]usecase.CurrencyConsistency, error) {
-	q := generated.New(r.pool)
+	return checkConsistencyByCurrency(ctx, generated.New(r.pool))
+}
+
+// CheckConsistencyByCurrencyTx is CheckConsistencyByCurrency within tx.
+func (r *LedgerRepository) CheckConsistencyByCurrencyTx(ctx context.Context, tx usecase.Transaction) ([]usecase.CurrencyConsistency, error) {
+	return checkConsistencyByCurrency(ctx, generated.New(tx.(*Tx).PgxTx()))
+}
+
+func checkConsistencyByCurrency(ctx context.Context, q *generated.Queries) ([]usecase.CurrencyConsistency, error) {
 	rows, err := q.CheckLedgerConsistencyByCurrency(ctx)
 	if err != nil {
 		return nil, err
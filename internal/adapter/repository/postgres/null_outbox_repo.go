@@ -24,7 +24,7 @@ func (r *NullOutboxRepository) GetUnpublished(ctx context.Context, limit int) ([
 	return nil, nil
 }
 
-func (r *NullOutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+func (r *NullOutboxRepository) MarkPublished(ctx context.Context, owner, id string, publishedAt time.Time) error {
 	return nil
 }
 
@@ -32,11 +32,7 @@ func (r *NullOutboxRepository) GetByAggregate(ctx context.Context, aggregateType
 	return nil, nil
 }
 
-func (r *NullOutboxRepository) DeletePublished(ctx context.Context, before time.Time) error {
-	return nil
-}
-
-func (r *NullOutboxRepository) RecordFailure(ctx context.Context, id, lastError string) (int, error) {
+func (r *NullOutboxRepository) RecordFailure(ctx context.Context, owner, id, lastError string) (int, error) {
 	return 0, nil
 }
 
@@ -47,3 +43,39 @@ func (r *NullOutboxRepository) MarkDeadLettered(ctx context.Context, id string,
 func (r *NullOutboxRepository) GetDeadLettered(ctx context.Context, limit, offset int) ([]*domain.OutboxEvent, error) {
 	return nil, nil
 }
+
+func (r *NullOutboxRepository) GetDeadLetteredCursor(ctx context.Context, after *domain.TimeCursor, limit int) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (r *NullOutboxRepository) ReplayDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (r *NullOutboxRepository) ArchiveDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter, reason string, at time.Time) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (r *NullOutboxRepository) ClaimUnpublished(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (r *NullOutboxRepository) ReleaseClaims(ctx context.Context, owner string, ids []string) error {
+	return nil
+}
+
+func (r *NullOutboxRepository) OldestUnpublishedAt(ctx context.Context) (*time.Time, error) {
+	return nil, nil
+}
+
+func (r *NullOutboxRepository) GetByID(ctx context.Context, id string) (*domain.OutboxEvent, error) {
+	return nil, domain.ErrOutboxEventNotFound
+}
+
+func (r *NullOutboxRepository) GetByAggregateSequence(ctx context.Context, aggregateType, aggregateID string, sequence int64) (*domain.OutboxEvent, error) {
+	return nil, domain.ErrOutboxEventNotFound
+}
+
+func (r *NullOutboxRepository) ListPublishedAfter(ctx context.Context, filter domain.EventStreamFilter, after domain.EventStreamPosition, limit int) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
@@ -3,8 +3,10 @@ package postgres
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/iho/goledger/internal/domain"
@@ -26,8 +28,14 @@ func NewOutboxRepository(pool *pgxpool.Pool) *OutboxRepository {
 	}
 }
 
-// Create creates a new outbox event within a transaction.
+// Create creates a new outbox event within a transaction. A payload that
+// does not match its event schema is rejected with
+// domain.ErrInvalidEventPayload, failing the transaction.
 func (r *OutboxRepository) Create(ctx context.Context, tx usecase.Transaction, event *domain.OutboxEvent) error {
+	if err := domain.ValidateOutboxEvent(event); err != nil {
+		return err
+	}
+
 	pgxTx := tx.(*Tx).PgxTx()
 	queries := generated.New(pgxTx)
 
@@ -76,12 +84,81 @@ func (r *OutboxRepository) GetUnpublished(ctx context.Context, limit int) ([]*do
 	return events, nil
 }
 
-// MarkPublished marks an event as published.
-func (r *OutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
-	return r.queries.MarkEventPublished(ctx, generated.MarkEventPublishedParams{
-		ID:          id,
+// ClaimUnpublished leases pending events to owner. Claims are serialized
+// with an advisory lock so concurrent claimers see each other's leases.
+func (r *OutboxRepository) ClaimUnpublished(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	queries := generated.New(tx)
+
+	if err := queries.LockOutboxClaims(ctx); err != nil {
+		return nil, err
+	}
+
+	rows, err := queries.ClaimOutboxEvents(ctx, generated.ClaimOutboxEventsParams{
+		LeaseUntil: timeToPgTimestamptz(leaseUntil),
+		LockedBy:   owner,
+		Now:        timeToPgTimestamptz(now),
+		BatchSize:  toInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, rowToOutboxEvent(row))
+	}
+
+	return events, nil
+}
+
+// ReleaseClaims drops owner's leases on the given events.
+func (r *OutboxRepository) ReleaseClaims(ctx context.Context, owner string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return r.queries.ReleaseOutboxClaims(ctx, generated.ReleaseOutboxClaimsParams{
+		Ids:      ids,
+		LockedBy: owner,
+	})
+}
+
+// OldestUnpublishedAt returns the creation time of the oldest pending event.
+func (r *OutboxRepository) OldestUnpublishedAt(ctx context.Context) (*time.Time, error) {
+	createdAt, err := r.queries.GetOldestPendingOutboxEventCreatedAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgTimestamptzToTimePtr(createdAt), nil
+}
+
+// MarkPublished marks an event owner holds the lease on as published.
+func (r *OutboxRepository) MarkPublished(ctx context.Context, owner, id string, publishedAt time.Time) error {
+	rows, err := r.queries.MarkEventPublished(ctx, generated.MarkEventPublishedParams{
 		PublishedAt: timeToPgTimestamptz(publishedAt),
+		ID:          id,
+		LockedBy:    owner,
 	})
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return domain.ErrOutboxLeaseLost
+	}
+
+	return nil
 }
 
 // GetByAggregate retrieves events for a specific aggregate.
@@ -104,18 +181,68 @@ func (r *OutboxRepository) GetByAggregate(ctx context.Context, aggregateType, ag
 	return events, nil
 }
 
-// DeletePublished deletes published events older than the given time.
-func (r *OutboxRepository) DeletePublished(ctx context.Context, before time.Time) error {
-	return r.queries.DeletePublishedEvents(ctx, timeToPgTimestamptz(before))
+// GetByID retrieves an event by ID.
+func (r *OutboxRepository) GetByID(ctx context.Context, id string) (*domain.OutboxEvent, error) {
+	row, err := r.queries.GetOutboxEvent(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrOutboxEventNotFound
+		}
+		return nil, err
+	}
+
+	return rowToOutboxEvent(row), nil
+}
+
+// GetByAggregateSequence retrieves an aggregate's event by its sequence.
+func (r *OutboxRepository) GetByAggregateSequence(ctx context.Context, aggregateType, aggregateID string, sequence int64) (*domain.OutboxEvent, error) {
+	row, err := r.queries.GetOutboxEventByAggregateSequence(ctx, generated.GetOutboxEventByAggregateSequenceParams{
+		AggregateType:     aggregateType,
+		AggregateID:       aggregateID,
+		AggregateSequence: sequence,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrOutboxEventNotFound
+		}
+		return nil, err
+	}
+
+	return rowToOutboxEvent(row), nil
+}
+
+// ListPublishedAfter lists published events after a position.
+func (r *OutboxRepository) ListPublishedAfter(ctx context.Context, filter domain.EventStreamFilter, after domain.EventStreamPosition, limit int) ([]*domain.OutboxEvent, error) {
+	rows, err := r.queries.ListPublishedOutboxEvents(ctx, generated.ListPublishedOutboxEventsParams{
+		AfterPublishedAt: timeToPgTimestamptz(after.PublishedAt),
+		AfterID:          after.EventID,
+		AggregateType:    filter.AggregateType,
+		AccountID:        filter.AccountID,
+		BatchSize:        toInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, rowToOutboxEvent(row))
+	}
+
+	return events, nil
 }
 
 // RecordFailure increments the event's attempt counter and stores the error.
-func (r *OutboxRepository) RecordFailure(ctx context.Context, id, lastError string) (int, error) {
+func (r *OutboxRepository) RecordFailure(ctx context.Context, owner, id, lastError string) (int, error) {
 	attempts, err := r.queries.RecordOutboxFailure(ctx, generated.RecordOutboxFailureParams{
-		ID:        id,
 		LastError: &lastError,
+		ID:        id,
+		LockedBy:  owner,
 	})
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrOutboxLeaseLost
+		}
 		return 0, err
 	}
 
@@ -148,6 +275,102 @@ func (r *OutboxRepository) GetDeadLettered(ctx context.Context, limit, offset in
 	return events, nil
 }
 
+// GetDeadLetteredCursor lists dead-lettered events using keyset pagination
+// on (dead_lettered_at, id). A nil after starts from the most recently
+// dead-lettered event.
+func (r *OutboxRepository) GetDeadLetteredCursor(ctx context.Context, after *domain.TimeCursor, limit int) ([]*domain.OutboxEvent, error) {
+	params := generated.GetDeadLetteredEventsCursorParams{Limit: toInt32(limit)}
+	if after != nil {
+		params.AfterAt = timeToPgTimestamptz(after.At)
+		params.AfterID = after.ID
+	}
+
+	rows, err := r.queries.GetDeadLetteredEventsCursor(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, rowToOutboxEvent(row))
+	}
+
+	return events, nil
+}
+
+// ReplayDeadLettered requeues the matching dead-lettered events.
+func (r *OutboxRepository) ReplayDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter) ([]*domain.OutboxEvent, error) {
+	queries := generated.New(tx.(*Tx).PgxTx())
+
+	rows, err := queries.ReplayDeadLetteredEvents(ctx, generated.ReplayDeadLetteredEventsParams{
+		Ids:         deadLetterIDs(filter),
+		EventType:   filter.EventType,
+		CreatedFrom: timePtrToPgTimestamptz(filter.CreatedFrom),
+		CreatedTo:   timePtrToPgTimestamptz(filter.CreatedTo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, rowToOutboxEvent(row))
+	}
+
+	return events, nil
+}
+
+// ArchiveDeadLettered moves the matching dead-lettered events to the
+// dead-letter archive.
+func (r *OutboxRepository) ArchiveDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter, reason string, at time.Time) ([]*domain.OutboxEvent, error) {
+	queries := generated.New(tx.(*Tx).PgxTx())
+
+	rows, err := queries.ArchiveDeadLetteredEvents(ctx, generated.ArchiveDeadLetteredEventsParams{
+		ArchivedAt:    timeToPgTimestamptz(at),
+		ArchiveReason: reason,
+		Ids:           deadLetterIDs(filter),
+		EventType:     filter.EventType,
+		CreatedFrom:   timePtrToPgTimestamptz(filter.CreatedFrom),
+		CreatedTo:     timePtrToPgTimestamptz(filter.CreatedTo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.OutboxEvent, 0, len(rows))
+	for _, row := range rows {
+		var lastError string
+		if row.LastError != nil {
+			lastError = *row.LastError
+		}
+
+		events = append(events, &domain.OutboxEvent{
+			ID:                row.ID,
+			AggregateID:       row.AggregateID,
+			AggregateType:     row.AggregateType,
+			EventType:         row.EventType,
+			CreatedAt:         row.CreatedAt.Time,
+			EventVersion:      row.EventVersion,
+			AggregateSequence: row.AggregateSequence,
+			Attempts:          int(row.Attempts),
+			LastError:         lastError,
+			DeadLetteredAt:    pgTimestamptzToTimePtr(row.DeadLetteredAt),
+		})
+	}
+
+	return events, nil
+}
+
+// deadLetterIDs returns the filter's IDs as a non-nil slice, since a nil
+// slice would be sent as a NULL array and match nothing.
+func deadLetterIDs(filter domain.DeadLetterFilter) []string {
+	if filter.IDs == nil {
+		return []string{}
+	}
+
+	return filter.IDs
+}
+
 func rowToOutboxEvent(row generated.OutboxEvent) *domain.OutboxEvent {
 	var payload map[string]any
 	if row.Payload != nil {
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// partitionLockTimeout bounds how long detaching a partition waits for
+// its lock on outbox_events, which blocks outbox writes while queued.
+const partitionLockTimeout = "5s"
+
+// partitionBoundLayout formats partition bounds as timestamptz literals.
+const partitionBoundLayout = "2006-01-02 15:04:05-07"
+
+// OutboxRetentionRepository implements usecase.OutboxRetentionRepository.
+// Partition names are only ever interpolated into SQL as identifiers
+// derived from a domain.OutboxPartition.
+type OutboxRetentionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOutboxRetentionRepository creates a new OutboxRetentionRepository.
+func NewOutboxRetentionRepository(pool *pgxpool.Pool) *OutboxRetentionRepository {
+	return &OutboxRetentionRepository{pool: pool}
+}
+
+// EnsurePartition creates p via ensure_outbox_partition(), which moves p's
+// events out of the default partition in the same statement.
+func (r *OutboxRetentionRepository) EnsurePartition(ctx context.Context, p domain.OutboxPartition) error {
+	_, err := r.pool.Exec(ctx, "SELECT ensure_outbox_partition($1::date)", p.From)
+	return err
+}
+
+// ListPartitions lists outbox_events' monthly partitions, oldest first.
+func (r *OutboxRetentionRepository) ListPartitions(ctx context.Context) ([]domain.OutboxPartition, error) {
+	rows, err := r.pool.Query(ctx, `SELECT c.relname FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'outbox_events'::regclass`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	partitions := make([]domain.OutboxPartition, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+
+		p, err := domain.ParseOutboxPartitionName(name)
+		if errors.Is(err, domain.ErrInvalidOutboxPartition) {
+			continue // the default partition
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		partitions = append(partitions, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].From.Before(partitions[j].From) })
+
+	return partitions, nil
+}
+
+// CountDefaultPartition counts the events in outbox_events_default.
+func (r *OutboxRetentionRepository) CountDefaultPartition(ctx context.Context) (int64, error) {
+	var count int64
+	err := r.pool.QueryRow(ctx, "SELECT count(*) FROM outbox_events_default").Scan(&count)
+
+	return count, err
+}
+
+// PartitionStats counts p's events.
+func (r *OutboxRetentionRepository) PartitionStats(ctx context.Context, p domain.OutboxPartition) (domain.OutboxPartitionStats, error) {
+	return partitionStats(ctx, r.pool, p)
+}
+
+// ExportPartition streams p's events from one read-only, repeatable-read
+// transaction, in creation order.
+func (r *OutboxRetentionRepository) ExportPartition(ctx context.Context, p domain.OutboxPartition, emit func(row []byte) error) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	query := fmt.Sprintf("SELECT row_to_json(t)::text FROM %s t ORDER BY created_at, id", partitionIdentifier(p.Name))
+
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row []byte
+		if err := rows.Scan(&row); err != nil {
+			return err
+		}
+
+		if err := emit(row); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// DetachPartition detaches p from outbox_events. Waiting for the lock is
+// bounded by partitionLockTimeout, so a busy outbox fails the prune rather
+// than stalling writers behind it.
+func (r *OutboxRetentionRepository) DetachPartition(ctx context.Context, tx usecase.Transaction, p domain.OutboxPartition) (domain.OutboxPartitionStats, error) {
+	pgxTx := tx.(*Tx).PgxTx()
+
+	if _, err := pgxTx.Exec(ctx, "SET LOCAL lock_timeout = '"+partitionLockTimeout+"'"); err != nil {
+		return domain.OutboxPartitionStats{}, err
+	}
+
+	if _, err := pgxTx.Exec(ctx, "ALTER TABLE outbox_events DETACH PARTITION "+partitionIdentifier(p.Name)); err != nil {
+		return domain.OutboxPartitionStats{}, err
+	}
+
+	return partitionStats(ctx, pgxTx, p)
+}
+
+// ArchivePartition renames a detached partition and attaches it to
+// outbox_events_archive. The partition's bounds CHECK constraint lets the
+// attach skip scanning it.
+func (r *OutboxRetentionRepository) ArchivePartition(ctx context.Context, tx usecase.Transaction, p domain.OutboxPartition) error {
+	pgxTx := tx.(*Tx).PgxTx()
+
+	archiveName := partitionIdentifier(p.ArchiveName())
+
+	if _, err := pgxTx.Exec(ctx, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", partitionIdentifier(p.Name), archiveName)); err != nil {
+		return err
+	}
+
+	_, err := pgxTx.Exec(ctx, fmt.Sprintf("ALTER TABLE outbox_events_archive ATTACH PARTITION %s FOR VALUES FROM ('%s') TO ('%s')",
+		archiveName, p.From.Format(partitionBoundLayout), p.To.Format(partitionBoundLayout)))
+
+	return err
+}
+
+// DropPartition drops a detached partition.
+func (r *OutboxRetentionRepository) DropPartition(ctx context.Context, tx usecase.Transaction, p domain.OutboxPartition) error {
+	_, err := tx.(*Tx).PgxTx().Exec(ctx, "DROP TABLE "+partitionIdentifier(p.Name))
+	return err
+}
+
+func partitionStats(ctx context.Context, q queryRower, p domain.OutboxPartition) (domain.OutboxPartitionStats, error) {
+	var stats domain.OutboxPartitionStats
+	query := fmt.Sprintf("SELECT count(*), count(*) FILTER (WHERE NOT published) FROM %s", partitionIdentifier(p.Name))
+	err := q.QueryRow(ctx, query).Scan(&stats.Events, &stats.Unpublished)
+
+	return stats, err
+}
+
+func partitionIdentifier(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
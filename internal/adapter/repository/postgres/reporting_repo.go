@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/postgres/generated"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// ReportingRepository implements usecase.ReportingRepository.
+type ReportingRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+// NewReportingRepository creates a new ReportingRepository.
+func NewReportingRepository(pool *pgxpool.Pool) *ReportingRepository {
+	return &ReportingRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// LockCheckpoint returns the projection's checkpoint, creating it if needed,
+// and locks it until tx ends.
+func (r *ReportingRepository) LockCheckpoint(ctx context.Context, tx usecase.Transaction, name string) (*domain.ProjectionCheckpoint, error) {
+	pgxTx := tx.(*Tx).PgxTx()
+	q := generated.New(pgxTx)
+
+	row, err := q.LockProjectionCheckpoint(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToProjectionCheckpoint(row), nil
+}
+
+// GetCheckpoint returns the projection's checkpoint. A projection that has
+// never run is at the start.
+func (r *ReportingRepository) GetCheckpoint(ctx context.Context, name string) (*domain.ProjectionCheckpoint, error) {
+	row, err := r.queries.GetProjectionCheckpoint(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.ProjectionCheckpoint{Name: name}, nil
+		}
+		return nil, err
+	}
+
+	return rowToProjectionCheckpoint(row), nil
+}
+
+// ListProjectableEntries lists up to limit entries after the cursor that no
+// running transaction can precede.
+func (r *ReportingRepository) ListProjectableEntries(ctx context.Context, tx usecase.Transaction, after domain.ProjectionCursor, limit int) ([]*domain.ProjectableEntry, error) {
+	pgxTx := tx.(*Tx).PgxTx()
+	q := generated.New(pgxTx)
+
+	rows, err := q.ListProjectableEntries(ctx, generated.ListProjectableEntriesParams{
+		AfterTxID:    after.TxID,
+		AfterEntryID: after.EntryID,
+		BatchSize:    toInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*domain.ProjectableEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, &domain.ProjectableEntry{
+			Cursor:                domain.ProjectionCursor{TxID: row.TxID, EntryID: row.ID},
+			AccountID:             row.AccountID,
+			TransferID:            row.TransferID,
+			Amount:                numericToDecimal(row.Amount),
+			AccountCurrentBalance: numericToDecimal(row.AccountCurrentBalance),
+			AccountVersion:        row.AccountVersion,
+			CreatedAt:             row.CreatedAt.Time,
+			EventAt:               row.EventAt.Time,
+			Currency:              row.Currency,
+			CounterpartyAccountID: row.CounterpartyAccountID,
+		})
+	}
+
+	return entries, nil
+}
+
+// NextUnprojectedAt returns when the next entry after the cursor was
+// created, or nil when the projection is caught up.
+func (r *ReportingRepository) NextUnprojectedAt(ctx context.Context, after domain.ProjectionCursor) (*time.Time, error) {
+	createdAt, err := r.queries.GetNextUnprojectedEntryTime(ctx, generated.GetNextUnprojectedEntryTimeParams{
+		AfterTxID:    after.TxID,
+		AfterEntryID: after.EntryID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return pgTimestamptzToTimePtr(createdAt), nil
+}
+
+// Apply adds the delta to the read models and advances the checkpoint to
+// the delta's cursor, all within tx.
+func (r *ReportingRepository) Apply(ctx context.Context, tx usecase.Transaction, name string, delta *domain.ReportingDelta, now time.Time) error {
+	pgxTx := tx.(*Tx).PgxTx()
+	q := generated.New(pgxTx)
+
+	for _, v := range delta.Volumes {
+		if err := q.AddAccountDailyVolume(ctx, generated.AddAccountDailyVolumeParams{
+			AccountID:   v.AccountID,
+			Day:         pgtype.Date{Time: v.Day, Valid: true},
+			Currency:    v.Currency,
+			DebitTotal:  decimalToNumeric(v.DebitTotal),
+			CreditTotal: decimalToNumeric(v.CreditTotal),
+			EntryCount:  v.EntryCount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range delta.Counterparties {
+		if err := q.AddCounterpartyTotal(ctx, generated.AddCounterpartyTotalParams{
+			AccountID:             c.AccountID,
+			CounterpartyAccountID: c.CounterpartyAccountID,
+			Currency:              c.Currency,
+			SentTotal:             decimalToNumeric(c.SentTotal),
+			ReceivedTotal:         decimalToNumeric(c.ReceivedTotal),
+			EntryCount:            c.EntryCount,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, b := range delta.Balances {
+		if err := q.SetAccountBalance(ctx, generated.SetAccountBalanceParams{
+			AccountID:      b.AccountID,
+			Currency:       b.Currency,
+			Balance:        decimalToNumeric(b.Balance),
+			AccountVersion: b.AccountVersion,
+			LastEntryID:    b.LastEntryID,
+			LastEntryAt:    timeToPgTimestamptz(b.LastEntryAt),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return q.AdvanceProjectionCheckpoint(ctx, generated.AdvanceProjectionCheckpointParams{
+		LastTxID:    delta.Cursor.TxID,
+		LastEntryID: delta.Cursor.EntryID,
+		Entries:     int64(delta.Entries),
+		UpdatedAt:   timeToPgTimestamptz(now),
+		Name:        name,
+	})
+}
+
+// Reset empties the read models and rewinds the checkpoint, within tx.
+func (r *ReportingRepository) Reset(ctx context.Context, tx usecase.Transaction, name string, now time.Time) error {
+	pgxTx := tx.(*Tx).PgxTx()
+	q := generated.New(pgxTx)
+
+	if err := q.TruncateReportingReadModels(ctx); err != nil {
+		return err
+	}
+
+	return q.ResetProjectionCheckpoint(ctx, generated.ResetProjectionCheckpointParams{
+		UpdatedAt: timeToPgTimestamptz(now),
+		Name:      name,
+	})
+}
+
+// ListDailyVolumes lists an account's daily volumes in [from, to), oldest
+// day first.
+func (r *ReportingRepository) ListDailyVolumes(ctx context.Context, accountID string, from, to time.Time) ([]*domain.AccountDailyVolume, error) {
+	rows, err := r.queries.ListAccountDailyVolumes(ctx, generated.ListAccountDailyVolumesParams{
+		AccountID: accountID,
+		FromDay:   pgtype.Date{Time: from, Valid: true},
+		ToDay:     pgtype.Date{Time: to, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := make([]*domain.AccountDailyVolume, 0, len(rows))
+	for _, row := range rows {
+		volumes = append(volumes, &domain.AccountDailyVolume{
+			AccountID:   row.AccountID,
+			Day:         row.Day.Time,
+			Currency:    row.Currency,
+			DebitTotal:  numericToDecimal(row.DebitTotal),
+			CreditTotal: numericToDecimal(row.CreditTotal),
+			EntryCount:  row.EntryCount,
+		})
+	}
+
+	return volumes, nil
+}
+
+// ListCounterpartyTotals lists an account's totals per counterparty,
+// largest volume first.
+func (r *ReportingRepository) ListCounterpartyTotals(ctx context.Context, accountID string, limit, offset int) ([]*domain.CounterpartyTotal, error) {
+	rows, err := r.queries.ListCounterpartyTotals(ctx, generated.ListCounterpartyTotalsParams{
+		AccountID: accountID,
+		Lim:       toInt32(limit),
+		Off:       toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	totals := make([]*domain.CounterpartyTotal, 0, len(rows))
+	for _, row := range rows {
+		totals = append(totals, &domain.CounterpartyTotal{
+			AccountID:             row.AccountID,
+			CounterpartyAccountID: row.CounterpartyAccountID,
+			Currency:              row.Currency,
+			SentTotal:             numericToDecimal(row.SentTotal),
+			ReceivedTotal:         numericToDecimal(row.ReceivedTotal),
+			EntryCount:            row.EntryCount,
+		})
+	}
+
+	return totals, nil
+}
+
+// ListBalances lists projected account balances by account ID.
+func (r *ReportingRepository) ListBalances(ctx context.Context, limit, offset int) ([]*domain.AccountBalanceSnapshot, error) {
+	rows, err := r.queries.ListReportingBalances(ctx, generated.ListReportingBalancesParams{
+		Lim: toInt32(limit),
+		Off: toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*domain.AccountBalanceSnapshot, 0, len(rows))
+	for _, row := range rows {
+		balances = append(balances, &domain.AccountBalanceSnapshot{
+			AccountID:      row.AccountID,
+			Currency:       row.Currency,
+			Balance:        numericToDecimal(row.Balance),
+			AccountVersion: row.AccountVersion,
+			LastEntryID:    row.LastEntryID,
+			LastEntryAt:    row.LastEntryAt.Time,
+		})
+	}
+
+	return balances, nil
+}
+
+func rowToProjectionCheckpoint(row generated.ReportingProjectionCheckpoint) *domain.ProjectionCheckpoint {
+	return &domain.ProjectionCheckpoint{
+		Name:             row.Name,
+		Cursor:           domain.ProjectionCursor{TxID: row.LastTxID, EntryID: row.LastEntryID},
+		EntriesProjected: row.EntriesProjected,
+		UpdatedAt:        row.UpdatedAt.Time,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -21,6 +23,10 @@ const pgErrUniqueViolation = "23505"
 // transfer can only be reversed once (see migration 000007).
 const reversalUniqueIndexName = "idx_transfers_reversed_transfer_id"
 
+// idempotencyKeyUniqueIndexName is the unique partial index on client
+// idempotency keys (see migration 000016).
+const idempotencyKeyUniqueIndexName = "idx_transfers_idempotency_key"
+
 // TransferRepository implements usecase.TransferRepository.
 type TransferRepository struct {
 	pool    *pgxpool.Pool
@@ -59,11 +65,17 @@ func (r *TransferRepository) Create(ctx context.Context, tx usecase.Transaction,
 		EventAt:            timeToPgTimestamptz(transfer.EventAt),
 		Metadata:           metadata,
 		ReversedTransferID: transfer.ReversedTransferID,
+		IdempotencyKey:     transfer.IdempotencyKey,
 	})
 	if err != nil {
 		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation && pgErr.ConstraintName == reversalUniqueIndexName {
-			return domain.ErrTransferAlreadyReversed
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation {
+			switch pgErr.ConstraintName {
+			case reversalUniqueIndexName:
+				return domain.ErrTransferAlreadyReversed
+			case idempotencyKeyUniqueIndexName:
+				return domain.ErrDuplicateIdempotencyKey
+			}
 		}
 
 		return err
@@ -86,6 +98,20 @@ func (r *TransferRepository) GetByID(ctx context.Context, id string) (*domain.Tr
 	return rowToTransfer(row), nil
 }
 
+// GetByIdempotencyKey retrieves the transfer created with an idempotency key.
+func (r *TransferRepository) GetByIdempotencyKey(ctx context.Context, key string) (*domain.Transfer, error) {
+	row, err := r.queries.GetTransferByIdempotencyKey(ctx, &key)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTransferNotFound
+		}
+
+		return nil, err
+	}
+
+	return rowToTransfer(row), nil
+}
+
 // ListByAccount lists transfers for an account.
 func (r *TransferRepository) ListByAccount(ctx context.Context, accountID string, limit, offset int) ([]*domain.Transfer, error) {
 	rows, err := r.queries.ListTransfersByAccount(ctx, generated.ListTransfersByAccountParams{
@@ -127,6 +153,162 @@ func (r *TransferRepository) ListByAccountCursor(ctx context.Context, accountID,
 	return transfers, nil
 }
 
+// transferSortColumns maps search sort fields to their column and the type
+// their cursor value is cast to. Each has an index on (column, id).
+var transferSortColumns = map[domain.TransferSortField]struct{ column, cast string }{
+	domain.TransferSortCreatedAt: {"t.created_at", "timestamptz"},
+	domain.TransferSortEventAt:   {"t.event_at", "timestamptz"},
+	domain.TransferSortAmount:    {"t.amount", "numeric"},
+}
+
+// Search lists transfers matching filter in sort order, using keyset
+// pagination on (sort key, id). A nil after starts from the first
+// transfer; pass the cursor of the last transfer seen to continue.
+func (r *TransferRepository) Search(ctx context.Context, filter domain.TransferSearchFilter, sort domain.TransferSort, after *domain.TransferCursor, limit int) ([]*domain.Transfer, error) {
+	sortColumn, ok := transferSortColumns[sort.Field]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown sort field %q", domain.ErrInvalidTransferSearch, sort.Field)
+	}
+
+	var (
+		conds []string
+		args  []any
+	)
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if filter.AccountID != "" {
+		switch filter.Direction {
+		case domain.TransferDirectionIncoming:
+			conds = append(conds, "t.to_account_id = "+arg(filter.AccountID))
+		case domain.TransferDirectionOutgoing:
+			conds = append(conds, "t.from_account_id = "+arg(filter.AccountID))
+		default:
+			p := arg(filter.AccountID)
+			conds = append(conds, fmt.Sprintf("(t.from_account_id = %s OR t.to_account_id = %s)", p, p))
+		}
+	}
+
+	if filter.MinAmount != nil {
+		conds = append(conds, "t.amount >= "+arg(decimalToNumeric(*filter.MinAmount)))
+	}
+
+	if filter.MaxAmount != nil {
+		conds = append(conds, "t.amount <= "+arg(decimalToNumeric(*filter.MaxAmount)))
+	}
+
+	if filter.EventAtFrom != nil {
+		conds = append(conds, "t.event_at >= "+arg(*filter.EventAtFrom))
+	}
+
+	if filter.EventAtTo != nil {
+		conds = append(conds, "t.event_at < "+arg(*filter.EventAtTo))
+	}
+
+	if filter.CreatedAtFrom != nil {
+		conds = append(conds, "t.created_at >= "+arg(*filter.CreatedAtFrom))
+	}
+
+	if filter.CreatedAtTo != nil {
+		conds = append(conds, "t.created_at < "+arg(*filter.CreatedAtTo))
+	}
+
+	// Both accounts of a transfer share its currency.
+	if filter.Currency != "" {
+		conds = append(conds, "EXISTS (SELECT 1 FROM accounts a WHERE a.id = t.from_account_id AND a.currency = "+arg(filter.Currency)+")")
+	}
+
+	const reversedCond = "EXISTS (SELECT 1 FROM transfers r WHERE r.reversed_transfer_id = t.id)"
+
+	switch filter.ReversalStatus {
+	case domain.TransferReversalStatusReversed:
+		conds = append(conds, reversedCond)
+	case domain.TransferReversalStatusNotReversed:
+		conds = append(conds, "t.reversed_transfer_id IS NULL", "NOT "+reversedCond)
+	case domain.TransferReversalStatusReversal:
+		conds = append(conds, "t.reversed_transfer_id IS NOT NULL")
+	}
+
+	if len(filter.Metadata) > 0 {
+		metadata, err := json.Marshal(filter.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		conds = append(conds, "t.metadata @> "+arg(string(metadata))+"::jsonb")
+	}
+
+	direction, cmp := "DESC", "<"
+	if sort.Ascending {
+		direction, cmp = "ASC", ">"
+	}
+
+	if after != nil {
+		conds = append(conds, fmt.Sprintf("(%s, t.id) %s (%s::%s, %s)",
+			sortColumn.column, cmp, arg(after.Value), sortColumn.cast, arg(after.ID)))
+	}
+
+	query := `
+		SELECT t.id, t.from_account_id, t.to_account_id, t.amount, t.created_at,
+		       t.event_at, t.metadata, t.reversed_transfer_id, t.idempotency_key
+		FROM transfers t`
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY %s %s, t.id %s LIMIT %s", sortColumn.column, direction, direction, arg(limit))
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transfers []*domain.Transfer
+	for rows.Next() {
+		var row generated.Transfer
+		if err := rows.Scan(
+			&row.ID,
+			&row.FromAccountID,
+			&row.ToAccountID,
+			&row.Amount,
+			&row.CreatedAt,
+			&row.EventAt,
+			&row.Metadata,
+			&row.ReversedTransferID,
+			&row.IdempotencyKey,
+		); err != nil {
+			return nil, err
+		}
+
+		transfers = append(transfers, rowToTransfer(row))
+	}
+
+	return transfers, rows.Err()
+}
+
+// GetByIDs retrieves the transfers with the given IDs.
+func (r *TransferRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Transfer, error) {
+	rows, err := r.queries.GetTransfersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]*domain.Transfer, 0, len(rows))
+	for _, row := range rows {
+		transfers = append(transfers, rowToTransfer(row))
+	}
+
+	return transfers, nil
+}
+
+// ExistingIdempotencyKeys returns which of the given idempotency keys are
+// already used by a transfer.
+func (r *TransferRepository) ExistingIdempotencyKeys(ctx context.Context, keys []string) ([]string, error) {
+	return r.queries.GetExistingIdempotencyKeys(ctx, keys)
+}
+
 func rowToTransfer(row generated.Transfer) *domain.Transfer {
 	var metadata map[string]any
 	if row.Metadata != nil {
@@ -146,5 +328,6 @@ func rowToTransfer(row generated.Transfer) *domain.Transfer {
 		EventAt:            row.EventAt.Time,
 		Metadata:           metadata,
 		ReversedTransferID: row.ReversedTransferID,
+		IdempotencyKey:     row.IdempotencyKey,
 	}
 }
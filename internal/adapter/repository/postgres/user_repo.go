@@ -5,11 +5,15 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
 )
 
+const userColumns = "id, email, name, hashed_password, role, active, created_at, updated_at"
+
 // UserRepository implements user persistence
 type UserRepository struct {
 	pool *pgxpool.Pool
@@ -20,14 +24,27 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 	return &UserRepository{pool: pool}
 }
 
+type userExecer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
 // Create inserts a new user
 func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
+	return r.create(ctx, r.pool, user)
+}
+
+// CreateTx inserts a new user within a transaction
+func (r *UserRepository) CreateTx(ctx context.Context, tx usecase.Transaction, user *domain.User) error {
+	return r.create(ctx, tx.(*Tx).PgxTx(), user)
+}
+
+func (r *UserRepository) create(ctx context.Context, db userExecer, user *domain.User) error {
 	query := `
 		INSERT INTO users (id, email, name, hashed_password, role, active, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	_, err := db.Exec(ctx, query,
 		user.ID,
 		user.Email,
 		user.Name,
@@ -38,72 +55,48 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 		user.UpdatedAt,
 	)
 
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation {
+		return domain.ErrUserAlreadyExists
+	}
+
 	return err
 }
 
 // GetByID retrieves a user by ID
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*domain.User, error) {
-	query := `
-		SELECT id, email, name, hashed_password, role, active, created_at, updated_at
-		FROM users
-		WHERE id = $1
-	`
-
-	var user domain.User
-	err := r.pool.QueryRow(ctx, query, id).Scan(
-		&user.ID,
-		&user.Email,
-		&user.Name,
-		&user.HashedPassword,
-		&user.Role,
-		&user.Active,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
-
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, errors.New("user not found")
-	}
+	return scanUser(r.pool.QueryRow(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1", id))
+}
 
-	return &user, err
+// GetByIDForUpdate retrieves a user by ID and locks its row until the
+// transaction ends
+func (r *UserRepository) GetByIDForUpdate(ctx context.Context, tx usecase.Transaction, id string) (*domain.User, error) {
+	return scanUser(tx.(*Tx).PgxTx().QueryRow(ctx, "SELECT "+userColumns+" FROM users WHERE id = $1 FOR UPDATE", id))
 }
 
 // GetByEmail retrieves a user by email
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
-	query := `
-		SELECT id, email, name, hashed_password, role, active, created_at, updated_at
-		FROM users
-		WHERE email = $1
-	`
-
-	var user domain.User
-	err := r.pool.QueryRow(ctx, query, email).Scan(
-		&user.ID,
-		&user.Email,
-		&user.Name,
-		&user.HashedPassword,
-		&user.Role,
-		&user.Active,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
-
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, errors.New("user not found")
-	}
-
-	return &user, err
+	return scanUser(r.pool.QueryRow(ctx, "SELECT "+userColumns+" FROM users WHERE email = $1", email))
 }
 
 // Update updates a user
 func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
+	return r.update(ctx, r.pool, user)
+}
+
+// UpdateTx updates a user within a transaction
+func (r *UserRepository) UpdateTx(ctx context.Context, tx usecase.Transaction, user *domain.User) error {
+	return r.update(ctx, tx.(*Tx).PgxTx(), user)
+}
+
+func (r *UserRepository) update(ctx context.Context, db userExecer, user *domain.User) error {
 	query := `
 		UPDATE users
 		SET name = $2, hashed_password = $3, role = $4, active = $5, updated_at = $6
 		WHERE id = $1
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	tag, err := db.Exec(ctx, query,
 		user.ID,
 		user.Name,
 		user.HashedPassword,
@@ -111,25 +104,61 @@ func (r *UserRepository) Update(ctx context.Context, user *domain.User) error {
 		user.Active,
 		user.UpdatedAt,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if tag.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
 }
 
 // Delete deletes a user
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM users WHERE id = $1`
-	_, err := r.pool.Exec(ctx, query, id)
-	return err
+	return r.delete(ctx, r.pool, id)
+}
+
+// DeleteTx deletes a user within a transaction
+func (r *UserRepository) DeleteTx(ctx context.Context, tx usecase.Transaction, id string) error {
+	return r.delete(ctx, tx.(*Tx).PgxTx(), id)
+}
+
+func (r *UserRepository) delete(ctx context.Context, db userExecer, id string) error {
+	tag, err := db.Exec(ctx, `DELETE FROM users WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// CountActiveAdminsForUpdate counts active admins, locking their rows so
+// concurrent role changes can't together remove the last one
+func (r *UserRepository) CountActiveAdminsForUpdate(ctx context.Context, tx usecase.Transaction) (int, error) {
+	rows, err := tx.(*Tx).PgxTx().Query(ctx,
+		"SELECT id FROM users WHERE role = $1 AND active ORDER BY id FOR UPDATE", domain.RoleAdmin)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	count := 0
+	for rows.Next() {
+		count++
+	}
+
+	return count, rows.Err()
 }
 
 // List retrieves all users with pagination
 func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*domain.User, error) {
-	query := `
-		SELECT id, email, name, hashed_password, role, active, created_at, updated_at
-		FROM users
-		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
+	query := "SELECT " + userColumns + " FROM users ORDER BY created_at DESC LIMIT $1 OFFSET $2"
 
 	rows, err := r.pool.Query(ctx, query, limit, offset)
 	if err != nil {
@@ -139,22 +168,35 @@ func (r *UserRepository) List(ctx context.Context, limit, offset int) ([]*domain
 
 	var users []*domain.User
 	for rows.Next() {
-		var user domain.User
-		err := rows.Scan(
-			&user.ID,
-			&user.Email,
-			&user.Name,
-			&user.HashedPassword,
-			&user.Role,
-			&user.Active,
-			&user.CreatedAt,
-			&user.UpdatedAt,
-		)
+		user, err := scanUser(rows)
 		if err != nil {
 			return nil, err
 		}
-		users = append(users, &user)
+		users = append(users, user)
 	}
 
 	return users, rows.Err()
 }
+
+func scanUser(row pgx.Row) (*domain.User, error) {
+	var user domain.User
+	err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.Name,
+		&user.HashedPassword,
+		&user.Role,
+		&user.Active,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,341 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/postgres/generated"
+)
+
+// WebhookRepository implements usecase.WebhookRepository.
+type WebhookRepository struct {
+	pool    *pgxpool.Pool
+	queries *generated.Queries
+}
+
+// NewWebhookRepository creates a new WebhookRepository.
+func NewWebhookRepository(pool *pgxpool.Pool) *WebhookRepository {
+	return &WebhookRepository{
+		pool:    pool,
+		queries: generated.New(pool),
+	}
+}
+
+// CreateSubscription creates a new webhook subscription.
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error {
+	_, err := r.queries.CreateWebhookSubscription(ctx, generated.CreateWebhookSubscriptionParams{
+		ID:            sub.ID,
+		Url:           sub.URL,
+		Secret:        sub.Secret,
+		EventTypes:    sub.EventTypes,
+		AggregateType: sub.AggregateType,
+		AggregateID:   sub.AggregateID,
+		Description:   sub.Description,
+		Active:        sub.Active,
+		CreatedAt:     timeToPgTimestamptz(sub.CreatedAt),
+		UpdatedAt:     timeToPgTimestamptz(sub.UpdatedAt),
+	})
+
+	return err
+}
+
+// GetSubscription retrieves a webhook subscription by ID.
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	row, err := r.queries.GetWebhookSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	return rowToWebhookSubscription(row), nil
+}
+
+// ListSubscriptions lists webhook subscriptions, oldest first.
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	rows, err := r.queries.ListWebhookSubscriptions(ctx, generated.ListWebhookSubscriptionsParams{
+		Limit:  toInt32(limit),
+		Offset: toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*domain.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		subs = append(subs, rowToWebhookSubscription(row))
+	}
+
+	return subs, nil
+}
+
+// ListActiveSubscriptions lists every active webhook subscription.
+func (r *WebhookRepository) ListActiveSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	rows, err := r.queries.ListActiveWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*domain.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		subs = append(subs, rowToWebhookSubscription(row))
+	}
+
+	return subs, nil
+}
+
+// UpdateSubscription stores a subscription's mutable fields.
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error {
+	return r.queries.UpdateWebhookSubscription(ctx, generated.UpdateWebhookSubscriptionParams{
+		ID:            sub.ID,
+		Url:           sub.URL,
+		EventTypes:    sub.EventTypes,
+		AggregateType: sub.AggregateType,
+		AggregateID:   sub.AggregateID,
+		Description:   sub.Description,
+		Active:        sub.Active,
+		UpdatedAt:     timeToPgTimestamptz(sub.UpdatedAt),
+	})
+}
+
+// DeleteSubscription deletes a subscription; its deliveries cascade.
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	n, err := r.queries.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return domain.ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// CreateDelivery queues a delivery unless the subscription already has one
+// for the same event.
+func (r *WebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	return r.queries.CreateWebhookDelivery(ctx, generated.CreateWebhookDeliveryParams{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Body:           delivery.Body,
+		NextAttemptAt:  timeToPgTimestamptz(delivery.NextAttemptAt),
+		CreatedAt:      timeToPgTimestamptz(delivery.CreatedAt),
+	})
+}
+
+// ClaimDue leases due deliveries of active subscriptions.
+func (r *WebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	rows, err := r.queries.ClaimDueWebhookDeliveries(ctx, generated.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: timeToPgTimestamptz(leaseUntil),
+		Now:        timeToPgTimestamptz(now),
+		BatchSize:  toInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, rowToWebhookDelivery(row))
+	}
+
+	return deliveries, nil
+}
+
+// GetDelivery retrieves a delivery by ID.
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	row, err := r.queries.GetWebhookDeliveryByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookDeliveryNotFound
+		}
+		return nil, err
+	}
+
+	return rowToWebhookDelivery(row), nil
+}
+
+// ListDeliveries lists a subscription's deliveries, newest first.
+func (r *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	rows, err := r.queries.ListWebhookDeliveries(ctx, generated.ListWebhookDeliveriesParams{
+		SubscriptionID: subscriptionID,
+		Status:         string(status),
+		Lim:            toInt32(limit),
+		Off:            toInt32(offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]*domain.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, rowToWebhookDelivery(row))
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery stores the delivery's status and appends attempt, if any,
+// to its log in one transaction.
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	queries := generated.New(tx)
+
+	var lastError *string
+	if delivery.LastError != "" {
+		lastError = &delivery.LastError
+	}
+
+	if err := queries.UpdateWebhookDeliveryResult(ctx, generated.UpdateWebhookDeliveryResultParams{
+		ID:             delivery.ID,
+		Status:         string(delivery.Status),
+		Attempts:       toInt32(delivery.Attempts),
+		LastError:      lastError,
+		LastStatusCode: intToInt32Ptr(delivery.LastStatusCode),
+		NextAttemptAt:  timeToPgTimestamptz(delivery.NextAttemptAt),
+		DeliveredAt:    timePtrToPgTimestamptz(delivery.DeliveredAt),
+		DeadLetteredAt: timePtrToPgTimestamptz(delivery.DeadLetteredAt),
+		UpdatedAt:      timeToPgTimestamptz(delivery.UpdatedAt),
+	}); err != nil {
+		return err
+	}
+
+	if attempt != nil {
+		var attemptError *string
+		if attempt.Error != "" {
+			attemptError = &attempt.Error
+		}
+
+		if err := queries.CreateWebhookDeliveryAttempt(ctx, generated.CreateWebhookDeliveryAttemptParams{
+			DeliveryID:  attempt.DeliveryID,
+			Attempt:     toInt32(attempt.Attempt),
+			StatusCode:  intToInt32Ptr(attempt.StatusCode),
+			Error:       attemptError,
+			DurationMs:  attempt.Duration.Milliseconds(),
+			AttemptedAt: timeToPgTimestamptz(attempt.AttemptedAt),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ListAttempts returns a delivery's attempt log, oldest first.
+func (r *WebhookRepository) ListAttempts(ctx context.Context, deliveryID string) ([]*domain.WebhookDeliveryAttempt, error) {
+	rows, err := r.queries.ListWebhookDeliveryAttempts(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*domain.WebhookDeliveryAttempt, 0, len(rows))
+	for _, row := range rows {
+		var errText string
+		if row.Error != nil {
+			errText = *row.Error
+		}
+
+		attempts = append(attempts, &domain.WebhookDeliveryAttempt{
+			DeliveryID:  row.DeliveryID,
+			Attempt:     int(row.Attempt),
+			StatusCode:  int32PtrToInt(row.StatusCode),
+			Error:       errText,
+			Duration:    time.Duration(row.DurationMs) * time.Millisecond,
+			AttemptedAt: row.AttemptedAt.Time,
+		})
+	}
+
+	return attempts, nil
+}
+
+func rowToWebhookSubscription(row generated.WebhookSubscription) *domain.WebhookSubscription {
+	return &domain.WebhookSubscription{
+		ID:            row.ID,
+		URL:           row.Url,
+		Secret:        row.Secret,
+		EventTypes:    row.EventTypes,
+		AggregateType: row.AggregateType,
+		AggregateID:   row.AggregateID,
+		Description:   row.Description,
+		Active:        row.Active,
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt.Time,
+	}
+}
+
+func rowToWebhookDelivery(row generated.WebhookDelivery) *domain.WebhookDelivery {
+	var lastError string
+	if row.LastError != nil {
+		lastError = *row.LastError
+	}
+
+	return &domain.WebhookDelivery{
+		ID:             row.ID,
+		SubscriptionID: row.SubscriptionID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Body:           row.Body,
+		Status:         domain.WebhookDeliveryStatus(row.Status),
+		Attempts:       int(row.Attempts),
+		LastError:      lastError,
+		LastStatusCode: int32PtrToInt(row.LastStatusCode),
+		NextAttemptAt:  row.NextAttemptAt.Time,
+		DeliveredAt:    pgTimestamptzToTimePtr(row.DeliveredAt),
+		DeadLetteredAt: pgTimestamptzToTimePtr(row.DeadLetteredAt),
+		CreatedAt:      row.CreatedAt.Time,
+		UpdatedAt:      row.UpdatedAt.Time,
+	}
+}
+
+func timePtrToPgTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+
+	return timeToPgTimestamptz(*t)
+}
+
+func pgTimestamptzToTimePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+
+	v := t.Time
+
+	return &v
+}
+
+func intToInt32Ptr(n *int) *int32 {
+	if n == nil {
+		return nil
+	}
+
+	v := toInt32(*n)
+
+	return &v
+}
+
+func int32PtrToInt(n *int32) *int {
+	if n == nil {
+		return nil
+	}
+
+	v := int(*n)
+
+	return &v
+}
@@ -25,6 +25,17 @@ func (a *Account) AvailableBalance() decimal.Decimal {
 	return a.Balance.Sub(a.EncumberedBalance)
 }
 
+// CheckVersion checks that the account is at expected, when set. Callers
+// pass the version a client last read, so it acts on a balance that
+// hasn't changed since.
+func (a *Account) CheckVersion(expected *int64) error {
+	if expected != nil && *expected != a.Version {
+		return ErrVersionMismatch
+	}
+
+	return nil
+}
+
 // ValidateDebit checks if account can be debited by amount.
 func (a *Account) ValidateDebit(amount decimal.Decimal) error {
 	newBalance := a.AvailableBalance().Sub(amount)
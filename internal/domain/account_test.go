@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -134,3 +135,18 @@ func TestAccount_ApplyCredit(t *testing.T) {
 		t.Errorf("expected balance %s, got %s", expected, newBalance)
 	}
 }
+
+func TestAccount_CheckVersion(t *testing.T) {
+	account := &Account{Version: 3}
+	version := func(v int64) *int64 { return &v }
+
+	if err := account.CheckVersion(nil); err != nil {
+		t.Errorf("expected no precondition to pass, got %v", err)
+	}
+	if err := account.CheckVersion(version(3)); err != nil {
+		t.Errorf("expected the current version to pass, got %v", err)
+	}
+	if err := account.CheckVersion(version(2)); !errors.Is(err, ErrVersionMismatch) {
+		t.Errorf("expected ErrVersionMismatch for a stale version, got %v", err)
+	}
+}
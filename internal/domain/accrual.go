@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrAccrualRuleNotFound = errors.New("accrual rule not found")
+	ErrInvalidAccrualRule  = errors.New("invalid accrual rule")
+)
+
+// AccrualKind is what an accrual rule posts: interest paid to the account,
+// or a fee charged from it.
+type AccrualKind string
+
+const (
+	AccrualKindInterest AccrualKind = "interest"
+	AccrualKindFee      AccrualKind = "fee"
+)
+
+// AccrualSchedule is how often a rule accrues.
+type AccrualSchedule string
+
+const (
+	AccrualScheduleDaily   AccrualSchedule = "daily"
+	AccrualScheduleMonthly AccrualSchedule = "monthly"
+)
+
+// AccrualRunStatus is the state of a single (rule, account, period) accrual.
+type AccrualRunStatus string
+
+const (
+	// AccrualRunStatusPending means the period has been claimed but the
+	// transfer has not been posted yet (or the runner crashed in between).
+	AccrualRunStatusPending AccrualRunStatus = "pending"
+	// AccrualRunStatusPosted means the accrual transfer was created.
+	AccrualRunStatusPosted AccrualRunStatus = "posted"
+	// AccrualRunStatusSkipped means the computed amount was zero (e.g. no
+	// positive balance to pay interest on), so nothing was posted.
+	AccrualRunStatusSkipped AccrualRunStatus = "skipped"
+)
+
+// DefaultAccrualPrecision is the number of decimal places accrual amounts
+// are rounded to when a rule doesn't specify one.
+const DefaultAccrualPrecision = 2
+
+// AccrualRule describes a recurring interest or fee posting, attached either
+// to a single account or to every member of an account group.
+//
+// Interest rules use Rate as an annual rate applied to the end-of-period
+// balance (pro-rated per day or month) and post from CounterpartyAccountID
+// (an interest expense account) to the target account. Fee rules charge the
+// flat Amount each period from the target account to CounterpartyAccountID
+// (a fee income account).
+type AccrualRule struct {
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	AccountID             *string
+	AccountGroup          *string
+	ID                    string
+	Name                  string
+	Kind                  AccrualKind
+	Schedule              AccrualSchedule
+	CounterpartyAccountID string
+	Rate                  decimal.Decimal
+	Amount                decimal.Decimal
+	Precision             int32
+	Active                bool
+}
+
+// Validate checks the rule is internally consistent.
+func (r *AccrualRule) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidAccrualRule)
+	}
+
+	if (r.AccountID == nil) == (r.AccountGroup == nil) {
+		return fmt.Errorf("%w: exactly one of account or account group must be set", ErrInvalidAccrualRule)
+	}
+
+	if r.CounterpartyAccountID == "" {
+		return fmt.Errorf("%w: counterparty account is required", ErrInvalidAccrualRule)
+	}
+
+	if r.AccountID != nil && *r.AccountID == r.CounterpartyAccountID {
+		return fmt.Errorf("%w: counterparty account must differ from the target account", ErrInvalidAccrualRule)
+	}
+
+	switch r.Schedule {
+	case AccrualScheduleDaily, AccrualScheduleMonthly:
+	default:
+		return fmt.Errorf("%w: unknown schedule %q", ErrInvalidAccrualRule, r.Schedule)
+	}
+
+	switch r.Kind {
+	case AccrualKindInterest:
+		if !r.Rate.IsPositive() {
+			return fmt.Errorf("%w: interest rate must be positive", ErrInvalidAccrualRule)
+		}
+	case AccrualKindFee:
+		if !r.Amount.IsPositive() {
+			return fmt.Errorf("%w: fee amount must be positive", ErrInvalidAccrualRule)
+		}
+	default:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidAccrualRule, r.Kind)
+	}
+
+	if r.Precision < 0 {
+		return fmt.Errorf("%w: precision must not be negative", ErrInvalidAccrualRule)
+	}
+
+	return nil
+}
+
+// Compute returns the amount to post for one period given the account's
+// end-of-period balance. Interest is only paid on a positive balance.
+func (r *AccrualRule) Compute(balance decimal.Decimal) decimal.Decimal {
+	switch r.Kind {
+	case AccrualKindInterest:
+		if !balance.IsPositive() {
+			return decimal.Zero
+		}
+
+		periodsPerYear := decimal.NewFromInt(365)
+		if r.Schedule == AccrualScheduleMonthly {
+			periodsPerYear = decimal.NewFromInt(12)
+		}
+
+		return balance.Mul(r.Rate).Div(periodsPerYear).RoundBank(r.Precision)
+	case AccrualKindFee:
+		return r.Amount.RoundBank(r.Precision)
+	default:
+		return decimal.Zero
+	}
+}
+
+// AccrualPeriod returns the period a completed business day closes for the
+// given schedule, and whether that period is complete as of day. Daily
+// periods are the day itself; monthly periods start on the first of the
+// month and only complete on its last day. Days are interpreted in UTC.
+func AccrualPeriod(schedule AccrualSchedule, day time.Time) (start, end time.Time, complete bool) {
+	y, m, d := day.UTC().Date()
+	dayStart := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	if schedule == AccrualScheduleMonthly {
+		start = time.Date(y, m, 1, 0, 0, 0, 0, time.UTC)
+		end = start.AddDate(0, 1, 0)
+		return start, end, dayStart.AddDate(0, 0, 1).Equal(end)
+	}
+
+	return dayStart, dayStart.AddDate(0, 0, 1), true
+}
+
+// AccrualRun records the outcome of one rule for one account and period.
+// (rule, account, period) is unique, which is what makes re-running the
+// accrual engine for a day it already processed a no-op.
+type AccrualRun struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PeriodStart time.Time
+	TransferID  *string
+	ID          string
+	RuleID      string
+	AccountID   string
+	Status      AccrualRunStatus
+	Balance     decimal.Decimal
+	Amount      decimal.Decimal
+}
+
+// IdempotencyKey is the idempotency key of the run's transfer. It is
+// derived from the (rule, account, period) the run is unique by, not the
+// run ID, so a later claim of a period whose earlier claim was released
+// after its transfer committed adopts that transfer instead of posting
+// again.
+func (r *AccrualRun) IdempotencyKey() string {
+	return fmt.Sprintf("accrual:%s:%s:%s", r.RuleID, r.AccountID, r.PeriodStart.Format(time.DateOnly))
+}
@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestAccrualRule_Validate(t *testing.T) {
+	account := "acc-1"
+	group := "savings"
+
+	valid := func() AccrualRule {
+		return AccrualRule{
+			Name:                  "Savings interest",
+			Kind:                  AccrualKindInterest,
+			Schedule:              AccrualScheduleDaily,
+			AccountID:             &account,
+			CounterpartyAccountID: "acc-expense",
+			Rate:                  decimal.RequireFromString("0.05"),
+			Precision:             2,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(r *AccrualRule)
+		valid  bool
+	}{
+		{"valid interest rule", func(r *AccrualRule) {}, true},
+		{"valid group fee rule", func(r *AccrualRule) {
+			r.AccountID, r.AccountGroup = nil, &group
+			r.Kind, r.Schedule = AccrualKindFee, AccrualScheduleMonthly
+			r.Amount = decimal.NewFromInt(5)
+		}, true},
+		{"missing name", func(r *AccrualRule) { r.Name = "" }, false},
+		{"no target", func(r *AccrualRule) { r.AccountID = nil }, false},
+		{"both account and group", func(r *AccrualRule) { r.AccountGroup = &group }, false},
+		{"counterparty is target", func(r *AccrualRule) { r.CounterpartyAccountID = account }, false},
+		{"unknown schedule", func(r *AccrualRule) { r.Schedule = "weekly" }, false},
+		{"unknown kind", func(r *AccrualRule) { r.Kind = "rebate" }, false},
+		{"interest without rate", func(r *AccrualRule) { r.Rate = decimal.Zero }, false},
+		{"fee without amount", func(r *AccrualRule) { r.Kind = AccrualKindFee }, false},
+		{"negative precision", func(r *AccrualRule) { r.Precision = -1 }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := valid()
+			tt.mutate(&r)
+
+			err := r.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid rule, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidAccrualRule) {
+				t.Fatalf("expected ErrInvalidAccrualRule, got %v", err)
+			}
+		})
+	}
+}
+
+func TestAccrualRule_Compute(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    AccrualRule
+		balance string
+		want    string
+	}{
+		{
+			name:    "daily interest on positive balance",
+			rule:    AccrualRule{Kind: AccrualKindInterest, Schedule: AccrualScheduleDaily, Rate: decimal.RequireFromString("0.0365"), Precision: 2},
+			balance: "10000",
+			want:    "1",
+		},
+		{
+			name:    "monthly interest",
+			rule:    AccrualRule{Kind: AccrualKindInterest, Schedule: AccrualScheduleMonthly, Rate: decimal.RequireFromString("0.12"), Precision: 2},
+			balance: "1000",
+			want:    "10",
+		},
+		{
+			name:    "interest rounds to precision",
+			rule:    AccrualRule{Kind: AccrualKindInterest, Schedule: AccrualScheduleDaily, Rate: decimal.RequireFromString("0.05"), Precision: 2},
+			balance: "123.45",
+			want:    "0.02",
+		},
+		{
+			name:    "no interest on negative balance",
+			rule:    AccrualRule{Kind: AccrualKindInterest, Schedule: AccrualScheduleDaily, Rate: decimal.RequireFromString("0.05"), Precision: 2},
+			balance: "-500",
+			want:    "0",
+		},
+		{
+			name:    "fee ignores balance",
+			rule:    AccrualRule{Kind: AccrualKindFee, Schedule: AccrualScheduleMonthly, Amount: decimal.RequireFromString("4.99"), Precision: 2},
+			balance: "0",
+			want:    "4.99",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Compute(decimal.RequireFromString(tt.balance))
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestAccrualPeriod(t *testing.T) {
+	day := time.Date(2026, 10, 17, 15, 30, 0, 0, time.UTC)
+
+	start, end, complete := AccrualPeriod(AccrualScheduleDaily, day)
+	if !start.Equal(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)) || !complete {
+		t.Fatalf("unexpected daily period: %s - %s complete=%v", start, end, complete)
+	}
+
+	start, end, complete = AccrualPeriod(AccrualScheduleMonthly, day)
+	if !start.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected monthly period: %s - %s", start, end)
+	}
+	if complete {
+		t.Fatal("expected mid-month monthly period to be incomplete")
+	}
+
+	if _, _, complete = AccrualPeriod(AccrualScheduleMonthly, time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)); !complete {
+		t.Fatal("expected monthly period to complete on the last day of the month")
+	}
+}
@@ -53,9 +53,19 @@ const (
 	AuditActionHoldCapture AuditAction = "hold.capture"
 	AuditActionHoldView    AuditAction = "hold.view"
 
+	// Outbox actions
+	AuditActionOutboxReplay  AuditAction = "outbox.replay"
+	AuditActionOutboxArchive AuditAction = "outbox.archive"
+
 	// Auth actions
 	AuditActionUserLogin  AuditAction = "user.login"
 	AuditActionUserLogout AuditAction = "user.logout"
+
+	// User management actions
+	AuditActionUserCreate         AuditAction = "user.create"
+	AuditActionUserUpdate         AuditAction = "user.update"
+	AuditActionUserDelete         AuditAction = "user.delete"
+	AuditActionUserPasswordChange AuditAction = "user.password_change"
 )
 
 // AuditStatus represents the status of an audited action
@@ -95,5 +105,21 @@ type AuditFilter struct {
 	StartDate    *time.Time
 	EndDate      *time.Time
 	Limit        int
-	Offset       int
+	// Offset pages by OFFSET; deprecated in favour of After.
+	Offset int
+	// After continues a listing after the last log of the previous page
+	// (newest first, by created_at then ID).
+	After *TimeCursor
+}
+
+// NextAuditCursor returns the encoded cursor after a page of logs listed
+// with the given limit, or "" when the page is the last.
+func NextAuditCursor(logs []*AuditLog, limit int) string {
+	if limit <= 0 || len(logs) < limit {
+		return ""
+	}
+
+	last := logs[len(logs)-1]
+
+	return TimeCursor{At: last.CreatedAt, ID: last.ID}.Encode()
 }
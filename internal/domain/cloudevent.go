@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// CloudEventsSpecVersion is the CloudEvents version events conform to.
+	CloudEventsSpecVersion = "1.0"
+	// CloudEventsContentType is the media type of a structured-mode event.
+	CloudEventsContentType = "application/cloudevents+json"
+	// DefaultCloudEventSource is the source attribute used when none is
+	// configured.
+	DefaultCloudEventSource = "/goledger"
+)
+
+// CloudEventOptions holds the deployment-specific CloudEvents attributes.
+type CloudEventOptions struct {
+	// Source identifies this ledger; defaults to DefaultCloudEventSource.
+	Source string
+	// SchemaBaseURL is where the event schema endpoints are served, e.g.
+	// https://ledger.example.com/api/v1/events/schemas. Each event's
+	// dataschema is SchemaBaseURL/<type>/<version>; it is omitted when
+	// SchemaBaseURL is empty.
+	SchemaBaseURL string
+}
+
+// SchemaURL returns the dataschema of an event type and version, or "" when
+// no SchemaBaseURL is set.
+func (o CloudEventOptions) SchemaURL(eventType string, version int32) string {
+	if o.SchemaBaseURL == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(o.SchemaBaseURL, "/") + "/" + eventType + "/" + strconv.FormatInt(int64(version), 10)
+}
+
+// CloudEvent is an outbox event in the CloudEvents 1.0 JSON format. The
+// aggregate fields are extension attributes.
+type CloudEvent struct {
+	SpecVersion       string         `json:"specversion"`
+	ID                string         `json:"id"`
+	Source            string         `json:"source"`
+	Type              string         `json:"type"`
+	Subject           string         `json:"subject,omitempty"`
+	Time              time.Time      `json:"time"`
+	DataContentType   string         `json:"datacontenttype"`
+	DataSchema        string         `json:"dataschema,omitempty"`
+	AggregateType     string         `json:"aggregatetype"`
+	AggregateSequence int64          `json:"aggregatesequence"`
+	EventVersion      int32          `json:"eventversion"`
+	Data              map[string]any `json:"data"`
+}
+
+// NewCloudEvent wraps an outbox event. Its subject is the aggregate ID.
+func NewCloudEvent(event *OutboxEvent, opts CloudEventOptions) CloudEvent {
+	source := opts.Source
+	if source == "" {
+		source = DefaultCloudEventSource
+	}
+
+	version := event.EventVersion
+	if version == 0 {
+		version = 1
+	}
+
+	return CloudEvent{
+		SpecVersion:       CloudEventsSpecVersion,
+		ID:                event.ID,
+		Source:            source,
+		Type:              event.EventType,
+		Subject:           event.AggregateID,
+		Time:              event.CreatedAt,
+		DataContentType:   "application/json",
+		DataSchema:        opts.SchemaURL(event.EventType, version),
+		AggregateType:     event.AggregateType,
+		AggregateSequence: event.AggregateSequence,
+		EventVersion:      version,
+		Data:              event.Payload,
+	}
+}
+
+// Attributes returns the event's context attributes as strings, for the
+// binary content mode of protocol bindings that carry them as headers.
+// datacontenttype is left out, as bindings map it to their own
+// content-type header.
+func (e CloudEvent) Attributes() map[string]string {
+	attrs := map[string]string{
+		"specversion":       e.SpecVersion,
+		"id":                e.ID,
+		"source":            e.Source,
+		"type":              e.Type,
+		"time":              e.Time.UTC().Format(time.RFC3339Nano),
+		"aggregatetype":     e.AggregateType,
+		"aggregatesequence": strconv.FormatInt(e.AggregateSequence, 10),
+		"eventversion":      strconv.FormatInt(int64(e.EventVersion), 10),
+	}
+
+	if e.Subject != "" {
+		attrs["subject"] = e.Subject
+	}
+	if e.DataSchema != "" {
+		attrs["dataschema"] = e.DataSchema
+	}
+
+	return attrs
+}
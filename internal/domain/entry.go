@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -17,3 +19,37 @@ type Entry struct {
 	AccountCurrentBalance  decimal.Decimal
 	AccountVersion         int64
 }
+
+// ErrInvalidEntryExport is returned for an entry export with an empty time
+// range.
+var ErrInvalidEntryExport = errors.New("invalid entry export")
+
+// EntryExportBatchSize is how many entries an export reads from its database
+// cursor at a time, bounding its memory regardless of account size.
+const EntryExportBatchSize = 1000
+
+// EntryExportTimeout is the longest an export may run. It bounds how long a
+// slow or stalled reader holds the export's database snapshot.
+const EntryExportTimeout = 30 * time.Minute
+
+// EntryExportFilter selects an account's entries for export, in chain order.
+// From and To optionally bound created_at to [From, To).
+type EntryExportFilter struct {
+	From      *time.Time
+	To        *time.Time
+	AccountID string
+}
+
+// Validate checks that the filter names an account and, when both bounds
+// are set, that To is after From.
+func (f EntryExportFilter) Validate() error {
+	if f.AccountID == "" {
+		return NewFieldError("account_id", fmt.Errorf("%w: account ID is required", ErrInvalidEntryExport))
+	}
+
+	if f.From != nil && f.To != nil && !f.To.After(*f.From) {
+		return NewFieldError("to", fmt.Errorf("%w: to must be after from", ErrInvalidEntryExport))
+	}
+
+	return nil
+}
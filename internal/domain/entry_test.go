@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEntryExportFilter_Validate(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  EntryExportFilter
+		wantErr bool
+	}{
+		{"unbounded", EntryExportFilter{AccountID: "acc"}, false},
+		{"from only", EntryExportFilter{AccountID: "acc", From: &from}, false},
+		{"to only", EntryExportFilter{AccountID: "acc", To: &to}, false},
+		{"range", EntryExportFilter{AccountID: "acc", From: &from, To: &to}, false},
+		{"missing account", EntryExportFilter{}, true},
+		{"empty range", EntryExportFilter{AccountID: "acc", From: &from, To: &from}, true},
+		{"inverted range", EntryExportFilter{AccountID: "acc", From: &to, To: &from}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidEntryExport) {
+				t.Fatalf("expected ErrInvalidEntryExport, got %v", err)
+			}
+		})
+	}
+}
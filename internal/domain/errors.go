@@ -7,6 +7,10 @@ var (
 	ErrNegativeBalanceNotAllowed = errors.New("account does not allow negative balance")
 	ErrPositiveBalanceNotAllowed = errors.New("account does not allow positive balance")
 	ErrAccountNotFound           = errors.New("account not found")
+	// ErrVersionMismatch is returned when a mutation is conditioned on an
+	// account version (an HTTP If-Match, a gRPC expected_version) that is
+	// no longer current.
+	ErrVersionMismatch = errors.New("account version does not match the expected version")
 
 	// Transfer errors.
 	ErrSameAccount             = errors.New("cannot transfer to same account")
@@ -14,4 +18,9 @@ var (
 	ErrCurrencyMismatch        = errors.New("cannot transfer between different currencies")
 	ErrTransferNotFound        = errors.New("transfer not found")
 	ErrTransferAlreadyReversed = errors.New("transfer has already been reversed")
+	ErrDuplicateIdempotencyKey = errors.New("a transfer with this idempotency key already exists")
+
+	// Request idempotency errors, for retries carrying an idempotency key.
+	ErrIdempotencyKeyReused    = errors.New("idempotency key reused with a different request")
+	ErrRequestAlreadyProcessed = errors.New("request with this idempotency key was already processed")
 )
@@ -0,0 +1,314 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrEventSchemaNotFound = errors.New("event schema not found")
+	// ErrInvalidEventPayload means an outbox payload does not match the
+	// schema of its event type and version, so it is never inserted.
+	ErrInvalidEventPayload = errors.New("invalid event payload")
+)
+
+const (
+	// JSONSchemaDialect is the JSON Schema draft event schemas declare.
+	JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+	decimalPattern    = `^-?[0-9]+(\.[0-9]+)?$`
+)
+
+var eventSchemaRegistryOnce = sync.OnceValue(buildEventSchemaRegistry)
+
+// eventPayloads lists every payload type the ledger emits. Registering a
+// new event type or version means adding its struct here; a payload whose
+// fields change incompatibly gets a new struct with a bumped EventVersion,
+// so the schema published for the old version never changes.
+var eventPayloads = []EventPayload{
+	TransferCreatedEvent{},
+	TransferReversedEvent{},
+	HoldCreatedEvent{},
+	HoldVoidedEvent{},
+	HoldCapturedEvent{},
+	AccountCreatedEvent{},
+}
+
+// JSONSchema is the subset of JSON Schema (draft 2020-12) generated for
+// event payloads.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 string                 `json:"type"`
+	Format               string                 `json:"format,omitempty"`
+	Pattern              string                 `json:"pattern,omitempty"`
+	MinLength            *int                   `json:"minLength,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// EventSchema is the published schema of one event type and version.
+type EventSchema struct {
+	EventType string
+	Version   int32
+	Schema    *JSONSchema
+}
+
+type eventSchemaKey struct {
+	eventType string
+	version   int32
+}
+
+type eventSchemaRegistry struct {
+	schemas []EventSchema
+	byKey   map[eventSchemaKey]EventSchema
+}
+
+func buildEventSchemaRegistry() *eventSchemaRegistry {
+	registry := &eventSchemaRegistry{byKey: make(map[eventSchemaKey]EventSchema, len(eventPayloads))}
+
+	for _, payload := range eventPayloads {
+		t := reflect.TypeOf(payload)
+
+		schema := schemaForType(t)
+		schema.Schema = JSONSchemaDialect
+		schema.Title = t.Name()
+
+		key := eventSchemaKey{payload.EventType(), payload.EventVersion()}
+		if _, ok := registry.byKey[key]; ok {
+			panic(fmt.Sprintf("domain: %s v%d registered twice", key.eventType, key.version))
+		}
+
+		es := EventSchema{EventType: key.eventType, Version: key.version, Schema: schema}
+		registry.byKey[key] = es
+		registry.schemas = append(registry.schemas, es)
+	}
+
+	slices.SortFunc(registry.schemas, func(a, b EventSchema) int {
+		if c := strings.Compare(a.EventType, b.EventType); c != 0 {
+			return c
+		}
+		return int(a.Version - b.Version)
+	})
+
+	return registry
+}
+
+// schemaForType generates the schema of a payload type from its json tags.
+// Fields without omitempty are required, and required strings must not be
+// empty. A `schema:"decimal"` tag constrains a string to a decimal amount;
+// `schema:"date-time"` to an RFC 3339 timestamp.
+func schemaForType(t reflect.Type) *JSONSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return &JSONSchema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &JSONSchema{Type: "string"}
+	case reflect.Bool:
+		return &JSONSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &JSONSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &JSONSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &JSONSchema{Type: "array", Items: schemaForType(t.Elem())}
+	case reflect.Struct:
+		return schemaForStruct(t)
+	default:
+		panic(fmt.Sprintf("domain: no JSON Schema mapping for %s", t))
+	}
+}
+
+func schemaForStruct(t reflect.Type) *JSONSchema {
+	closed := false
+	schema := &JSONSchema{
+		Type:                 "object",
+		Properties:           make(map[string]*JSONSchema),
+		AdditionalProperties: &closed,
+	}
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		prop := schemaForType(field.Type)
+		switch field.Tag.Get("schema") {
+		case "decimal":
+			prop.Pattern = decimalPattern
+			prop.pattern = regexp.MustCompile(decimalPattern)
+		case "date-time":
+			prop.Format = "date-time"
+		}
+
+		optional := slices.Contains(strings.Split(opts, ","), "omitempty") || field.Type.Kind() == reflect.Pointer
+		if !optional {
+			schema.Required = append(schema.Required, name)
+			if prop.Type == "string" && prop.Pattern == "" && prop.Format == "" {
+				minLength := 1
+				prop.MinLength = &minLength
+			}
+		}
+
+		schema.Properties[name] = prop
+	}
+
+	return schema
+}
+
+// EventSchemas returns every registered schema, ordered by event type and
+// version.
+func EventSchemas() []EventSchema {
+	return slices.Clone(eventSchemaRegistryOnce().schemas)
+}
+
+// LookupEventSchema returns the schema of an event type and version.
+func LookupEventSchema(eventType string, version int32) (EventSchema, error) {
+	es, ok := eventSchemaRegistryOnce().byKey[eventSchemaKey{eventType, version}]
+	if !ok {
+		return EventSchema{}, fmt.Errorf("%w: %s v%d", ErrEventSchemaNotFound, eventType, version)
+	}
+
+	return es, nil
+}
+
+// ValidateOutboxEvent checks the event's payload against the schema of its
+// type and version. An event version of 0 means 1, as on insert.
+func ValidateOutboxEvent(event *OutboxEvent) error {
+	version := event.EventVersion
+	if version == 0 {
+		version = 1
+	}
+
+	es, err := LookupEventSchema(event.EventType, version)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEventPayload, err)
+	}
+
+	// Validate the payload as consumers will see it, after JSON encoding.
+	data, err := json.Marshal(event.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEventPayload, err)
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEventPayload, err)
+	}
+
+	if err := es.Schema.validate(value, "payload"); err != nil {
+		return fmt.Errorf("%w: %s v%d: %w", ErrInvalidEventPayload, event.EventType, version, err)
+	}
+
+	return nil
+}
+
+// validate checks a decoded JSON value against the schema.
+func (s *JSONSchema) validate(value any, path string) error {
+	switch s.Type {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s must be an object", path)
+		}
+
+		for _, name := range s.Required {
+			if _, ok := obj[name]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+
+		for name, v := range obj {
+			prop, ok := s.Properties[name]
+			if !ok {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return fmt.Errorf("%s.%s is not allowed", path, name)
+				}
+				continue
+			}
+
+			if err := prop.validate(v, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s must be an array", path)
+		}
+
+		for i, item := range items {
+			if err := s.Items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be a string", path)
+		}
+
+		return s.validateString(str, path)
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return fmt.Errorf("%s must be an integer", path)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s must be a number", path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean", path)
+		}
+	}
+
+	return nil
+}
+
+func (s *JSONSchema) validateString(str, path string) error {
+	if s.MinLength != nil && len(str) < *s.MinLength {
+		return fmt.Errorf("%s must not be empty", path)
+	}
+
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		return fmt.Errorf("%s must match %s", path, s.Pattern)
+	}
+
+	if s.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339Nano, str); err != nil {
+			return fmt.Errorf("%s must be an RFC 3339 timestamp", path)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEventSchemas_CoverRegisteredPayloads(t *testing.T) {
+	schemas := EventSchemas()
+	if len(schemas) != len(eventPayloads) {
+		t.Fatalf("expected %d schemas, got %d", len(eventPayloads), len(schemas))
+	}
+
+	es, err := LookupEventSchema(EventTypeTransferCreated, 1)
+	if err != nil {
+		t.Fatalf("LookupEventSchema() error = %v", err)
+	}
+
+	if es.Schema.Schema != JSONSchemaDialect || es.Schema.Title != "TransferCreatedEvent" || *es.Schema.AdditionalProperties {
+		t.Fatalf("unexpected schema header: %+v", es.Schema)
+	}
+
+	if len(es.Schema.Required) != 5 || es.Schema.Properties["fees"].Items.Properties["amount"].Pattern == "" ||
+		es.Schema.Properties["event_at"].Format != "date-time" {
+		t.Fatalf("unexpected schema: %+v", es.Schema)
+	}
+
+	if _, err := LookupEventSchema(EventTypeTransferCreated, 2); !errors.Is(err, ErrEventSchemaNotFound) {
+		t.Fatalf("expected ErrEventSchemaNotFound, got %v", err)
+	}
+}
+
+func TestValidateOutboxEvent(t *testing.T) {
+	valid := func() map[string]any {
+		return map[string]any{
+			"hold_id":       "hold-1",
+			"transfer_id":   "tr-1",
+			"to_account_id": "acc-2",
+			"amount":        "10.50",
+		}
+	}
+
+	tests := []struct {
+		name   string
+		event  OutboxEvent
+		modify func(map[string]any)
+		ok     bool
+	}{
+		{name: "valid", event: OutboxEvent{EventType: EventTypeHoldCaptured, EventVersion: 1}, ok: true},
+		{name: "version defaults to 1", event: OutboxEvent{EventType: EventTypeHoldCaptured}, ok: true},
+		{name: "unknown version", event: OutboxEvent{EventType: EventTypeHoldCaptured, EventVersion: 9}},
+		{name: "unknown type", event: OutboxEvent{EventType: "hold.melted"}},
+		{name: "missing field", event: OutboxEvent{EventType: EventTypeHoldCaptured}, modify: func(p map[string]any) { delete(p, "transfer_id") }},
+		{name: "empty field", event: OutboxEvent{EventType: EventTypeHoldCaptured}, modify: func(p map[string]any) { p["hold_id"] = "" }},
+		{name: "extra field", event: OutboxEvent{EventType: EventTypeHoldCaptured}, modify: func(p map[string]any) { p["note"] = "x" }},
+		{name: "wrong type", event: OutboxEvent{EventType: EventTypeHoldCaptured}, modify: func(p map[string]any) { p["amount"] = 10.5 }},
+		{name: "bad decimal", event: OutboxEvent{EventType: EventTypeHoldCaptured}, modify: func(p map[string]any) { p["amount"] = "1e3" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.event.Payload = valid()
+			if tt.modify != nil {
+				tt.modify(tt.event.Payload)
+			}
+
+			err := ValidateOutboxEvent(&tt.event)
+			if tt.ok && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, ErrInvalidEventPayload) {
+				t.Fatalf("expected ErrInvalidEventPayload, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewOutboxEvent(t *testing.T) {
+	now := time.Now().UTC()
+
+	event, err := NewOutboxEvent("evt-1", TransferCreatedEvent{
+		TransferID:    "tr-1",
+		FromAccountID: "acc-1",
+		ToAccountID:   "acc-2",
+		Amount:        "10",
+		EventAt:       now.Format(time.RFC3339),
+		Fees:          []TransferFeeEvent{{PolicyID: "fp-1", TransferID: "tr-2", FromAccountID: "acc-1", ToAccountID: "acc-3", Amount: "0.5"}},
+	}, now)
+	if err != nil {
+		t.Fatalf("NewOutboxEvent() error = %v", err)
+	}
+
+	if event.AggregateType != AggregateTypeTransfer || event.AggregateID != "tr-1" || event.EventType != EventTypeTransferCreated ||
+		event.EventVersion != 1 || event.Published || len(event.Payload["fees"].([]any)) != 1 {
+		t.Fatalf("unexpected event: %+v", event)
+	}
+
+	if _, err := NewOutboxEvent("evt-2", TransferReversedEvent{ReversalTransferID: "tr-3", Amount: "10", EventAt: "yesterday"}, now); !errors.Is(err, ErrInvalidEventPayload) {
+		t.Fatalf("expected ErrInvalidEventPayload, got %v", err)
+	}
+}
+
+func TestNewCloudEvent(t *testing.T) {
+	event := &OutboxEvent{
+		ID:                "evt-1",
+		AggregateType:     AggregateTypeHold,
+		AggregateID:       "hold-1",
+		EventType:         EventTypeHoldVoided,
+		AggregateSequence: 2,
+		Payload:           map[string]any{"hold_id": "hold-1"},
+		CreatedAt:         time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	ce := NewCloudEvent(event, CloudEventOptions{SchemaBaseURL: "https://ledger.example.com/api/v1/events/schemas/"})
+	if ce.SpecVersion != "1.0" || ce.Source != DefaultCloudEventSource || ce.Subject != "hold-1" || ce.EventVersion != 1 ||
+		ce.DataSchema != "https://ledger.example.com/api/v1/events/schemas/hold.voided/1" {
+		t.Fatalf("unexpected CloudEvent: %+v", ce)
+	}
+
+	attrs := ce.Attributes()
+	if attrs["time"] != "2026-01-02T03:04:05Z" || attrs["aggregatesequence"] != "2" || attrs["datacontenttype"] != "" {
+		t.Fatalf("unexpected attributes: %v", attrs)
+	}
+
+	if ce := NewCloudEvent(event, CloudEventOptions{Source: "/ledger-eu"}); ce.Source != "/ledger-eu" || ce.DataSchema != "" {
+		t.Fatalf("unexpected CloudEvent without schema URL: %+v", ce)
+	}
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidEventStreamRequest = errors.New("invalid event stream request")
+	// ErrEventStreamCursorNotFound means the resume cursor names an event
+	// that is unknown, unpublished or already pruned; resubscribe without it.
+	ErrEventStreamCursorNotFound = errors.New("event stream cursor not found")
+	// ErrEventStreamLagging ends a subscription whose consumer fell too far
+	// behind the live feed; resubscribe from the last received event.
+	ErrEventStreamLagging = errors.New("event stream subscriber fell behind")
+	// ErrEventStreamClosed ends subscriptions when the server shuts down.
+	ErrEventStreamClosed = errors.New("event stream closed")
+)
+
+// EventStreamFilter selects the published outbox events a stream delivers.
+// All set criteria must hold; unset criteria match anything.
+type EventStreamFilter struct {
+	// AccountID matches the account's own events and every event whose
+	// payload names it as account_id, from_account_id or to_account_id.
+	AccountID     string
+	AggregateType string
+	// EventTypes lists the event types to deliver; empty means all. An
+	// entry ending in ".*" matches a family, e.g. "transfer.*".
+	EventTypes []string
+}
+
+// Matches reports whether the event passes the filter.
+func (f EventStreamFilter) Matches(event *OutboxEvent) bool {
+	if f.AggregateType != "" && f.AggregateType != event.AggregateType {
+		return false
+	}
+
+	if f.AccountID != "" && !involvesAccount(event, f.AccountID) {
+		return false
+	}
+
+	return matchesEventType(f.EventTypes, event.EventType)
+}
+
+func involvesAccount(event *OutboxEvent, accountID string) bool {
+	if event.AggregateType == AggregateTypeAccount && event.AggregateID == accountID {
+		return true
+	}
+
+	for _, key := range []string{"account_id", "from_account_id", "to_account_id"} {
+		if id, ok := event.Payload[key].(string); ok && id == accountID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EventStreamCursor is where a resumed stream continues from: right after
+// the event with EventID, or, for a stream filtered by AccountID, right
+// after the account's event with AggregateSequence. Both zero means live
+// events only.
+type EventStreamCursor struct {
+	AggregateSequence *int64
+	EventID           string
+}
+
+// IsZero reports whether the cursor is unset.
+func (c EventStreamCursor) IsZero() bool {
+	return c.EventID == "" && c.AggregateSequence == nil
+}
+
+// ValidateEventStreamRequest checks a filter and cursor belong together.
+func ValidateEventStreamRequest(filter EventStreamFilter, cursor EventStreamCursor) error {
+	if cursor.EventID != "" && cursor.AggregateSequence != nil {
+		return fmt.Errorf("%w: set either an event ID or an aggregate sequence cursor, not both", ErrInvalidEventStreamRequest)
+	}
+
+	if cursor.AggregateSequence != nil {
+		if filter.AccountID == "" {
+			return fmt.Errorf("%w: an aggregate sequence cursor requires an account ID", ErrInvalidEventStreamRequest)
+		}
+		if *cursor.AggregateSequence < 0 {
+			return fmt.Errorf("%w: aggregate sequence must not be negative", ErrInvalidEventStreamRequest)
+		}
+	}
+
+	return nil
+}
+
+// EventStreamPosition orders published events for streaming: by publish
+// time, then ID.
+type EventStreamPosition struct {
+	PublishedAt time.Time
+	EventID     string
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEventStreamFilter_Matches(t *testing.T) {
+	transfer := &OutboxEvent{
+		AggregateType: AggregateTypeTransfer,
+		AggregateID:   "tr-1",
+		EventType:     EventTypeTransferCreated,
+		Payload:       map[string]any{"from_account_id": "acc-1", "to_account_id": "acc-2"},
+	}
+	account := &OutboxEvent{AggregateType: AggregateTypeAccount, AggregateID: "acc-3", EventType: EventTypeAccountCreated}
+
+	tests := []struct {
+		name   string
+		filter EventStreamFilter
+		event  *OutboxEvent
+		want   bool
+	}{
+		{name: "empty filter", filter: EventStreamFilter{}, event: transfer, want: true},
+		{name: "payload account", filter: EventStreamFilter{AccountID: "acc-2"}, event: transfer, want: true},
+		{name: "own account event", filter: EventStreamFilter{AccountID: "acc-3"}, event: account, want: true},
+		{name: "other account", filter: EventStreamFilter{AccountID: "acc-9"}, event: transfer, want: false},
+		{name: "aggregate type", filter: EventStreamFilter{AggregateType: AggregateTypeAccount}, event: transfer, want: false},
+		{name: "event family", filter: EventStreamFilter{EventTypes: []string{"transfer.*"}}, event: transfer, want: true},
+		{name: "other event type", filter: EventStreamFilter{EventTypes: []string{EventTypeHoldCreated}}, event: transfer, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.event); got != tt.want {
+				t.Fatalf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEventStreamRequest(t *testing.T) {
+	seq := int64(2)
+
+	if err := ValidateEventStreamRequest(EventStreamFilter{AccountID: "acc-1"}, EventStreamCursor{AggregateSequence: &seq}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := ValidateEventStreamRequest(EventStreamFilter{}, EventStreamCursor{AggregateSequence: &seq}); !errors.Is(err, ErrInvalidEventStreamRequest) {
+		t.Fatalf("expected ErrInvalidEventStreamRequest without an account, got %v", err)
+	}
+
+	if err := ValidateEventStreamRequest(EventStreamFilter{AccountID: "acc-1"}, EventStreamCursor{EventID: "evt-1", AggregateSequence: &seq}); !errors.Is(err, ErrInvalidEventStreamRequest) {
+		t.Fatalf("expected ErrInvalidEventStreamRequest for two cursors, got %v", err)
+	}
+}
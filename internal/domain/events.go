@@ -1,6 +1,10 @@
 package domain
 
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 // Event types
 const (
@@ -46,50 +50,129 @@ type OutboxEvent struct {
 	DeadLetteredAt *time.Time
 }
 
+// EventPayload is a typed outbox event payload. Each implementation is
+// registered in eventPayloads, which generates the JSON Schema published for
+// its event type and version; see EventSchemas.
+type EventPayload interface {
+	EventType() string
+	EventVersion() int32
+	AggregateType() string
+	AggregateID() string
+}
+
+// NewOutboxEvent builds an unpublished outbox event from a typed payload and
+// checks the payload against its schema.
+func NewOutboxEvent(id string, payload EventPayload, createdAt time.Time) (*OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", payload.EventType(), err)
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to encode %s payload: %w", payload.EventType(), err)
+	}
+
+	event := &OutboxEvent{
+		ID:            id,
+		AggregateID:   payload.AggregateID(),
+		AggregateType: payload.AggregateType(),
+		EventType:     payload.EventType(),
+		EventVersion:  payload.EventVersion(),
+		Payload:       fields,
+		CreatedAt:     createdAt,
+	}
+
+	if err := ValidateOutboxEvent(event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
 // TransferCreatedEvent payload
 type TransferCreatedEvent struct {
+	TransferID    string             `json:"transfer_id"`
+	FromAccountID string             `json:"from_account_id"`
+	ToAccountID   string             `json:"to_account_id"`
+	Amount        string             `json:"amount"   schema:"decimal"`
+	EventAt       string             `json:"event_at" schema:"date-time"`
+	Fees          []TransferFeeEvent `json:"fees,omitempty"`
+}
+
+func (TransferCreatedEvent) EventType() string     { return EventTypeTransferCreated }
+func (TransferCreatedEvent) EventVersion() int32   { return 1 }
+func (TransferCreatedEvent) AggregateType() string { return AggregateTypeTransfer }
+func (e TransferCreatedEvent) AggregateID() string { return e.TransferID }
+
+// TransferFeeEvent is one fee leg in a TransferCreatedEvent.
+type TransferFeeEvent struct {
+	PolicyID      string `json:"policy_id"`
 	TransferID    string `json:"transfer_id"`
 	FromAccountID string `json:"from_account_id"`
 	ToAccountID   string `json:"to_account_id"`
-	Amount        string `json:"amount"`
-	Currency      string `json:"currency"`
-	EventAt       string `json:"event_at"`
+	Amount        string `json:"amount" schema:"decimal"`
 }
 
 // TransferReversedEvent payload
 type TransferReversedEvent struct {
 	ReversalTransferID string `json:"reversal_transfer_id"`
 	OriginalTransferID string `json:"original_transfer_id"`
-	Amount             string `json:"amount"`
-	Currency           string `json:"currency"`
+	Amount             string `json:"amount"   schema:"decimal"`
+	EventAt            string `json:"event_at" schema:"date-time"`
 }
 
+func (TransferReversedEvent) EventType() string     { return EventTypeTransferReversed }
+func (TransferReversedEvent) EventVersion() int32   { return 1 }
+func (TransferReversedEvent) AggregateType() string { return AggregateTypeTransfer }
+func (e TransferReversedEvent) AggregateID() string { return e.ReversalTransferID }
+
 // HoldCreatedEvent payload
 type HoldCreatedEvent struct {
 	HoldID    string `json:"hold_id"`
 	AccountID string `json:"account_id"`
-	Amount    string `json:"amount"`
+	Amount    string `json:"amount" schema:"decimal"`
 	Currency  string `json:"currency"`
 }
 
+func (HoldCreatedEvent) EventType() string     { return EventTypeHoldCreated }
+func (HoldCreatedEvent) EventVersion() int32   { return 1 }
+func (HoldCreatedEvent) AggregateType() string { return AggregateTypeHold }
+func (e HoldCreatedEvent) AggregateID() string { return e.HoldID }
+
 // HoldVoidedEvent payload
 type HoldVoidedEvent struct {
 	HoldID    string `json:"hold_id"`
 	AccountID string `json:"account_id"`
-	Amount    string `json:"amount"`
+	Amount    string `json:"amount" schema:"decimal"`
 }
 
+func (HoldVoidedEvent) EventType() string     { return EventTypeHoldVoided }
+func (HoldVoidedEvent) EventVersion() int32   { return 1 }
+func (HoldVoidedEvent) AggregateType() string { return AggregateTypeHold }
+func (e HoldVoidedEvent) AggregateID() string { return e.HoldID }
+
 // HoldCapturedEvent payload
 type HoldCapturedEvent struct {
 	HoldID      string `json:"hold_id"`
 	TransferID  string `json:"transfer_id"`
 	ToAccountID string `json:"to_account_id"`
-	Amount      string `json:"amount"`
+	Amount      string `json:"amount" schema:"decimal"`
 }
 
+func (HoldCapturedEvent) EventType() string     { return EventTypeHoldCaptured }
+func (HoldCapturedEvent) EventVersion() int32   { return 1 }
+func (HoldCapturedEvent) AggregateType() string { return AggregateTypeHold }
+func (e HoldCapturedEvent) AggregateID() string { return e.HoldID }
+
 // AccountCreatedEvent payload
 type AccountCreatedEvent struct {
 	AccountID string `json:"account_id"`
 	Name      string `json:"name"`
 	Currency  string `json:"currency"`
 }
+
+func (AccountCreatedEvent) EventType() string     { return EventTypeAccountCreated }
+func (AccountCreatedEvent) EventVersion() int32   { return 1 }
+func (AccountCreatedEvent) AggregateType() string { return AggregateTypeAccount }
+func (e AccountCreatedEvent) AggregateID() string { return e.AccountID }
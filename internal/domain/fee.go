@@ -0,0 +1,135 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	ErrFeePolicyNotFound = errors.New("fee policy not found")
+	ErrInvalidFeePolicy  = errors.New("invalid fee policy")
+)
+
+// FeePayer says which side of a transfer a fee is charged to.
+type FeePayer string
+
+const (
+	// FeePayerSource charges the fee to the transfer's source account.
+	FeePayerSource FeePayer = "source"
+	// FeePayerDestination charges the fee to the transfer's destination
+	// account (e.g. a merchant paying the processing fee on a payment).
+	FeePayerDestination FeePayer = "destination"
+)
+
+// DefaultFeePrecision is the number of decimal places fees are rounded to
+// when a policy doesn't specify one.
+const DefaultFeePrecision = 2
+
+// FeePolicy is a declarative percentage-plus-fixed fee applied to every
+// transfer it matches. All set match criteria must hold; unset criteria
+// match anything. Matching fees are posted as separate fee transfers from
+// the payer to RevenueAccountID, in the same database transaction as the
+// transfer that triggered them.
+type FeePolicy struct {
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	FromAccountID    *string
+	ToAccountID      *string
+	Currency         *string
+	MetadataMatch    map[string]string
+	ID               string
+	Name             string
+	Payer            FeePayer
+	RevenueAccountID string
+	Percentage       decimal.Decimal
+	FixedAmount      decimal.Decimal
+	Precision        int32
+	Active           bool
+}
+
+// Validate checks the policy is internally consistent.
+func (p *FeePolicy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidFeePolicy)
+	}
+
+	if p.RevenueAccountID == "" {
+		return fmt.Errorf("%w: revenue account is required", ErrInvalidFeePolicy)
+	}
+
+	switch p.Payer {
+	case FeePayerSource, FeePayerDestination:
+	default:
+		return fmt.Errorf("%w: unknown payer %q", ErrInvalidFeePolicy, p.Payer)
+	}
+
+	if p.Percentage.IsNegative() || p.FixedAmount.IsNegative() {
+		return fmt.Errorf("%w: percentage and fixed amount must not be negative", ErrInvalidFeePolicy)
+	}
+
+	if p.Percentage.IsZero() && p.FixedAmount.IsZero() {
+		return fmt.Errorf("%w: percentage or fixed amount must be set", ErrInvalidFeePolicy)
+	}
+
+	if p.Percentage.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return fmt.Errorf("%w: percentage is a fraction and must be below 1", ErrInvalidFeePolicy)
+	}
+
+	if p.Precision < 0 {
+		return fmt.Errorf("%w: precision must not be negative", ErrInvalidFeePolicy)
+	}
+
+	return nil
+}
+
+// Matches reports whether the policy applies to a transfer between the
+// given accounts, in the given currency, carrying the given metadata.
+func (p *FeePolicy) Matches(fromAccountID, toAccountID, currency string, metadata map[string]any) bool {
+	return p.MatchesTransfer(fromAccountID, toAccountID, metadata) && p.MatchesCurrency(currency)
+}
+
+// MatchesTransfer checks the account and metadata criteria only; it lets
+// callers find candidate policies before account currencies are known.
+// Metadata values are compared by their string form.
+func (p *FeePolicy) MatchesTransfer(fromAccountID, toAccountID string, metadata map[string]any) bool {
+	if p.FromAccountID != nil && *p.FromAccountID != fromAccountID {
+		return false
+	}
+
+	if p.ToAccountID != nil && *p.ToAccountID != toAccountID {
+		return false
+	}
+
+	for k, want := range p.MetadataMatch {
+		got, ok := metadata[k]
+		if !ok || fmt.Sprint(got) != want {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchesCurrency checks the currency criterion only.
+func (p *FeePolicy) MatchesCurrency(currency string) bool {
+	return p.Currency == nil || *p.Currency == currency
+}
+
+// Compute returns the fee for a transfer amount: amount × percentage plus
+// the fixed amount, rounded to the policy's precision.
+func (p *FeePolicy) Compute(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(p.Percentage).Add(p.FixedAmount).RoundBank(p.Precision)
+}
+
+// TransferFee is one fee leg charged on a transfer: the fee transfer posted
+// from the payer to the policy's revenue account.
+type TransferFee struct {
+	PolicyID      string
+	TransferID    string
+	FromAccountID string
+	ToAccountID   string
+	Amount        decimal.Decimal
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestFeePolicy_Validate(t *testing.T) {
+	valid := func() FeePolicy {
+		return FeePolicy{
+			Name:             "Card fee",
+			Payer:            FeePayerSource,
+			RevenueAccountID: "acc-revenue",
+			Percentage:       decimal.RequireFromString("0.029"),
+			FixedAmount:      decimal.RequireFromString("0.30"),
+			Precision:        2,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(p *FeePolicy)
+		valid  bool
+	}{
+		{"valid percentage plus fixed", func(p *FeePolicy) {}, true},
+		{"valid fixed only", func(p *FeePolicy) { p.Percentage = decimal.Zero }, true},
+		{"valid destination payer", func(p *FeePolicy) { p.Payer = FeePayerDestination }, true},
+		{"missing name", func(p *FeePolicy) { p.Name = "" }, false},
+		{"missing revenue account", func(p *FeePolicy) { p.RevenueAccountID = "" }, false},
+		{"unknown payer", func(p *FeePolicy) { p.Payer = "both" }, false},
+		{"negative percentage", func(p *FeePolicy) { p.Percentage = decimal.RequireFromString("-0.01") }, false},
+		{"negative fixed", func(p *FeePolicy) { p.FixedAmount = decimal.RequireFromString("-1") }, false},
+		{"no fee", func(p *FeePolicy) { p.Percentage, p.FixedAmount = decimal.Zero, decimal.Zero }, false},
+		{"percentage of one or more", func(p *FeePolicy) { p.Percentage = decimal.NewFromInt(1) }, false},
+		{"negative precision", func(p *FeePolicy) { p.Precision = -1 }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid()
+			tt.mutate(&p)
+
+			err := p.Validate()
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid policy, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidFeePolicy) {
+				t.Fatalf("expected ErrInvalidFeePolicy, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFeePolicy_Matches(t *testing.T) {
+	from := "acc-1"
+	to := "acc-2"
+	usd := "USD"
+
+	tests := []struct {
+		name     string
+		policy   FeePolicy
+		from     string
+		to       string
+		currency string
+		metadata map[string]any
+		want     bool
+	}{
+		{"no criteria matches anything", FeePolicy{}, "x", "y", "EUR", nil, true},
+		{"source matches", FeePolicy{FromAccountID: &from}, "acc-1", "y", "USD", nil, true},
+		{"source mismatch", FeePolicy{FromAccountID: &from}, "x", "y", "USD", nil, false},
+		{"destination mismatch", FeePolicy{ToAccountID: &to}, "acc-1", "x", "USD", nil, false},
+		{"currency mismatch", FeePolicy{Currency: &usd}, "x", "y", "EUR", nil, false},
+		{
+			"metadata matches by string form",
+			FeePolicy{MetadataMatch: map[string]string{"channel": "card", "tier": "2"}},
+			"x", "y", "USD", map[string]any{"channel": "card", "tier": 2}, true,
+		},
+		{
+			"metadata key missing",
+			FeePolicy{MetadataMatch: map[string]string{"channel": "card"}},
+			"x", "y", "USD", map[string]any{"other": "card"}, false,
+		},
+		{
+			"metadata value mismatch",
+			FeePolicy{MetadataMatch: map[string]string{"channel": "card"}},
+			"x", "y", "USD", map[string]any{"channel": "wire"}, false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Matches(tt.from, tt.to, tt.currency, tt.metadata); got != tt.want {
+				t.Fatalf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeePolicy_Compute(t *testing.T) {
+	p := FeePolicy{
+		Percentage:  decimal.RequireFromString("0.029"),
+		FixedAmount: decimal.RequireFromString("0.30"),
+		Precision:   2,
+	}
+
+	// 100 × 0.029 + 0.30 = 3.20
+	if got := p.Compute(decimal.NewFromInt(100)); !got.Equal(decimal.RequireFromString("3.2")) {
+		t.Fatalf("Compute(100) = %s, want 3.2", got)
+	}
+
+	// 12.34 × 0.029 = 0.35786, + 0.30 = 0.65786 → 0.66
+	if got := p.Compute(decimal.RequireFromString("12.34")); !got.Equal(decimal.RequireFromString("0.66")) {
+		t.Fatalf("Compute(12.34) = %s, want 0.66", got)
+	}
+}
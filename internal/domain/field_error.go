@@ -0,0 +1,45 @@
+package domain
+
+// FieldError attributes a validation error to the input field that caused
+// it, so APIs can point clients at the offending field. It reads and
+// matches exactly like the error it wraps.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+// NewFieldError attributes err to field.
+func NewFieldError(field string, err error) error {
+	return &FieldError{Field: field, Err: err}
+}
+
+func (e *FieldError) Error() string { return e.Err.Error() }
+
+func (e *FieldError) Unwrap() error { return e.Err }
+
+// FieldErrors returns the field errors in err's tree, outermost first.
+func FieldErrors(err error) []*FieldError {
+	var out []*FieldError
+	collectFieldErrors(err, &out)
+	return out
+}
+
+func collectFieldErrors(err error, out *[]*FieldError) {
+	for err != nil {
+		if fe, ok := err.(*FieldError); ok {
+			*out = append(*out, fe)
+		}
+
+		switch e := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				collectFieldErrors(inner, out)
+			}
+			return
+		case interface{ Unwrap() error }:
+			err = e.Unwrap()
+		default:
+			return
+		}
+	}
+}
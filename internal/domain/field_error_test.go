@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestFieldError(t *testing.T) {
+	inner := fmt.Errorf("%w: unknown direction %q", ErrInvalidTransferSearch, "sideways")
+	err := fmt.Errorf("search: %w", NewFieldError("direction", inner))
+
+	if !errors.Is(err, ErrInvalidTransferSearch) {
+		t.Fatal("expected the field error to match the error it wraps")
+	}
+	if err.Error() != "search: "+inner.Error() {
+		t.Fatalf("expected the field error to read like the error it wraps, got %q", err.Error())
+	}
+
+	fields := FieldErrors(err)
+	if len(fields) != 1 || fields[0].Field != "direction" {
+		t.Fatalf("expected one direction field error, got %+v", fields)
+	}
+}
+
+func TestFieldErrors_Joined(t *testing.T) {
+	err := errors.Join(
+		NewFieldError("from", ErrInvalidStatementPeriod),
+		errors.New("unrelated"),
+		NewFieldError("to", ErrInvalidStatementPeriod),
+	)
+
+	fields := FieldErrors(err)
+	if len(fields) != 2 || fields[0].Field != "from" || fields[1].Field != "to" {
+		t.Fatalf("expected from and to field errors, got %+v", fields)
+	}
+
+	if FieldErrors(ErrInvalidAmount) != nil {
+		t.Fatal("expected no field errors for a bare error")
+	}
+}
+
+func TestValidateAmount_NamesField(t *testing.T) {
+	fields := FieldErrors(ValidateAmount(decimal.Zero))
+	if len(fields) != 1 || fields[0].Field != "amount" {
+		t.Fatalf("expected an amount field error, got %+v", fields)
+	}
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -11,6 +12,7 @@ var (
 	ErrHoldNotFound      = errors.New("hold not found")
 	ErrInsufficientFunds = errors.New("insufficient funds for hold")
 	ErrHoldNotActive     = errors.New("hold is not active")
+	ErrInvalidHoldFilter = errors.New("invalid hold filter")
 )
 
 type HoldStatus string
@@ -21,6 +23,16 @@ const (
 	HoldStatusCaptured HoldStatus = "captured"
 )
 
+// IsValid checks if the status is a known hold status.
+func (s HoldStatus) IsValid() bool {
+	switch s {
+	case HoldStatusActive, HoldStatusVoided, HoldStatusCaptured:
+		return true
+	default:
+		return false
+	}
+}
+
 type Hold struct {
 	ID        string
 	AccountID string
@@ -39,3 +51,25 @@ func (h *Hold) Validate() error {
 	}
 	return nil
 }
+
+// HoldFilter narrows a hold listing. Zero fields match every hold.
+type HoldFilter struct {
+	Status HoldStatus
+	// ExpiresAfter and ExpiresBefore bound the hold's expiry, [after,
+	// before). Setting either excludes holds without an expiry.
+	ExpiresAfter  *time.Time
+	ExpiresBefore *time.Time
+}
+
+// Validate checks the filter's status and expiry range.
+func (f HoldFilter) Validate() error {
+	if f.Status != "" && !f.Status.IsValid() {
+		return NewFieldError("status", fmt.Errorf("%w: unknown status %q", ErrInvalidHoldFilter, f.Status))
+	}
+
+	if f.ExpiresAfter != nil && f.ExpiresBefore != nil && !f.ExpiresAfter.Before(*f.ExpiresBefore) {
+		return NewFieldError("expires_after", fmt.Errorf("%w: expires_after must be before expires_before", ErrInvalidHoldFilter))
+	}
+
+	return nil
+}
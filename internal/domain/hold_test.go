@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHoldFilter_Validate(t *testing.T) {
+	after := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	before := after.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  HoldFilter
+		wantErr bool
+	}{
+		{name: "empty", filter: HoldFilter{}},
+		{name: "status", filter: HoldFilter{Status: HoldStatusActive}},
+		{name: "unknown status", filter: HoldFilter{Status: "expired"}, wantErr: true},
+		{name: "range", filter: HoldFilter{ExpiresAfter: &after, ExpiresBefore: &before}},
+		{name: "open range", filter: HoldFilter{ExpiresBefore: &before}},
+		{name: "inverted range", filter: HoldFilter{ExpiresAfter: &before, ExpiresBefore: &after}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidHoldFilter) {
+				t.Fatalf("expected ErrInvalidHoldFilter, got %v", err)
+			}
+		})
+	}
+}
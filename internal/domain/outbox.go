@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrOutboxEventNotFound     = errors.New("outbox event not found")
+	ErrInvalidDeadLetterFilter = errors.New("invalid dead-letter filter")
+	// ErrOutboxLeaseLost is returned when a publisher updates an event
+	// whose lease it no longer holds.
+	ErrOutboxLeaseLost = errors.New("outbox event lease is no longer held")
+)
+
+// DeadLetterFilter selects dead-lettered outbox events to replay or archive.
+// Set criteria must all hold. Because both operations act in bulk, an empty
+// filter is rejected unless All is set.
+type DeadLetterFilter struct {
+	// CreatedFrom and CreatedTo bound the event's creation time, [from, to).
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	IDs         []string
+	EventType   string
+	All         bool
+}
+
+// IsEmpty reports whether the filter has no criteria set.
+func (f DeadLetterFilter) IsEmpty() bool {
+	return len(f.IDs) == 0 && f.EventType == "" && f.CreatedFrom == nil && f.CreatedTo == nil
+}
+
+// Validate checks the filter selects something deliberately.
+func (f DeadLetterFilter) Validate() error {
+	if f.IsEmpty() && !f.All {
+		return fmt.Errorf("%w: set event IDs, an event type or a time range, or select all", ErrInvalidDeadLetterFilter)
+	}
+
+	for _, id := range f.IDs {
+		if strings.TrimSpace(id) == "" {
+			return fmt.Errorf("%w: event ID is empty", ErrInvalidDeadLetterFilter)
+		}
+	}
+
+	if f.CreatedFrom != nil && f.CreatedTo != nil && !f.CreatedFrom.Before(*f.CreatedTo) {
+		return fmt.Errorf("%w: created_from must be before created_to", ErrInvalidDeadLetterFilter)
+	}
+
+	return nil
+}
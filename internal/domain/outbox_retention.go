@@ -0,0 +1,146 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// OutboxPartitionPrefix prefixes the names of outbox_events' monthly
+	// partitions, which are named outbox_events_YYYY_MM.
+	OutboxPartitionPrefix = "outbox_events_"
+	// OutboxArchivePartitionPrefix prefixes the names of partitions moved
+	// to outbox_events_archive.
+	OutboxArchivePartitionPrefix = "outbox_events_archive_"
+
+	outboxPartitionLayout = "2006_01"
+)
+
+var ErrInvalidOutboxPartition = errors.New("invalid outbox partition")
+
+// OutboxArchiveMode is where the retention job keeps the events it prunes.
+type OutboxArchiveMode string
+
+const (
+	// OutboxArchiveTable moves pruned partitions to outbox_events_archive.
+	OutboxArchiveTable OutboxArchiveMode = "table"
+	// OutboxArchiveFile writes pruned partitions to gzip-compressed NDJSON
+	// files, then drops them.
+	OutboxArchiveFile OutboxArchiveMode = "file"
+)
+
+// OutboxPartition is one monthly partition of outbox_events, holding the
+// events created in [From, To) (UTC months).
+type OutboxPartition struct {
+	Name string
+	From time.Time
+	To   time.Time
+}
+
+// OutboxPartitionFor returns the partition holding events created at t.
+func OutboxPartitionFor(t time.Time) OutboxPartition {
+	t = t.UTC()
+	from := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return OutboxPartition{
+		Name: OutboxPartitionPrefix + from.Format(outboxPartitionLayout),
+		From: from,
+		To:   from.AddDate(0, 1, 0),
+	}
+}
+
+// ParseOutboxPartitionName parses a monthly partition name such as
+// outbox_events_2026_01. The default partition is not a monthly partition.
+func ParseOutboxPartitionName(name string) (OutboxPartition, error) {
+	suffix, ok := strings.CutPrefix(name, OutboxPartitionPrefix)
+	if !ok {
+		return OutboxPartition{}, fmt.Errorf("%w: %q", ErrInvalidOutboxPartition, name)
+	}
+
+	from, err := time.Parse(outboxPartitionLayout, suffix)
+	if err != nil {
+		return OutboxPartition{}, fmt.Errorf("%w: %q", ErrInvalidOutboxPartition, name)
+	}
+
+	return OutboxPartitionFor(from), nil
+}
+
+// ArchiveName is the partition's name once moved to outbox_events_archive.
+func (p OutboxPartition) ArchiveName() string {
+	return OutboxArchivePartitionPrefix + p.From.Format(outboxPartitionLayout)
+}
+
+// OutboxPartitionStats counts a partition's events.
+type OutboxPartitionStats struct {
+	Events int64
+	// Unpublished counts events not yet published, dead-lettered or not.
+	Unpublished int64
+}
+
+// OutboxPruneStatus is what the retention job did with one partition.
+type OutboxPruneStatus string
+
+const (
+	OutboxPartitionPruned   OutboxPruneStatus = "pruned"
+	OutboxPartitionRetained OutboxPruneStatus = "retained"
+	// OutboxPartitionPrunable marks a partition a dry run would prune.
+	OutboxPartitionPrunable OutboxPruneStatus = "prunable"
+)
+
+// OutboxPartitionResult is the outcome of pruning one partition.
+type OutboxPartitionResult struct {
+	Partition OutboxPartition
+	Status    OutboxPruneStatus
+	Stats     OutboxPartitionStats
+	// Reason explains why a partition was retained.
+	Reason string
+	// ArchivedTo is the archive partition or file the events were moved to.
+	ArchivedTo string
+}
+
+// OutboxPruneReport is the outcome of one retention run. Only partitions
+// entirely older than Cutoff are considered.
+type OutboxPruneReport struct {
+	Cutoff     time.Time
+	Mode       OutboxArchiveMode
+	Partitions []OutboxPartitionResult
+	// DefaultPartitionEvents counts events in the default partition, which
+	// is never pruned.
+	DefaultPartitionEvents int64
+	DryRun                 bool
+}
+
+// Pruned returns how many partitions were pruned.
+func (r *OutboxPruneReport) Pruned() int {
+	return r.count(OutboxPartitionPruned)
+}
+
+// Retained returns how many partitions past the cutoff were kept.
+func (r *OutboxPruneReport) Retained() int {
+	return r.count(OutboxPartitionRetained)
+}
+
+// EventsArchived returns how many events the pruned partitions held.
+func (r *OutboxPruneReport) EventsArchived() int64 {
+	var events int64
+	for _, p := range r.Partitions {
+		if p.Status == OutboxPartitionPruned {
+			events += p.Stats.Events
+		}
+	}
+
+	return events
+}
+
+func (r *OutboxPruneReport) count(status OutboxPruneStatus) int {
+	n := 0
+	for _, p := range r.Partitions {
+		if p.Status == status {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestOutboxPartitionFor(t *testing.T) {
+	// 23:30 on Dec 31 in UTC-5 is already January in UTC.
+	p := OutboxPartitionFor(time.Date(2025, 12, 31, 23, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+
+	if p.Name != "outbox_events_2026_01" {
+		t.Fatalf("unexpected name %q", p.Name)
+	}
+	if !p.From.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || !p.To.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected bounds: %s to %s", p.From, p.To)
+	}
+	if p.ArchiveName() != "outbox_events_archive_2026_01" {
+		t.Fatalf("unexpected archive name %q", p.ArchiveName())
+	}
+}
+
+func TestParseOutboxPartitionName(t *testing.T) {
+	p, err := ParseOutboxPartitionName("outbox_events_2026_12")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !p.From.Equal(time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC)) || !p.To.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected bounds: %s to %s", p.From, p.To)
+	}
+
+	for _, name := range []string{"outbox_events_default", "outbox_events_2026_13", "audit_logs_2026_01", "outbox_events_archive_2026_01"} {
+		if _, err := ParseOutboxPartitionName(name); !errors.Is(err, ErrInvalidOutboxPartition) {
+			t.Fatalf("%s: expected ErrInvalidOutboxPartition, got %v", name, err)
+		}
+	}
+}
+
+func TestOutboxPruneReport_Totals(t *testing.T) {
+	report := &OutboxPruneReport{Partitions: []OutboxPartitionResult{
+		{Status: OutboxPartitionPruned, Stats: OutboxPartitionStats{Events: 10}},
+		{Status: OutboxPartitionPruned, Stats: OutboxPartitionStats{Events: 5}},
+		{Status: OutboxPartitionRetained, Stats: OutboxPartitionStats{Events: 7, Unpublished: 1}},
+		{Status: OutboxPartitionPrunable, Stats: OutboxPartitionStats{Events: 3}},
+	}}
+
+	if report.Pruned() != 2 || report.Retained() != 1 || report.EventsArchived() != 15 {
+		t.Fatalf("unexpected totals: pruned %d, retained %d, archived %d", report.Pruned(), report.Retained(), report.EventsArchived())
+	}
+}
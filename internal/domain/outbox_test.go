@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeadLetterFilter_Validate(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  DeadLetterFilter
+		wantErr bool
+	}{
+		{name: "empty", filter: DeadLetterFilter{}, wantErr: true},
+		{name: "all", filter: DeadLetterFilter{All: true}},
+		{name: "ids", filter: DeadLetterFilter{IDs: []string{"evt-1"}}},
+		{name: "blank id", filter: DeadLetterFilter{IDs: []string{" "}}, wantErr: true},
+		{name: "event type", filter: DeadLetterFilter{EventType: EventTypeTransferCreated}},
+		{name: "range", filter: DeadLetterFilter{CreatedFrom: &from, CreatedTo: &to}},
+		{name: "open range", filter: DeadLetterFilter{CreatedFrom: &from}},
+		{name: "inverted range", filter: DeadLetterFilter{CreatedFrom: &to, CreatedTo: &from}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidDeadLetterFilter) {
+				t.Fatalf("expected ErrInvalidDeadLetterFilter, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidCursor is returned for a page cursor that wasn't produced by the
+// listing it was passed back to.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// TimeCursor is the position after the last row of a page ordered by a
+// timestamp, newest first, with ties broken by ID. Audit logs and dead
+// letters page by it; clients see it only as an opaque string.
+type TimeCursor struct {
+	At time.Time
+	ID string
+}
+
+type timeCursorJSON struct {
+	At string `json:"t"`
+	ID string `json:"id"`
+}
+
+// Encode returns the cursor as an opaque, URL-safe string.
+func (c TimeCursor) Encode() string {
+	data, _ := json.Marshal(timeCursorJSON{
+		At: c.At.UTC().Format(time.RFC3339Nano),
+		ID: c.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeTimeCursor parses a cursor from Encode.
+func DecodeTimeCursor(s string) (TimeCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return TimeCursor{}, ErrInvalidCursor
+	}
+
+	var raw timeCursorJSON
+	if err := json.Unmarshal(data, &raw); err != nil || raw.ID == "" {
+		return TimeCursor{}, ErrInvalidCursor
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, raw.At)
+	if err != nil {
+		return TimeCursor{}, ErrInvalidCursor
+	}
+
+	return TimeCursor{At: at, ID: raw.ID}, nil
+}
@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimeCursor_RoundTrip(t *testing.T) {
+	cursor := TimeCursor{At: time.Date(2026, 10, 1, 12, 0, 0, 123456000, time.UTC), ID: "01J0000000000000000000000A"}
+
+	got, err := DecodeTimeCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.At.Equal(cursor.At) || got.ID != cursor.ID {
+		t.Fatalf("expected %+v, got %+v", cursor, got)
+	}
+}
+
+func TestDecodeTimeCursor_Invalid(t *testing.T) {
+	for _, s := range []string{"", "!!!", "bm90LWpzb24", "eyJ0IjoieWVzdGVyZGF5IiwiaWQiOiJhIn0"} {
+		if _, err := DecodeTimeCursor(s); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%q: expected ErrInvalidCursor, got %v", s, err)
+		}
+	}
+}
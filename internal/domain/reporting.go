@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// ReportingProjection names the checkpoint of the reporting read models.
+	ReportingProjection = "reporting"
+	// MaxReportingPeriod bounds the days one daily-volume query returns.
+	MaxReportingPeriod = 366 * 24 * time.Hour
+)
+
+var ErrInvalidReportingPeriod = errors.New("invalid reporting period")
+
+// ValidateReportingPeriod checks a daily-volume query's [from, to) range.
+func ValidateReportingPeriod(from, to time.Time) error {
+	if !to.After(from) {
+		return NewFieldError("to", fmt.Errorf("%w: end must be after start", ErrInvalidReportingPeriod))
+	}
+
+	if to.Sub(from) > MaxReportingPeriod {
+		return NewFieldError("to", fmt.Errorf("%w: period must not exceed %d days", ErrInvalidReportingPeriod, int(MaxReportingPeriod.Hours()/24)))
+	}
+
+	return nil
+}
+
+// ProjectionCursor is a position in the entries table, in the order the
+// projector applies entries: by inserting transaction, then entry ID.
+type ProjectionCursor struct {
+	TxID    int64
+	EntryID string
+}
+
+// ProjectionCheckpoint records how far a projection has applied entries.
+type ProjectionCheckpoint struct {
+	Name             string
+	Cursor           ProjectionCursor
+	EntriesProjected int64
+	UpdatedAt        time.Time
+}
+
+// ProjectableEntry is a ledger entry with what the reporting read models
+// need from its transfer and account.
+type ProjectableEntry struct {
+	Cursor                ProjectionCursor
+	AccountID             string
+	TransferID            string
+	Amount                decimal.Decimal
+	AccountCurrentBalance decimal.Decimal
+	AccountVersion        int64
+	CreatedAt             time.Time
+	EventAt               time.Time
+	Currency              string
+	CounterpartyAccountID string
+}
+
+// AccountDailyVolume is an account's debits and credits on one day (UTC),
+// by transfer event date.
+type AccountDailyVolume struct {
+	AccountID   string
+	Day         time.Time
+	Currency    string
+	DebitTotal  decimal.Decimal
+	CreditTotal decimal.Decimal
+	EntryCount  int64
+}
+
+// CounterpartyTotal is what an account sent to and received from one
+// counterparty account.
+type CounterpartyTotal struct {
+	AccountID             string
+	CounterpartyAccountID string
+	Currency              string
+	SentTotal             decimal.Decimal
+	ReceivedTotal         decimal.Decimal
+	EntryCount            int64
+}
+
+// AccountBalanceSnapshot is an account's balance as of its latest projected
+// entry.
+type AccountBalanceSnapshot struct {
+	AccountID      string
+	Currency       string
+	Balance        decimal.Decimal
+	AccountVersion int64
+	LastEntryID    string
+	LastEntryAt    time.Time
+}
+
+// ReportingDelta is the change one batch of entries makes to the reporting
+// read models. Volumes and totals are increments; balances replace older
+// versions.
+type ReportingDelta struct {
+	Volumes        []AccountDailyVolume
+	Counterparties []CounterpartyTotal
+	Balances       []AccountBalanceSnapshot
+	// Cursor is the position of the batch's last entry.
+	Cursor  ProjectionCursor
+	Entries int
+}
+
+// NewReportingDelta folds a batch of entries, in projection order, into
+// per-key increments. Keys are sorted so concurrent writers lock rows in
+// the same order.
+func NewReportingDelta(entries []*ProjectableEntry) *ReportingDelta {
+	type volumeKey struct {
+		accountID string
+		day       time.Time
+	}
+	type counterpartyKey struct {
+		accountID      string
+		counterpartyID string
+	}
+
+	volumes := make(map[volumeKey]*AccountDailyVolume)
+	counterparties := make(map[counterpartyKey]*CounterpartyTotal)
+	balances := make(map[string]*AccountBalanceSnapshot)
+
+	delta := &ReportingDelta{Entries: len(entries)}
+
+	for _, e := range entries {
+		delta.Cursor = e.Cursor
+
+		day := e.EventAt.UTC().Truncate(24 * time.Hour)
+		v, ok := volumes[volumeKey{e.AccountID, day}]
+		if !ok {
+			v = &AccountDailyVolume{AccountID: e.AccountID, Day: day, Currency: e.Currency}
+			volumes[volumeKey{e.AccountID, day}] = v
+		}
+
+		c, ok := counterparties[counterpartyKey{e.AccountID, e.CounterpartyAccountID}]
+		if !ok {
+			c = &CounterpartyTotal{AccountID: e.AccountID, CounterpartyAccountID: e.CounterpartyAccountID, Currency: e.Currency}
+			counterparties[counterpartyKey{e.AccountID, e.CounterpartyAccountID}] = c
+		}
+
+		// Debit entries are negative.
+		if e.Amount.IsNegative() {
+			v.DebitTotal = v.DebitTotal.Add(e.Amount.Neg())
+			c.SentTotal = c.SentTotal.Add(e.Amount.Neg())
+		} else {
+			v.CreditTotal = v.CreditTotal.Add(e.Amount)
+			c.ReceivedTotal = c.ReceivedTotal.Add(e.Amount)
+		}
+		v.EntryCount++
+		c.EntryCount++
+
+		if b, ok := balances[e.AccountID]; !ok || e.AccountVersion > b.AccountVersion {
+			balances[e.AccountID] = &AccountBalanceSnapshot{
+				AccountID:      e.AccountID,
+				Currency:       e.Currency,
+				Balance:        e.AccountCurrentBalance,
+				AccountVersion: e.AccountVersion,
+				LastEntryID:    e.Cursor.EntryID,
+				LastEntryAt:    e.CreatedAt,
+			}
+		}
+	}
+
+	for _, v := range volumes {
+		delta.Volumes = append(delta.Volumes, *v)
+	}
+	sort.Slice(delta.Volumes, func(i, j int) bool {
+		a, b := delta.Volumes[i], delta.Volumes[j]
+		if a.AccountID != b.AccountID {
+			return a.AccountID < b.AccountID
+		}
+		return a.Day.Before(b.Day)
+	})
+
+	for _, c := range counterparties {
+		delta.Counterparties = append(delta.Counterparties, *c)
+	}
+	sort.Slice(delta.Counterparties, func(i, j int) bool {
+		a, b := delta.Counterparties[i], delta.Counterparties[j]
+		if a.AccountID != b.AccountID {
+			return a.AccountID < b.AccountID
+		}
+		return a.CounterpartyAccountID < b.CounterpartyAccountID
+	})
+
+	for _, b := range balances {
+		delta.Balances = append(delta.Balances, *b)
+	}
+	sort.Slice(delta.Balances, func(i, j int) bool {
+		return delta.Balances[i].AccountID < delta.Balances[j].AccountID
+	})
+
+	return delta
+}
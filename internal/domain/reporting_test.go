@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewReportingDelta(t *testing.T) {
+	day1 := time.Date(2026, 3, 1, 23, 30, 0, 0, time.UTC)
+	day2 := day1.Add(time.Hour)
+
+	entries := []*ProjectableEntry{
+		{
+			Cursor: ProjectionCursor{TxID: 10, EntryID: "e1"}, AccountID: "b", CounterpartyAccountID: "a",
+			Amount: decimal.NewFromInt(-30), AccountCurrentBalance: decimal.NewFromInt(70), AccountVersion: 2,
+			EventAt: day1, Currency: "USD",
+		},
+		{
+			Cursor: ProjectionCursor{TxID: 10, EntryID: "e2"}, AccountID: "a", CounterpartyAccountID: "b",
+			Amount: decimal.NewFromInt(30), AccountCurrentBalance: decimal.NewFromInt(30), AccountVersion: 1,
+			EventAt: day1, Currency: "USD",
+		},
+		{
+			Cursor: ProjectionCursor{TxID: 11, EntryID: "e3"}, AccountID: "b", CounterpartyAccountID: "a",
+			Amount: decimal.NewFromInt(5), AccountCurrentBalance: decimal.NewFromInt(75), AccountVersion: 3,
+			EventAt: day2, Currency: "USD",
+		},
+		{
+			Cursor: ProjectionCursor{TxID: 11, EntryID: "e4"}, AccountID: "b", CounterpartyAccountID: "a",
+			Amount: decimal.NewFromInt(-10), AccountCurrentBalance: decimal.NewFromInt(65), AccountVersion: 4,
+			EventAt: day2, Currency: "USD",
+		},
+	}
+
+	delta := NewReportingDelta(entries)
+
+	if delta.Entries != 4 || delta.Cursor != (ProjectionCursor{TxID: 11, EntryID: "e4"}) {
+		t.Fatalf("unexpected delta position: %d entries, cursor %+v", delta.Entries, delta.Cursor)
+	}
+
+	if len(delta.Volumes) != 3 {
+		t.Fatalf("expected 3 daily volumes, got %d", len(delta.Volumes))
+	}
+	if v := delta.Volumes[0]; v.AccountID != "a" || !v.CreditTotal.Equal(decimal.NewFromInt(30)) || !v.DebitTotal.IsZero() {
+		t.Fatalf("unexpected volume for a: %+v", v)
+	}
+	if v := delta.Volumes[2]; v.AccountID != "b" || !v.Day.Equal(day2.Truncate(24*time.Hour)) ||
+		!v.DebitTotal.Equal(decimal.NewFromInt(10)) || !v.CreditTotal.Equal(decimal.NewFromInt(5)) || v.EntryCount != 2 {
+		t.Fatalf("unexpected second-day volume for b: %+v", v)
+	}
+
+	if len(delta.Counterparties) != 2 {
+		t.Fatalf("expected 2 counterparty totals, got %d", len(delta.Counterparties))
+	}
+	if c := delta.Counterparties[1]; c.AccountID != "b" || c.CounterpartyAccountID != "a" ||
+		!c.SentTotal.Equal(decimal.NewFromInt(40)) || !c.ReceivedTotal.Equal(decimal.NewFromInt(5)) || c.EntryCount != 3 {
+		t.Fatalf("unexpected counterparty total for b: %+v", c)
+	}
+
+	if len(delta.Balances) != 2 {
+		t.Fatalf("expected 2 balances, got %d", len(delta.Balances))
+	}
+	if b := delta.Balances[1]; b.AccountID != "b" || !b.Balance.Equal(decimal.NewFromInt(65)) || b.AccountVersion != 4 || b.LastEntryID != "e4" {
+		t.Fatalf("expected b's latest balance, got %+v", b)
+	}
+}
+
+func TestNewReportingDelta_Empty(t *testing.T) {
+	delta := NewReportingDelta(nil)
+	if delta.Entries != 0 || len(delta.Volumes) != 0 || delta.Cursor != (ProjectionCursor{}) {
+		t.Fatalf("expected an empty delta, got %+v", delta)
+	}
+}
+
+func TestValidateReportingPeriod(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := ValidateReportingPeriod(from, from.AddDate(0, 1, 0)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := ValidateReportingPeriod(from, from); !errors.Is(err, ErrInvalidReportingPeriod) {
+		t.Fatalf("expected ErrInvalidReportingPeriod for an empty period, got %v", err)
+	}
+
+	if err := ValidateReportingPeriod(from, from.AddDate(2, 0, 0)); !errors.Is(err, ErrInvalidReportingPeriod) {
+		t.Fatalf("expected ErrInvalidReportingPeriod for a long period, got %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var ErrInvalidStatementPeriod = errors.New("invalid statement period")
+
+// MaxStatementPeriod bounds a single statement, so one request can't pull an
+// account's entire history into memory.
+const MaxStatementPeriod = 366 * 24 * time.Hour
+
+// Statement is an account statement for the period [PeriodStart, PeriodEnd):
+// the balance before the period, every entry booked in it, and the balance
+// after it. ClosingBalance always equals OpeningBalance + TotalCredits -
+// TotalDebits.
+type Statement struct {
+	PeriodStart    time.Time
+	PeriodEnd      time.Time
+	GeneratedAt    time.Time
+	AccountID      string
+	AccountName    string
+	Currency       string
+	OpeningBalance decimal.Decimal
+	ClosingBalance decimal.Decimal
+	TotalCredits   decimal.Decimal
+	TotalDebits    decimal.Decimal
+	Lines          []StatementLine
+}
+
+// StatementLine is one entry on a statement, joined with the transfer that
+// produced it.
+type StatementLine struct {
+	// BookedAt is when the entry was recorded; ValueAt is the transfer's
+	// business event time.
+	BookedAt              time.Time
+	ValueAt               time.Time
+	Metadata              map[string]any
+	ReversedTransferID    *string
+	EntryID               string
+	TransferID            string
+	CounterpartyAccountID string
+	// Amount is signed: positive credits the account, negative debits it.
+	Amount  decimal.Decimal
+	Balance decimal.Decimal
+}
+
+// ValidateStatementPeriod checks that [from, to) is a non-empty period no
+// longer than MaxStatementPeriod.
+func ValidateStatementPeriod(from, to time.Time) error {
+	if !to.After(from) {
+		return NewFieldError("to", fmt.Errorf("%w: end must be after start", ErrInvalidStatementPeriod))
+	}
+
+	if to.Sub(from) > MaxStatementPeriod {
+		return NewFieldError("to", fmt.Errorf("%w: period must not exceed %d days", ErrInvalidStatementPeriod, int(MaxStatementPeriod.Hours()/24)))
+	}
+
+	return nil
+}
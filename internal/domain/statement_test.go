@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateStatementPeriod(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		to    time.Time
+		valid bool
+	}{
+		{"one month", from.AddDate(0, 1, 0), true},
+		{"full leap year", from.AddDate(0, 0, 366), true},
+		{"empty period", from, false},
+		{"end before start", from.Add(-time.Hour), false},
+		{"too long", from.AddDate(0, 0, 367), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateStatementPeriod(from, tt.to)
+			if tt.valid && err != nil {
+				t.Fatalf("expected valid period, got %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidStatementPeriod) {
+				t.Fatalf("expected ErrInvalidStatementPeriod, got %v", err)
+			}
+		})
+	}
+}
@@ -16,6 +16,12 @@ type Transfer struct {
 	ToAccountID        string
 	Amount             decimal.Decimal
 	ReversedTransferID *string
+	// IdempotencyKey is an optional client-supplied key, unique across all
+	// transfers.
+	IdempotencyKey *string
+	// Fees are the fee legs charged on this transfer by matching fee
+	// policies. Only populated on the transfer returned from creation.
+	Fees []TransferFee
 }
 
 // Validate validates transfer request.
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidTransferSearch is returned for an invalid transfer search
+// filter, sort or cursor.
+var ErrInvalidTransferSearch = errors.New("invalid transfer search")
+
+// TransferDirection restricts a search on an account to one side of its
+// transfers.
+type TransferDirection string
+
+const (
+	// TransferDirectionIncoming matches transfers to the account.
+	TransferDirectionIncoming TransferDirection = "incoming"
+	// TransferDirectionOutgoing matches transfers from the account.
+	TransferDirectionOutgoing TransferDirection = "outgoing"
+)
+
+// TransferReversalStatus filters transfers by their part in a reversal.
+type TransferReversalStatus string
+
+const (
+	// TransferReversalStatusReversed matches transfers that have been
+	// reversed.
+	TransferReversalStatusReversed TransferReversalStatus = "reversed"
+	// TransferReversalStatusNotReversed matches ordinary transfers that
+	// haven't been reversed, leaving out reversals themselves.
+	TransferReversalStatusNotReversed TransferReversalStatus = "not_reversed"
+	// TransferReversalStatusReversal matches reversals.
+	TransferReversalStatusReversal TransferReversalStatus = "reversal"
+)
+
+// TransferSearchFilter selects transfers for a search. Zero fields don't
+// filter; time and amount ranges are [from, to) and [min, max].
+type TransferSearchFilter struct {
+	// AccountID matches transfers from or to the account, narrowed by
+	// Direction.
+	AccountID     string
+	Direction     TransferDirection
+	MinAmount     *decimal.Decimal
+	MaxAmount     *decimal.Decimal
+	EventAtFrom   *time.Time
+	EventAtTo     *time.Time
+	CreatedAtFrom *time.Time
+	CreatedAtTo   *time.Time
+	// Currency is the currency of the transfer's accounts.
+	Currency       string
+	ReversalStatus TransferReversalStatus
+	// Metadata matches transfers whose metadata contains it (JSONB @>).
+	Metadata map[string]any
+}
+
+// Validate checks the filter's enums and ranges.
+func (f TransferSearchFilter) Validate() error {
+	switch f.Direction {
+	case "":
+	case TransferDirectionIncoming, TransferDirectionOutgoing:
+		if f.AccountID == "" {
+			return NewFieldError("direction", fmt.Errorf("%w: direction requires an account", ErrInvalidTransferSearch))
+		}
+	default:
+		return NewFieldError("direction", fmt.Errorf("%w: unknown direction %q", ErrInvalidTransferSearch, f.Direction))
+	}
+
+	switch f.ReversalStatus {
+	case "", TransferReversalStatusReversed, TransferReversalStatusNotReversed, TransferReversalStatusReversal:
+	default:
+		return NewFieldError("reversal_status", fmt.Errorf("%w: unknown reversal status %q", ErrInvalidTransferSearch, f.ReversalStatus))
+	}
+
+	if f.MinAmount != nil && f.MaxAmount != nil && f.MinAmount.GreaterThan(*f.MaxAmount) {
+		return NewFieldError("min_amount", fmt.Errorf("%w: min_amount must not exceed max_amount", ErrInvalidTransferSearch))
+	}
+
+	if f.EventAtFrom != nil && f.EventAtTo != nil && !f.EventAtFrom.Before(*f.EventAtTo) {
+		return NewFieldError("event_at_from", fmt.Errorf("%w: event_at_from must be before event_at_to", ErrInvalidTransferSearch))
+	}
+
+	if f.CreatedAtFrom != nil && f.CreatedAtTo != nil && !f.CreatedAtFrom.Before(*f.CreatedAtTo) {
+		return NewFieldError("created_at_from", fmt.Errorf("%w: created_at_from must be before created_at_to", ErrInvalidTransferSearch))
+	}
+
+	return nil
+}
+
+// TransferSortField is the column a transfer search is ordered by. Ties
+// are broken by transfer ID.
+type TransferSortField string
+
+const (
+	TransferSortCreatedAt TransferSortField = "created_at"
+	TransferSortEventAt   TransferSortField = "event_at"
+	TransferSortAmount    TransferSortField = "amount"
+)
+
+// TransferSort orders a transfer search. The zero value is newest first.
+type TransferSort struct {
+	Field     TransferSortField
+	Ascending bool
+}
+
+// Normalize fills in the default field and validates it.
+func (s TransferSort) Normalize() (TransferSort, error) {
+	switch s.Field {
+	case "":
+		s.Field = TransferSortCreatedAt
+	case TransferSortCreatedAt, TransferSortEventAt, TransferSortAmount:
+	default:
+		return s, NewFieldError("sort", fmt.Errorf("%w: unknown sort field %q", ErrInvalidTransferSearch, s.Field))
+	}
+
+	return s, nil
+}
+
+// TransferCursor is the position after the last transfer of a search page:
+// its sort key and ID. Clients see it only as an opaque string.
+type TransferCursor struct {
+	Sort TransferSort
+	// Value is the sort key of the last transfer: RFC 3339 for times, a
+	// decimal for amounts.
+	Value string
+	ID    string
+}
+
+type transferCursorJSON struct {
+	Field     TransferSortField `json:"f"`
+	Ascending bool              `json:"a,omitempty"`
+	Value     string            `json:"v"`
+	ID        string            `json:"id"`
+}
+
+// NewTransferCursor returns the cursor after t in a search ordered by sort.
+func NewTransferCursor(sort TransferSort, t *Transfer) TransferCursor {
+	c := TransferCursor{Sort: sort, ID: t.ID}
+
+	switch sort.Field {
+	case TransferSortEventAt:
+		c.Value = t.EventAt.UTC().Format(time.RFC3339Nano)
+	case TransferSortAmount:
+		c.Value = t.Amount.String()
+	default:
+		c.Value = t.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	return c
+}
+
+// Encode returns the cursor as an opaque, URL-safe string.
+func (c TransferCursor) Encode() string {
+	data, _ := json.Marshal(transferCursorJSON{
+		Field:     c.Sort.Field,
+		Ascending: c.Sort.Ascending,
+		Value:     c.Value,
+		ID:        c.ID,
+	})
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeTransferCursor parses a cursor from Encode. It must come from a
+// search with the same sort.
+func DecodeTransferCursor(s string, sort TransferSort) (TransferCursor, error) {
+	invalid := NewFieldError("cursor", fmt.Errorf("%w: malformed cursor", ErrInvalidTransferSearch))
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return TransferCursor{}, invalid
+	}
+
+	var raw transferCursorJSON
+	if err := json.Unmarshal(data, &raw); err != nil || raw.ID == "" {
+		return TransferCursor{}, invalid
+	}
+
+	if raw.Field != sort.Field || raw.Ascending != sort.Ascending {
+		return TransferCursor{}, NewFieldError("cursor", fmt.Errorf("%w: cursor belongs to a search with a different sort", ErrInvalidTransferSearch))
+	}
+
+	switch raw.Field {
+	case TransferSortAmount:
+		if _, err := decimal.NewFromString(raw.Value); err != nil {
+			return TransferCursor{}, invalid
+		}
+	default:
+		if _, err := time.Parse(time.RFC3339Nano, raw.Value); err != nil {
+			return TransferCursor{}, invalid
+		}
+	}
+
+	return TransferCursor{Sort: sort, Value: raw.Value, ID: raw.ID}, nil
+}
@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestTransferSearchFilter_Validate(t *testing.T) {
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	low := decimal.NewFromInt(10)
+	high := decimal.NewFromInt(100)
+
+	tests := []struct {
+		name    string
+		filter  TransferSearchFilter
+		wantErr bool
+	}{
+		{name: "empty", filter: TransferSearchFilter{}},
+		{name: "direction", filter: TransferSearchFilter{AccountID: "acc-1", Direction: TransferDirectionIncoming}},
+		{name: "direction without account", filter: TransferSearchFilter{Direction: TransferDirectionOutgoing}, wantErr: true},
+		{name: "unknown direction", filter: TransferSearchFilter{AccountID: "acc-1", Direction: "sideways"}, wantErr: true},
+		{name: "reversal status", filter: TransferSearchFilter{ReversalStatus: TransferReversalStatusReversed}},
+		{name: "unknown reversal status", filter: TransferSearchFilter{ReversalStatus: "undone"}, wantErr: true},
+		{name: "amount range", filter: TransferSearchFilter{MinAmount: &low, MaxAmount: &high}},
+		{name: "exact amount", filter: TransferSearchFilter{MinAmount: &low, MaxAmount: &low}},
+		{name: "inverted amount range", filter: TransferSearchFilter{MinAmount: &high, MaxAmount: &low}, wantErr: true},
+		{name: "event range", filter: TransferSearchFilter{EventAtFrom: &from, EventAtTo: &to}},
+		{name: "inverted event range", filter: TransferSearchFilter{EventAtFrom: &to, EventAtTo: &from}, wantErr: true},
+		{name: "empty created range", filter: TransferSearchFilter{CreatedAtFrom: &from, CreatedAtTo: &from}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Validate() = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTransferSearch) {
+				t.Fatalf("expected ErrInvalidTransferSearch, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferSort_Normalize(t *testing.T) {
+	sort, err := TransferSort{}.Normalize()
+	if err != nil || sort.Field != TransferSortCreatedAt || sort.Ascending {
+		t.Fatalf("expected newest first by default, got %+v, %v", sort, err)
+	}
+
+	if _, err := (TransferSort{Field: "to_account_id"}).Normalize(); !errors.Is(err, ErrInvalidTransferSearch) {
+		t.Fatalf("expected ErrInvalidTransferSearch, got %v", err)
+	}
+}
+
+func TestTransferCursor_RoundTrip(t *testing.T) {
+	transfer := &Transfer{
+		ID:        "01J0000000000000000000000A",
+		Amount:    decimal.RequireFromString("12.50"),
+		CreatedAt: time.Date(2026, 10, 1, 12, 0, 0, 123456000, time.UTC),
+		EventAt:   time.Date(2026, 9, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, sort := range []TransferSort{
+		{Field: TransferSortCreatedAt},
+		{Field: TransferSortEventAt, Ascending: true},
+		{Field: TransferSortAmount},
+	} {
+		cursor := NewTransferCursor(sort, transfer)
+
+		got, err := DecodeTransferCursor(cursor.Encode(), sort)
+		if err != nil {
+			t.Fatalf("%+v: unexpected error: %v", sort, err)
+		}
+		if got != cursor {
+			t.Fatalf("%+v: expected %+v, got %+v", sort, cursor, got)
+		}
+	}
+}
+
+func TestDecodeTransferCursor_Invalid(t *testing.T) {
+	transfer := &Transfer{ID: "t-1", Amount: decimal.NewFromInt(1)}
+	amountCursor := NewTransferCursor(TransferSort{Field: TransferSortAmount}, transfer).Encode()
+
+	tests := []struct {
+		name   string
+		cursor string
+		sort   TransferSort
+	}{
+		{name: "not base64", cursor: "!!!", sort: TransferSort{Field: TransferSortAmount}},
+		{name: "not json", cursor: "bm90LWpzb24", sort: TransferSort{Field: TransferSortAmount}},
+		{name: "other field", cursor: amountCursor, sort: TransferSort{Field: TransferSortCreatedAt}},
+		{name: "other order", cursor: amountCursor, sort: TransferSort{Field: TransferSortAmount, Ascending: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeTransferCursor(tt.cursor, tt.sort); !errors.Is(err, ErrInvalidTransferSearch) {
+				t.Fatalf("expected ErrInvalidTransferSearch, got %v", err)
+			}
+		})
+	}
+}
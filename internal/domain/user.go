@@ -97,6 +97,21 @@ func (r Role) CanManageAccounts() bool {
 	return r == RoleAdmin
 }
 
+// Satisfies reports whether r grants at least the access of min: admin
+// satisfies every role, operator satisfies operator and viewer.
+func (r Role) Satisfies(min Role) bool {
+	switch min {
+	case RoleAdmin:
+		return r == RoleAdmin
+	case RoleOperator:
+		return r == RoleAdmin || r == RoleOperator
+	case RoleViewer:
+		return r.IsValid()
+	default:
+		return false
+	}
+}
+
 // CanViewAll checks if the role can view all resources
 func (r Role) CanViewAll() bool {
 	// All authenticated users can view
@@ -110,3 +125,41 @@ var (
 	ErrExpiredToken     = errors.New("token has expired")
 	ErrInsufficientRole = errors.New("insufficient role for this operation")
 )
+
+// User management errors
+var (
+	ErrUserNotFound      = errors.New("user not found")
+	ErrUserAlreadyExists = errors.New("user with this email already exists")
+	ErrUserInactive      = errors.New("user account is inactive")
+	ErrInvalidRole       = errors.New("invalid role")
+	ErrIncorrectPassword = errors.New("current password is incorrect")
+	// ErrLastAdmin is returned when a change would leave no active admin.
+	ErrLastAdmin = errors.New("cannot remove the last active admin")
+)
+
+// redactedValue replaces secrets in audit state.
+const redactedValue = "[REDACTED]"
+
+// IsActiveAdmin reports whether u is an active admin.
+func (u *User) IsActiveAdmin() bool {
+	return u.Active && u.Role == RoleAdmin
+}
+
+// AuditState returns u as audit log state, with the password hash
+// redacted.
+func (u *User) AuditState() JSON {
+	state := JSON{
+		"id":         u.ID,
+		"email":      u.Email,
+		"name":       u.Name,
+		"role":       string(u.Role),
+		"active":     u.Active,
+		"created_at": u.CreatedAt,
+		"updated_at": u.UpdatedAt,
+	}
+	if u.HashedPassword != "" {
+		state["hashed_password"] = redactedValue
+	}
+
+	return state
+}
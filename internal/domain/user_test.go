@@ -0,0 +1,29 @@
+package domain
+
+import "testing"
+
+func TestRole_Satisfies(t *testing.T) {
+	tests := []struct {
+		role Role
+		min  Role
+		want bool
+	}{
+		{RoleAdmin, RoleAdmin, true},
+		{RoleAdmin, RoleOperator, true},
+		{RoleAdmin, RoleViewer, true},
+		{RoleOperator, RoleAdmin, false},
+		{RoleOperator, RoleOperator, true},
+		{RoleOperator, RoleViewer, true},
+		{RoleViewer, RoleAdmin, false},
+		{RoleViewer, RoleOperator, false},
+		{RoleViewer, RoleViewer, true},
+		{Role("guest"), RoleViewer, false},
+		{RoleAdmin, Role("guest"), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.role.Satisfies(tt.min); got != tt.want {
+			t.Errorf("%s.Satisfies(%s) = %v, want %v", tt.role, tt.min, got, tt.want)
+		}
+	}
+}
@@ -48,11 +48,11 @@ func ValidateAccountName(name string) error {
 	name = strings.TrimSpace(name)
 
 	if len(name) < MinAccountNameLength {
-		return fmt.Errorf("%w: name cannot be empty", ErrInvalidAccountName)
+		return NewFieldError("name", fmt.Errorf("%w: name cannot be empty", ErrInvalidAccountName))
 	}
 
 	if len(name) > MaxAccountNameLength {
-		return fmt.Errorf("%w: name exceeds %d characters", ErrInvalidAccountName, MaxAccountNameLength)
+		return NewFieldError("name", fmt.Errorf("%w: name exceeds %d characters", ErrInvalidAccountName, MaxAccountNameLength))
 	}
 
 	// Check for SQL injection attempts
@@ -60,7 +60,7 @@ func ValidateAccountName(name string) error {
 	nameUpper := strings.ToUpper(name)
 	for _, pattern := range dangerous {
 		if strings.Contains(nameUpper, pattern) {
-			return fmt.Errorf("%w: contains forbidden characters", ErrInvalidAccountName)
+			return NewFieldError("name", fmt.Errorf("%w: contains forbidden characters", ErrInvalidAccountName))
 		}
 	}
 
@@ -72,7 +72,7 @@ func ValidateCurrency(currency string) error {
 	currency = strings.ToUpper(strings.TrimSpace(currency))
 
 	if !validCurrencies[currency] {
-		return fmt.Errorf("%w: %s is not a valid ISO 4217 currency code", ErrInvalidCurrency, currency)
+		return NewFieldError("currency", fmt.Errorf("%w: %s is not a valid ISO 4217 currency code", ErrInvalidCurrency, currency))
 	}
 
 	return nil
@@ -81,17 +81,17 @@ func ValidateCurrency(currency string) error {
 // ValidateAmount validates transfer/hold amount
 func ValidateAmount(amount decimal.Decimal) error {
 	if amount.LessThanOrEqual(decimal.Zero) {
-		return ErrInvalidAmount
+		return NewFieldError("amount", ErrInvalidAmount)
 	}
 
 	minAmount, _ := decimal.NewFromString(MinTransferAmount)
 	if amount.LessThan(minAmount) {
-		return fmt.Errorf("%w: minimum amount is %s", ErrAmountTooSmall, MinTransferAmount)
+		return NewFieldError("amount", fmt.Errorf("%w: minimum amount is %s", ErrAmountTooSmall, MinTransferAmount))
 	}
 
 	maxAmount, _ := decimal.NewFromString(MaxTransferAmount)
 	if amount.GreaterThan(maxAmount) {
-		return fmt.Errorf("%w: maximum amount is %s", ErrAmountTooLarge, MaxTransferAmount)
+		return NewFieldError("amount", fmt.Errorf("%w: maximum amount is %s", ErrAmountTooLarge, MaxTransferAmount))
 	}
 
 	return nil
@@ -111,7 +111,7 @@ func ValidateMetadata(metadata map[string]any) error {
 	}
 
 	if size > MaxMetadataSize {
-		return fmt.Errorf("%w: metadata size %d bytes exceeds limit of %d bytes", ErrMetadataTooLarge, size, MaxMetadataSize)
+		return NewFieldError("metadata", fmt.Errorf("%w: metadata size %d bytes exceeds limit of %d bytes", ErrMetadataTooLarge, size, MaxMetadataSize))
 	}
 
 	return nil
@@ -122,7 +122,7 @@ func ValidateEmail(email string) error {
 	email = strings.TrimSpace(strings.ToLower(email))
 
 	if !emailRegex.MatchString(email) {
-		return ErrInvalidEmail
+		return NewFieldError("email", ErrInvalidEmail)
 	}
 
 	return nil
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var (
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrInvalidWebhookSubscription  = errors.New("invalid webhook subscription")
+)
+
+// WebhookSubscription delivers every outbox event it matches to URL as a
+// signed HTTP POST. All set match criteria must hold; unset criteria match
+// anything.
+type WebhookSubscription struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// EventTypes lists the event types to deliver; empty means all. An
+	// entry ending in ".*" matches a family, e.g. "transfer.*".
+	EventTypes    []string
+	AggregateType *string
+	AggregateID   *string
+	ID            string
+	URL           string
+	// Secret keys the HMAC-SHA256 signature of every delivery.
+	Secret      string
+	Description string
+	Active      bool
+}
+
+// Validate checks the subscription is internally consistent.
+func (s *WebhookSubscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return NewFieldError("url", fmt.Errorf("%w: url must be an absolute http or https URL", ErrInvalidWebhookSubscription))
+	}
+
+	if s.Secret == "" {
+		return NewFieldError("secret", fmt.Errorf("%w: secret is required", ErrInvalidWebhookSubscription))
+	}
+
+	for _, t := range s.EventTypes {
+		if strings.TrimSpace(t) == "" || t == ".*" {
+			return NewFieldError("event_types", fmt.Errorf("%w: event type %q is empty", ErrInvalidWebhookSubscription, t))
+		}
+	}
+
+	if s.AggregateID != nil && s.AggregateType == nil {
+		return NewFieldError("aggregate_type", fmt.Errorf("%w: aggregate id requires an aggregate type", ErrInvalidWebhookSubscription))
+	}
+
+	return nil
+}
+
+// Matches reports whether the subscription should receive the event.
+func (s *WebhookSubscription) Matches(event *OutboxEvent) bool {
+	if !s.Active {
+		return false
+	}
+
+	if s.AggregateType != nil && *s.AggregateType != event.AggregateType {
+		return false
+	}
+
+	if s.AggregateID != nil && *s.AggregateID != event.AggregateID {
+		return false
+	}
+
+	return matchesEventType(s.EventTypes, event.EventType)
+}
+
+// matchesEventType reports whether eventType matches any of patterns; an
+// empty list matches everything and a pattern ending in ".*" matches a
+// family, e.g. "transfer.*".
+func matchesEventType(patterns []string, eventType string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, t := range patterns {
+		if prefix, ok := strings.CutSuffix(t, "*"); ok && strings.HasSuffix(prefix, ".") {
+			if strings.HasPrefix(eventType, prefix) {
+				return true
+			}
+		} else if t == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookDeliveryStatus is the lifecycle state of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending deliveries are waiting for their next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryDelivered deliveries got a 2xx response.
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryDeadLettered deliveries exhausted their attempts and
+	// are only retried when redelivered explicitly.
+	WebhookDeliveryDeadLettered WebhookDeliveryStatus = "dead_lettered"
+)
+
+// WebhookDelivery is one outbox event queued for one subscription.
+type WebhookDelivery struct {
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	NextAttemptAt  time.Time
+	DeliveredAt    *time.Time
+	DeadLetteredAt *time.Time
+	LastStatusCode *int
+	ID             string
+	SubscriptionID string
+	EventID        string
+	EventType      string
+	Status         WebhookDeliveryStatus
+	LastError      string
+	// Body is the exact request body that is signed and sent.
+	Body     []byte
+	Attempts int
+}
+
+// WebhookDeliveryAttempt records the outcome of one HTTP attempt.
+type WebhookDeliveryAttempt struct {
+	AttemptedAt time.Time
+	StatusCode  *int
+	DeliveryID  string
+	Error       string
+	Attempt     int
+	Duration    time.Duration
+}
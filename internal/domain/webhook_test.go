@@ -0,0 +1,35 @@
+package domain
+
+import "testing"
+
+func TestWebhookSubscription_Matches(t *testing.T) {
+	transfer := AggregateTypeTransfer
+	trID := "tr-1"
+
+	event := &OutboxEvent{AggregateType: AggregateTypeTransfer, AggregateID: "tr-1", EventType: EventTypeTransferCreated}
+
+	tests := []struct {
+		name string
+		sub  WebhookSubscription
+		want bool
+	}{
+		{name: "no filters", sub: WebhookSubscription{Active: true}, want: true},
+		{name: "inactive", sub: WebhookSubscription{}, want: false},
+		{name: "exact type", sub: WebhookSubscription{Active: true, EventTypes: []string{"hold.voided", "transfer.created"}}, want: true},
+		{name: "other type", sub: WebhookSubscription{Active: true, EventTypes: []string{"transfer.reversed"}}, want: false},
+		{name: "family", sub: WebhookSubscription{Active: true, EventTypes: []string{"transfer.*"}}, want: true},
+		{name: "family is not a prefix match", sub: WebhookSubscription{Active: true, EventTypes: []string{"trans*"}}, want: false},
+		{name: "aggregate", sub: WebhookSubscription{Active: true, AggregateType: &transfer, AggregateID: &trID}, want: true},
+		{name: "other aggregate", sub: WebhookSubscription{Active: true, AggregateType: &transfer, AggregateID: ptr("tr-2")}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sub.Matches(event); got != tt.want {
+				t.Fatalf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptr(s string) *string { return &s }
@@ -0,0 +1,147 @@
+// Package accrual runs the interest/fee accrual engine on a schedule,
+// processing each completed business day once its end-of-day balances are
+// final.
+package accrual
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// Runner is the subset of AccrualUseCase the scheduler depends on, so tests
+// can supply a fake without a real database.
+type Runner interface {
+	RunAccruals(ctx context.Context, day time.Time) (*usecase.AccrualReport, error)
+}
+
+// Scheduler periodically runs accruals for recently completed days.
+type Scheduler struct {
+	accrualUC   Runner
+	logger      *slog.Logger
+	metrics     *metrics.Metrics
+	now         func() time.Time
+	interval    time.Duration
+	catchUpDays int
+}
+
+// Config for Scheduler.
+type Config struct {
+	AccrualUC Runner
+	Logger    *slog.Logger
+	Metrics   *metrics.Metrics
+	// Now overrides the clock (tests); defaults to time.Now.
+	Now      func() time.Time
+	Interval time.Duration
+	// CatchUpDays is how many completed days (ending yesterday) each pass
+	// covers, so accruals missed while the server was down are posted once
+	// it's back. Already-processed days are no-ops. Defaults to 1.
+	CatchUpDays int
+}
+
+// NewScheduler creates a new accrual Scheduler.
+func NewScheduler(cfg Config) *Scheduler {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+
+	if cfg.CatchUpDays <= 0 {
+		cfg.CatchUpDays = 1
+	}
+
+	return &Scheduler{
+		accrualUC:   cfg.AccrualUC,
+		logger:      cfg.Logger,
+		metrics:     cfg.Metrics,
+		now:         cfg.Now,
+		interval:    cfg.Interval,
+		catchUpDays: cfg.CatchUpDays,
+	}
+}
+
+// Start runs accruals on a ticker until the context is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.logger.Info("accrual scheduler started",
+		slog.Duration("interval", s.interval),
+		slog.Int("catch_up_days", s.catchUpDays))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.runOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("accrual scheduler shutting down")
+			return ctx.Err()
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce processes each day in the catch-up window, oldest first. Errors
+// are logged but never fatal to the scheduler loop.
+func (s *Scheduler) runOnce(ctx context.Context) {
+	today := s.now().UTC()
+
+	for i := s.catchUpDays; i >= 1; i-- {
+		day := today.AddDate(0, 0, -i)
+
+		report, err := s.accrualUC.RunAccruals(ctx, day)
+		if err != nil {
+			s.logger.Error("accrual run failed",
+				slog.String("day", day.Format(time.DateOnly)),
+				slog.String("error", err.Error()))
+			if s.metrics != nil {
+				s.metrics.AccrualRuns.WithLabelValues("error").Inc()
+			}
+			continue
+		}
+
+		s.record(report)
+	}
+}
+
+func (s *Scheduler) record(report *usecase.AccrualReport) {
+	day := report.Day.Format(time.DateOnly)
+
+	for _, f := range report.Failures {
+		s.logger.Error("accrual failed to post",
+			slog.String("day", day),
+			slog.String("rule_id", f.RuleID),
+			slog.String("account_id", f.AccountID),
+			slog.String("error", f.Error))
+	}
+
+	status := "ok"
+	if len(report.Failures) > 0 {
+		status = "partial"
+	}
+
+	if report.Posted > 0 || report.Skipped > 0 || len(report.Failures) > 0 {
+		s.logger.Info("accrual run completed",
+			slog.String("day", day),
+			slog.Int("posted", report.Posted),
+			slog.Int("skipped", report.Skipped),
+			slog.Int("already_processed", report.AlreadyProcessed),
+			slog.Int("redriven", report.Redriven),
+			slog.Int("failed", len(report.Failures)))
+	}
+
+	if s.metrics == nil {
+		return
+	}
+
+	s.metrics.AccrualRuns.WithLabelValues(status).Inc()
+	s.metrics.AccrualsPosted.Add(float64(report.Posted))
+	s.metrics.AccrualFailures.Add(float64(len(report.Failures)))
+}
@@ -0,0 +1,123 @@
+package accrual_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/iho/goledger/internal/infrastructure/accrual"
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type fakeRunner struct {
+	days   []time.Time
+	report *usecase.AccrualReport
+	err    error
+}
+
+func (f *fakeRunner) RunAccruals(ctx context.Context, day time.Time) (*usecase.AccrualReport, error) {
+	f.days = append(f.days, day)
+	if f.err != nil {
+		return nil, f.err
+	}
+	report := *f.report
+	report.Day = day
+	return &report, nil
+}
+
+// newTestMetrics registers metrics against a fresh registry so tests don't
+// collide with the process-wide default Prometheus registry.
+func newTestMetrics(t *testing.T) *metrics.Metrics {
+	t.Helper()
+
+	registry := prometheus.NewRegistry()
+	prevRegisterer, prevGatherer := prometheus.DefaultRegisterer, prometheus.DefaultGatherer
+	prometheus.DefaultRegisterer = registry
+	prometheus.DefaultGatherer = registry
+	t.Cleanup(func() {
+		prometheus.DefaultRegisterer, prometheus.DefaultGatherer = prevRegisterer, prevGatherer
+	})
+
+	return metrics.New()
+}
+
+func runOnceViaShortLoop(t *testing.T, s *accrual.Scheduler) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := s.Start(ctx)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestScheduler_CoversCatchUpWindowOldestFirst(t *testing.T) {
+	fake := &fakeRunner{report: &usecase.AccrualReport{Posted: 2}}
+	now := time.Date(2026, 10, 18, 6, 0, 0, 0, time.UTC)
+
+	m := newTestMetrics(t)
+	s := accrual.NewScheduler(accrual.Config{
+		AccrualUC:   fake,
+		Metrics:     m,
+		Now:         func() time.Time { return now },
+		Interval:    time.Hour,
+		CatchUpDays: 3,
+	})
+
+	runOnceViaShortLoop(t, s)
+
+	want := []string{"2026-10-15", "2026-10-16", "2026-10-17"}
+	if len(fake.days) != len(want) {
+		t.Fatalf("expected %d days, got %v", len(want), fake.days)
+	}
+	for i, d := range fake.days {
+		if d.Format(time.DateOnly) != want[i] {
+			t.Fatalf("expected day %d to be %s, got %s", i, want[i], d.Format(time.DateOnly))
+		}
+	}
+
+	if got := testutil.ToFloat64(m.AccrualRuns.WithLabelValues("ok")); got != 3 {
+		t.Fatalf("expected 3 ok runs, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.AccrualsPosted); got != 6 {
+		t.Fatalf("expected 6 posted accruals, got %v", got)
+	}
+}
+
+func TestScheduler_FailuresRecordPartialMetric(t *testing.T) {
+	fake := &fakeRunner{report: &usecase.AccrualReport{
+		Failures: []usecase.AccrualFailure{{RuleID: "rule-1", AccountID: "acc-1", Error: "boom"}},
+	}}
+
+	m := newTestMetrics(t)
+	s := accrual.NewScheduler(accrual.Config{AccrualUC: fake, Metrics: m, Interval: time.Hour})
+
+	runOnceViaShortLoop(t, s)
+
+	if got := testutil.ToFloat64(m.AccrualRuns.WithLabelValues("partial")); got != 1 {
+		t.Fatalf("expected 1 partial run, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.AccrualFailures); got != 1 {
+		t.Fatalf("expected 1 failure, got %v", got)
+	}
+}
+
+func TestScheduler_RunErrorRecordsErrorMetric(t *testing.T) {
+	fake := &fakeRunner{err: errors.New("db down")}
+
+	m := newTestMetrics(t)
+	s := accrual.NewScheduler(accrual.Config{AccrualUC: fake, Metrics: m, Interval: time.Hour})
+
+	runOnceViaShortLoop(t, s)
+
+	if got := testutil.ToFloat64(m.AccrualRuns.WithLabelValues("error")); got != 1 {
+		t.Fatalf("expected 1 error run, got %v", got)
+	}
+}
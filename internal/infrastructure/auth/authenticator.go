@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// DefaultUserCacheTTL is how long Authenticator trusts a loaded user, and so
+// the longest a deactivation or role change takes to reach tokens already
+// issued.
+const DefaultUserCacheTTL = 5 * time.Second
+
+// maxCachedUsers bounds the user cache; it is emptied when full.
+const maxCachedUsers = 1024
+
+// UserLoader loads the stored state of a user. usecase.UserUseCase
+// implements it.
+type UserLoader interface {
+	GetUser(ctx context.Context, id string) (*domain.User, error)
+}
+
+// Authenticator resolves bearer tokens to the stored user they were issued
+// to. A token only proves who the caller is: whether they may still sign in
+// and which role they hold are read from the user store on every request,
+// through a cache of at most ttl.
+type Authenticator struct {
+	jwtManager *JWTManager
+	users      UserLoader
+	ttl        time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedUser
+}
+
+type cachedUser struct {
+	user    domain.User
+	expires time.Time
+}
+
+// NewAuthenticator creates an authenticator verifying tokens with
+// jwtManager and loading users from users. ttl <= 0 disables the cache.
+func NewAuthenticator(jwtManager *JWTManager, users UserLoader, ttl time.Duration) *Authenticator {
+	return &Authenticator{
+		jwtManager: jwtManager,
+		users:      users,
+		ttl:        ttl,
+		cache:      make(map[string]cachedUser),
+	}
+}
+
+// Authenticate verifies token and returns its user as currently stored. It
+// returns domain.ErrInvalidToken or domain.ErrExpiredToken for bad tokens,
+// domain.ErrUnauthorized when the user no longer exists and
+// domain.ErrUserInactive when they were deactivated.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*domain.User, error) {
+	claims, err := a.jwtManager.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := a.loadUser(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil, domain.ErrUnauthorized
+		}
+		return nil, fmt.Errorf("load user %s: %w", claims.UserID, err)
+	}
+
+	if !user.Active {
+		return nil, domain.ErrUserInactive
+	}
+
+	return user, nil
+}
+
+// loadUser returns a copy of the user with id, from the cache while it is
+// fresh.
+func (a *Authenticator) loadUser(ctx context.Context, id string) (*domain.User, error) {
+	now := time.Now()
+
+	a.mu.Lock()
+	cached, ok := a.cache[id]
+	a.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		user := cached.user
+		return &user, nil
+	}
+
+	user, err := a.users.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	user.HashedPassword = ""
+
+	if a.ttl > 0 {
+		a.mu.Lock()
+		if len(a.cache) >= maxCachedUsers {
+			clear(a.cache)
+		}
+		a.cache[id] = cachedUser{user: *user, expires: now.Add(a.ttl)}
+		a.mu.Unlock()
+	}
+
+	return user, nil
+}
@@ -0,0 +1,122 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/auth"
+)
+
+type fakeUserLoader struct {
+	users map[string]domain.User
+	loads int
+}
+
+func (f *fakeUserLoader) GetUser(_ context.Context, id string) (*domain.User, error) {
+	f.loads++
+	user, ok := f.users[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+	return &user, nil
+}
+
+func TestAuthenticatorUsesStoredUser(t *testing.T) {
+	t.Parallel()
+
+	manager := auth.NewJWTManager("super-secret", time.Minute)
+	users := &fakeUserLoader{users: map[string]domain.User{
+		"user-1": {ID: "user-1", Email: "user@example.com", Role: domain.RoleAdmin, Active: true},
+	}}
+	authenticator := auth.NewAuthenticator(manager, users, 0)
+
+	token, err := manager.Generate(&domain.User{ID: "user-1", Email: "user@example.com", Role: domain.RoleAdmin})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	user, err := authenticator.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Role != domain.RoleAdmin {
+		t.Fatalf("expected admin, got %s", user.Role)
+	}
+
+	t.Run("demoted admin gets the stored role", func(t *testing.T) {
+		users.users["user-1"] = domain.User{ID: "user-1", Role: domain.RoleViewer, Active: true}
+
+		user, err := authenticator.Authenticate(context.Background(), token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if user.Role != domain.RoleViewer {
+			t.Fatalf("expected the stored viewer role, got %s", user.Role)
+		}
+	})
+
+	t.Run("deactivated user's existing token is rejected", func(t *testing.T) {
+		users.users["user-1"] = domain.User{ID: "user-1", Role: domain.RoleAdmin, Active: false}
+
+		if _, err := authenticator.Authenticate(context.Background(), token); !errors.Is(err, domain.ErrUserInactive) {
+			t.Fatalf("expected ErrUserInactive, got %v", err)
+		}
+	})
+
+	t.Run("deleted user's existing token is rejected", func(t *testing.T) {
+		delete(users.users, "user-1")
+
+		if _, err := authenticator.Authenticate(context.Background(), token); !errors.Is(err, domain.ErrUnauthorized) {
+			t.Fatalf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+
+	t.Run("invalid token is rejected before loading", func(t *testing.T) {
+		loads := users.loads
+		if _, err := authenticator.Authenticate(context.Background(), "invalid"); !errors.Is(err, domain.ErrInvalidToken) {
+			t.Fatalf("expected ErrInvalidToken, got %v", err)
+		}
+		if users.loads != loads {
+			t.Fatal("expected no user load for an invalid token")
+		}
+	})
+}
+
+func TestAuthenticatorCachesUsers(t *testing.T) {
+	t.Parallel()
+
+	manager := auth.NewJWTManager("super-secret", time.Minute)
+	users := &fakeUserLoader{users: map[string]domain.User{
+		"user-1": {ID: "user-1", Role: domain.RoleOperator, Active: true},
+	}}
+	authenticator := auth.NewAuthenticator(manager, users, time.Hour)
+
+	token, err := manager.Generate(&domain.User{ID: "user-1", Role: domain.RoleOperator})
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	for range 3 {
+		user, err := authenticator.Authenticate(context.Background(), token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// Callers may not change the cached user through the returned one.
+		user.Role = domain.RoleAdmin
+	}
+
+	if users.loads != 1 {
+		t.Fatalf("expected one load within the TTL, got %d", users.loads)
+	}
+
+	user, err := authenticator.Authenticate(context.Background(), token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.Role != domain.RoleOperator {
+		t.Fatalf("expected the cached operator role, got %s", user.Role)
+	}
+}
@@ -0,0 +1,357 @@
+// Package backup reads and writes portable ledger archives: a gzipped tar
+// holding a manifest.json followed by one NDJSON file per table. The
+// manifest records the archive format version, the schema version the rows
+// were exported from, and each table's row count and SHA-256 checksum, so a
+// truncated or altered archive is detected before a restore commits.
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// FormatVersion is the archive layout version written by this package.
+// Readers reject archives with a newer version.
+const FormatVersion = 1
+
+const manifestName = "manifest.json"
+
+// ErrCorruptArchive is returned when an archive doesn't match its manifest.
+var ErrCorruptArchive = errors.New("corrupt ledger archive")
+
+type manifest struct {
+	CreatedAt      time.Time       `json:"created_at"`
+	AuditChainHead string          `json:"audit_chain_head"`
+	Tables         []manifestTable `json:"tables"`
+	FormatVersion  int             `json:"format_version"`
+	SchemaVersion  int64           `json:"schema_version"`
+}
+
+type manifestTable struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	SHA256 string `json:"sha256"`
+	Rows   int64  `json:"rows"`
+}
+
+// Writer writes a ledger archive. Rows are spooled to temporary files,
+// since each tar entry's size and the manifest (written first) must be
+// known before any table is copied into the archive.
+type Writer struct {
+	out    io.Writer
+	dir    string
+	tables map[string]*spooledTable
+	cur    *spooledTable
+}
+
+type spooledTable struct {
+	file *os.File
+	buf  *bufio.Writer
+	hash hash.Hash
+	path string
+	rows int64
+}
+
+// NewWriter creates a Writer that writes the archive to out on Finish.
+func NewWriter(out io.Writer) (*Writer, error) {
+	dir, err := os.MkdirTemp("", "goledger-backup-*")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{out: out, dir: dir, tables: make(map[string]*spooledTable)}, nil
+}
+
+// WriteRow appends one JSON row to table. All rows of a table must be
+// written consecutively.
+func (w *Writer) WriteRow(table string, row []byte) error {
+	if w.cur == nil || w.cur.path != w.tablePath(table) {
+		if _, ok := w.tables[table]; ok {
+			return fmt.Errorf("rows for table %q must be written consecutively", table)
+		}
+
+		if err := w.closeCurrent(); err != nil {
+			return err
+		}
+
+		file, err := os.Create(w.tablePath(table))
+		if err != nil {
+			return err
+		}
+
+		h := sha256.New()
+		w.cur = &spooledTable{file: file, buf: bufio.NewWriter(io.MultiWriter(file, h)), hash: h, path: file.Name()}
+		w.tables[table] = w.cur
+	}
+
+	if _, err := w.cur.buf.Write(row); err != nil {
+		return err
+	}
+	if err := w.cur.buf.WriteByte('\n'); err != nil {
+		return err
+	}
+
+	w.cur.rows++
+
+	return nil
+}
+
+// Finish writes the archive: the manifest, then every table in
+// info.Tables order (tables without rows are written as empty files). It
+// fills in the row counts of info.Tables and removes the spooled files.
+func (w *Writer) Finish(info *usecase.LedgerArchiveInfo) error {
+	defer os.RemoveAll(w.dir)
+
+	if err := w.closeCurrent(); err != nil {
+		return err
+	}
+
+	m := manifest{
+		FormatVersion:  FormatVersion,
+		CreatedAt:      info.CreatedAt,
+		SchemaVersion:  info.SchemaVersion,
+		AuditChainHead: info.AuditChainHead,
+		Tables:         make([]manifestTable, 0, len(info.Tables)),
+	}
+
+	for i, t := range info.Tables {
+		mt := manifestTable{Name: t.Name, File: t.Name + ".ndjson", SHA256: hex.EncodeToString(sha256.New().Sum(nil))}
+		if st, ok := w.tables[t.Name]; ok {
+			mt.Rows = st.rows
+			mt.SHA256 = hex.EncodeToString(st.hash.Sum(nil))
+		}
+		info.Tables[i].Rows = mt.Rows
+		m.Tables = append(m.Tables, mt)
+	}
+
+	manifestJSON, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w.out)
+	tw := tar.NewWriter(gz)
+
+	if err := tw.WriteHeader(tarHeader(manifestName, int64(len(manifestJSON)), info.CreatedAt)); err != nil {
+		return err
+	}
+	if _, err := tw.Write(manifestJSON); err != nil {
+		return err
+	}
+
+	for _, mt := range m.Tables {
+		if err := w.copyTable(tw, mt, info.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func (w *Writer) copyTable(tw *tar.Writer, mt manifestTable, modTime time.Time) error {
+	st, ok := w.tables[mt.Name]
+	if !ok {
+		return tw.WriteHeader(tarHeader(mt.File, 0, modTime))
+	}
+
+	file, err := os.Open(st.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	stat, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if err := tw.WriteHeader(tarHeader(mt.File, stat.Size(), modTime)); err != nil {
+		return err
+	}
+
+	_, err = io.Copy(tw, file)
+
+	return err
+}
+
+func (w *Writer) closeCurrent() error {
+	if w.cur == nil {
+		return nil
+	}
+
+	cur := w.cur
+	w.cur = nil
+
+	if err := cur.buf.Flush(); err != nil {
+		_ = cur.file.Close()
+		return err
+	}
+
+	return cur.file.Close()
+}
+
+func (w *Writer) tablePath(table string) string {
+	return filepath.Join(w.dir, filepath.Base(table)+".ndjson")
+}
+
+func tarHeader(name string, size int64, modTime time.Time) *tar.Header {
+	return &tar.Header{
+		Name:     name,
+		Mode:     0o600,
+		Size:     size,
+		ModTime:  modTime,
+		Typeflag: tar.TypeReg,
+	}
+}
+
+// Reader reads a ledger archive written by Writer, verifying each table's
+// row count and checksum as its last row is read.
+type Reader struct {
+	tr       *tar.Reader
+	gz       *gzip.Reader
+	manifest manifest
+	next     int
+	cur      *manifestTable
+	rows     *bufio.Reader
+	hash     hash.Hash
+	read     int64
+}
+
+// NewReader opens an archive and reads its manifest.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptArchive, err)
+	}
+
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptArchive, err)
+	}
+	if hdr.Name != manifestName {
+		return nil, fmt.Errorf("%w: expected %s first, found %s", ErrCorruptArchive, manifestName, hdr.Name)
+	}
+
+	var m manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %w", ErrCorruptArchive, err)
+	}
+
+	if m.FormatVersion < 1 || m.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d (this build reads up to %d)", m.FormatVersion, FormatVersion)
+	}
+
+	return &Reader{tr: tr, gz: gz, manifest: m}, nil
+}
+
+// Info returns the archive's metadata from its manifest.
+func (r *Reader) Info() usecase.LedgerArchiveInfo {
+	info := usecase.LedgerArchiveInfo{
+		CreatedAt:      r.manifest.CreatedAt,
+		SchemaVersion:  r.manifest.SchemaVersion,
+		AuditChainHead: r.manifest.AuditChainHead,
+		Tables:         make([]usecase.LedgerArchiveTable, 0, len(r.manifest.Tables)),
+	}
+
+	for _, t := range r.manifest.Tables {
+		info.Tables = append(info.Tables, usecase.LedgerArchiveTable{Name: t.Name, Rows: t.Rows})
+	}
+
+	return info
+}
+
+// NextTable advances to the next table and returns its name, or io.EOF
+// after the last one.
+func (r *Reader) NextTable() (string, error) {
+	if r.cur != nil && r.rows != nil {
+		// Drain and verify the unread remainder of the current table.
+		for {
+			if _, err := r.NextRow(); err != nil {
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				return "", err
+			}
+		}
+	}
+
+	if r.next >= len(r.manifest.Tables) {
+		return "", io.EOF
+	}
+
+	mt := &r.manifest.Tables[r.next]
+	r.next++
+
+	hdr, err := r.tr.Next()
+	if err != nil {
+		return "", fmt.Errorf("%w: missing %s: %w", ErrCorruptArchive, mt.File, err)
+	}
+	if hdr.Name != mt.File {
+		return "", fmt.Errorf("%w: expected %s, found %s", ErrCorruptArchive, mt.File, hdr.Name)
+	}
+
+	r.cur = mt
+	r.hash = sha256.New()
+	r.rows = bufio.NewReader(io.TeeReader(r.tr, r.hash))
+	r.read = 0
+
+	return mt.Name, nil
+}
+
+// NextRow returns the next row of the current table. At the end of the
+// table it verifies the row count and checksum against the manifest and
+// returns io.EOF, or ErrCorruptArchive on a mismatch.
+func (r *Reader) NextRow() ([]byte, error) {
+	if r.rows == nil {
+		return nil, io.EOF
+	}
+
+	line, err := r.rows.ReadBytes('\n')
+	if len(line) > 0 && err == nil {
+		r.read++
+		return line[:len(line)-1], nil
+	}
+
+	if !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %s: %w", ErrCorruptArchive, r.cur.File, err)
+	}
+	if len(line) > 0 {
+		return nil, fmt.Errorf("%w: %s: truncated last row", ErrCorruptArchive, r.cur.File)
+	}
+
+	r.rows = nil
+
+	if r.read != r.cur.Rows {
+		return nil, fmt.Errorf("%w: %s has %d rows, manifest says %d", ErrCorruptArchive, r.cur.File, r.read, r.cur.Rows)
+	}
+
+	if sum := hex.EncodeToString(r.hash.Sum(nil)); sum != r.cur.SHA256 {
+		return nil, fmt.Errorf("%w: %s checksum mismatch", ErrCorruptArchive, r.cur.File)
+	}
+
+	return nil, io.EOF
+}
+
+// Close releases the reader.
+func (r *Reader) Close() error {
+	return r.gz.Close()
+}
@@ -0,0 +1,209 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+func writeArchive(t *testing.T, rows map[string][]string, tables ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	info := &usecase.LedgerArchiveInfo{
+		CreatedAt:      time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		SchemaVersion:  16,
+		AuditChainHead: "abc",
+	}
+	for _, table := range tables {
+		info.Tables = append(info.Tables, usecase.LedgerArchiveTable{Name: table})
+		for _, row := range rows[table] {
+			if err := w.WriteRow(table, []byte(row)); err != nil {
+				t.Fatalf("WriteRow() error = %v", err)
+			}
+		}
+	}
+
+	if err := w.Finish(info); err != nil {
+		t.Fatalf("Finish() error = %v", err)
+	}
+
+	for _, table := range info.Tables {
+		if table.Rows != int64(len(rows[table.Name])) {
+			t.Fatalf("Finish() recorded %d rows for %s, want %d", table.Rows, table.Name, len(rows[table.Name]))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func readArchive(r *Reader) (map[string][]string, error) {
+	got := make(map[string][]string)
+	for {
+		table, err := r.NextTable()
+		if errors.Is(err, io.EOF) {
+			return got, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		got[table] = []string{}
+		for {
+			row, err := r.NextRow()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			got[table] = append(got[table], string(row))
+		}
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	rows := map[string][]string{
+		"accounts":  {`{"id":"acc-1","balance":100.50}`, `{"id":"acc-2","balance":-100.50}`},
+		"transfers": {`{"id":"tr-1","metadata":{"note":"line\nbreak"}}`},
+	}
+
+	data := writeArchive(t, rows, "accounts", "transfers", "holds")
+
+	r, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewReader() error = %v", err)
+	}
+	defer r.Close()
+
+	info := r.Info()
+	if info.SchemaVersion != 16 || info.AuditChainHead != "abc" || len(info.Tables) != 3 {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+	if info.Tables[0].Name != "accounts" || info.Tables[0].Rows != 2 || info.Tables[2].Rows != 0 {
+		t.Fatalf("unexpected tables: %+v", info.Tables)
+	}
+
+	got, err := readArchive(r)
+	if err != nil {
+		t.Fatalf("read error = %v", err)
+	}
+
+	if len(got["accounts"]) != 2 || got["accounts"][1] != rows["accounts"][1] {
+		t.Errorf("accounts = %v", got["accounts"])
+	}
+	if len(got["transfers"]) != 1 || got["transfers"][0] != rows["transfers"][0] {
+		t.Errorf("transfers = %v", got["transfers"])
+	}
+	if rows, ok := got["holds"]; !ok || len(rows) != 0 {
+		t.Errorf("holds = %v, want an empty table", rows)
+	}
+}
+
+func TestWriter_RejectsInterleavedTables(t *testing.T) {
+	w, err := NewWriter(io.Discard)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	_ = w.WriteRow("accounts", []byte(`{}`))
+	_ = w.WriteRow("transfers", []byte(`{}`))
+
+	if err := w.WriteRow("accounts", []byte(`{}`)); err == nil {
+		t.Fatal("expected an error when returning to an earlier table")
+	}
+
+	_ = w.Finish(&usecase.LedgerArchiveInfo{})
+}
+
+// rewriteTable re-packs an archive with one table file's content replaced,
+// keeping the original manifest.
+func rewriteTable(t *testing.T, data []byte, file, content string) []byte {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gw)
+
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		body, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Name == file {
+			body = []byte(content)
+		}
+
+		hdr.Size = int64(len(body))
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	_ = tw.Close()
+	_ = gw.Close()
+
+	return out.Bytes()
+}
+
+func TestReader_DetectsTampering(t *testing.T) {
+	data := writeArchive(t, map[string][]string{
+		"accounts": {`{"id":"acc-1","balance":100}`},
+	}, "accounts")
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "altered row", content: `{"id":"acc-1","balance":999}` + "\n"},
+		{name: "extra row", content: `{"id":"acc-1","balance":100}` + "\n" + `{"id":"acc-2"}` + "\n"},
+		{name: "truncated", content: `{"id":"acc-1","bal`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReader(bytes.NewReader(rewriteTable(t, data, "accounts.ndjson", tt.content)))
+			if err != nil {
+				t.Fatalf("NewReader() error = %v", err)
+			}
+
+			if _, err := readArchive(r); !errors.Is(err, ErrCorruptArchive) {
+				t.Fatalf("expected ErrCorruptArchive, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewReader_RejectsNonArchive(t *testing.T) {
+	if _, err := NewReader(bytes.NewReader([]byte("not an archive"))); !errors.Is(err, ErrCorruptArchive) {
+		t.Fatalf("expected ErrCorruptArchive, got %v", err)
+	}
+}
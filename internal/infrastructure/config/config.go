@@ -2,7 +2,9 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v10"
@@ -37,6 +39,62 @@ type Config struct {
 	// OutboxMaxAttempts is how many delivery failures an outbox event
 	// tolerates before the publisher dead-letters it (stops retrying).
 	OutboxMaxAttempts int `env:"OUTBOX_MAX_ATTEMPTS" envDefault:"5"`
+	// OutboxPublisher selects where outbox events are delivered: "log"
+	// (written to the application log), "kafka" or "nats".
+	OutboxPublisher string `env:"OUTBOX_PUBLISHER" envDefault:"log"`
+	// OutboxWorkers is how many aggregates are published concurrently.
+	OutboxWorkers int `env:"OUTBOX_WORKERS" envDefault:"4"`
+	// OutboxLease is how long a replica holds the events it claimed before
+	// another replica may claim them again.
+	OutboxLease time.Duration `env:"OUTBOX_LEASE" envDefault:"1m"`
+	// OutboxPollInterval is how often the publisher polls the outbox. With
+	// OutboxNotify it is only a fallback for missed notifications.
+	OutboxPollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"5s"`
+	// OutboxNotify wakes the publisher via Postgres LISTEN/NOTIFY as soon
+	// as events are committed.
+	OutboxNotify bool `env:"OUTBOX_NOTIFY" envDefault:"true"`
+
+	// Outbox retention
+	// OutboxRetentionInterval is how often the retention job creates
+	// upcoming outbox partitions and prunes expired ones. Set to 0 to
+	// disable it (the CLI can still prune).
+	OutboxRetentionInterval time.Duration `env:"OUTBOX_RETENTION_INTERVAL" envDefault:"1h"`
+	// OutboxRetentionDays is how long published events stay in the outbox
+	// before their month is archived. 0 keeps them forever.
+	OutboxRetentionDays int `env:"OUTBOX_RETENTION_DAYS" envDefault:"0"`
+	// OutboxArchive selects where pruned events go: "table" (moved to
+	// outbox_events_archive) or "file" (gzipped NDJSON in
+	// OUTBOX_ARCHIVE_DIR, then dropped).
+	OutboxArchive    string `env:"OUTBOX_ARCHIVE"     envDefault:"table"`
+	OutboxArchiveDir string `env:"OUTBOX_ARCHIVE_DIR"`
+
+	// Kafka (used when OUTBOX_PUBLISHER=kafka)
+	KafkaBrokers  []string `env:"KAFKA_BROKERS"   envSeparator:","`
+	KafkaTopic    string   `env:"KAFKA_TOPIC"     envDefault:"goledger.events"`
+	KafkaClientID string   `env:"KAFKA_CLIENT_ID" envDefault:"goledger"`
+	// KafkaIdempotent enables the idempotent producer, so broker-side
+	// retries never write an event twice.
+	KafkaIdempotent      bool          `env:"KAFKA_IDEMPOTENT"               envDefault:"true"`
+	KafkaDeliveryTimeout time.Duration `env:"KAFKA_DELIVERY_TIMEOUT"         envDefault:"30s"`
+	KafkaTLSEnabled      bool          `env:"KAFKA_TLS_ENABLED"              envDefault:"false"`
+	KafkaTLSCAFile       string        `env:"KAFKA_TLS_CA_FILE"`
+	KafkaTLSCertFile     string        `env:"KAFKA_TLS_CERT_FILE"`
+	KafkaTLSKeyFile      string        `env:"KAFKA_TLS_KEY_FILE"`
+	KafkaTLSSkipVerify   bool          `env:"KAFKA_TLS_INSECURE_SKIP_VERIFY" envDefault:"false"`
+	KafkaSASLMechanism   string        `env:"KAFKA_SASL_MECHANISM"`
+	KafkaSASLUsername    string        `env:"KAFKA_SASL_USERNAME"`
+	KafkaSASLPassword    string        `env:"KAFKA_SASL_PASSWORD"`
+
+	// NATS JetStream (used when OUTBOX_PUBLISHER=nats)
+	NATSURL           string `env:"NATS_URL"            envDefault:"nats://localhost:4222"`
+	NATSCredsFile     string `env:"NATS_CREDS_FILE"`
+	NATSSubjectPrefix string `env:"NATS_SUBJECT_PREFIX" envDefault:"goledger"`
+	// NATSStream is created or updated on first publish to capture
+	// NATS_SUBJECT_PREFIX.>; set it empty when the stream is managed
+	// outside the service.
+	NATSStream          string        `env:"NATS_STREAM"           envDefault:"GOLEDGER_EVENTS"`
+	NATSDuplicateWindow time.Duration `env:"NATS_DUPLICATE_WINDOW" envDefault:"2m"`
+	NATSPublishTimeout  time.Duration `env:"NATS_PUBLISH_TIMEOUT"  envDefault:"10s"`
 
 	// Authentication (optional - leave empty to disable)
 	JWTSecret     string        `env:"JWT_SECRET"       envDefault:""`
@@ -49,6 +107,65 @@ type Config struct {
 	// /api/v1/ledger/consistency endpoint keeps working either way).
 	ReconciliationInterval time.Duration `env:"RECONCILIATION_INTERVAL" envDefault:"1h"`
 
+	// Accruals
+	// AccrualInterval is how often the interest/fee accrual scheduler runs.
+	// Set to 0 to disable it (accruals can still be run via the CLI).
+	AccrualInterval time.Duration `env:"ACCRUAL_INTERVAL" envDefault:"1h"`
+	// AccrualCatchUpDays is how many completed days each scheduler pass
+	// covers, so days missed during downtime are accrued afterwards.
+	AccrualCatchUpDays int `env:"ACCRUAL_CATCH_UP_DAYS" envDefault:"7"`
+
+	// Reporting projector
+	// ProjectorInterval is how often the projector applies new entries to
+	// the reporting read models. Set to 0 to disable it (the CLI can still
+	// run and rebuild the projection).
+	ProjectorInterval time.Duration `env:"PROJECTOR_INTERVAL" envDefault:"5s"`
+	// ProjectorBatchSize is how many entries each projection transaction
+	// applies.
+	ProjectorBatchSize int `env:"PROJECTOR_BATCH_SIZE" envDefault:"500"`
+
+	// Webhooks
+	// WebhooksEnabled fans outbox events out to webhook subscriptions and
+	// runs the delivery dispatcher. Subscriptions can be managed either way.
+	WebhooksEnabled bool `env:"WEBHOOKS_ENABLED" envDefault:"true"`
+	// WebhookMaxAttempts is how many times a delivery is tried before it is
+	// dead-lettered; retries back off exponentially from WEBHOOK_BACKOFF_BASE
+	// up to WEBHOOK_BACKOFF_MAX.
+	WebhookMaxAttempts  int           `env:"WEBHOOK_MAX_ATTEMPTS"   envDefault:"10"`
+	WebhookBackoffBase  time.Duration `env:"WEBHOOK_BACKOFF_BASE"   envDefault:"30s"`
+	WebhookBackoffMax   time.Duration `env:"WEBHOOK_BACKOFF_MAX"    envDefault:"6h"`
+	WebhookTimeout      time.Duration `env:"WEBHOOK_TIMEOUT"        envDefault:"10s"`
+	WebhookPollInterval time.Duration `env:"WEBHOOK_POLL_INTERVAL"  envDefault:"2s"`
+
+	// Event stream
+	// EventStreamEnabled serves live published events over SSE
+	// (/api/v1/events/stream) and gRPC (EventService.SubscribeEvents).
+	EventStreamEnabled bool `env:"EVENT_STREAM_ENABLED" envDefault:"true"`
+	// EventStreamPollInterval is how often the stream reads newly
+	// published events from the outbox.
+	EventStreamPollInterval time.Duration `env:"EVENT_STREAM_POLL_INTERVAL" envDefault:"500ms"`
+
+	// Gateway
+	// GatewayEnabled serves the gRPC API as HTTP/JSON under /v1 on the HTTP
+	// port, transcoded per the google.api.http annotations in the protos.
+	GatewayEnabled bool `env:"GATEWAY_ENABLED" envDefault:"true"`
+
+	// GraphQL
+	// GraphQLEnabled serves the read-only GraphQL API at /api/v1/graphql.
+	GraphQLEnabled bool `env:"GRAPHQL_ENABLED" envDefault:"true"`
+	// GraphQLMaxDepth bounds how deeply a query may nest selections.
+	GraphQLMaxDepth int `env:"GRAPHQL_MAX_DEPTH" envDefault:"10"`
+	// GraphQLMaxCost bounds a query's estimated cost: one per field, times
+	// the page size of every enclosing connection.
+	GraphQLMaxCost int `env:"GRAPHQL_MAX_COST" envDefault:"2000"`
+
+	// CloudEvents
+	// EventSource is the CloudEvents source attribute of every event.
+	EventSource string `env:"EVENT_SOURCE" envDefault:"/goledger"`
+	// EventSchemaBaseURL is the public URL of /api/v1/events/schemas; each
+	// event's dataschema is <base>/<type>/<version>. Empty omits dataschema.
+	EventSchemaBaseURL string `env:"EVENT_SCHEMA_BASE_URL" envDefault:"http://localhost:8080/api/v1/events/schemas"`
+
 	// Tracing
 	TracingEnabled bool   `env:"TRACING_ENABLED" envDefault:"false"`
 	OTLPEndpoint   string `env:"OTEL_EXPORTER_OTLP_ENDPOINT" envDefault:""`
@@ -92,5 +209,141 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("DATABASE_MIN_CONNS (%d) must not exceed DATABASE_MAX_CONNS (%d)", c.DatabaseMinConns, c.DatabaseMaxConns)
 	}
 
+	if c.OutboxWorkers < 1 {
+		return fmt.Errorf("OUTBOX_WORKERS must be at least 1, got %d", c.OutboxWorkers)
+	}
+
+	if c.OutboxLease <= 0 {
+		return fmt.Errorf("OUTBOX_LEASE must be positive, got %s", c.OutboxLease)
+	}
+
+	if c.OutboxPollInterval <= 0 {
+		return fmt.Errorf("OUTBOX_POLL_INTERVAL must be positive, got %s", c.OutboxPollInterval)
+	}
+
+	if c.EventStreamPollInterval <= 0 {
+		return fmt.Errorf("EVENT_STREAM_POLL_INTERVAL must be positive, got %s", c.EventStreamPollInterval)
+	}
+
+	if err := c.validateEventSchemaBaseURL(); err != nil {
+		return err
+	}
+
+	if err := c.validateOutboxPublisher(); err != nil {
+		return err
+	}
+
+	if err := c.validateOutboxRetention(); err != nil {
+		return err
+	}
+
+	if c.AccrualCatchUpDays < 1 {
+		return fmt.Errorf("ACCRUAL_CATCH_UP_DAYS must be at least 1, got %d", c.AccrualCatchUpDays)
+	}
+
+	if c.ProjectorBatchSize < 1 {
+		return fmt.Errorf("PROJECTOR_BATCH_SIZE must be at least 1, got %d", c.ProjectorBatchSize)
+	}
+
+	return c.validateWebhooks()
+}
+
+func (c *Config) validateOutboxRetention() error {
+	if c.OutboxRetentionDays < 0 {
+		return fmt.Errorf("OUTBOX_RETENTION_DAYS must not be negative, got %d", c.OutboxRetentionDays)
+	}
+
+	switch c.OutboxArchive {
+	case "table":
+		return nil
+	case "file":
+		if c.OutboxArchiveDir == "" {
+			return fmt.Errorf("OUTBOX_ARCHIVE_DIR must be set when OUTBOX_ARCHIVE is file")
+		}
+		return nil
+	default:
+		return fmt.Errorf("OUTBOX_ARCHIVE must be table or file, got %q", c.OutboxArchive)
+	}
+}
+
+func (c *Config) validateWebhooks() error {
+	if c.WebhookMaxAttempts < 1 {
+		return fmt.Errorf("WEBHOOK_MAX_ATTEMPTS must be at least 1, got %d", c.WebhookMaxAttempts)
+	}
+
+	if c.WebhookBackoffBase <= 0 || c.WebhookBackoffMax < c.WebhookBackoffBase {
+		return fmt.Errorf("WEBHOOK_BACKOFF_BASE must be positive and not exceed WEBHOOK_BACKOFF_MAX")
+	}
+
+	if c.WebhookTimeout <= 0 || c.WebhookPollInterval <= 0 {
+		return fmt.Errorf("WEBHOOK_TIMEOUT and WEBHOOK_POLL_INTERVAL must be positive")
+	}
+
+	return nil
+}
+
+func (c *Config) validateEventSchemaBaseURL() error {
+	if c.EventSchemaBaseURL != "" {
+		u, err := url.Parse(c.EventSchemaBaseURL)
+		if err != nil || !u.IsAbs() {
+			return fmt.Errorf("EVENT_SCHEMA_BASE_URL must be an absolute URL, got %q", c.EventSchemaBaseURL)
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) validateOutboxPublisher() error {
+	switch c.OutboxPublisher {
+	case "log":
+		return nil
+	case "kafka":
+		return c.validateKafka()
+	case "nats":
+		return c.validateNATS()
+	default:
+		return fmt.Errorf("OUTBOX_PUBLISHER must be log, kafka or nats, got %q", c.OutboxPublisher)
+	}
+}
+
+func (c *Config) validateKafka() error {
+	if len(c.KafkaBrokers) == 0 {
+		return fmt.Errorf("KAFKA_BROKERS must be set when OUTBOX_PUBLISHER is kafka")
+	}
+
+	if c.KafkaTopic == "" {
+		return fmt.Errorf("KAFKA_TOPIC must not be empty")
+	}
+
+	if (c.KafkaTLSCertFile == "") != (c.KafkaTLSKeyFile == "") {
+		return fmt.Errorf("KAFKA_TLS_CERT_FILE and KAFKA_TLS_KEY_FILE must be set together")
+	}
+
+	switch strings.ToUpper(c.KafkaSASLMechanism) {
+	case "":
+	case "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+		if c.KafkaSASLUsername == "" {
+			return fmt.Errorf("KAFKA_SASL_USERNAME must be set when KAFKA_SASL_MECHANISM is set")
+		}
+	default:
+		return fmt.Errorf("KAFKA_SASL_MECHANISM must be PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, got %q", c.KafkaSASLMechanism)
+	}
+
+	return nil
+}
+
+func (c *Config) validateNATS() error {
+	if c.NATSURL == "" {
+		return fmt.Errorf("NATS_URL must be set when OUTBOX_PUBLISHER is nats")
+	}
+
+	if c.NATSSubjectPrefix == "" || strings.ContainsAny(c.NATSSubjectPrefix, "*> ") {
+		return fmt.Errorf("NATS_SUBJECT_PREFIX must be a non-empty subject without wildcards, got %q", c.NATSSubjectPrefix)
+	}
+
+	if c.NATSDuplicateWindow < 0 {
+		return fmt.Errorf("NATS_DUPLICATE_WINDOW must not be negative")
+	}
+
 	return nil
 }
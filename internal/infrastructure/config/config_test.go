@@ -118,3 +118,150 @@ func TestLoadDatabaseMaxConnsNotPositive(t *testing.T) {
 		t.Fatalf("expected error when DATABASE_MAX_CONNS is not positive")
 	}
 }
+
+func TestLoadKafkaPublisher(t *testing.T) {
+	t.Setenv("OUTBOX_PUBLISHER", "kafka")
+	t.Setenv("KAFKA_BROKERS", "kafka-1:9092,kafka-2:9092")
+	t.Setenv("KAFKA_SASL_MECHANISM", "SCRAM-SHA-512")
+	t.Setenv("KAFKA_SASL_USERNAME", "ledger")
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(cfg.KafkaBrokers) != 2 || cfg.KafkaBrokers[1] != "kafka-2:9092" {
+		t.Fatalf("expected two brokers, got %v", cfg.KafkaBrokers)
+	}
+
+	if !cfg.KafkaIdempotent || cfg.KafkaTopic != "goledger.events" {
+		t.Fatalf("expected idempotent producer on the default topic, got idempotent=%v topic=%s", cfg.KafkaIdempotent, cfg.KafkaTopic)
+	}
+}
+
+func TestLoadNATSPublisher(t *testing.T) {
+	t.Setenv("OUTBOX_PUBLISHER", "nats")
+	t.Setenv("NATS_URL", "nats://nats-1:4222,nats://nats-2:4222")
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.NATSStream != "GOLEDGER_EVENTS" || cfg.NATSSubjectPrefix != "goledger" || cfg.NATSDuplicateWindow != 2*time.Minute {
+		t.Fatalf("unexpected NATS defaults: stream=%s prefix=%s window=%s", cfg.NATSStream, cfg.NATSSubjectPrefix, cfg.NATSDuplicateWindow)
+	}
+}
+
+func TestLoadInvalidOutboxPublisher(t *testing.T) {
+	tests := map[string]map[string]string{
+		"unknown publisher":     {"OUTBOX_PUBLISHER": "carrier-pigeon"},
+		"kafka without brokers": {"OUTBOX_PUBLISHER": "kafka"},
+		"sasl without username": {"OUTBOX_PUBLISHER": "kafka", "KAFKA_BROKERS": "kafka:9092", "KAFKA_SASL_MECHANISM": "PLAIN"},
+		"unknown sasl":          {"OUTBOX_PUBLISHER": "kafka", "KAFKA_BROKERS": "kafka:9092", "KAFKA_SASL_MECHANISM": "GSSAPI"},
+		"cert without key":      {"OUTBOX_PUBLISHER": "kafka", "KAFKA_BROKERS": "kafka:9092", "KAFKA_TLS_CERT_FILE": "client.pem"},
+		"negative nats window":  {"OUTBOX_PUBLISHER": "nats", "NATS_DUPLICATE_WINDOW": "-1m"},
+		"nats wildcard prefix":  {"OUTBOX_PUBLISHER": "nats", "NATS_SUBJECT_PREFIX": "ledger.>"},
+		"zero workers":          {"OUTBOX_WORKERS": "0"},
+		"negative lease":        {"OUTBOX_LEASE": "-1m"},
+		"zero poll interval":    {"OUTBOX_POLL_INTERVAL": "0s"},
+		"zero stream interval":  {"EVENT_STREAM_POLL_INTERVAL": "0s"},
+		"relative schema url":   {"EVENT_SCHEMA_BASE_URL": "/api/v1/events/schemas"},
+	}
+
+	for name, env := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range env {
+				t.Setenv(k, v)
+			}
+
+			if _, err := config.Load(); err == nil {
+				t.Fatalf("expected a validation error")
+			}
+		})
+	}
+}
+
+func TestLoadInvalidWebhookSettings(t *testing.T) {
+	tests := map[string]map[string]string{
+		"no attempts":            {"WEBHOOK_MAX_ATTEMPTS": "0"},
+		"base exceeds max":       {"WEBHOOK_BACKOFF_BASE": "1h", "WEBHOOK_BACKOFF_MAX": "1m"},
+		"negative timeout":       {"WEBHOOK_TIMEOUT": "-1s"},
+		"negative poll interval": {"WEBHOOK_POLL_INTERVAL": "-1s"},
+	}
+
+	for name, env := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range env {
+				t.Setenv(k, v)
+			}
+
+			if _, err := config.Load(); err == nil {
+				t.Fatalf("expected a validation error")
+			}
+		})
+	}
+}
+
+func TestLoadProjectorSettings(t *testing.T) {
+	t.Setenv("PROJECTOR_INTERVAL", "0s")
+	t.Setenv("PROJECTOR_BATCH_SIZE", "100")
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading config: %v", err)
+	}
+
+	if cfg.ProjectorInterval != 0 || cfg.ProjectorBatchSize != 100 {
+		t.Fatalf("unexpected projector settings: interval=%s batch=%d", cfg.ProjectorInterval, cfg.ProjectorBatchSize)
+	}
+
+	t.Setenv("PROJECTOR_BATCH_SIZE", "0")
+	if _, err := config.Load(); err == nil {
+		t.Fatalf("expected a validation error for a zero batch size")
+	}
+}
+
+func TestLoadOutboxRetentionSettings(t *testing.T) {
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading config: %v", err)
+	}
+
+	if cfg.OutboxRetentionInterval != time.Hour || cfg.OutboxRetentionDays != 0 || cfg.OutboxArchive != "table" {
+		t.Fatalf("unexpected retention defaults: interval=%s days=%d archive=%s", cfg.OutboxRetentionInterval, cfg.OutboxRetentionDays, cfg.OutboxArchive)
+	}
+
+	t.Setenv("OUTBOX_RETENTION_DAYS", "90")
+	t.Setenv("OUTBOX_ARCHIVE", "file")
+	t.Setenv("OUTBOX_ARCHIVE_DIR", "/var/lib/goledger/outbox")
+
+	cfg, err = config.Load()
+	if err != nil {
+		t.Fatalf("unexpected error loading config: %v", err)
+	}
+
+	if cfg.OutboxRetentionDays != 90 || cfg.OutboxArchive != "file" || cfg.OutboxArchiveDir != "/var/lib/goledger/outbox" {
+		t.Fatalf("unexpected retention settings: %+v", cfg)
+	}
+}
+
+func TestLoadInvalidOutboxRetention(t *testing.T) {
+	tests := map[string]map[string]string{
+		"negative days":    {"OUTBOX_RETENTION_DAYS": "-1"},
+		"unknown archive":  {"OUTBOX_ARCHIVE": "s3"},
+		"file without dir": {"OUTBOX_ARCHIVE": "file"},
+	}
+
+	for name, env := range tests {
+		t.Run(name, func(t *testing.T) {
+			for k, v := range env {
+				t.Setenv(k, v)
+			}
+
+			if _, err := config.Load(); err == nil {
+				t.Fatalf("expected a validation error")
+			}
+		})
+	}
+}
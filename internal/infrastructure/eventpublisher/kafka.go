@@ -0,0 +1,243 @@
+package eventpublisher
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+	"github.com/twmb/franz-go/pkg/sasl/scram"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// Kafka record header names.
+const (
+	HeaderEventID           = "event_id"
+	HeaderEventType         = "event_type"
+	HeaderEventVersion      = "event_version"
+	HeaderAggregateType     = "aggregate_type"
+	HeaderAggregateSequence = "aggregate_sequence"
+	// HeaderContentType carries the CloudEvents datacontenttype.
+	HeaderContentType = "content-type"
+	// KafkaCloudEventsPrefix prefixes each CloudEvents attribute header
+	// (binary content mode of the CloudEvents Kafka binding).
+	KafkaCloudEventsPrefix = "ce_"
+)
+
+// SASL mechanisms accepted by KafkaConfig.SASLMechanism.
+const (
+	SASLPlain       = "PLAIN"
+	SASLScramSHA256 = "SCRAM-SHA-256"
+	SASLScramSHA512 = "SCRAM-SHA-512"
+)
+
+// KafkaConfig configures a KafkaPublisher.
+type KafkaConfig struct {
+	Brokers  []string
+	Topic    string
+	ClientID string
+	// CloudEvents sets the source and dataschema attributes of each
+	// record.
+	CloudEvents domain.CloudEventOptions
+
+	// DisableIdempotence turns off the idempotent producer. Ordering is
+	// still preserved by limiting each broker to one in-flight request,
+	// but a retried request may then be written twice.
+	DisableIdempotence bool
+	// DeliveryTimeout bounds how long one Publish call waits for the
+	// broker to acknowledge, including retries. Zero means no limit beyond
+	// the caller's context.
+	DeliveryTimeout time.Duration
+
+	TLSEnabled            bool
+	TLSCAFile             string
+	TLSCertFile           string
+	TLSKeyFile            string
+	TLSInsecureSkipVerify bool
+
+	// SASLMechanism is empty (no SASL), PLAIN, SCRAM-SHA-256 or
+	// SCRAM-SHA-512.
+	SASLMechanism string
+	SASLUsername  string
+	SASLPassword  string
+}
+
+// KafkaPublisher publishes outbox events to a Kafka topic. Records are
+// keyed by AggregateID, so every event of an aggregate lands on the same
+// partition and is consumed in the order it was written; the event's
+// metadata travels in record headers and the value is the JSON payload.
+type KafkaPublisher struct {
+	client      *kgo.Client
+	topic       string
+	cloudEvents domain.CloudEventOptions
+}
+
+// NewKafkaPublisher creates a KafkaPublisher. The client connects lazily,
+// so an unreachable broker surfaces as a Publish error (and an outbox
+// retry) rather than a startup failure.
+func NewKafkaPublisher(cfg KafkaConfig) (*KafkaPublisher, error) {
+	opts, err := kafkaOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kafka client: %w", err)
+	}
+
+	return &KafkaPublisher{client: client, topic: cfg.Topic, cloudEvents: cfg.CloudEvents}, nil
+}
+
+func kafkaOptions(cfg KafkaConfig) ([]kgo.Opt, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, errors.New("kafka: at least one broker is required")
+	}
+
+	if cfg.Topic == "" {
+		return nil, errors.New("kafka: topic is required")
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(cfg.Brokers...),
+		kgo.DefaultProduceTopic(cfg.Topic),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+		// Hash the key onto a partition (murmur2, as the Java client
+		// does) so other producers of the same key agree on placement.
+		kgo.RecordPartitioner(kgo.StickyKeyPartitioner(nil)),
+	}
+
+	if cfg.ClientID != "" {
+		opts = append(opts, kgo.ClientID(cfg.ClientID))
+	}
+
+	if cfg.DisableIdempotence {
+		opts = append(opts,
+			kgo.DisableIdempotentWrite(),
+			kgo.MaxProduceRequestsInflightPerBroker(1),
+		)
+	}
+
+	if cfg.DeliveryTimeout > 0 {
+		opts = append(opts, kgo.RecordDeliveryTimeout(cfg.DeliveryTimeout))
+	}
+
+	if cfg.TLSEnabled {
+		tlsCfg, err := kafkaTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.DialTLSConfig(tlsCfg))
+	}
+
+	if cfg.SASLMechanism != "" {
+		mechanism, err := kafkaSASL(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kgo.SASL(mechanism))
+	}
+
+	return opts, nil
+}
+
+func kafkaTLSConfig(cfg KafkaConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.TLSInsecureSkipVerify,
+	}
+
+	if cfg.TLSCAFile != "" {
+		pem, err := os.ReadFile(cfg.TLSCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("kafka: failed to read CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("kafka: no certificates found in %s", cfg.TLSCAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if cfg.TLSCertFile != "" || cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("kafka: failed to load client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsCfg, nil
+}
+
+func kafkaSASL(cfg KafkaConfig) (sasl.Mechanism, error) {
+	if cfg.SASLUsername == "" {
+		return nil, errors.New("kafka: SASL username is required")
+	}
+
+	switch strings.ToUpper(cfg.SASLMechanism) {
+	case SASLPlain:
+		return plain.Auth{User: cfg.SASLUsername, Pass: cfg.SASLPassword}.AsMechanism(), nil
+	case SASLScramSHA256:
+		return scram.Auth{User: cfg.SASLUsername, Pass: cfg.SASLPassword}.AsSha256Mechanism(), nil
+	case SASLScramSHA512:
+		return scram.Auth{User: cfg.SASLUsername, Pass: cfg.SASLPassword}.AsSha512Mechanism(), nil
+	default:
+		return nil, fmt.Errorf("kafka: unsupported SASL mechanism %q", cfg.SASLMechanism)
+	}
+}
+
+// Publish writes the event and waits for the broker to acknowledge it. The
+// record is a CloudEvent in binary content mode: the value is the payload
+// and the attributes are ce_ headers.
+func (p *KafkaPublisher) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	ce := domain.NewCloudEvent(event, p.cloudEvents)
+
+	record := &kgo.Record{
+		Topic: p.topic,
+		Key:   []byte(event.AggregateID),
+		Value: payload,
+		Headers: []kgo.RecordHeader{
+			{Key: HeaderEventID, Value: []byte(event.ID)},
+			{Key: HeaderEventType, Value: []byte(event.EventType)},
+			{Key: HeaderEventVersion, Value: []byte(strconv.FormatInt(int64(event.EventVersion), 10))},
+			{Key: HeaderAggregateType, Value: []byte(event.AggregateType)},
+			{Key: HeaderAggregateSequence, Value: []byte(strconv.FormatInt(event.AggregateSequence, 10))},
+			{Key: HeaderContentType, Value: []byte(ce.DataContentType)},
+		},
+		Timestamp: event.CreatedAt,
+	}
+	attrs := ce.Attributes()
+	for _, name := range slices.Sorted(maps.Keys(attrs)) {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: KafkaCloudEventsPrefix + name, Value: []byte(attrs[name])})
+	}
+
+	if err := p.client.ProduceSync(ctx, record).FirstErr(); err != nil {
+		return fmt.Errorf("kafka: failed to publish event %s: %w", event.ID, err)
+	}
+
+	return nil
+}
+
+// Close closes the client. Publish is synchronous, so nothing is buffered.
+func (p *KafkaPublisher) Close() {
+	p.client.Close()
+}
@@ -0,0 +1,213 @@
+package eventpublisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/sasl/plain"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+const testTopic = "goledger.events"
+
+func newTestCluster(t *testing.T, opts ...kfake.Opt) *kfake.Cluster {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(append([]kfake.Opt{kfake.NumBrokers(1), kfake.SeedTopics(4, testTopic)}, opts...)...)
+	if err != nil {
+		t.Fatalf("failed to start fake kafka: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+
+	return cluster
+}
+
+func consumeAll(t *testing.T, n int, opts ...kgo.Opt) []*kgo.Record {
+	t.Helper()
+
+	client, err := kgo.NewClient(append(opts, kgo.ConsumeTopics(testTopic), kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()))...)
+	if err != nil {
+		t.Fatalf("failed to create consumer: %v", err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var records []*kgo.Record
+	for len(records) < n {
+		fetches := client.PollFetches(ctx)
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("timed out after consuming %d of %d records", len(records), n)
+		}
+		fetches.EachRecord(func(r *kgo.Record) { records = append(records, r) })
+	}
+
+	return records
+}
+
+func headers(r *kgo.Record) map[string]string {
+	h := make(map[string]string, len(r.Headers))
+	for _, header := range r.Headers {
+		h[header.Key] = string(header.Value)
+	}
+	return h
+}
+
+func TestKafkaPublisher_Publish(t *testing.T) {
+	cluster := newTestCluster(t)
+
+	p, err := NewKafkaPublisher(KafkaConfig{
+		Brokers:         cluster.ListenAddrs(),
+		Topic:           testTopic,
+		DeliveryTimeout: 5 * time.Second,
+		CloudEvents:     domain.CloudEventOptions{SchemaBaseURL: "https://ledger.example.com/api/v1/events/schemas"},
+	})
+	if err != nil {
+		t.Fatalf("NewKafkaPublisher() error = %v", err)
+	}
+	defer p.Close()
+
+	var events []*domain.OutboxEvent
+	for i := 1; i <= 3; i++ {
+		for _, aggregate := range []string{"acc-1", "acc-2"} {
+			events = append(events, &domain.OutboxEvent{
+				ID:                fmt.Sprintf("evt-%s-%d", aggregate, i),
+				AggregateID:       aggregate,
+				AggregateType:     "account",
+				EventType:         "account.updated",
+				EventVersion:      2,
+				AggregateSequence: int64(i),
+				Payload:           map[string]any{"seq": i},
+				CreatedAt:         time.Now().UTC(),
+			})
+		}
+	}
+
+	for _, e := range events {
+		if err := p.Publish(context.Background(), e); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	records := consumeAll(t, len(events), kgo.SeedBrokers(cluster.ListenAddrs()...))
+
+	partitions := make(map[string]int32)
+	lastSeq := make(map[string]int64)
+
+	for _, r := range records {
+		key := string(r.Key)
+		h := headers(r)
+
+		if h[HeaderEventType] != "account.updated" || h[HeaderEventVersion] != "2" || h[HeaderAggregateType] != "account" {
+			t.Fatalf("unexpected headers: %v", h)
+		}
+
+		if h["ce_specversion"] != "1.0" || h["ce_type"] != "account.updated" || h["ce_source"] != domain.DefaultCloudEventSource ||
+			h["ce_subject"] != key || h["ce_dataschema"] != "https://ledger.example.com/api/v1/events/schemas/account.updated/2" ||
+			h[HeaderContentType] != "application/json" {
+			t.Fatalf("unexpected CloudEvents headers: %v", h)
+		}
+
+		if p, ok := partitions[key]; ok && p != r.Partition {
+			t.Fatalf("aggregate %s was spread across partitions %d and %d", key, p, r.Partition)
+		}
+		partitions[key] = r.Partition
+
+		var seq int64
+		if _, err := fmt.Sscan(h[HeaderAggregateSequence], &seq); err != nil {
+			t.Fatalf("bad aggregate_sequence header: %v", err)
+		}
+		if seq != lastSeq[key]+1 {
+			t.Fatalf("aggregate %s: got sequence %d after %d", key, seq, lastSeq[key])
+		}
+		lastSeq[key] = seq
+
+		var payload map[string]any
+		if err := json.Unmarshal(r.Value, &payload); err != nil || payload["seq"] != float64(seq) {
+			t.Fatalf("unexpected payload %s (err %v)", r.Value, err)
+		}
+	}
+}
+
+func TestKafkaPublisher_SASL(t *testing.T) {
+	cluster := newTestCluster(t, kfake.EnableSASL(), kfake.Superuser(SASLPlain, "ledger", "secret"))
+
+	p, err := NewKafkaPublisher(KafkaConfig{
+		Brokers:         cluster.ListenAddrs(),
+		Topic:           testTopic,
+		DeliveryTimeout: 5 * time.Second,
+		SASLMechanism:   "plain",
+		SASLUsername:    "ledger",
+		SASLPassword:    "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewKafkaPublisher() error = %v", err)
+	}
+	defer p.Close()
+
+	event := &domain.OutboxEvent{ID: "evt-1", AggregateID: "acc-1", EventType: "account.created", Payload: map[string]any{}}
+	if err := p.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	records := consumeAll(t, 1,
+		kgo.SeedBrokers(cluster.ListenAddrs()...),
+		kgo.SASL(plain.Auth{User: "ledger", Pass: "secret"}.AsMechanism()),
+	)
+	if string(records[0].Key) != "acc-1" {
+		t.Fatalf("unexpected key %q", records[0].Key)
+	}
+}
+
+func TestKafkaPublisher_PublishFailsWithBadCredentials(t *testing.T) {
+	cluster := newTestCluster(t, kfake.EnableSASL(), kfake.Superuser(SASLPlain, "ledger", "secret"))
+
+	p, err := NewKafkaPublisher(KafkaConfig{
+		Brokers:            cluster.ListenAddrs(),
+		Topic:              testTopic,
+		DisableIdempotence: true,
+		DeliveryTimeout:    2 * time.Second,
+		SASLMechanism:      SASLPlain,
+		SASLUsername:       "ledger",
+		SASLPassword:       "wrong",
+	})
+	if err != nil {
+		t.Fatalf("NewKafkaPublisher() error = %v", err)
+	}
+	defer p.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := p.Publish(ctx, &domain.OutboxEvent{ID: "evt-1", AggregateID: "acc-1"}); err == nil {
+		t.Fatal("expected publish to fail with bad credentials")
+	}
+}
+
+func TestKafkaOptions_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  KafkaConfig
+	}{
+		{name: "no brokers", cfg: KafkaConfig{Topic: testTopic}},
+		{name: "no topic", cfg: KafkaConfig{Brokers: []string{"localhost:9092"}}},
+		{name: "unknown sasl", cfg: KafkaConfig{Brokers: []string{"localhost:9092"}, Topic: testTopic, SASLMechanism: "GSSAPI", SASLUsername: "u"}},
+		{name: "sasl without user", cfg: KafkaConfig{Brokers: []string{"localhost:9092"}, Topic: testTopic, SASLMechanism: SASLScramSHA512}},
+		{name: "missing CA file", cfg: KafkaConfig{Brokers: []string{"localhost:9092"}, Topic: testTopic, TLSEnabled: true, TLSCAFile: "/nonexistent/ca.pem"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := kafkaOptions(tt.cfg); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
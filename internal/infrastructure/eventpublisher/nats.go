@@ -0,0 +1,200 @@
+package eventpublisher
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// NATSConfig configures a NATSPublisher.
+type NATSConfig struct {
+	URL string
+	// CredsFile is an optional NATS user credentials (.creds) file.
+	CredsFile string
+	// SubjectPrefix is prepended to every subject:
+	// <prefix>.<aggregate_type>.<event_type>.
+	SubjectPrefix string
+	// Stream, when set, is created (or updated) on first publish to
+	// capture <prefix>.>; leave empty when the stream is provisioned
+	// separately.
+	Stream string
+	// DuplicateWindow is how long JetStream remembers message IDs for
+	// deduplication. Only applied to a stream this publisher manages.
+	DuplicateWindow time.Duration
+	// PublishTimeout bounds how long one Publish waits for the stream's
+	// acknowledgement.
+	PublishTimeout time.Duration
+	// CloudEvents sets the source and dataschema attributes of each
+	// message.
+	CloudEvents domain.CloudEventOptions
+}
+
+// NATSCloudEventsPrefix prefixes each CloudEvents attribute header (binary
+// content mode of the CloudEvents NATS binding).
+const NATSCloudEventsPrefix = "ce-"
+
+// NATSPublisher publishes outbox events to NATS JetStream. Each message
+// carries the outbox event ID as its Nats-Msg-Id, so a redelivery after a
+// crash between publishing and marking the event published is dropped by
+// the server within the stream's duplicate window.
+type NATSPublisher struct {
+	conn *nats.Conn
+	js   jetstream.JetStream
+	cfg  NATSConfig
+
+	mu          sync.Mutex
+	streamReady bool
+}
+
+// NewNATSPublisher connects to NATS. Connection failures are retried in the
+// background, so an unreachable server surfaces as a Publish error (and an
+// outbox retry) rather than a startup failure.
+func NewNATSPublisher(cfg NATSConfig) (*NATSPublisher, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("nats: URL is required")
+	}
+
+	if cfg.SubjectPrefix == "" {
+		return nil, errors.New("nats: subject prefix is required")
+	}
+
+	if cfg.PublishTimeout <= 0 {
+		cfg.PublishTimeout = 10 * time.Second
+	}
+
+	opts := []nats.Option{
+		nats.Name("goledger-outbox"),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+	}
+
+	if cfg.CredsFile != "" {
+		opts = append(opts, nats.UserCredentials(cfg.CredsFile))
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("nats: failed to connect: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("nats: failed to create JetStream context: %w", err)
+	}
+
+	return &NATSPublisher{conn: conn, js: js, cfg: cfg}, nil
+}
+
+// Subject returns the subject an event is published on. Characters with a
+// special meaning in subjects are replaced, so an event type can never
+// produce a wildcard or an extra token.
+func (p *NATSPublisher) Subject(event *domain.OutboxEvent) string {
+	return p.cfg.SubjectPrefix + "." + subjectToken(event.AggregateType) + "." + subjectEventType(event.EventType)
+}
+
+// Publish writes the event and waits for JetStream to acknowledge it. The
+// message is a CloudEvent in binary content mode: the data is the payload
+// and the attributes are ce- headers.
+func (p *NATSPublisher) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.PublishTimeout)
+	defer cancel()
+
+	if err := p.ensureStream(ctx); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(p.Subject(event))
+	msg.Data = payload
+	msg.Header.Set(HeaderEventID, event.ID)
+	msg.Header.Set(HeaderEventType, event.EventType)
+	msg.Header.Set(HeaderEventVersion, strconv.FormatInt(int64(event.EventVersion), 10))
+	msg.Header.Set(HeaderAggregateType, event.AggregateType)
+	msg.Header.Set(HeaderAggregateSequence, strconv.FormatInt(event.AggregateSequence, 10))
+
+	ce := domain.NewCloudEvent(event, p.cfg.CloudEvents)
+	msg.Header.Set(HeaderContentType, ce.DataContentType)
+	for name, value := range ce.Attributes() {
+		msg.Header.Set(NATSCloudEventsPrefix+name, value)
+	}
+
+	if _, err := p.js.PublishMsg(ctx, msg, jetstream.WithMsgID(event.ID)); err != nil {
+		return fmt.Errorf("nats: failed to publish event %s: %w", event.ID, err)
+	}
+
+	return nil
+}
+
+// ensureStream creates or updates the managed stream once.
+func (p *NATSPublisher) ensureStream(ctx context.Context) error {
+	if p.cfg.Stream == "" {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.streamReady {
+		return nil
+	}
+
+	_, err := p.js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:       p.cfg.Stream,
+		Subjects:   []string{p.cfg.SubjectPrefix + ".>"},
+		Duplicates: p.cfg.DuplicateWindow,
+		Storage:    jetstream.FileStorage,
+	})
+	if err != nil {
+		return fmt.Errorf("nats: failed to create stream %s: %w", p.cfg.Stream, err)
+	}
+
+	p.streamReady = true
+
+	return nil
+}
+
+// Close drains pending messages and closes the connection.
+func (p *NATSPublisher) Close() {
+	_ = p.conn.Drain()
+}
+
+// subjectEventType keeps an event type's own dots as subject token
+// separators ("transfer.created" stays two tokens), sanitizing each token.
+func subjectEventType(eventType string) string {
+	tokens := strings.Split(eventType, ".")
+	for i, t := range tokens {
+		tokens[i] = subjectToken(t)
+	}
+
+	return strings.Join(tokens, ".")
+}
+
+func subjectToken(s string) string {
+	if s == "" {
+		return "_"
+	}
+
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '_'
+		default:
+			return r
+		}
+	}, s)
+}
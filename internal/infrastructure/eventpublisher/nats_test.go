@@ -0,0 +1,190 @@
+package eventpublisher
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+const testStream = "GOLEDGER_EVENTS"
+
+func newTestNATSServer(t *testing.T) *server.Server {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      -1,
+		NoLog:     true,
+		NoSigs:    true,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+	})
+	if err != nil {
+		t.Fatalf("failed to create nats server: %v", err)
+	}
+
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+
+	if !srv.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server did not start")
+	}
+
+	return srv
+}
+
+func newTestNATSPublisher(t *testing.T, srv *server.Server) *NATSPublisher {
+	t.Helper()
+
+	p, err := NewNATSPublisher(NATSConfig{
+		URL:             srv.ClientURL(),
+		SubjectPrefix:   "goledger",
+		Stream:          testStream,
+		DuplicateWindow: time.Minute,
+		PublishTimeout:  5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewNATSPublisher() error = %v", err)
+	}
+	t.Cleanup(p.Close)
+
+	return p
+}
+
+func testStreamHandle(t *testing.T, srv *server.Server) jetstream.Stream {
+	t.Helper()
+
+	nc, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	t.Cleanup(nc.Close)
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		t.Fatalf("failed to create JetStream context: %v", err)
+	}
+
+	stream, err := js.Stream(context.Background(), testStream)
+	if err != nil {
+		t.Fatalf("failed to look up stream: %v", err)
+	}
+
+	return stream
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	srv := newTestNATSServer(t)
+	p := newTestNATSPublisher(t, srv)
+
+	event := &domain.OutboxEvent{
+		ID:                "evt-1",
+		AggregateID:       "tr-1",
+		AggregateType:     "transfer",
+		EventType:         "transfer.created",
+		EventVersion:      1,
+		AggregateSequence: 3,
+		Payload:           map[string]any{"amount": "10.00"},
+	}
+
+	if err := p.Publish(context.Background(), event); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	msg, err := testStreamHandle(t, srv).GetMsg(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("failed to read stored message: %v", err)
+	}
+
+	if msg.Subject != "goledger.transfer.transfer.created" {
+		t.Fatalf("unexpected subject %q", msg.Subject)
+	}
+
+	if msg.Header.Get(nats.MsgIdHdr) != "evt-1" || msg.Header.Get(HeaderEventType) != "transfer.created" ||
+		msg.Header.Get(HeaderAggregateSequence) != "3" {
+		t.Fatalf("unexpected headers: %v", msg.Header)
+	}
+
+	if msg.Header.Get("ce-specversion") != "1.0" || msg.Header.Get("ce-id") != "evt-1" || msg.Header.Get("ce-type") != "transfer.created" ||
+		msg.Header.Get("ce-aggregatesequence") != "3" || msg.Header.Get(HeaderContentType) != "application/json" {
+		t.Fatalf("unexpected CloudEvents headers: %v", msg.Header)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(msg.Data, &payload); err != nil || payload["amount"] != "10.00" {
+		t.Fatalf("unexpected payload %s (err %v)", msg.Data, err)
+	}
+}
+
+func TestNATSPublisher_DeduplicatesByEventID(t *testing.T) {
+	srv := newTestNATSServer(t)
+	p := newTestNATSPublisher(t, srv)
+
+	event := &domain.OutboxEvent{ID: "evt-1", AggregateType: "hold", EventType: "hold.created", Payload: map[string]any{}}
+
+	for i := 0; i < 3; i++ {
+		if err := p.Publish(context.Background(), event); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+
+	other := &domain.OutboxEvent{ID: "evt-2", AggregateType: "hold", EventType: "hold.voided", Payload: map[string]any{}}
+	if err := p.Publish(context.Background(), other); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+
+	info, err := testStreamHandle(t, srv).Info(context.Background())
+	if err != nil {
+		t.Fatalf("failed to read stream info: %v", err)
+	}
+
+	if info.State.Msgs != 2 {
+		t.Fatalf("expected 2 stored messages, got %d", info.State.Msgs)
+	}
+}
+
+func TestNATSPublisher_PublishFailsWithoutServer(t *testing.T) {
+	p, err := NewNATSPublisher(NATSConfig{
+		URL:            "nats://127.0.0.1:1",
+		SubjectPrefix:  "goledger",
+		Stream:         testStream,
+		PublishTimeout: 500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("NewNATSPublisher() error = %v", err)
+	}
+	defer p.Close()
+
+	if err := p.Publish(context.Background(), &domain.OutboxEvent{ID: "evt-1", EventType: "account.created"}); err == nil {
+		t.Fatal("expected publish to fail without a server")
+	}
+}
+
+func TestNATSPublisher_Subject(t *testing.T) {
+	p := &NATSPublisher{cfg: NATSConfig{SubjectPrefix: "ledger"}}
+
+	tests := []struct {
+		aggregateType string
+		eventType     string
+		want          string
+	}{
+		{"account", "account.created", "ledger.account.account.created"},
+		{"", "transfer.reversed", "ledger._.transfer.reversed"},
+		{"hold", "hold.*", "ledger.hold.hold._"},
+		{"hold>", "a..b", "ledger.hold_.a._.b"},
+	}
+
+	for _, tt := range tests {
+		got := p.Subject(&domain.OutboxEvent{AggregateType: tt.aggregateType, EventType: tt.eventType})
+		if got != tt.want {
+			t.Errorf("Subject(%q, %q) = %q, want %q", tt.aggregateType, tt.eventType, got, tt.want)
+		}
+	}
+}
@@ -3,7 +3,11 @@ package eventpublisher
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/iho/goledger/internal/domain"
@@ -15,14 +19,19 @@ import (
 // before the publisher stops retrying it and marks it dead-lettered.
 const DefaultMaxAttempts = 5
 
-// EventPublisher handles publishing events from the outbox.
+// EventPublisher handles publishing events from the outbox. Events are
+// leased before publishing, so any number of replicas can run one each.
 type EventPublisher struct {
 	outboxRepo  usecase.OutboxRepository
 	publisher   Publisher
 	logger      *slog.Logger
 	metrics     *metrics.Metrics
+	wakeups     <-chan struct{}
+	owner       string
 	batchSize   int
 	interval    time.Duration
+	lease       time.Duration
+	workers     int
 	maxAttempts int
 }
 
@@ -31,6 +40,37 @@ type Publisher interface {
 	Publish(ctx context.Context, event *domain.OutboxEvent) error
 }
 
+// PublisherFunc adapts a function to the Publisher interface.
+type PublisherFunc func(ctx context.Context, event *domain.OutboxEvent) error
+
+// Publish calls f(ctx, event).
+func (f PublisherFunc) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	return f(ctx, event)
+}
+
+// FanoutPublisher publishes every event to each of its publishers in order,
+// stopping at the first failure. The outbox retries a failed event as a
+// whole, so every publisher but the last should be idempotent per event ID.
+type FanoutPublisher struct {
+	publishers []Publisher
+}
+
+// NewFanoutPublisher creates a FanoutPublisher.
+func NewFanoutPublisher(publishers ...Publisher) *FanoutPublisher {
+	return &FanoutPublisher{publishers: publishers}
+}
+
+// Publish publishes the event to each publisher in turn.
+func (p *FanoutPublisher) Publish(ctx context.Context, event *domain.OutboxEvent) error {
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // Config for EventPublisher.
 type Config struct {
 	OutboxRepo usecase.OutboxRepository
@@ -39,9 +79,23 @@ type Config struct {
 	Metrics    *metrics.Metrics
 	BatchSize  int           // Number of events to fetch per batch
 	Interval   time.Duration // Polling interval
+	// Wakeups, when set, triggers a publish pass as soon as a value arrives
+	// (typically a Postgres NOTIFY for new events). Polling on Interval
+	// continues as a fallback for missed notifications.
+	Wakeups <-chan struct{}
 	// MaxAttempts is how many delivery failures an event tolerates before
 	// being dead-lettered (stops being retried). Defaults to DefaultMaxAttempts.
 	MaxAttempts int
+	// Workers is how many aggregates are published concurrently; events of
+	// one aggregate are always published in order by a single worker.
+	// Defaults to 4.
+	Workers int
+	// Lease is how long claimed events stay reserved for this instance.
+	// It must comfortably exceed the time to publish a batch, or another
+	// replica may claim the events again. Defaults to 1m.
+	Lease time.Duration
+	// Owner identifies this instance's leases. Defaults to hostname-pid.
+	Owner string
 }
 
 // NewEventPublisher creates a new EventPublisher.
@@ -58,14 +112,28 @@ func NewEventPublisher(cfg Config) *EventPublisher {
 	if cfg.MaxAttempts == 0 {
 		cfg.MaxAttempts = DefaultMaxAttempts
 	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = 4
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = time.Minute
+	}
+	if cfg.Owner == "" {
+		hostname, _ := os.Hostname()
+		cfg.Owner = fmt.Sprintf("%s-%d", hostname, os.Getpid())
+	}
 
 	return &EventPublisher{
 		outboxRepo:  cfg.OutboxRepo,
 		publisher:   cfg.Publisher,
 		logger:      cfg.Logger,
 		metrics:     cfg.Metrics,
+		wakeups:     cfg.Wakeups,
+		owner:       cfg.Owner,
 		batchSize:   cfg.BatchSize,
 		interval:    cfg.Interval,
+		lease:       cfg.Lease,
+		workers:     cfg.Workers,
 		maxAttempts: cfg.MaxAttempts,
 	}
 }
@@ -74,8 +142,12 @@ func NewEventPublisher(cfg Config) *EventPublisher {
 // It runs continuously until the context is cancelled.
 func (ep *EventPublisher) Start(ctx context.Context) error {
 	ep.logger.Info("event publisher started",
+		slog.String("owner", ep.owner),
 		slog.Int("batch_size", ep.batchSize),
-		slog.Duration("interval", ep.interval))
+		slog.Int("workers", ep.workers),
+		slog.Duration("interval", ep.interval),
+		slog.Duration("lease", ep.lease),
+		slog.Bool("notifications", ep.wakeups != nil))
 
 	ticker := time.NewTicker(ep.interval)
 	defer ticker.Stop()
@@ -94,13 +166,21 @@ func (ep *EventPublisher) Start(ctx context.Context) error {
 			if err := ep.processEvents(ctx); err != nil {
 				ep.logger.Error("error processing events", slog.String("error", err.Error()))
 			}
+		case <-ep.wakeups:
+			if err := ep.processEvents(ctx); err != nil {
+				ep.logger.Error("error processing events", slog.String("error", err.Error()))
+			}
 		}
 	}
 }
 
-// processEvents fetches and publishes a batch of unpublished events.
+// processEvents claims a batch of pending events and publishes it, one
+// aggregate per worker at a time.
 func (ep *EventPublisher) processEvents(ctx context.Context) error {
-	events, err := ep.outboxRepo.GetUnpublished(ctx, ep.batchSize)
+	ep.recordLag(ctx)
+
+	now := time.Now().UTC()
+	events, err := ep.outboxRepo.ClaimUnpublished(ctx, ep.owner, now, now.Add(ep.lease), ep.batchSize)
 	if err != nil {
 		return err
 	}
@@ -111,35 +191,157 @@ func (ep *EventPublisher) processEvents(ctx context.Context) error {
 
 	ep.logger.Info("processing events", slog.Int("count", len(events)))
 
-	for _, event := range events {
-		if err := ep.publishEvent(ctx, event); err != nil {
+	if ep.metrics != nil {
+		ep.metrics.OutboxEventsClaimed.Add(float64(len(events)))
+		ep.metrics.OutboxEventsInFlight.Add(float64(len(events)))
+	}
+
+	aggregates := groupByAggregate(events)
+	jobs := make(chan []*domain.OutboxEvent)
+
+	var wg sync.WaitGroup
+	for range min(ep.workers, len(aggregates)) {
+		wg.Go(func() {
+			for aggregateEvents := range jobs {
+				ep.publishAggregate(ctx, aggregateEvents)
+			}
+		})
+	}
+
+	for _, aggregateEvents := range aggregates {
+		jobs <- aggregateEvents
+	}
+	close(jobs)
+	wg.Wait()
+
+	return nil
+}
+
+// publishAggregate publishes one aggregate's claimed events in order. After
+// a failure the remaining events are released rather than published, so a
+// consumer never sees an event before the ones preceding it.
+func (ep *EventPublisher) publishAggregate(ctx context.Context, events []*domain.OutboxEvent) {
+	for i, event := range events {
+		err := ep.publishEvent(ctx, event)
+		ep.doneInFlight(1)
+
+		if err != nil {
 			ep.logger.Error("failed to publish event",
 				slog.String("event_id", event.ID),
 				slog.String("event_type", event.EventType),
 				slog.String("error", err.Error()))
 
 			ep.recordFailure(ctx, event, err)
-			// Continue processing other events even if one fails
-			continue
+			ep.release(ctx, events[i+1:])
+			return
 		}
 
 		// Mark as published
-		if err := ep.outboxRepo.MarkPublished(ctx, event.ID, time.Now().UTC()); err != nil {
-			ep.logger.Error("failed to mark event as published",
-				slog.String("event_id", event.ID),
-				slog.String("error", err.Error()))
-			// Don't continue - we don't want to re-publish this event
+		publishedAt := time.Now().UTC()
+		if err := ep.outboxRepo.MarkPublished(ctx, ep.owner, event.ID, publishedAt); err != nil {
+			if errors.Is(err, domain.ErrOutboxLeaseLost) {
+				// The lease expired mid-publish and another publisher
+				// re-claimed the event; it owns the event (and the
+				// successors) now, so this one was a duplicate delivery.
+				ep.logger.Warn("lost outbox lease before marking event published",
+					slog.String("event_id", event.ID))
+			} else {
+				ep.logger.Error("failed to mark event as published",
+					slog.String("event_id", event.ID),
+					slog.String("error", err.Error()))
+			}
+			// Don't continue - we don't want to re-publish this event, and
+			// its successors must wait until it is marked.
+			ep.release(ctx, events[i+1:])
+			return
+		}
+
+		if ep.metrics != nil {
+			ep.metrics.OutboxPublishLatency.Observe(publishedAt.Sub(event.CreatedAt).Seconds())
 		}
 	}
+}
 
-	return nil
+// release hands the leases on events back so a later claim retries them.
+func (ep *EventPublisher) release(ctx context.Context, events []*domain.OutboxEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+
+	if err := ep.outboxRepo.ReleaseClaims(ctx, ep.owner, ids); err != nil {
+		// The leases expire on their own; the events are only delayed.
+		ep.logger.Error("failed to release outbox claims",
+			slog.Int("count", len(ids)),
+			slog.String("error", err.Error()))
+	}
+
+	ep.doneInFlight(len(events))
+}
+
+func (ep *EventPublisher) doneInFlight(n int) {
+	if ep.metrics != nil {
+		ep.metrics.OutboxEventsInFlight.Sub(float64(n))
+	}
+}
+
+// recordLag sets the outbox lag gauge from the oldest pending event.
+func (ep *EventPublisher) recordLag(ctx context.Context) {
+	if ep.metrics == nil {
+		return
+	}
+
+	oldest, err := ep.outboxRepo.OldestUnpublishedAt(ctx)
+	if err != nil {
+		ep.logger.Error("failed to measure outbox lag", slog.String("error", err.Error()))
+		return
+	}
+
+	if oldest == nil {
+		ep.metrics.OutboxLag.Set(0)
+		return
+	}
+
+	ep.metrics.OutboxLag.Set(max(time.Since(*oldest).Seconds(), 0))
+}
+
+// groupByAggregate splits claimed events per aggregate, keeping the claim
+// order, which is aggregate_sequence order within each aggregate.
+func groupByAggregate(events []*domain.OutboxEvent) [][]*domain.OutboxEvent {
+	type aggregateKey struct{ aggregateType, aggregateID string }
+
+	index := make(map[aggregateKey]int)
+	var groups [][]*domain.OutboxEvent
+
+	for _, event := range events {
+		key := aggregateKey{event.AggregateType, event.AggregateID}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], event)
+	}
+
+	return groups
 }
 
 // recordFailure records a delivery failure and dead-letters the event once
 // it has exhausted maxAttempts, so one poison message can't block the rest
-// of the queue behind it (GetUnpublished excludes dead-lettered rows).
+// of the queue behind it (ClaimUnpublished skips dead-lettered rows).
 func (ep *EventPublisher) recordFailure(ctx context.Context, event *domain.OutboxEvent, publishErr error) {
-	attempts, err := ep.outboxRepo.RecordFailure(ctx, event.ID, publishErr.Error())
+	attempts, err := ep.outboxRepo.RecordFailure(ctx, ep.owner, event.ID, publishErr.Error())
+	if errors.Is(err, domain.ErrOutboxLeaseLost) {
+		// Another publisher re-claimed the event and retries it.
+		ep.logger.Warn("lost outbox lease before recording delivery failure",
+			slog.String("event_id", event.ID))
+		return
+	}
 	if err != nil {
 		ep.logger.Error("failed to record outbox delivery failure",
 			slog.String("event_id", event.ID),
@@ -3,8 +3,10 @@ package eventpublisher
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"log/slog"
+	"sync"
 	"testing"
 	"time"
 
@@ -34,8 +36,8 @@ func TestProcessEventsPublishesAndMarks(t *testing.T) {
 func TestProcessEventsContinuesOnPublishError(t *testing.T) {
 	repo := &stubOutboxRepo{
 		events: []*domain.OutboxEvent{
-			{ID: "evt-1", EventType: "type"},
-			{ID: "evt-2", EventType: "type"},
+			{ID: "evt-1", EventType: "type", AggregateType: "account", AggregateID: "acc-1"},
+			{ID: "evt-2", EventType: "type", AggregateType: "account", AggregateID: "acc-2"},
 		},
 	}
 	pub := &stubPublisher{
@@ -55,6 +57,99 @@ func TestProcessEventsContinuesOnPublishError(t *testing.T) {
 	}
 }
 
+func TestProcessEventsHoldsBackAggregateAfterFailure(t *testing.T) {
+	repo := &stubOutboxRepo{
+		events: []*domain.OutboxEvent{
+			{ID: "evt-1", AggregateType: "account", AggregateID: "acc-1", AggregateSequence: 1},
+			{ID: "evt-2", AggregateType: "account", AggregateID: "acc-1", AggregateSequence: 2},
+			{ID: "evt-3", AggregateType: "account", AggregateID: "acc-1", AggregateSequence: 3},
+		},
+	}
+	pub := &stubPublisher{
+		errorsByID: map[string]error{"evt-2": errors.New("fail")},
+	}
+	ep := newTestPublisher(repo, pub)
+
+	if err := ep.processEvents(context.Background()); err != nil {
+		t.Fatalf("processEvents returned error: %v", err)
+	}
+
+	if len(pub.published) != 1 || pub.published[0].ID != "evt-1" {
+		t.Fatalf("expected only evt-1 to be published, got %#v", pub.published)
+	}
+	if len(repo.released) != 1 || repo.released[0] != "evt-3" {
+		t.Fatalf("expected evt-3 to be released, got %#v", repo.released)
+	}
+	if repo.failures["evt-2"] != 1 {
+		t.Fatalf("expected evt-2 failure to be recorded, got %d", repo.failures["evt-2"])
+	}
+}
+
+func TestProcessEventsStopsAggregateOnLostLease(t *testing.T) {
+	repo := &stubOutboxRepo{
+		events: []*domain.OutboxEvent{
+			{ID: "evt-1", AggregateType: "account", AggregateID: "acc-1", AggregateSequence: 1},
+			{ID: "evt-2", AggregateType: "account", AggregateID: "acc-1", AggregateSequence: 2},
+			{ID: "evt-3", AggregateType: "account", AggregateID: "acc-2", AggregateSequence: 1},
+		},
+		lostLeases: map[string]bool{"evt-1": true, "evt-3": true},
+	}
+	pub := &stubPublisher{
+		errorsByID: map[string]error{"evt-3": errors.New("fail")},
+	}
+	ep := newTestPublisher(repo, pub)
+	ep.maxAttempts = 1
+
+	if err := ep.processEvents(context.Background()); err != nil {
+		t.Fatalf("processEvents returned error: %v", err)
+	}
+
+	if len(repo.marked) != 0 {
+		t.Fatalf("expected no event marked without its lease, got %#v", repo.marked)
+	}
+	if len(repo.released) != 1 || repo.released[0] != "evt-2" {
+		t.Fatalf("expected evt-2 to be released, got %#v", repo.released)
+	}
+	if len(repo.deadLettered) != 0 {
+		t.Fatalf("expected no dead-lettering without the lease, got %#v", repo.deadLettered)
+	}
+}
+
+func TestProcessEventsPublishesAggregateInOrder(t *testing.T) {
+	var events []*domain.OutboxEvent
+	for i := range 10 {
+		aggregateID := "acc-1"
+		if i%2 == 1 {
+			aggregateID = "acc-2"
+		}
+		events = append(events, &domain.OutboxEvent{
+			ID:                fmt.Sprintf("evt-%02d", i),
+			AggregateType:     "account",
+			AggregateID:       aggregateID,
+			AggregateSequence: int64(i),
+		})
+	}
+	repo := &stubOutboxRepo{events: events}
+	pub := &stubPublisher{}
+	ep := newTestPublisher(repo, pub)
+
+	if err := ep.processEvents(context.Background()); err != nil {
+		t.Fatalf("processEvents failed: %v", err)
+	}
+
+	if len(pub.published) != len(events) {
+		t.Fatalf("expected %d published events, got %d", len(events), len(pub.published))
+	}
+
+	last := make(map[string]int64)
+	for _, event := range pub.published {
+		if seq, ok := last[event.AggregateID]; ok && event.AggregateSequence <= seq {
+			t.Fatalf("%s published out of order: %d after %d", event.AggregateID, event.AggregateSequence, seq)
+		}
+		last[event.AggregateID] = event.AggregateSequence
+	}
+}
+
 func TestProcessEventsDeadLettersAfterMaxAttempts(t *testing.T) {
 	repo := &stubOutboxRepo{
 		events: []*domain.OutboxEvent{{ID: "evt-1", EventType: "type"}},
@@ -118,6 +213,62 @@ func TestStartStopsOnContextCancellation(t *testing.T) {
 	}
 }
 
+func TestStartProcessesOnWakeup(t *testing.T) {
+	repo := &stubOutboxRepo{}
+	pub := &stubPublisher{}
+	wakeups := make(chan struct{})
+	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
+	ep := NewEventPublisher(Config{
+		OutboxRepo: repo,
+		Publisher:  pub,
+		Logger:     logger,
+		Interval:   time.Hour,
+		Wakeups:    wakeups,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = ep.Start(ctx) }()
+
+	// The first send is only accepted once Start is in its select loop,
+	// after the initial pass over the (still empty) outbox.
+	wakeups <- struct{}{}
+	repo.setEvents([]*domain.OutboxEvent{{ID: "evt-1", EventType: "type"}})
+	wakeups <- struct{}{}
+
+	deadline := time.After(time.Second)
+	for {
+		if len(repo.markedIDs()) == 1 {
+			return
+		}
+		select {
+		case <-deadline:
+			t.Fatal("expected the wakeup to publish the event before the poll interval")
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+func TestFanoutPublisherStopsAtFirstFailure(t *testing.T) {
+	var calls []string
+	record := func(name string, err error) Publisher {
+		return PublisherFunc(func(context.Context, *domain.OutboxEvent) error {
+			calls = append(calls, name)
+			return err
+		})
+	}
+
+	fanout := NewFanoutPublisher(record("webhooks", nil), record("kafka", errors.New("broker down")), record("never", nil))
+
+	if err := fanout.Publish(context.Background(), &domain.OutboxEvent{ID: "evt-1"}); err == nil {
+		t.Fatal("expected the second publisher's error")
+	}
+
+	if len(calls) != 2 || calls[0] != "webhooks" || calls[1] != "kafka" {
+		t.Fatalf("unexpected calls: %v", calls)
+	}
+}
+
 func newTestPublisher(repo *stubOutboxRepo, pub *stubPublisher) *EventPublisher {
 	logger := slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{}))
 	return NewEventPublisher(Config{
@@ -130,10 +281,26 @@ func newTestPublisher(repo *stubOutboxRepo, pub *stubPublisher) *EventPublisher
 }
 
 type stubOutboxRepo struct {
+	mu           sync.Mutex
 	events       []*domain.OutboxEvent
 	marked       []string
+	released     []string
 	failures     map[string]int
 	deadLettered []string
+	// lostLeases are events another publisher has re-claimed.
+	lostLeases map[string]bool
+}
+
+func (s *stubOutboxRepo) setEvents(events []*domain.OutboxEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = events
+}
+
+func (s *stubOutboxRepo) markedIDs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.marked...)
 }
 
 func (s *stubOutboxRepo) Create(ctx context.Context, tx usecase.Transaction, event *domain.OutboxEvent) error {
@@ -141,26 +308,49 @@ func (s *stubOutboxRepo) Create(ctx context.Context, tx usecase.Transaction, eve
 }
 
 func (s *stubOutboxRepo) GetUnpublished(ctx context.Context, limit int) ([]*domain.OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if len(s.events) <= limit {
 		return append([]*domain.OutboxEvent(nil), s.events...), nil
 	}
 	return append([]*domain.OutboxEvent(nil), s.events[:limit]...), nil
 }
 
-func (s *stubOutboxRepo) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
-	s.marked = append(s.marked, id)
+func (s *stubOutboxRepo) ClaimUnpublished(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.OutboxEvent, error) {
+	return s.GetUnpublished(ctx, limit)
+}
+
+func (s *stubOutboxRepo) ReleaseClaims(ctx context.Context, owner string, ids []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released = append(s.released, ids...)
 	return nil
 }
 
-func (s *stubOutboxRepo) GetByAggregate(ctx context.Context, aggregateType, aggregateID string, limit, offset int) ([]*domain.OutboxEvent, error) {
+func (s *stubOutboxRepo) OldestUnpublishedAt(ctx context.Context) (*time.Time, error) {
 	return nil, nil
 }
 
-func (s *stubOutboxRepo) DeletePublished(ctx context.Context, before time.Time) error {
+func (s *stubOutboxRepo) MarkPublished(ctx context.Context, owner, id string, publishedAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lostLeases[id] {
+		return domain.ErrOutboxLeaseLost
+	}
+	s.marked = append(s.marked, id)
 	return nil
 }
 
-func (s *stubOutboxRepo) RecordFailure(ctx context.Context, id, lastError string) (int, error) {
+func (s *stubOutboxRepo) GetByAggregate(ctx context.Context, aggregateType, aggregateID string, limit, offset int) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (s *stubOutboxRepo) RecordFailure(ctx context.Context, owner, id, lastError string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lostLeases[id] {
+		return 0, domain.ErrOutboxLeaseLost
+	}
 	if s.failures == nil {
 		s.failures = make(map[string]int)
 	}
@@ -169,6 +359,8 @@ func (s *stubOutboxRepo) RecordFailure(ctx context.Context, id, lastError string
 }
 
 func (s *stubOutboxRepo) MarkDeadLettered(ctx context.Context, id string, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.deadLettered = append(s.deadLettered, id)
 	return nil
 }
@@ -177,7 +369,32 @@ func (s *stubOutboxRepo) GetDeadLettered(ctx context.Context, limit, offset int)
 	return nil, nil
 }
 
+func (s *stubOutboxRepo) GetDeadLetteredCursor(ctx context.Context, after *domain.TimeCursor, limit int) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (s *stubOutboxRepo) ReplayDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (s *stubOutboxRepo) ArchiveDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter, reason string, at time.Time) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
+func (s *stubOutboxRepo) GetByID(ctx context.Context, id string) (*domain.OutboxEvent, error) {
+	return nil, domain.ErrOutboxEventNotFound
+}
+
+func (s *stubOutboxRepo) GetByAggregateSequence(ctx context.Context, aggregateType, aggregateID string, sequence int64) (*domain.OutboxEvent, error) {
+	return nil, domain.ErrOutboxEventNotFound
+}
+
+func (s *stubOutboxRepo) ListPublishedAfter(ctx context.Context, filter domain.EventStreamFilter, after domain.EventStreamPosition, limit int) ([]*domain.OutboxEvent, error) {
+	return nil, nil
+}
+
 type stubPublisher struct {
+	mu         sync.Mutex
 	published  []*domain.OutboxEvent
 	errorsByID map[string]error
 }
@@ -186,6 +403,8 @@ func (s *stubPublisher) Publish(ctx context.Context, event *domain.OutboxEvent)
 	if err := s.errorsByID[event.ID]; err != nil {
 		return err
 	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	s.published = append(s.published, event)
 	return nil
 }
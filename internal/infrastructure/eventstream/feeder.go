@@ -0,0 +1,67 @@
+package eventstream
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Poller is the subset of EventStreamUseCase the feeder depends on, so
+// tests can supply a fake without a real database.
+type Poller interface {
+	Poll(ctx context.Context) error
+}
+
+// Feeder periodically reads newly published outbox events into the event
+// stream.
+type Feeder struct {
+	streamUC Poller
+	logger   *slog.Logger
+	interval time.Duration
+}
+
+// Config for Feeder.
+type Config struct {
+	StreamUC Poller
+	Logger   *slog.Logger
+	// Interval is how often the outbox is polled. Defaults to 500ms.
+	Interval time.Duration
+}
+
+// NewFeeder creates a new Feeder.
+func NewFeeder(cfg Config) *Feeder {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 500 * time.Millisecond
+	}
+
+	return &Feeder{
+		streamUC: cfg.StreamUC,
+		logger:   cfg.Logger,
+		interval: cfg.Interval,
+	}
+}
+
+// Start polls on a ticker until the context is cancelled. Poll errors are
+// logged but never fatal; the next tick picks up where the last one left.
+func (f *Feeder) Start(ctx context.Context) error {
+	f.logger.Info("event stream feeder started", slog.Duration("interval", f.interval))
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.streamUC.Poll(ctx); err != nil && ctx.Err() == nil {
+			f.logger.Error("event stream poll failed", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			f.logger.Info("event stream feeder shutting down")
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package eventstream_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/iho/goledger/internal/infrastructure/eventstream"
+)
+
+type pollerFunc func(ctx context.Context) error
+
+func (f pollerFunc) Poll(ctx context.Context) error { return f(ctx) }
+
+func TestFeeder_PollsUntilCancelled(t *testing.T) {
+	var polls atomic.Int32
+	feeder := eventstream.NewFeeder(eventstream.Config{
+		StreamUC: pollerFunc(func(context.Context) error {
+			// Errors are logged, never fatal.
+			if polls.Add(1) == 1 {
+				return errors.New("db down")
+			}
+			return nil
+		}),
+		Logger:   slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Interval: time.Millisecond,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- feeder.Start(ctx) }()
+
+	deadline := time.After(time.Second)
+	for polls.Load() < 3 {
+		select {
+		case <-deadline:
+			t.Fatalf("expected repeated polls, got %d", polls.Load())
+		case <-time.After(time.Millisecond):
+		}
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
@@ -61,6 +61,29 @@ type Metrics struct {
 
 	// Outbox metrics
 	OutboxEventsDeadLettered prometheus.Counter
+	OutboxEventsClaimed      prometheus.Counter
+	OutboxEventsInFlight     prometheus.Gauge
+	OutboxLag                prometheus.Gauge
+	OutboxPublishLatency     prometheus.Histogram
+
+	// Projection metrics
+	ProjectionLag     prometheus.Gauge
+	ProjectionEntries prometheus.Counter
+	ProjectionRuns    *prometheus.CounterVec
+
+	// Outbox retention metrics
+	OutboxRetentionEventsArchived     prometheus.Counter
+	OutboxRetentionPartitionsPruned   prometheus.Counter
+	OutboxRetentionPartitionsRetained prometheus.Gauge
+	OutboxRetentionRuns               *prometheus.CounterVec
+
+	// Webhook metrics
+	WebhookDeliveries *prometheus.CounterVec
+
+	// Accrual metrics
+	AccrualRuns     *prometheus.CounterVec
+	AccrualsPosted  prometheus.Counter
+	AccrualFailures prometheus.Counter
 }
 
 // New creates and registers all Prometheus metrics
@@ -284,5 +307,86 @@ func New() *Metrics {
 			Name: "goledger_outbox_events_dead_lettered_total",
 			Help: "Total outbox events dead-lettered after exhausting delivery attempts",
 		}),
+		OutboxEventsClaimed: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "goledger_outbox_events_claimed_total",
+			Help: "Total outbox events leased by this publisher instance",
+		}),
+		OutboxEventsInFlight: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "goledger_outbox_events_in_flight",
+			Help: "Outbox events claimed by this instance and not yet published or failed",
+		}),
+		OutboxLag: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "goledger_outbox_lag_seconds",
+			Help: "Age of the oldest pending outbox event, 0 when the outbox is drained",
+		}),
+		OutboxPublishLatency: promauto.NewHistogram(prometheus.HistogramOpts{
+			Name:    "goledger_outbox_publish_latency_seconds",
+			Help:    "Time from an outbox event's creation to its publication",
+			Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+		}),
+
+		// Projection metrics
+		ProjectionLag: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "goledger_projection_lag_seconds",
+			Help: "Age of the oldest entry not yet applied to the reporting read models, 0 when caught up",
+		}),
+		ProjectionEntries: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "goledger_projection_entries_total",
+			Help: "Total entries applied to the reporting read models",
+		}),
+		ProjectionRuns: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "goledger_projection_runs_total",
+				Help: "Total reporting projector runs by result",
+			},
+			[]string{"result"}, // ok, error
+		),
+
+		// Outbox retention metrics
+		OutboxRetentionEventsArchived: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "goledger_outbox_retention_events_archived_total",
+			Help: "Total published outbox events moved out of the outbox by the retention job",
+		}),
+		OutboxRetentionPartitionsPruned: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "goledger_outbox_retention_partitions_pruned_total",
+			Help: "Total monthly outbox partitions archived and removed from the outbox",
+		}),
+		OutboxRetentionPartitionsRetained: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "goledger_outbox_retention_partitions_retained",
+			Help: "Outbox partitions past the retention window kept because they hold unpublished events",
+		}),
+		OutboxRetentionRuns: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "goledger_outbox_retention_runs_total",
+				Help: "Total outbox retention runs by result",
+			},
+			[]string{"result"}, // ok, error
+		),
+
+		// Webhook metrics
+		WebhookDeliveries: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "goledger_webhook_delivery_attempts_total",
+				Help: "Total webhook delivery attempts by outcome",
+			},
+			[]string{"outcome"}, // delivered, failed, dead_lettered
+		),
+
+		// Accrual metrics
+		AccrualRuns: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "goledger_accrual_runs_total",
+				Help: "Total scheduled accrual runs by outcome",
+			},
+			[]string{"status"}, // ok, partial, error
+		),
+		AccrualsPosted: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "goledger_accruals_posted_total",
+			Help: "Total interest/fee accrual transfers posted",
+		}),
+		AccrualFailures: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "goledger_accrual_failures_total",
+			Help: "Total per-account accruals that failed to post and will be retried",
+		}),
 	}
 }
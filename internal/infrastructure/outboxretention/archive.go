@@ -0,0 +1,98 @@
+package outboxretention
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// FileArchiver writes each pruned partition to <dir>/<partition>.ndjson.gz,
+// one event per line as a JSON object keyed by column name.
+type FileArchiver struct {
+	dir string
+}
+
+// NewFileArchiver creates a FileArchiver writing to dir, which is created
+// on first use.
+func NewFileArchiver(dir string) *FileArchiver {
+	return &FileArchiver{dir: dir}
+}
+
+// Create starts a partition's archive in a temporary file next to its
+// final path.
+func (a *FileArchiver) Create(partition string) (usecase.OutboxPartitionArchive, error) {
+	if err := os.MkdirAll(a.dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	file, err := os.CreateTemp(a.dir, partition+".ndjson.gz.tmp-*")
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bufio.NewWriter(file)
+
+	return &fileArchive{
+		file: file,
+		buf:  buf,
+		gz:   gzip.NewWriter(buf),
+		path: filepath.Join(a.dir, partition+".ndjson.gz"),
+	}, nil
+}
+
+type fileArchive struct {
+	file *os.File
+	buf  *bufio.Writer
+	gz   *gzip.Writer
+	path string
+}
+
+func (a *fileArchive) WriteRow(row []byte) error {
+	if _, err := a.gz.Write(row); err != nil {
+		return err
+	}
+
+	_, err := a.gz.Write([]byte{'\n'})
+
+	return err
+}
+
+// Commit flushes and fsyncs the archive, then renames it into place, so an
+// archive at its final path is always complete.
+func (a *fileArchive) Commit() (string, error) {
+	if err := a.finish(); err != nil {
+		a.Abort()
+		return "", err
+	}
+
+	if err := os.Rename(a.file.Name(), a.path); err != nil {
+		_ = os.Remove(a.file.Name())
+		return "", err
+	}
+
+	return a.path, nil
+}
+
+func (a *fileArchive) finish() error {
+	if err := a.gz.Close(); err != nil {
+		return err
+	}
+
+	if err := a.buf.Flush(); err != nil {
+		return err
+	}
+
+	if err := a.file.Sync(); err != nil {
+		return err
+	}
+
+	return a.file.Close()
+}
+
+func (a *fileArchive) Abort() {
+	_ = a.file.Close()
+	_ = os.Remove(a.file.Name())
+}
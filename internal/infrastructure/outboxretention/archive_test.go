@@ -0,0 +1,76 @@
+package outboxretention_test
+
+import (
+	"bufio"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iho/goledger/internal/infrastructure/outboxretention"
+)
+
+func TestFileArchiver_CommitWritesCompressedNDJSON(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	archive, err := outboxretention.NewFileArchiver(dir).Create("outbox_events_2026_01")
+	if err != nil {
+		t.Fatalf("failed to create archive: %v", err)
+	}
+
+	for _, row := range []string{`{"id":"evt-1"}`, `{"id":"evt-2"}`} {
+		if err := archive.WriteRow([]byte(row)); err != nil {
+			t.Fatalf("failed to write row: %v", err)
+		}
+	}
+
+	path, err := archive.Commit()
+	if err != nil {
+		t.Fatalf("failed to commit archive: %v", err)
+	}
+
+	if path != filepath.Join(dir, "outbox_events_2026_01.ndjson.gz") {
+		t.Fatalf("unexpected path %q", path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("failed to open archive: %v", err)
+	}
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatalf("archive is not gzip: %v", err)
+	}
+
+	var lines []string
+	scanner := bufio.NewScanner(gz)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	if len(lines) != 2 || lines[0] != `{"id":"evt-1"}` || lines[1] != `{"id":"evt-2"}` {
+		t.Fatalf("unexpected archive contents: %q", lines)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Fatalf("expected only the archive in %s, got %d files", dir, len(entries))
+	}
+}
+
+func TestFileArchiver_AbortLeavesNothing(t *testing.T) {
+	dir := t.TempDir()
+	archive, err := outboxretention.NewFileArchiver(dir).Create("outbox_events_2026_01")
+	if err != nil {
+		t.Fatalf("failed to create archive: %v", err)
+	}
+
+	_ = archive.WriteRow([]byte(`{"id":"evt-1"}`))
+	archive.Abort()
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 0 {
+		t.Fatalf("expected an empty directory, got %d files", len(entries))
+	}
+}
@@ -0,0 +1,152 @@
+// Package outboxretention keeps outbox_events partitioned by month and, on
+// a schedule, moves the published events of months past the retention
+// window out of the outbox.
+package outboxretention
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// Pruner is the subset of OutboxRetentionUseCase the scheduler depends on,
+// so tests can supply a fake without a real database.
+type Pruner interface {
+	EnsurePartitions(ctx context.Context) error
+	Prune(ctx context.Context, opts usecase.OutboxPruneOptions) (*domain.OutboxPruneReport, error)
+}
+
+// Scheduler periodically creates upcoming outbox partitions and prunes
+// expired ones.
+type Scheduler struct {
+	retentionUC   Pruner
+	logger        *slog.Logger
+	metrics       *metrics.Metrics
+	mode          domain.OutboxArchiveMode
+	interval      time.Duration
+	retentionDays int
+}
+
+// Config for Scheduler.
+type Config struct {
+	RetentionUC Pruner
+	Logger      *slog.Logger
+	Metrics     *metrics.Metrics
+	// Mode is where pruned events are archived. Defaults to
+	// domain.OutboxArchiveTable.
+	Mode     domain.OutboxArchiveMode
+	Interval time.Duration
+	// RetentionDays is how long published events stay in the outbox. 0
+	// keeps them forever; partitions are still created ahead of time.
+	RetentionDays int
+}
+
+// NewScheduler creates a new outbox retention Scheduler.
+func NewScheduler(cfg Config) *Scheduler {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+
+	if cfg.Mode == "" {
+		cfg.Mode = domain.OutboxArchiveTable
+	}
+
+	return &Scheduler{
+		retentionUC:   cfg.RetentionUC,
+		logger:        cfg.Logger,
+		metrics:       cfg.Metrics,
+		mode:          cfg.Mode,
+		interval:      cfg.Interval,
+		retentionDays: cfg.RetentionDays,
+	}
+}
+
+// Start runs retention on a ticker until the context is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.logger.Info("outbox retention started",
+		slog.Duration("interval", s.interval),
+		slog.Int("retention_days", s.retentionDays),
+		slog.String("archive", string(s.mode)))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.runOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("outbox retention shutting down")
+			return ctx.Err()
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce ensures upcoming partitions exist and, when a retention window
+// is set, prunes expired ones. Errors are logged but never fatal to the
+// scheduler loop; partitions pruned before an error stay pruned.
+func (s *Scheduler) runOnce(ctx context.Context) {
+	if s.retentionDays <= 0 {
+		if err := s.retentionUC.EnsurePartitions(ctx); err != nil {
+			s.logger.Error("outbox partition maintenance failed", slog.String("error", err.Error()))
+			s.recordRun("error")
+			return
+		}
+		s.recordRun("ok")
+		return
+	}
+
+	report, err := s.retentionUC.Prune(ctx, usecase.OutboxPruneOptions{Mode: s.mode, RetentionDays: s.retentionDays})
+	if report != nil {
+		s.recordReport(report)
+	}
+
+	if err != nil {
+		s.logger.Error("outbox retention failed", slog.String("error", err.Error()))
+		s.recordRun("error")
+		return
+	}
+
+	s.recordRun("ok")
+}
+
+func (s *Scheduler) recordReport(report *domain.OutboxPruneReport) {
+	for _, p := range report.Partitions {
+		switch p.Status {
+		case domain.OutboxPartitionPruned:
+			s.logger.Info("outbox partition pruned",
+				slog.String("partition", p.Partition.Name),
+				slog.Int64("events", p.Stats.Events),
+				slog.String("archived_to", p.ArchivedTo))
+		case domain.OutboxPartitionRetained:
+			s.logger.Warn("outbox partition past retention kept",
+				slog.String("partition", p.Partition.Name),
+				slog.String("reason", p.Reason))
+		}
+	}
+
+	if report.DefaultPartitionEvents > 0 {
+		s.logger.Warn("outbox default partition holds events and is never pruned",
+			slog.Int64("events", report.DefaultPartitionEvents))
+	}
+
+	if s.metrics == nil {
+		return
+	}
+
+	s.metrics.OutboxRetentionEventsArchived.Add(float64(report.EventsArchived()))
+	s.metrics.OutboxRetentionPartitionsPruned.Add(float64(report.Pruned()))
+	s.metrics.OutboxRetentionPartitionsRetained.Set(float64(report.Retained()))
+}
+
+func (s *Scheduler) recordRun(result string) {
+	if s.metrics != nil {
+		s.metrics.OutboxRetentionRuns.WithLabelValues(result).Inc()
+	}
+}
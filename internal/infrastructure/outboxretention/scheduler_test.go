@@ -0,0 +1,149 @@
+package outboxretention_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/infrastructure/outboxretention"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type fakePruner struct {
+	mu       sync.Mutex
+	report   *domain.OutboxPruneReport
+	err      error
+	ensured  int
+	prunings []usecase.OutboxPruneOptions
+}
+
+func (f *fakePruner) EnsurePartitions(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ensured++
+	return f.err
+}
+
+func (f *fakePruner) Prune(ctx context.Context, opts usecase.OutboxPruneOptions) (*domain.OutboxPruneReport, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.prunings = append(f.prunings, opts)
+	return f.report, f.err
+}
+
+// newTestMetrics registers metrics against a fresh registry so each test's
+// metrics.Metrics doesn't collide with the process-wide default registry.
+func newTestMetrics(t *testing.T) *metrics.Metrics {
+	t.Helper()
+
+	registry := prometheus.NewRegistry()
+	prevRegisterer, prevGatherer := prometheus.DefaultRegisterer, prometheus.DefaultGatherer
+	prometheus.DefaultRegisterer = registry
+	prometheus.DefaultGatherer = registry
+	t.Cleanup(func() {
+		prometheus.DefaultRegisterer, prometheus.DefaultGatherer = prevRegisterer, prevGatherer
+	})
+
+	return metrics.New()
+}
+
+func runOnceViaShortLoop(t *testing.T, s *outboxretention.Scheduler) {
+	t.Helper()
+	// Start runs immediately on entry; cancel before the next tick.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := s.Start(ctx)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestScheduler_RecordsPrunedPartitions(t *testing.T) {
+	fake := &fakePruner{report: &domain.OutboxPruneReport{Partitions: []domain.OutboxPartitionResult{
+		{Status: domain.OutboxPartitionPruned, Stats: domain.OutboxPartitionStats{Events: 40}},
+		{Status: domain.OutboxPartitionPruned, Stats: domain.OutboxPartitionStats{Events: 2}},
+		{Status: domain.OutboxPartitionRetained, Stats: domain.OutboxPartitionStats{Events: 9, Unpublished: 1}},
+	}}}
+
+	m := newTestMetrics(t)
+	s := outboxretention.NewScheduler(outboxretention.Config{
+		RetentionUC:   fake,
+		Metrics:       m,
+		Interval:      time.Hour,
+		RetentionDays: 30,
+	})
+
+	runOnceViaShortLoop(t, s)
+
+	if len(fake.prunings) != 1 || fake.prunings[0].RetentionDays != 30 || fake.prunings[0].Mode != domain.OutboxArchiveTable {
+		t.Fatalf("unexpected prune calls: %+v", fake.prunings)
+	}
+
+	if got := testutil.ToFloat64(m.OutboxRetentionEventsArchived); got != 42 {
+		t.Fatalf("expected 42 archived events, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.OutboxRetentionPartitionsPruned); got != 2 {
+		t.Fatalf("expected 2 pruned partitions, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.OutboxRetentionPartitionsRetained); got != 1 {
+		t.Fatalf("expected 1 retained partition, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.OutboxRetentionRuns.WithLabelValues("ok")); got != 1 {
+		t.Fatalf("expected one ok run, got %v", got)
+	}
+}
+
+func TestScheduler_WithoutRetentionOnlyEnsuresPartitions(t *testing.T) {
+	fake := &fakePruner{}
+
+	m := newTestMetrics(t)
+	s := outboxretention.NewScheduler(outboxretention.Config{
+		RetentionUC: fake,
+		Metrics:     m,
+		Interval:    time.Hour,
+	})
+
+	runOnceViaShortLoop(t, s)
+
+	if fake.ensured != 1 || len(fake.prunings) != 0 {
+		t.Fatalf("expected only partition maintenance, got %d ensures and %d prunes", fake.ensured, len(fake.prunings))
+	}
+}
+
+func TestScheduler_ErrorKeepsPartialProgress(t *testing.T) {
+	fake := &fakePruner{
+		report: &domain.OutboxPruneReport{Partitions: []domain.OutboxPartitionResult{
+			{Status: domain.OutboxPartitionPruned, Stats: domain.OutboxPartitionStats{Events: 5}},
+		}},
+		err: errors.New("lock timeout"),
+	}
+
+	m := newTestMetrics(t)
+	s := outboxretention.NewScheduler(outboxretention.Config{
+		RetentionUC:   fake,
+		Metrics:       m,
+		Interval:      time.Hour,
+		RetentionDays: 7,
+	})
+
+	runOnceViaShortLoop(t, s)
+
+	if got := testutil.ToFloat64(m.OutboxRetentionRuns.WithLabelValues("error")); got != 1 {
+		t.Fatalf("expected one error run, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.OutboxRetentionEventsArchived); got != 5 {
+		t.Fatalf("expected the 5 archived events recorded, got %v", got)
+	}
+}
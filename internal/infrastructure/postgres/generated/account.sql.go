@@ -112,6 +112,41 @@ func (q *Queries) GetAccountByIDForUpdate(ctx context.Context, id string) (Accou
 	return i, err
 }
 
+const getAccountsByIDs = `-- name: GetAccountsByIDs :many
+SELECT id, name, currency, balance, version, allow_negative_balance, allow_positive_balance, created_at, updated_at, encumbered_balance FROM accounts WHERE id = ANY($1::text[])
+`
+
+func (q *Queries) GetAccountsByIDs(ctx context.Context, dollar_1 []string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, getAccountsByIDs, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Currency,
+			&i.Balance,
+			&i.Version,
+			&i.AllowNegativeBalance,
+			&i.AllowPositiveBalance,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.EncumberedBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAccountsByIDsForUpdate = `-- name: GetAccountsByIDsForUpdate :many
 SELECT id, name, currency, balance, version, allow_negative_balance, allow_positive_balance, created_at, updated_at, encumbered_balance FROM accounts WHERE id = ANY($1::text[]) ORDER BY id FOR UPDATE
 `
@@ -187,6 +222,51 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 	return items, nil
 }
 
+const listAccountsCursor = `-- name: ListAccountsCursor :many
+SELECT id, name, currency, balance, version, allow_negative_balance, allow_positive_balance, created_at, updated_at, encumbered_balance FROM accounts
+WHERE ($2::text = '' OR id < $2::text)
+ORDER BY id DESC
+LIMIT $1
+`
+
+type ListAccountsCursorParams struct {
+	Limit  int32  `json:"limit"`
+	Cursor string `json:"cursor"`
+}
+
+// Keyset pagination on ULID id, as ListTransfersByAccountCursor. An empty
+// cursor starts from the most recent account.
+func (q *Queries) ListAccountsCursor(ctx context.Context, arg ListAccountsCursorParams) ([]Account, error) {
+	rows, err := q.db.Query(ctx, listAccountsCursor, arg.Limit, arg.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Currency,
+			&i.Balance,
+			&i.Version,
+			&i.AllowNegativeBalance,
+			&i.AllowPositiveBalance,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.EncumberedBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateAccountBalance = `-- name: UpdateAccountBalance :exec
 UPDATE accounts
 SET balance = $2, version = version + 1, updated_at = $3
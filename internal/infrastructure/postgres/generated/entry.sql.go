@@ -25,7 +25,7 @@ func (q *Queries) CountEntriesByAccount(ctx context.Context, accountID string) (
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at
+RETURNING id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at, tx_id
 `
 
 type CreateEntryParams struct {
@@ -60,6 +60,7 @@ func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry
 		&i.AccountCurrentBalance,
 		&i.AccountVersion,
 		&i.CreatedAt,
+		&i.TxID,
 	)
 	return i, err
 }
@@ -86,7 +87,7 @@ func (q *Queries) GetAccountBalanceAtTime(ctx context.Context, arg GetAccountBal
 }
 
 const getEntriesByAccount = `-- name: GetEntriesByAccount :many
-SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at FROM entries
+SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at, tx_id FROM entries
 WHERE account_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -116,6 +117,99 @@ func (q *Queries) GetEntriesByAccount(ctx context.Context, arg GetEntriesByAccou
 			&i.AccountCurrentBalance,
 			&i.AccountVersion,
 			&i.CreatedAt,
+			&i.TxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEntriesByAccountCursor = `-- name: GetEntriesByAccountCursor :many
+SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at, tx_id FROM entries
+WHERE account_id = $1
+  AND ($3::text = '' OR id < $3::text)
+ORDER BY id DESC
+LIMIT $2
+`
+
+type GetEntriesByAccountCursorParams struct {
+	AccountID string `json:"account_id"`
+	Limit     int32  `json:"limit"`
+	Cursor    string `json:"cursor"`
+}
+
+// Keyset pagination on ULID id, as ListTransfersByAccountCursor. An empty
+// cursor starts from the most recent entry.
+func (q *Queries) GetEntriesByAccountCursor(ctx context.Context, arg GetEntriesByAccountCursorParams) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, getEntriesByAccountCursor, arg.AccountID, arg.Limit, arg.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.TransferID,
+			&i.Amount,
+			&i.AccountPreviousBalance,
+			&i.AccountCurrentBalance,
+			&i.AccountVersion,
+			&i.CreatedAt,
+			&i.TxID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEntriesByAccountInRange = `-- name: GetEntriesByAccountInRange :many
+SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at, tx_id FROM entries
+WHERE account_id = $1
+  AND created_at >= $2
+  AND created_at < $3
+ORDER BY account_version ASC
+`
+
+type GetEntriesByAccountInRangeParams struct {
+	AccountID string             `json:"account_id"`
+	FromTime  pgtype.Timestamptz `json:"from_time"`
+	ToTime    pgtype.Timestamptz `json:"to_time"`
+}
+
+// Entries booked in [from, to) for an account, in chain order, for
+// statements.
+func (q *Queries) GetEntriesByAccountInRange(ctx context.Context, arg GetEntriesByAccountInRangeParams) ([]Entry, error) {
+	rows, err := q.db.Query(ctx, getEntriesByAccountInRange, arg.AccountID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Entry{}
+	for rows.Next() {
+		var i Entry
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.TransferID,
+			&i.Amount,
+			&i.AccountPreviousBalance,
+			&i.AccountCurrentBalance,
+			&i.AccountVersion,
+			&i.CreatedAt,
+			&i.TxID,
 		); err != nil {
 			return nil, err
 		}
@@ -128,7 +222,7 @@ func (q *Queries) GetEntriesByAccount(ctx context.Context, arg GetEntriesByAccou
 }
 
 const getEntriesByAccountOrdered = `-- name: GetEntriesByAccountOrdered :many
-SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at FROM entries
+SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at, tx_id FROM entries
 WHERE account_id = $1
 ORDER BY account_version ASC
 `
@@ -153,6 +247,7 @@ func (q *Queries) GetEntriesByAccountOrdered(ctx context.Context, accountID stri
 			&i.AccountCurrentBalance,
 			&i.AccountVersion,
 			&i.CreatedAt,
+			&i.TxID,
 		); err != nil {
 			return nil, err
 		}
@@ -165,7 +260,7 @@ func (q *Queries) GetEntriesByAccountOrdered(ctx context.Context, accountID stri
 }
 
 const getEntriesByTransfer = `-- name: GetEntriesByTransfer :many
-SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at FROM entries WHERE transfer_id = $1 ORDER BY created_at
+SELECT id, account_id, transfer_id, amount, account_previous_balance, account_current_balance, account_version, created_at, tx_id FROM entries WHERE transfer_id = $1 ORDER BY created_at
 `
 
 func (q *Queries) GetEntriesByTransfer(ctx context.Context, transferID string) ([]Entry, error) {
@@ -186,6 +281,7 @@ func (q *Queries) GetEntriesByTransfer(ctx context.Context, transferID string) (
 			&i.AccountCurrentBalance,
 			&i.AccountVersion,
 			&i.CreatedAt,
+			&i.TxID,
 		); err != nil {
 			return nil, err
 		}
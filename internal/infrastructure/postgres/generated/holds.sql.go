@@ -135,6 +135,66 @@ func (q *Queries) ListHoldsByAccount(ctx context.Context, arg ListHoldsByAccount
 	return items, nil
 }
 
+const listHoldsByAccountCursor = `-- name: ListHoldsByAccountCursor :many
+SELECT id, account_id, amount, status, expires_at, metadata, created_at, updated_at FROM holds
+WHERE account_id = $1
+  AND ($3::text = '' OR id < $3::text)
+  AND ($4::text IS NULL OR status = $4::text)
+  AND ($5::timestamptz IS NULL OR expires_at >= $5::timestamptz)
+  AND ($6::timestamptz IS NULL OR expires_at < $6::timestamptz)
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListHoldsByAccountCursorParams struct {
+	AccountID     string             `json:"account_id"`
+	Limit         int32              `json:"limit"`
+	Cursor        string             `json:"cursor"`
+	Status        *string            `json:"status"`
+	ExpiresAfter  pgtype.Timestamptz `json:"expires_after"`
+	ExpiresBefore pgtype.Timestamptz `json:"expires_before"`
+}
+
+// Keyset pagination on ULID id, like ListTransfersByAccountCursor. An
+// empty cursor starts from the most recent hold; each filter is skipped
+// when NULL. The expiry bounds are [expires_after, expires_before) and
+// exclude holds without an expiry.
+func (q *Queries) ListHoldsByAccountCursor(ctx context.Context, arg ListHoldsByAccountCursorParams) ([]Hold, error) {
+	rows, err := q.db.Query(ctx, listHoldsByAccountCursor,
+		arg.AccountID,
+		arg.Limit,
+		arg.Cursor,
+		arg.Status,
+		arg.ExpiresAfter,
+		arg.ExpiresBefore,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Hold{}
+	for rows.Next() {
+		var i Hold
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountID,
+			&i.Amount,
+			&i.Status,
+			&i.ExpiresAt,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateHoldStatus = `-- name: UpdateHoldStatus :exec
 UPDATE holds
 SET status = $2, updated_at = $3
@@ -21,6 +21,41 @@ type Account struct {
 	EncumberedBalance    pgtype.Numeric     `json:"encumbered_balance"`
 }
 
+type AccountGroupMember struct {
+	GroupName string             `json:"group_name"`
+	AccountID string             `json:"account_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type AccrualRule struct {
+	ID                    string             `json:"id"`
+	Name                  string             `json:"name"`
+	Kind                  string             `json:"kind"`
+	Schedule              string             `json:"schedule"`
+	AccountID             *string            `json:"account_id"`
+	AccountGroup          *string            `json:"account_group"`
+	CounterpartyAccountID string             `json:"counterparty_account_id"`
+	Rate                  pgtype.Numeric     `json:"rate"`
+	Amount                pgtype.Numeric     `json:"amount"`
+	Precision             int32              `json:"precision"`
+	Active                bool               `json:"active"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AccrualRun struct {
+	ID          string             `json:"id"`
+	RuleID      string             `json:"rule_id"`
+	AccountID   string             `json:"account_id"`
+	PeriodStart pgtype.Date        `json:"period_start"`
+	Status      string             `json:"status"`
+	Balance     pgtype.Numeric     `json:"balance"`
+	Amount      pgtype.Numeric     `json:"amount"`
+	TransferID  *string            `json:"transfer_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
 type AuditLog struct {
 	ID           string             `json:"id"`
 	UserID       string             `json:"user_id"`
@@ -71,6 +106,24 @@ type Entry struct {
 	AccountCurrentBalance  pgtype.Numeric     `json:"account_current_balance"`
 	AccountVersion         int64              `json:"account_version"`
 	CreatedAt              pgtype.Timestamptz `json:"created_at"`
+	TxID                   *int64             `json:"tx_id"`
+}
+
+type FeePolicy struct {
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	FromAccountID    *string            `json:"from_account_id"`
+	ToAccountID      *string            `json:"to_account_id"`
+	Currency         *string            `json:"currency"`
+	MetadataMatch    []byte             `json:"metadata_match"`
+	Payer            string             `json:"payer"`
+	RevenueAccountID string             `json:"revenue_account_id"`
+	Percentage       pgtype.Numeric     `json:"percentage"`
+	FixedAmount      pgtype.Numeric     `json:"fixed_amount"`
+	Precision        int32              `json:"precision"`
+	Active           bool               `json:"active"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
 }
 
 type Hold struct {
@@ -84,6 +137,28 @@ type Hold struct {
 	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
 }
 
+type OutboxAggregateSequence struct {
+	AggregateType string `json:"aggregate_type"`
+	AggregateID   string `json:"aggregate_id"`
+	LastSequence  int64  `json:"last_sequence"`
+}
+
+type OutboxDeadLetterArchive struct {
+	ID                string             `json:"id"`
+	AggregateID       string             `json:"aggregate_id"`
+	AggregateType     string             `json:"aggregate_type"`
+	EventType         string             `json:"event_type"`
+	EventVersion      int32              `json:"event_version"`
+	AggregateSequence int64              `json:"aggregate_sequence"`
+	Payload           []byte             `json:"payload"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Attempts          int32              `json:"attempts"`
+	LastError         *string            `json:"last_error"`
+	DeadLetteredAt    pgtype.Timestamptz `json:"dead_lettered_at"`
+	ArchivedAt        pgtype.Timestamptz `json:"archived_at"`
+	ArchiveReason     string             `json:"archive_reason"`
+}
+
 type OutboxEvent struct {
 	ID                string             `json:"id"`
 	AggregateID       string             `json:"aggregate_id"`
@@ -98,6 +173,79 @@ type OutboxEvent struct {
 	Attempts          int32              `json:"attempts"`
 	LastError         *string            `json:"last_error"`
 	DeadLetteredAt    pgtype.Timestamptz `json:"dead_lettered_at"`
+	LockedUntil       pgtype.Timestamptz `json:"locked_until"`
+	LockedBy          *string            `json:"locked_by"`
+}
+
+type OutboxEventsArchive struct {
+	ID                string             `json:"id"`
+	AggregateID       string             `json:"aggregate_id"`
+	AggregateType     string             `json:"aggregate_type"`
+	EventType         string             `json:"event_type"`
+	Payload           []byte             `json:"payload"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	PublishedAt       pgtype.Timestamptz `json:"published_at"`
+	Published         bool               `json:"published"`
+	EventVersion      int32              `json:"event_version"`
+	AggregateSequence int64              `json:"aggregate_sequence"`
+	Attempts          int32              `json:"attempts"`
+	LastError         *string            `json:"last_error"`
+	DeadLetteredAt    pgtype.Timestamptz `json:"dead_lettered_at"`
+	LockedUntil       pgtype.Timestamptz `json:"locked_until"`
+	LockedBy          *string            `json:"locked_by"`
+}
+
+type OutboxEventsDefault struct {
+	ID                string             `json:"id"`
+	AggregateID       string             `json:"aggregate_id"`
+	AggregateType     string             `json:"aggregate_type"`
+	EventType         string             `json:"event_type"`
+	Payload           []byte             `json:"payload"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	PublishedAt       pgtype.Timestamptz `json:"published_at"`
+	Published         bool               `json:"published"`
+	EventVersion      int32              `json:"event_version"`
+	AggregateSequence int64              `json:"aggregate_sequence"`
+	Attempts          int32              `json:"attempts"`
+	LastError         *string            `json:"last_error"`
+	DeadLetteredAt    pgtype.Timestamptz `json:"dead_lettered_at"`
+	LockedUntil       pgtype.Timestamptz `json:"locked_until"`
+	LockedBy          *string            `json:"locked_by"`
+}
+
+type ReportingAccountBalance struct {
+	AccountID      string             `json:"account_id"`
+	Currency       string             `json:"currency"`
+	Balance        pgtype.Numeric     `json:"balance"`
+	AccountVersion int64              `json:"account_version"`
+	LastEntryID    string             `json:"last_entry_id"`
+	LastEntryAt    pgtype.Timestamptz `json:"last_entry_at"`
+}
+
+type ReportingAccountDailyVolume struct {
+	AccountID   string         `json:"account_id"`
+	Day         pgtype.Date    `json:"day"`
+	Currency    string         `json:"currency"`
+	DebitTotal  pgtype.Numeric `json:"debit_total"`
+	CreditTotal pgtype.Numeric `json:"credit_total"`
+	EntryCount  int64          `json:"entry_count"`
+}
+
+type ReportingCounterpartyTotal struct {
+	AccountID             string         `json:"account_id"`
+	CounterpartyAccountID string         `json:"counterparty_account_id"`
+	Currency              string         `json:"currency"`
+	SentTotal             pgtype.Numeric `json:"sent_total"`
+	ReceivedTotal         pgtype.Numeric `json:"received_total"`
+	EntryCount            int64          `json:"entry_count"`
+}
+
+type ReportingProjectionCheckpoint struct {
+	Name             string             `json:"name"`
+	LastTxID         int64              `json:"last_tx_id"`
+	LastEntryID      string             `json:"last_entry_id"`
+	EntriesProjected int64              `json:"entries_projected"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
 }
 
 type Transfer struct {
@@ -109,6 +257,7 @@ type Transfer struct {
 	EventAt            pgtype.Timestamptz `json:"event_at"`
 	Metadata           []byte             `json:"metadata"`
 	ReversedTransferID *string            `json:"reversed_transfer_id"`
+	IdempotencyKey     *string            `json:"idempotency_key"`
 }
 
 type User struct {
@@ -121,3 +270,43 @@ type User struct {
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
 }
+
+type WebhookDelivery struct {
+	ID             string             `json:"id"`
+	SubscriptionID string             `json:"subscription_id"`
+	EventID        string             `json:"event_id"`
+	EventType      string             `json:"event_type"`
+	Body           []byte             `json:"body"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	LastError      *string            `json:"last_error"`
+	LastStatusCode *int32             `json:"last_status_code"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+	DeadLetteredAt pgtype.Timestamptz `json:"dead_lettered_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID          int64              `json:"id"`
+	DeliveryID  string             `json:"delivery_id"`
+	Attempt     int32              `json:"attempt"`
+	StatusCode  *int32             `json:"status_code"`
+	Error       *string            `json:"error"`
+	DurationMs  int64              `json:"duration_ms"`
+	AttemptedAt pgtype.Timestamptz `json:"attempted_at"`
+}
+
+type WebhookSubscription struct {
+	ID            string             `json:"id"`
+	Url           string             `json:"url"`
+	Secret        string             `json:"secret"`
+	EventTypes    []string           `json:"event_types"`
+	AggregateType *string            `json:"aggregate_type"`
+	AggregateID   *string            `json:"aggregate_id"`
+	Description   string             `json:"description"`
+	Active        bool               `json:"active"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
@@ -11,14 +11,181 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const archiveDeadLetteredEvents = `-- name: ArchiveDeadLetteredEvents :many
+WITH archived AS (
+    DELETE FROM outbox_events
+    WHERE dead_lettered_at IS NOT NULL
+      AND (cardinality($3::text[]) = 0 OR id = ANY($3::text[]))
+      AND ($4::text = '' OR event_type = $4::text)
+      AND ($5::timestamptz IS NULL OR created_at >= $5::timestamptz)
+      AND ($6::timestamptz IS NULL OR created_at < $6::timestamptz)
+    RETURNING id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by
+)
+INSERT INTO outbox_dead_letter_archive (
+    id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence,
+    payload, created_at, attempts, last_error, dead_lettered_at, archived_at, archive_reason
+)
+SELECT
+    id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence,
+    payload, created_at, attempts, last_error, dead_lettered_at, $1::timestamptz, $2::text
+FROM archived
+RETURNING id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence,
+    created_at, attempts, last_error, dead_lettered_at
+`
+
+type ArchiveDeadLetteredEventsParams struct {
+	ArchivedAt    pgtype.Timestamptz `json:"archived_at"`
+	ArchiveReason string             `json:"archive_reason"`
+	Ids           []string           `json:"ids"`
+	EventType     string             `json:"event_type"`
+	CreatedFrom   pgtype.Timestamptz `json:"created_from"`
+	CreatedTo     pgtype.Timestamptz `json:"created_to"`
+}
+
+type ArchiveDeadLetteredEventsRow struct {
+	ID                string             `json:"id"`
+	AggregateID       string             `json:"aggregate_id"`
+	AggregateType     string             `json:"aggregate_type"`
+	EventType         string             `json:"event_type"`
+	EventVersion      int32              `json:"event_version"`
+	AggregateSequence int64              `json:"aggregate_sequence"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	Attempts          int32              `json:"attempts"`
+	LastError         *string            `json:"last_error"`
+	DeadLetteredAt    pgtype.Timestamptz `json:"dead_lettered_at"`
+}
+
+// Moves the matching dead-lettered events to outbox_dead_letter_archive,
+// using the same filters as ReplayDeadLetteredEvents.
+func (q *Queries) ArchiveDeadLetteredEvents(ctx context.Context, arg ArchiveDeadLetteredEventsParams) ([]ArchiveDeadLetteredEventsRow, error) {
+	rows, err := q.db.Query(ctx, archiveDeadLetteredEvents,
+		arg.ArchivedAt,
+		arg.ArchiveReason,
+		arg.Ids,
+		arg.EventType,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ArchiveDeadLetteredEventsRow{}
+	for rows.Next() {
+		var i ArchiveDeadLetteredEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.AggregateType,
+			&i.EventType,
+			&i.EventVersion,
+			&i.AggregateSequence,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.LastError,
+			&i.DeadLetteredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimOutboxEvents = `-- name: ClaimOutboxEvents :many
+WITH pending AS (
+    SELECT
+        id,
+        created_at,
+        row_number() OVER (PARTITION BY aggregate_type, aggregate_id ORDER BY aggregate_sequence) AS position,
+        bool_or(locked_until IS NOT NULL AND locked_until > $3::timestamptz) OVER (PARTITION BY aggregate_type, aggregate_id) AS aggregate_leased
+    FROM outbox_events
+    WHERE published = FALSE AND dead_lettered_at IS NULL
+),
+claimable AS (
+    SELECT id FROM pending
+    WHERE NOT aggregate_leased
+    ORDER BY position, created_at
+    LIMIT $4
+)
+UPDATE outbox_events
+SET locked_until = $1::timestamptz, locked_by = $2::text
+WHERE outbox_events.id IN (
+    SELECT e.id FROM outbox_events e
+    WHERE e.id IN (SELECT id FROM claimable)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by
+`
+
+type ClaimOutboxEventsParams struct {
+	LeaseUntil pgtype.Timestamptz `json:"lease_until"`
+	LockedBy   string             `json:"locked_by"`
+	Now        pgtype.Timestamptz `json:"now"`
+	BatchSize  int32              `json:"batch_size"`
+}
+
+// Leases up to batch_size pending events. Aggregates with any pending
+// event still leased are skipped, and candidates are ordered by their
+// position within the aggregate first, so every aggregate's claimed events
+// are a prefix of its pending events in aggregate_sequence order. Must run
+// after LockOutboxClaims in the same transaction.
+func (q *Queries) ClaimOutboxEvents(ctx context.Context, arg ClaimOutboxEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, claimOutboxEvents,
+		arg.LeaseUntil,
+		arg.LockedBy,
+		arg.Now,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.AggregateType,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.Published,
+			&i.EventVersion,
+			&i.AggregateSequence,
+			&i.Attempts,
+			&i.LastError,
+			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createOutboxEvent = `-- name: CreateOutboxEvent :one
-INSERT INTO outbox_events (id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence, payload, created_at, published)
-VALUES (
-    $1, $2, $3, $4, $5,
-    COALESCE((SELECT MAX(aggregate_sequence) FROM outbox_events WHERE aggregate_type = $3 AND aggregate_id = $2), 0) + 1,
-    $6, $7, $8
+WITH allocated AS (
+    INSERT INTO outbox_aggregate_sequences (aggregate_type, aggregate_id, last_sequence)
+    VALUES ($3, $2, 1)
+    ON CONFLICT (aggregate_type, aggregate_id)
+    DO UPDATE SET last_sequence = outbox_aggregate_sequences.last_sequence + 1
+    RETURNING last_sequence
 )
-RETURNING id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at
+INSERT INTO outbox_events (id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence, payload, created_at, published)
+SELECT $1, $2, $3, $4, $5, allocated.last_sequence, $6, $7, $8
+FROM allocated
+RETURNING id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by
 `
 
 type CreateOutboxEventParams struct {
@@ -32,11 +199,9 @@ type CreateOutboxEventParams struct {
 	Published     bool               `json:"published"`
 }
 
-// aggregate_sequence is computed as the next number for this aggregate,
-// relying on the caller already holding a row lock on the aggregate (the
-// account/hold FOR UPDATE taken earlier in the same transaction) to
-// serialize concurrent writers - see idx_outbox_events_aggregate_sequence
-// for the invariant this must uphold.
+// aggregate_sequence is allocated from outbox_aggregate_sequences, so
+// numbering keeps counting up after the aggregate's old events are pruned.
+// The upsert's row lock serializes concurrent writers of one aggregate.
 func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) (OutboxEvent, error) {
 	row := q.db.QueryRow(ctx, createOutboxEvent,
 		arg.ID,
@@ -63,22 +228,14 @@ func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventPa
 		&i.Attempts,
 		&i.LastError,
 		&i.DeadLetteredAt,
+		&i.LockedUntil,
+		&i.LockedBy,
 	)
 	return i, err
 }
 
-const deletePublishedEvents = `-- name: DeletePublishedEvents :exec
-DELETE FROM outbox_events
-WHERE published = TRUE AND published_at < $1
-`
-
-func (q *Queries) DeletePublishedEvents(ctx context.Context, publishedAt pgtype.Timestamptz) error {
-	_, err := q.db.Exec(ctx, deletePublishedEvents, publishedAt)
-	return err
-}
-
 const getDeadLetteredEvents = `-- name: GetDeadLetteredEvents :many
-SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at FROM outbox_events
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events
 WHERE dead_lettered_at IS NOT NULL
 ORDER BY dead_lettered_at DESC
 LIMIT $1 OFFSET $2
@@ -112,6 +269,61 @@ func (q *Queries) GetDeadLetteredEvents(ctx context.Context, arg GetDeadLettered
 			&i.Attempts,
 			&i.LastError,
 			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDeadLetteredEventsCursor = `-- name: GetDeadLetteredEventsCursor :many
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events
+WHERE dead_lettered_at IS NOT NULL
+  AND ($2::timestamptz IS NULL
+       OR (dead_lettered_at, id) < ($2::timestamptz, $3::text))
+ORDER BY dead_lettered_at DESC, id DESC
+LIMIT $1
+`
+
+type GetDeadLetteredEventsCursorParams struct {
+	Limit   int32              `json:"limit"`
+	AfterAt pgtype.Timestamptz `json:"after_at"`
+	AfterID string             `json:"after_id"`
+}
+
+// Keyset pagination on (dead_lettered_at, id), most recently dead-lettered
+// first. A NULL after_at starts from the first page.
+func (q *Queries) GetDeadLetteredEventsCursor(ctx context.Context, arg GetDeadLetteredEventsCursorParams) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, getDeadLetteredEventsCursor, arg.Limit, arg.AfterAt, arg.AfterID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.AggregateType,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.Published,
+			&i.EventVersion,
+			&i.AggregateSequence,
+			&i.Attempts,
+			&i.LastError,
+			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -124,7 +336,7 @@ func (q *Queries) GetDeadLetteredEvents(ctx context.Context, arg GetDeadLettered
 }
 
 const getEventsByAggregate = `-- name: GetEventsByAggregate :many
-SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at FROM outbox_events
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events
 WHERE aggregate_type = $1 AND aggregate_id = $2
 ORDER BY created_at DESC
 LIMIT $3 OFFSET $4
@@ -165,6 +377,8 @@ func (q *Queries) GetEventsByAggregate(ctx context.Context, arg GetEventsByAggre
 			&i.Attempts,
 			&i.LastError,
 			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -176,8 +390,81 @@ func (q *Queries) GetEventsByAggregate(ctx context.Context, arg GetEventsByAggre
 	return items, nil
 }
 
+const getOldestPendingOutboxEventCreatedAt = `-- name: GetOldestPendingOutboxEventCreatedAt :one
+SELECT MIN(created_at)::timestamptz AS created_at FROM outbox_events
+WHERE published = FALSE AND dead_lettered_at IS NULL
+`
+
+func (q *Queries) GetOldestPendingOutboxEventCreatedAt(ctx context.Context) (pgtype.Timestamptz, error) {
+	row := q.db.QueryRow(ctx, getOldestPendingOutboxEventCreatedAt)
+	var created_at pgtype.Timestamptz
+	err := row.Scan(&created_at)
+	return created_at, err
+}
+
+const getOutboxEvent = `-- name: GetOutboxEvent :one
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events WHERE id = $1
+`
+
+func (q *Queries) GetOutboxEvent(ctx context.Context, id string) (OutboxEvent, error) {
+	row := q.db.QueryRow(ctx, getOutboxEvent, id)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateID,
+		&i.AggregateType,
+		&i.EventType,
+		&i.Payload,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.Published,
+		&i.EventVersion,
+		&i.AggregateSequence,
+		&i.Attempts,
+		&i.LastError,
+		&i.DeadLetteredAt,
+		&i.LockedUntil,
+		&i.LockedBy,
+	)
+	return i, err
+}
+
+const getOutboxEventByAggregateSequence = `-- name: GetOutboxEventByAggregateSequence :one
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events
+WHERE aggregate_type = $1 AND aggregate_id = $2 AND aggregate_sequence = $3
+`
+
+type GetOutboxEventByAggregateSequenceParams struct {
+	AggregateType     string `json:"aggregate_type"`
+	AggregateID       string `json:"aggregate_id"`
+	AggregateSequence int64  `json:"aggregate_sequence"`
+}
+
+func (q *Queries) GetOutboxEventByAggregateSequence(ctx context.Context, arg GetOutboxEventByAggregateSequenceParams) (OutboxEvent, error) {
+	row := q.db.QueryRow(ctx, getOutboxEventByAggregateSequence, arg.AggregateType, arg.AggregateID, arg.AggregateSequence)
+	var i OutboxEvent
+	err := row.Scan(
+		&i.ID,
+		&i.AggregateID,
+		&i.AggregateType,
+		&i.EventType,
+		&i.Payload,
+		&i.CreatedAt,
+		&i.PublishedAt,
+		&i.Published,
+		&i.EventVersion,
+		&i.AggregateSequence,
+		&i.Attempts,
+		&i.LastError,
+		&i.DeadLetteredAt,
+		&i.LockedUntil,
+		&i.LockedBy,
+	)
+	return i, err
+}
+
 const getUnpublishedEvents = `-- name: GetUnpublishedEvents :many
-SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at FROM outbox_events
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events
 WHERE published = FALSE AND dead_lettered_at IS NULL
 ORDER BY created_at ASC
 LIMIT $1
@@ -208,6 +495,77 @@ func (q *Queries) GetUnpublishedEvents(ctx context.Context, limit int32) ([]Outb
 			&i.Attempts,
 			&i.LastError,
 			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPublishedOutboxEvents = `-- name: ListPublishedOutboxEvents :many
+SELECT id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by FROM outbox_events
+WHERE published = TRUE
+  AND (published_at, id) > ($1::timestamptz, $2::text)
+  AND ($3::text = '' OR aggregate_type = $3::text)
+  AND (
+    $4::text = ''
+    OR (aggregate_type = 'account' AND aggregate_id = $4::text)
+    OR payload->>'account_id' = $4::text
+    OR payload->>'from_account_id' = $4::text
+    OR payload->>'to_account_id' = $4::text
+  )
+ORDER BY published_at, id
+LIMIT $5
+`
+
+type ListPublishedOutboxEventsParams struct {
+	AfterPublishedAt pgtype.Timestamptz `json:"after_published_at"`
+	AfterID          string             `json:"after_id"`
+	AggregateType    string             `json:"aggregate_type"`
+	AccountID        string             `json:"account_id"`
+	BatchSize        int32              `json:"batch_size"`
+}
+
+// Published events after a (published_at, id) position, in publication
+// order. account_id and aggregate_type narrow the scan (” = any); the
+// event stream applies its event type patterns itself.
+func (q *Queries) ListPublishedOutboxEvents(ctx context.Context, arg ListPublishedOutboxEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, listPublishedOutboxEvents,
+		arg.AfterPublishedAt,
+		arg.AfterID,
+		arg.AggregateType,
+		arg.AccountID,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.AggregateType,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.Published,
+			&i.EventVersion,
+			&i.AggregateSequence,
+			&i.Attempts,
+			&i.LastError,
+			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -219,20 +577,37 @@ func (q *Queries) GetUnpublishedEvents(ctx context.Context, limit int32) ([]Outb
 	return items, nil
 }
 
-const markEventPublished = `-- name: MarkEventPublished :exec
+const lockOutboxClaims = `-- name: LockOutboxClaims :exec
+SELECT pg_advisory_xact_lock(hashtext('goledger.outbox_claim'))
+`
+
+// Serializes claimers for the rest of the transaction, so two replicas
+// never compute overlapping per-aggregate prefixes.
+func (q *Queries) LockOutboxClaims(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockOutboxClaims)
+	return err
+}
+
+const markEventPublished = `-- name: MarkEventPublished :execrows
 UPDATE outbox_events
-SET published = TRUE, published_at = $2
-WHERE id = $1
+SET published = TRUE, published_at = $1, locked_until = NULL, locked_by = NULL
+WHERE id = $2 AND locked_by = $3::text
 `
 
 type MarkEventPublishedParams struct {
-	ID          string             `json:"id"`
 	PublishedAt pgtype.Timestamptz `json:"published_at"`
+	ID          string             `json:"id"`
+	LockedBy    string             `json:"locked_by"`
 }
 
-func (q *Queries) MarkEventPublished(ctx context.Context, arg MarkEventPublishedParams) error {
-	_, err := q.db.Exec(ctx, markEventPublished, arg.ID, arg.PublishedAt)
-	return err
+// Only the lease holder may mark the event: a publisher whose lease expired
+// and was re-claimed by another updates no rows.
+func (q *Queries) MarkEventPublished(ctx context.Context, arg MarkEventPublishedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markEventPublished, arg.PublishedAt, arg.ID, arg.LockedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
 const markOutboxDeadLettered = `-- name: MarkOutboxDeadLettered :exec
@@ -253,19 +628,99 @@ func (q *Queries) MarkOutboxDeadLettered(ctx context.Context, arg MarkOutboxDead
 
 const recordOutboxFailure = `-- name: RecordOutboxFailure :one
 UPDATE outbox_events
-SET attempts = attempts + 1, last_error = $2
-WHERE id = $1
+SET attempts = attempts + 1, last_error = $1, locked_until = NULL, locked_by = NULL
+WHERE id = $2 AND locked_by = $3::text
 RETURNING attempts
 `
 
 type RecordOutboxFailureParams struct {
-	ID        string  `json:"id"`
 	LastError *string `json:"last_error"`
+	ID        string  `json:"id"`
+	LockedBy  string  `json:"locked_by"`
 }
 
+// Also releases the event's lease so the next claim retries it. Like
+// MarkEventPublished, returns no rows unless locked_by still holds it.
 func (q *Queries) RecordOutboxFailure(ctx context.Context, arg RecordOutboxFailureParams) (int32, error) {
-	row := q.db.QueryRow(ctx, recordOutboxFailure, arg.ID, arg.LastError)
+	row := q.db.QueryRow(ctx, recordOutboxFailure, arg.LastError, arg.ID, arg.LockedBy)
 	var attempts int32
 	err := row.Scan(&attempts)
 	return attempts, err
 }
+
+const releaseOutboxClaims = `-- name: ReleaseOutboxClaims :exec
+UPDATE outbox_events
+SET locked_until = NULL, locked_by = NULL
+WHERE id = ANY($1::text[]) AND locked_by = $2::text
+`
+
+type ReleaseOutboxClaimsParams struct {
+	Ids      []string `json:"ids"`
+	LockedBy string   `json:"locked_by"`
+}
+
+func (q *Queries) ReleaseOutboxClaims(ctx context.Context, arg ReleaseOutboxClaimsParams) error {
+	_, err := q.db.Exec(ctx, releaseOutboxClaims, arg.Ids, arg.LockedBy)
+	return err
+}
+
+const replayDeadLetteredEvents = `-- name: ReplayDeadLetteredEvents :many
+UPDATE outbox_events
+SET attempts = 0, dead_lettered_at = NULL, locked_until = NULL, locked_by = NULL
+WHERE dead_lettered_at IS NOT NULL
+  AND (cardinality($1::text[]) = 0 OR id = ANY($1::text[]))
+  AND ($2::text = '' OR event_type = $2::text)
+  AND ($3::timestamptz IS NULL OR created_at >= $3::timestamptz)
+  AND ($4::timestamptz IS NULL OR created_at < $4::timestamptz)
+RETURNING id, aggregate_id, aggregate_type, event_type, payload, created_at, published_at, published, event_version, aggregate_sequence, attempts, last_error, dead_lettered_at, locked_until, locked_by
+`
+
+type ReplayDeadLetteredEventsParams struct {
+	Ids         []string           `json:"ids"`
+	EventType   string             `json:"event_type"`
+	CreatedFrom pgtype.Timestamptz `json:"created_from"`
+	CreatedTo   pgtype.Timestamptz `json:"created_to"`
+}
+
+// Requeues the matching dead-lettered events. ids, event_type and the
+// created_at range each match anything when empty/NULL.
+func (q *Queries) ReplayDeadLetteredEvents(ctx context.Context, arg ReplayDeadLetteredEventsParams) ([]OutboxEvent, error) {
+	rows, err := q.db.Query(ctx, replayDeadLetteredEvents,
+		arg.Ids,
+		arg.EventType,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []OutboxEvent{}
+	for rows.Next() {
+		var i OutboxEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AggregateID,
+			&i.AggregateType,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.PublishedAt,
+			&i.Published,
+			&i.EventVersion,
+			&i.AggregateSequence,
+			&i.Attempts,
+			&i.LastError,
+			&i.DeadLetteredAt,
+			&i.LockedUntil,
+			&i.LockedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
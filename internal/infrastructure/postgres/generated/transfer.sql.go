@@ -24,9 +24,9 @@ func (q *Queries) CountTransfersByAccount(ctx context.Context, fromAccountID str
 }
 
 const createTransfer = `-- name: CreateTransfer :one
-INSERT INTO transfers (id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-RETURNING id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id
+INSERT INTO transfers (id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key
 `
 
 type CreateTransferParams struct {
@@ -38,6 +38,7 @@ type CreateTransferParams struct {
 	EventAt            pgtype.Timestamptz `json:"event_at"`
 	Metadata           []byte             `json:"metadata"`
 	ReversedTransferID *string            `json:"reversed_transfer_id"`
+	IdempotencyKey     *string            `json:"idempotency_key"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
@@ -50,6 +51,7 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		arg.EventAt,
 		arg.Metadata,
 		arg.ReversedTransferID,
+		arg.IdempotencyKey,
 	)
 	var i Transfer
 	err := row.Scan(
@@ -61,12 +63,39 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.EventAt,
 		&i.Metadata,
 		&i.ReversedTransferID,
+		&i.IdempotencyKey,
 	)
 	return i, err
 }
 
+const getExistingIdempotencyKeys = `-- name: GetExistingIdempotencyKeys :many
+SELECT idempotency_key::text FROM transfers
+WHERE idempotency_key = ANY($1::text[])
+`
+
+// Which of the given idempotency keys already belong to a transfer.
+func (q *Queries) GetExistingIdempotencyKeys(ctx context.Context, keys []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getExistingIdempotencyKeys, keys)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var idempotency_key string
+		if err := rows.Scan(&idempotency_key); err != nil {
+			return nil, err
+		}
+		items = append(items, idempotency_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTransferByID = `-- name: GetTransferByID :one
-SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id FROM transfers WHERE id = $1
+SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key FROM transfers WHERE id = $1
 `
 
 func (q *Queries) GetTransferByID(ctx context.Context, id string) (Transfer, error) {
@@ -81,12 +110,68 @@ func (q *Queries) GetTransferByID(ctx context.Context, id string) (Transfer, err
 		&i.EventAt,
 		&i.Metadata,
 		&i.ReversedTransferID,
+		&i.IdempotencyKey,
 	)
 	return i, err
 }
 
+const getTransferByIdempotencyKey = `-- name: GetTransferByIdempotencyKey :one
+SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key FROM transfers WHERE idempotency_key = $1
+`
+
+func (q *Queries) GetTransferByIdempotencyKey(ctx context.Context, idempotencyKey *string) (Transfer, error) {
+	row := q.db.QueryRow(ctx, getTransferByIdempotencyKey, idempotencyKey)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.EventAt,
+		&i.Metadata,
+		&i.ReversedTransferID,
+		&i.IdempotencyKey,
+	)
+	return i, err
+}
+
+const getTransfersByIDs = `-- name: GetTransfersByIDs :many
+SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key FROM transfers WHERE id = ANY($1::text[])
+`
+
+func (q *Queries) GetTransfersByIDs(ctx context.Context, ids []string) ([]Transfer, error) {
+	rows, err := q.db.Query(ctx, getTransfersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Transfer{}
+	for rows.Next() {
+		var i Transfer
+		if err := rows.Scan(
+			&i.ID,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.CreatedAt,
+			&i.EventAt,
+			&i.Metadata,
+			&i.ReversedTransferID,
+			&i.IdempotencyKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listTransfersByAccount = `-- name: ListTransfersByAccount :many
-SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key FROM transfers
 WHERE from_account_id = $1 OR to_account_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3
@@ -116,6 +201,7 @@ func (q *Queries) ListTransfersByAccount(ctx context.Context, arg ListTransfersB
 			&i.EventAt,
 			&i.Metadata,
 			&i.ReversedTransferID,
+			&i.IdempotencyKey,
 		); err != nil {
 			return nil, err
 		}
@@ -128,7 +214,7 @@ func (q *Queries) ListTransfersByAccount(ctx context.Context, arg ListTransfersB
 }
 
 const listTransfersByAccountCursor = `-- name: ListTransfersByAccountCursor :many
-SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key FROM transfers
 WHERE (from_account_id = $1 OR to_account_id = $1)
   AND ($3::text = '' OR id < $3::text)
 ORDER BY id DESC
@@ -163,6 +249,7 @@ func (q *Queries) ListTransfersByAccountCursor(ctx context.Context, arg ListTran
 			&i.EventAt,
 			&i.Metadata,
 			&i.ReversedTransferID,
+			&i.IdempotencyKey,
 		); err != nil {
 			return nil, err
 		}
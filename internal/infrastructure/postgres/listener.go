@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OutboxChannel is the channel the outbox_events insert trigger notifies.
+const OutboxChannel = "goledger_outbox"
+
+const (
+	listenerMinBackoff = time.Second
+	listenerMaxBackoff = 30 * time.Second
+)
+
+// Listener holds a dedicated connection LISTENing on a channel and turns
+// its notifications into wakeups on C. Wakeups are coalesced: C is buffered
+// by one, so a burst of notifications while the consumer is busy results in
+// a single pending wakeup.
+type Listener struct {
+	pool    *pgxpool.Pool
+	channel string
+	logger  *slog.Logger
+	wakeups chan struct{}
+}
+
+// NewListener creates a Listener for channel. Call Run to start listening.
+func NewListener(pool *pgxpool.Pool, channel string, logger *slog.Logger) *Listener {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return &Listener{
+		pool:    pool,
+		channel: channel,
+		logger:  logger,
+		wakeups: make(chan struct{}, 1),
+	}
+}
+
+// C returns the wakeup channel.
+func (l *Listener) C() <-chan struct{} {
+	return l.wakeups
+}
+
+// Run listens until ctx is cancelled, reconnecting with exponential backoff
+// when the connection drops. Every (re)connect also sends a wakeup, since
+// notifications sent while disconnected are lost.
+func (l *Listener) Run(ctx context.Context) error {
+	backoff := listenerMinBackoff
+
+	for {
+		connected, err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			backoff = listenerMinBackoff
+		}
+
+		l.logger.Warn("database listener disconnected, reconnecting",
+			slog.String("channel", l.channel),
+			slog.Duration("backoff", backoff),
+			slog.String("error", err.Error()))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, listenerMaxBackoff)
+	}
+}
+
+// listen runs one connection's lifetime. connected reports whether LISTEN
+// succeeded, so Run can reset its backoff.
+func (l *Listener) listen(ctx context.Context) (connected bool, err error) {
+	poolConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+
+	// The connection stays subscribed for as long as it lives, so take it
+	// out of the pool rather than handing it back to other queries.
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return false, fmt.Errorf("failed to listen on %s: %w", l.channel, err)
+	}
+
+	l.logger.Info("database listener connected", slog.String("channel", l.channel))
+	l.wake()
+
+	for {
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return true, err
+		}
+		l.wake()
+	}
+}
+
+func (l *Listener) wake() {
+	select {
+	case l.wakeups <- struct{}{}:
+	default:
+	}
+}
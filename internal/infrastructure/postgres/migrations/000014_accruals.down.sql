@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS accrual_runs;
+DROP TABLE IF EXISTS accrual_rules;
+DROP TABLE IF EXISTS account_group_members;
@@ -0,0 +1,52 @@
+-- Interest and fee accrual rules. A rule targets either a single account or
+-- every member of an account group, and posts against a counterparty
+-- (interest expense / fee income) account through ordinary transfers.
+CREATE TABLE account_group_members (
+    group_name TEXT NOT NULL,
+    account_id TEXT NOT NULL REFERENCES accounts(id),
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (group_name, account_id)
+);
+
+CREATE INDEX idx_account_group_members_account ON account_group_members(account_id);
+
+CREATE TABLE accrual_rules (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL, -- 'interest', 'fee'
+    schedule TEXT NOT NULL, -- 'daily', 'monthly'
+    account_id TEXT REFERENCES accounts(id),
+    account_group TEXT,
+    counterparty_account_id TEXT NOT NULL REFERENCES accounts(id),
+    rate NUMERIC NOT NULL DEFAULT 0,
+    amount NUMERIC NOT NULL DEFAULT 0,
+    precision INT NOT NULL DEFAULT 2,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CHECK ((account_id IS NULL) <> (account_group IS NULL)),
+    CHECK (kind IN ('interest', 'fee')),
+    CHECK (schedule IN ('daily', 'monthly')),
+    CHECK (rate >= 0 AND amount >= 0 AND precision >= 0)
+);
+
+CREATE INDEX idx_accrual_rules_active ON accrual_rules(active) WHERE active;
+
+-- One row per (rule, account, period). The unique key is what makes a
+-- re-run for an already-processed period a no-op: the runner claims the
+-- period by inserting a 'pending' row before posting the transfer.
+CREATE TABLE accrual_runs (
+    id TEXT PRIMARY KEY,
+    rule_id TEXT NOT NULL REFERENCES accrual_rules(id),
+    account_id TEXT NOT NULL REFERENCES accounts(id),
+    period_start DATE NOT NULL,
+    status TEXT NOT NULL, -- 'pending', 'posted', 'skipped'
+    balance NUMERIC NOT NULL DEFAULT 0,
+    amount NUMERIC NOT NULL DEFAULT 0,
+    transfer_id TEXT REFERENCES transfers(id),
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (rule_id, account_id, period_start)
+);
+
+CREATE INDEX idx_accrual_runs_period ON accrual_runs(period_start);
@@ -0,0 +1,24 @@
+-- Declarative fee policies evaluated on transfer creation. Each matching
+-- policy posts a fee transfer from the payer to revenue_account_id in the
+-- same database transaction as the transfer that triggered it.
+CREATE TABLE fee_policies (
+    id TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    from_account_id TEXT REFERENCES accounts(id),
+    to_account_id TEXT REFERENCES accounts(id),
+    currency TEXT,
+    metadata_match JSONB,
+    payer TEXT NOT NULL, -- 'source', 'destination'
+    revenue_account_id TEXT NOT NULL REFERENCES accounts(id),
+    percentage NUMERIC NOT NULL DEFAULT 0,
+    fixed_amount NUMERIC NOT NULL DEFAULT 0,
+    precision INT NOT NULL DEFAULT 2,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CHECK (payer IN ('source', 'destination')),
+    CHECK (percentage >= 0 AND percentage < 1 AND fixed_amount >= 0 AND precision >= 0),
+    CHECK (percentage > 0 OR fixed_amount > 0)
+);
+
+CREATE INDEX idx_fee_policies_active ON fee_policies(active) WHERE active;
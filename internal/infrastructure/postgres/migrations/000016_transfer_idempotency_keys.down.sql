@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_transfers_idempotency_key;
+ALTER TABLE transfers DROP COLUMN IF EXISTS idempotency_key;
@@ -0,0 +1,5 @@
+-- Optional client-supplied idempotency key per transfer, enforced by the
+-- database so bulk imports can be re-run (or resumed after a crash) without
+-- posting any row twice.
+ALTER TABLE transfers ADD COLUMN idempotency_key TEXT;
+CREATE UNIQUE INDEX idx_transfers_idempotency_key ON transfers(idempotency_key) WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,58 @@
+-- Webhook subscriptions receive outbox events as signed HTTP callbacks.
+-- Every matching event becomes one webhook_deliveries row per subscription,
+-- retried with exponential backoff and dead-lettered after a configurable
+-- number of attempts, mirroring the outbox_events model from 000013.
+CREATE TABLE webhook_subscriptions (
+    id TEXT PRIMARY KEY,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    -- Event types to deliver; empty means every event type. An entry
+    -- ending in ".*" matches a whole family, e.g. "transfer.*".
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    aggregate_type TEXT,
+    aggregate_id TEXT,
+    description TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    CHECK (aggregate_id IS NULL OR aggregate_type IS NOT NULL)
+);
+
+CREATE INDEX idx_webhook_subscriptions_active ON webhook_subscriptions(active) WHERE active;
+
+-- body is the exact signed request body, captured at fan-out so a delivery
+-- stays replayable after its outbox event has been pruned.
+CREATE TABLE webhook_deliveries (
+    id TEXT PRIMARY KEY,
+    subscription_id TEXT NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    body JSONB NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending', -- 'pending', 'delivered', 'dead_lettered'
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    last_status_code INT,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    delivered_at TIMESTAMPTZ,
+    dead_lettered_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    UNIQUE (subscription_id, event_id),
+    CHECK (status IN ('pending', 'delivered', 'dead_lettered'))
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at DESC);
+
+-- One row per HTTP attempt, the per-subscription delivery log.
+CREATE TABLE webhook_delivery_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    delivery_id TEXT NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    attempt INT NOT NULL,
+    status_code INT,
+    error TEXT,
+    duration_ms BIGINT NOT NULL,
+    attempted_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_webhook_delivery_attempts_delivery ON webhook_delivery_attempts(delivery_id, attempt);
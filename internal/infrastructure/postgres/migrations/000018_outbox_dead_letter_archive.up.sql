@@ -0,0 +1,20 @@
+-- Dead-lettered outbox events an operator gives up on are moved here with
+-- a reason instead of being requeued, keeping outbox_events to events that
+-- may still be published.
+CREATE TABLE outbox_dead_letter_archive (
+    id TEXT PRIMARY KEY,
+    aggregate_id TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    event_version INT NOT NULL,
+    aggregate_sequence BIGINT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    attempts INT NOT NULL,
+    last_error TEXT,
+    dead_lettered_at TIMESTAMPTZ NOT NULL,
+    archived_at TIMESTAMPTZ NOT NULL,
+    archive_reason TEXT NOT NULL
+);
+
+CREATE INDEX idx_outbox_dead_letter_archive_archived_at ON outbox_dead_letter_archive(archived_at DESC);
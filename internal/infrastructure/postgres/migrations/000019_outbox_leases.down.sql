@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_outbox_events_pending;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS locked_by;
+ALTER TABLE outbox_events DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1,8 @@
+-- Lease columns let several publisher replicas share the outbox: a claim
+-- sets locked_until/locked_by, and an aggregate with any leased pending
+-- event is skipped by other claimers so per-aggregate order is kept.
+ALTER TABLE outbox_events ADD COLUMN locked_until TIMESTAMPTZ;
+ALTER TABLE outbox_events ADD COLUMN locked_by TEXT;
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events(aggregate_type, aggregate_id, aggregate_sequence)
+    WHERE published = FALSE AND dead_lettered_at IS NULL;
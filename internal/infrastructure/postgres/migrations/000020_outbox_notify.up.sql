@@ -0,0 +1,14 @@
+-- Wake outbox publishers as soon as events are committed instead of waiting
+-- for their next poll. NOTIFY is delivered on commit and identical payloads
+-- within one transaction are folded into one, so a statement-level trigger
+-- sends a single wakeup per transaction however many events it inserts.
+CREATE OR REPLACE FUNCTION notify_outbox_event() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('goledger_outbox', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER outbox_events_notify
+    AFTER INSERT ON outbox_events
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_outbox_event();
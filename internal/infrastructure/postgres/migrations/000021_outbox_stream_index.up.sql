@@ -0,0 +1,3 @@
+-- The event stream reads published events in publication order and resumes
+-- from a (published_at, id) position.
+CREATE INDEX idx_outbox_events_published_at ON outbox_events(published_at, id) WHERE published = TRUE;
@@ -0,0 +1,4 @@
+DROP SCHEMA IF EXISTS reporting CASCADE;
+
+DROP INDEX IF EXISTS idx_entries_projection;
+ALTER TABLE entries DROP COLUMN IF EXISTS tx_id;
@@ -0,0 +1,60 @@
+-- Reporting read models, maintained by the projector from the entries table
+-- so reporting queries don't scan the OLTP tables. They live in their own
+-- schema, with no foreign keys into public, so they can be moved to a
+-- separate database.
+
+-- tx_id records the inserting transaction, letting the projector tail
+-- entries safely: every entry with tx_id below the oldest running
+-- transaction's ID is committed (or will never be), so nothing can appear
+-- behind the projector's checkpoint. Rows written before this migration
+-- keep a NULL tx_id and are projected first, in ID order. The default is
+-- set separately so existing rows aren't rewritten.
+ALTER TABLE entries ADD COLUMN tx_id BIGINT;
+ALTER TABLE entries ALTER COLUMN tx_id SET DEFAULT (pg_current_xact_id()::text::bigint);
+
+CREATE INDEX idx_entries_projection ON entries ((COALESCE(tx_id, 0)), id);
+
+CREATE SCHEMA IF NOT EXISTS reporting;
+
+CREATE TABLE reporting.projection_checkpoints (
+    name TEXT PRIMARY KEY,
+    last_tx_id BIGINT NOT NULL DEFAULT 0,
+    last_entry_id TEXT NOT NULL DEFAULT '',
+    entries_projected BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+INSERT INTO reporting.projection_checkpoints (name) VALUES ('reporting');
+
+-- Debits and credits per account per day, by the transfer's event_at date
+-- (UTC).
+CREATE TABLE reporting.account_daily_volumes (
+    account_id TEXT NOT NULL,
+    day DATE NOT NULL,
+    currency TEXT NOT NULL,
+    debit_total NUMERIC NOT NULL,
+    credit_total NUMERIC NOT NULL,
+    entry_count BIGINT NOT NULL,
+    PRIMARY KEY (account_id, day)
+);
+
+-- Money sent to and received from each counterparty account.
+CREATE TABLE reporting.counterparty_totals (
+    account_id TEXT NOT NULL,
+    counterparty_account_id TEXT NOT NULL,
+    currency TEXT NOT NULL,
+    sent_total NUMERIC NOT NULL,
+    received_total NUMERIC NOT NULL,
+    entry_count BIGINT NOT NULL,
+    PRIMARY KEY (account_id, counterparty_account_id)
+);
+
+-- Each account's balance as of its latest projected entry.
+CREATE TABLE reporting.account_balances (
+    account_id TEXT PRIMARY KEY,
+    currency TEXT NOT NULL,
+    balance NUMERIC NOT NULL,
+    account_version BIGINT NOT NULL,
+    last_entry_id TEXT NOT NULL,
+    last_entry_at TIMESTAMPTZ NOT NULL
+);
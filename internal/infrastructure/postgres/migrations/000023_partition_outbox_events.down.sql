@@ -0,0 +1,43 @@
+DROP FUNCTION IF EXISTS ensure_outbox_partition(date);
+
+ALTER TABLE outbox_events RENAME TO outbox_events_partitioned;
+
+CREATE TABLE outbox_events (
+    id TEXT PRIMARY KEY,
+    aggregate_id TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+    event_version INT NOT NULL DEFAULT 1,
+    aggregate_sequence BIGINT NOT NULL DEFAULT 1,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    dead_lettered_at TIMESTAMPTZ,
+    locked_until TIMESTAMPTZ,
+    locked_by TEXT
+);
+
+-- Archived months move back too; files written by the file archive mode
+-- are not restored.
+INSERT INTO outbox_events SELECT * FROM outbox_events_partitioned;
+INSERT INTO outbox_events SELECT * FROM outbox_events_archive;
+
+DROP TABLE outbox_events_partitioned CASCADE;
+DROP TABLE outbox_events_archive CASCADE;
+DROP TABLE outbox_aggregate_sequences;
+
+CREATE INDEX idx_outbox_events_unpublished ON outbox_events(published, created_at) WHERE NOT published;
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events(aggregate_type, aggregate_id);
+CREATE UNIQUE INDEX idx_outbox_events_aggregate_sequence
+    ON outbox_events(aggregate_type, aggregate_id, aggregate_sequence);
+CREATE INDEX idx_outbox_events_dead_lettered ON outbox_events(dead_lettered_at) WHERE dead_lettered_at IS NOT NULL;
+CREATE INDEX idx_outbox_events_pending ON outbox_events(aggregate_type, aggregate_id, aggregate_sequence)
+    WHERE published = FALSE AND dead_lettered_at IS NULL;
+CREATE INDEX idx_outbox_events_published_at ON outbox_events(published_at, id) WHERE published = TRUE;
+
+CREATE TRIGGER outbox_events_notify
+    AFTER INSERT ON outbox_events
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_outbox_event();
@@ -0,0 +1,126 @@
+-- Retention for outbox_events: partition by month (as 000010 did for
+-- audit_logs) so published events past the retention window leave the
+-- outbox a whole partition at a time. The retention job detaches a month
+-- once every event in it is published, then either attaches it to
+-- outbox_events_archive or exports it to a file and drops it.
+--
+-- Every monthly partition carries a CHECK constraint matching its bounds,
+-- so attaching it to the archive needs no validation scan.
+
+-- aggregate_sequence used to be MAX()+1 over outbox_events, which would
+-- restart an aggregate's numbering once its old events were pruned, and
+-- uniqueness can no longer be enforced by an index that must include the
+-- partition key. Sequences are allocated from this table instead; the
+-- upsert's row lock serializes writers of one aggregate.
+CREATE TABLE outbox_aggregate_sequences (
+    aggregate_type TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    last_sequence BIGINT NOT NULL,
+    PRIMARY KEY (aggregate_type, aggregate_id)
+);
+
+INSERT INTO outbox_aggregate_sequences (aggregate_type, aggregate_id, last_sequence)
+SELECT aggregate_type, aggregate_id, MAX(aggregate_sequence)
+FROM (
+    SELECT aggregate_type, aggregate_id, aggregate_sequence FROM outbox_events
+    UNION ALL
+    SELECT aggregate_type, aggregate_id, aggregate_sequence FROM outbox_dead_letter_archive
+) AS allocated
+GROUP BY aggregate_type, aggregate_id;
+
+ALTER TABLE outbox_events RENAME TO outbox_events_unpartitioned;
+ALTER TABLE outbox_events_unpartitioned RENAME CONSTRAINT outbox_events_pkey TO outbox_events_unpartitioned_pkey;
+
+-- Columns keep their original order so SELECT * rows are unchanged.
+CREATE TABLE outbox_events (
+    id TEXT NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    aggregate_type TEXT NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ,
+    published BOOLEAN NOT NULL DEFAULT FALSE,
+    event_version INT NOT NULL DEFAULT 1,
+    aggregate_sequence BIGINT NOT NULL DEFAULT 1,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    dead_lettered_at TIMESTAMPTZ,
+    locked_until TIMESTAMPTZ,
+    locked_by TEXT,
+    PRIMARY KEY (id, created_at)
+) PARTITION BY RANGE (created_at);
+
+-- Catch-all partition so inserts never fail for a month without a
+-- partition. The retention job creates upcoming months ahead of time;
+-- events that land here anyway are never pruned.
+CREATE TABLE outbox_events_default PARTITION OF outbox_events DEFAULT;
+
+-- Archived months, attached here unchanged. Its indexes match ones every
+-- outbox partition already has, so attaching builds nothing. Drop old
+-- archive partitions (DROP TABLE outbox_events_archive_YYYY_MM) once they
+-- are no longer needed.
+CREATE TABLE outbox_events_archive (LIKE outbox_events INCLUDING DEFAULTS INCLUDING CONSTRAINTS)
+    PARTITION BY RANGE (created_at);
+ALTER TABLE outbox_events_archive ADD PRIMARY KEY (id, created_at);
+
+-- ensure_outbox_partition creates the monthly partition (UTC) covering
+-- for_date, named outbox_events_YYYY_MM, if it doesn't already exist.
+-- Idempotent; the retention job calls it for upcoming months.
+CREATE OR REPLACE FUNCTION ensure_outbox_partition(for_date date) RETURNS void AS $$
+DECLARE
+    partition_start date := date_trunc('month', for_date);
+    start_at timestamptz := partition_start::timestamp AT TIME ZONE 'UTC';
+    end_at timestamptz := (partition_start + interval '1 month')::timestamp AT TIME ZONE 'UTC';
+    partition_name text := 'outbox_events_' || to_char(partition_start, 'YYYY_MM');
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'outbox_events'::regclass AND c.relname = partition_name
+    ) THEN
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF outbox_events FOR VALUES FROM (%L) TO (%L)',
+            partition_name, start_at, end_at
+        );
+        EXECUTE format(
+            'ALTER TABLE %I ADD CONSTRAINT %I CHECK (created_at >= %L AND created_at < %L)',
+            partition_name, partition_name || '_bounds', start_at, end_at
+        );
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create partitions for every month with existing events and the next
+-- two, so the copy below and near-term writes land in real partitions.
+DO $$
+DECLARE
+    month_start date;
+BEGIN
+    SELECT COALESCE(date_trunc('month', MIN(created_at) AT TIME ZONE 'UTC'), date_trunc('month', now() AT TIME ZONE 'UTC'))::date
+    INTO month_start
+    FROM outbox_events_unpartitioned;
+
+    WHILE month_start <= (date_trunc('month', now() AT TIME ZONE 'UTC') + interval '2 months')::date LOOP
+        PERFORM ensure_outbox_partition(month_start);
+        month_start := (month_start + interval '1 month')::date;
+    END LOOP;
+END $$;
+
+INSERT INTO outbox_events SELECT * FROM outbox_events_unpartitioned;
+
+DROP TABLE outbox_events_unpartitioned;
+
+CREATE INDEX idx_outbox_events_unpublished ON outbox_events(published, created_at) WHERE NOT published;
+CREATE INDEX idx_outbox_events_aggregate ON outbox_events(aggregate_type, aggregate_id);
+CREATE INDEX idx_outbox_events_aggregate_sequence ON outbox_events(aggregate_type, aggregate_id, aggregate_sequence);
+CREATE INDEX idx_outbox_events_dead_lettered ON outbox_events(dead_lettered_at) WHERE dead_lettered_at IS NOT NULL;
+CREATE INDEX idx_outbox_events_pending ON outbox_events(aggregate_type, aggregate_id, aggregate_sequence)
+    WHERE published = FALSE AND dead_lettered_at IS NULL;
+CREATE INDEX idx_outbox_events_published_at ON outbox_events(published_at, id) WHERE published = TRUE;
+
+CREATE INDEX idx_outbox_events_archive_aggregate ON outbox_events_archive(aggregate_type, aggregate_id);
+CREATE INDEX idx_outbox_events_archive_published_at ON outbox_events_archive(published_at, id) WHERE published = TRUE;
+
+CREATE TRIGGER outbox_events_notify
+    AFTER INSERT ON outbox_events
+    FOR EACH STATEMENT EXECUTE FUNCTION notify_outbox_event();
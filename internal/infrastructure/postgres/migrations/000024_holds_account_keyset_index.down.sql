@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_holds_account_id;
@@ -0,0 +1,2 @@
+-- Hold listings page by account in ID (ULID, so creation) order.
+CREATE INDEX idx_holds_account_id ON holds(account_id, id);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_transfers_metadata;
+DROP INDEX IF EXISTS idx_transfers_amount;
+DROP INDEX IF EXISTS idx_transfers_created_at;
+DROP INDEX IF EXISTS idx_transfers_event_at;
+DROP INDEX IF EXISTS idx_transfers_to_account;
+DROP INDEX IF EXISTS idx_transfers_from_account;
+
+CREATE INDEX idx_transfers_from_account ON transfers(from_account_id);
+CREATE INDEX idx_transfers_to_account ON transfers(to_account_id);
+CREATE INDEX idx_transfers_event_at ON transfers(event_at);
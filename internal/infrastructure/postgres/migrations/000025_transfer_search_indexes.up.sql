@@ -0,0 +1,14 @@
+-- Transfer search pages by (sort key, id) keysets; account filters page by
+-- id within an account.
+DROP INDEX IF EXISTS idx_transfers_from_account;
+DROP INDEX IF EXISTS idx_transfers_to_account;
+DROP INDEX IF EXISTS idx_transfers_event_at;
+
+CREATE INDEX idx_transfers_from_account ON transfers(from_account_id, id);
+CREATE INDEX idx_transfers_to_account ON transfers(to_account_id, id);
+CREATE INDEX idx_transfers_event_at ON transfers(event_at, id);
+CREATE INDEX idx_transfers_created_at ON transfers(created_at, id);
+CREATE INDEX idx_transfers_amount ON transfers(amount, id);
+
+-- Metadata containment (metadata @> '{"order_id": "X"}').
+CREATE INDEX idx_transfers_metadata ON transfers USING GIN (metadata jsonb_path_ops);
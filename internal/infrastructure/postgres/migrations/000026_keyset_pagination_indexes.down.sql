@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_entries_account;
+CREATE INDEX idx_entries_account ON entries(account_id);
+
+DROP INDEX IF EXISTS idx_audit_logs_created_at;
+CREATE INDEX idx_audit_logs_created_at ON audit_logs(created_at DESC);
+
+DROP INDEX IF EXISTS idx_outbox_events_dead_lettered;
+CREATE INDEX idx_outbox_events_dead_lettered ON outbox_events(dead_lettered_at) WHERE dead_lettered_at IS NOT NULL;
@@ -0,0 +1,11 @@
+-- Entry, audit log and dead-letter listings page by keyset instead of
+-- OFFSET: entries by id within an account, audit logs and dead letters by
+-- (timestamp, id). Accounts page by their primary key.
+DROP INDEX IF EXISTS idx_entries_account;
+CREATE INDEX idx_entries_account ON entries(account_id, id);
+
+DROP INDEX IF EXISTS idx_audit_logs_created_at;
+CREATE INDEX idx_audit_logs_created_at ON audit_logs(created_at, id);
+
+DROP INDEX IF EXISTS idx_outbox_events_dead_lettered;
+CREATE INDEX idx_outbox_events_dead_lettered ON outbox_events(dead_lettered_at, id) WHERE dead_lettered_at IS NOT NULL;
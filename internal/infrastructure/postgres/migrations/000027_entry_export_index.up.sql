@@ -0,0 +1,3 @@
+-- Entry exports walk an account's chain in account_version order through a
+-- server-side cursor; this index serves that order without a sort.
+CREATE INDEX idx_entries_account_version ON entries(account_id, account_version);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_accrual_runs_pending;
@@ -0,0 +1,3 @@
+-- Stale pending accrual claims are re-driven on every accrual pass; this
+-- keeps that scan off the (ever-growing) history of completed runs.
+CREATE INDEX idx_accrual_runs_pending ON accrual_runs(updated_at) WHERE status = 'pending';
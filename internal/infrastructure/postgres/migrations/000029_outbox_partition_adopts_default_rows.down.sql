@@ -0,0 +1,22 @@
+CREATE OR REPLACE FUNCTION ensure_outbox_partition(for_date date) RETURNS void AS $$
+DECLARE
+    partition_start date := date_trunc('month', for_date);
+    start_at timestamptz := partition_start::timestamp AT TIME ZONE 'UTC';
+    end_at timestamptz := (partition_start + interval '1 month')::timestamp AT TIME ZONE 'UTC';
+    partition_name text := 'outbox_events_' || to_char(partition_start, 'YYYY_MM');
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'outbox_events'::regclass AND c.relname = partition_name
+    ) THEN
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF outbox_events FOR VALUES FROM (%L) TO (%L)',
+            partition_name, start_at, end_at
+        );
+        EXECUTE format(
+            'ALTER TABLE %I ADD CONSTRAINT %I CHECK (created_at >= %L AND created_at < %L)',
+            partition_name, partition_name || '_bounds', start_at, end_at
+        );
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
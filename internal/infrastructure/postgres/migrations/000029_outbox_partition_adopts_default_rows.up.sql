@@ -0,0 +1,45 @@
+-- ensure_outbox_partition used to CREATE TABLE ... PARTITION OF, which
+-- fails once outbox_events_default holds events in the new month's range
+-- (e.g. after the retention job was down over a month boundary), leaving
+-- that month and every later one without a partition. It now builds the
+-- partition as a plain table, moves the month's events out of the default
+-- partition into it and attaches it, all in the caller's transaction.
+CREATE OR REPLACE FUNCTION ensure_outbox_partition(for_date date) RETURNS void AS $$
+DECLARE
+    partition_start date := date_trunc('month', for_date);
+    start_at timestamptz := partition_start::timestamp AT TIME ZONE 'UTC';
+    end_at timestamptz := (partition_start + interval '1 month')::timestamp AT TIME ZONE 'UTC';
+    partition_name text := 'outbox_events_' || to_char(partition_start, 'YYYY_MM');
+BEGIN
+    IF EXISTS (
+        SELECT 1 FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'outbox_events'::regclass AND c.relname = partition_name
+    ) THEN
+        RETURN;
+    END IF;
+
+    -- ATTACH PARTITION needs this lock on the default partition anyway;
+    -- taking it first keeps new events for the month from landing there
+    -- after they were moved.
+    LOCK TABLE outbox_events_default IN ACCESS EXCLUSIVE MODE;
+
+    EXECUTE format(
+        'CREATE TABLE %I (LIKE outbox_events INCLUDING DEFAULTS INCLUDING CONSTRAINTS)',
+        partition_name
+    );
+    EXECUTE format(
+        'ALTER TABLE %I ADD CONSTRAINT %I CHECK (created_at >= %L AND created_at < %L)',
+        partition_name, partition_name || '_bounds', start_at, end_at
+    );
+    EXECUTE format(
+        'WITH moved AS (
+            DELETE FROM outbox_events_default WHERE created_at >= %L AND created_at < %L RETURNING *
+        ) INSERT INTO %I SELECT * FROM moved',
+        start_at, end_at, partition_name
+    );
+    EXECUTE format(
+        'ALTER TABLE outbox_events ATTACH PARTITION %I FOR VALUES FROM (%L) TO (%L)',
+        partition_name, start_at, end_at
+    );
+END;
+$$ LANGUAGE plpgsql;
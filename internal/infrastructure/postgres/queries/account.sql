@@ -9,6 +9,9 @@ SELECT * FROM accounts WHERE id = $1;
 -- name: GetAccountByIDForUpdate :one
 SELECT * FROM accounts WHERE id = $1 FOR UPDATE;
 
+-- name: GetAccountsByIDs :many
+SELECT * FROM accounts WHERE id = ANY($1::text[]);
+
 -- name: GetAccountsByIDsForUpdate :many
 SELECT * FROM accounts WHERE id = ANY($1::text[]) ORDER BY id FOR UPDATE;
 
@@ -30,5 +33,13 @@ WHERE id = $1;
 -- name: ListAccounts :many
 SELECT * FROM accounts ORDER BY created_at DESC LIMIT $1 OFFSET $2;
 
+-- name: ListAccountsCursor :many
+-- Keyset pagination on ULID id, as ListTransfersByAccountCursor. An empty
+-- cursor starts from the most recent account.
+SELECT * FROM accounts
+WHERE (sqlc.arg(cursor)::text = '' OR id < sqlc.arg(cursor)::text)
+ORDER BY id DESC
+LIMIT $1;
+
 -- name: CountAccounts :one
 SELECT COUNT(*) FROM accounts;
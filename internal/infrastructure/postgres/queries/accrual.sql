@@ -0,0 +1,68 @@
+-- name: CreateAccrualRule :one
+INSERT INTO accrual_rules (id, name, kind, schedule, account_id, account_group, counterparty_account_id, rate, amount, precision, active, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetAccrualRuleByID :one
+SELECT * FROM accrual_rules WHERE id = $1;
+
+-- name: ListAccrualRules :many
+SELECT * FROM accrual_rules
+ORDER BY created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListActiveAccrualRules :many
+SELECT * FROM accrual_rules
+WHERE active = TRUE
+ORDER BY created_at ASC;
+
+-- name: SetAccrualRuleActive :exec
+UPDATE accrual_rules
+SET active = $2, updated_at = $3
+WHERE id = $1;
+
+-- name: AddAccountGroupMember :exec
+INSERT INTO account_group_members (group_name, account_id, created_at)
+VALUES ($1, $2, $3)
+ON CONFLICT (group_name, account_id) DO NOTHING;
+
+-- name: RemoveAccountGroupMember :exec
+DELETE FROM account_group_members
+WHERE group_name = $1 AND account_id = $2;
+
+-- name: ListAccountGroupMembers :many
+SELECT account_id FROM account_group_members
+WHERE group_name = $1
+ORDER BY account_id ASC;
+
+-- name: ClaimAccrualRun :one
+-- Claims a (rule, account, period) for posting. Returns no rows when the
+-- period was already claimed by an earlier (or concurrent) run.
+INSERT INTO accrual_runs (id, rule_id, account_id, period_start, status, created_at, updated_at)
+VALUES ($1, $2, $3, $4, 'pending', $5, $5)
+ON CONFLICT (rule_id, account_id, period_start) DO NOTHING
+RETURNING *;
+
+-- name: CompleteAccrualRun :exec
+UPDATE accrual_runs
+SET status = $2, balance = $3, amount = $4, transfer_id = $5, updated_at = $6
+WHERE id = $1;
+
+-- name: DeleteAccrualRun :exec
+-- Releases a pending claim whose transfer failed, so the next run retries it.
+DELETE FROM accrual_runs
+WHERE id = $1 AND status = 'pending';
+
+-- name: ListStalePendingAccrualRuns :many
+-- Pending claims not touched since stale_before: the runner that claimed
+-- them crashed, or failed to release or complete them.
+SELECT * FROM accrual_runs
+WHERE status = 'pending' AND updated_at < sqlc.arg(stale_before)::timestamptz
+ORDER BY created_at ASC
+LIMIT $1;
+
+-- name: ListAccrualRuns :many
+SELECT * FROM accrual_runs
+WHERE (sqlc.arg(rule_id)::text = '' OR rule_id = sqlc.arg(rule_id))
+ORDER BY period_start DESC, created_at DESC
+LIMIT $1 OFFSET $2;
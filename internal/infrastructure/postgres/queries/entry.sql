@@ -12,6 +12,15 @@ WHERE account_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3;
 
+-- name: GetEntriesByAccountCursor :many
+-- Keyset pagination on ULID id, as ListTransfersByAccountCursor. An empty
+-- cursor starts from the most recent entry.
+SELECT * FROM entries
+WHERE account_id = $1
+  AND (sqlc.arg(cursor)::text = '' OR id < sqlc.arg(cursor)::text)
+ORDER BY id DESC
+LIMIT $2;
+
 -- name: CountEntriesByAccount :one
 SELECT COUNT(*) FROM entries WHERE account_id = $1;
 
@@ -29,3 +38,12 @@ SELECT COALESCE(
      ORDER BY created_at DESC, id DESC LIMIT 1),
     0
 )::NUMERIC AS balance;
+
+-- name: GetEntriesByAccountInRange :many
+-- Entries booked in [from, to) for an account, in chain order, for
+-- statements.
+SELECT * FROM entries
+WHERE account_id = sqlc.arg(account_id)
+  AND created_at >= sqlc.arg(from_time)
+  AND created_at < sqlc.arg(to_time)
+ORDER BY account_version ASC;
@@ -0,0 +1,23 @@
+-- name: CreateFeePolicy :one
+INSERT INTO fee_policies (id, name, from_account_id, to_account_id, currency, metadata_match, payer, revenue_account_id, percentage, fixed_amount, precision, active, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+RETURNING *;
+
+-- name: GetFeePolicyByID :one
+SELECT * FROM fee_policies WHERE id = $1;
+
+-- name: ListFeePolicies :many
+SELECT * FROM fee_policies
+ORDER BY created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListActiveFeePolicies :many
+-- Ordered by creation so fee legs are posted in a stable order.
+SELECT * FROM fee_policies
+WHERE active = TRUE
+ORDER BY created_at ASC, id ASC;
+
+-- name: SetFeePolicyActive :exec
+UPDATE fee_policies
+SET active = $2, updated_at = $3
+WHERE id = $1;
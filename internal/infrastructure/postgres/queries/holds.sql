@@ -19,3 +19,17 @@ SELECT * FROM holds
 WHERE account_id = $1
 ORDER BY created_at DESC
 LIMIT $2 OFFSET $3;
+
+-- name: ListHoldsByAccountCursor :many
+-- Keyset pagination on ULID id, like ListTransfersByAccountCursor. An
+-- empty cursor starts from the most recent hold; each filter is skipped
+-- when NULL. The expiry bounds are [expires_after, expires_before) and
+-- exclude holds without an expiry.
+SELECT * FROM holds
+WHERE account_id = $1
+  AND (sqlc.arg(cursor)::text = '' OR id < sqlc.arg(cursor)::text)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+  AND (sqlc.narg(expires_after)::timestamptz IS NULL OR expires_at >= sqlc.narg(expires_after)::timestamptz)
+  AND (sqlc.narg(expires_before)::timestamptz IS NULL OR expires_at < sqlc.narg(expires_before)::timestamptz)
+ORDER BY id DESC
+LIMIT $2;
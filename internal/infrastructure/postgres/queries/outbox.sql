@@ -1,15 +1,17 @@
 -- name: CreateOutboxEvent :one
--- aggregate_sequence is computed as the next number for this aggregate,
--- relying on the caller already holding a row lock on the aggregate (the
--- account/hold FOR UPDATE taken earlier in the same transaction) to
--- serialize concurrent writers - see idx_outbox_events_aggregate_sequence
--- for the invariant this must uphold.
-INSERT INTO outbox_events (id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence, payload, created_at, published)
-VALUES (
-    $1, $2, $3, $4, $5,
-    COALESCE((SELECT MAX(aggregate_sequence) FROM outbox_events WHERE aggregate_type = $3 AND aggregate_id = $2), 0) + 1,
-    $6, $7, $8
+-- aggregate_sequence is allocated from outbox_aggregate_sequences, so
+-- numbering keeps counting up after the aggregate's old events are pruned.
+-- The upsert's row lock serializes concurrent writers of one aggregate.
+WITH allocated AS (
+    INSERT INTO outbox_aggregate_sequences (aggregate_type, aggregate_id, last_sequence)
+    VALUES ($3, $2, 1)
+    ON CONFLICT (aggregate_type, aggregate_id)
+    DO UPDATE SET last_sequence = outbox_aggregate_sequences.last_sequence + 1
+    RETURNING last_sequence
 )
+INSERT INTO outbox_events (id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence, payload, created_at, published)
+SELECT $1, $2, $3, $4, $5, allocated.last_sequence, $6, $7, $8
+FROM allocated
 RETURNING *;
 
 -- name: GetUnpublishedEvents :many
@@ -20,15 +22,63 @@ WHERE published = FALSE AND dead_lettered_at IS NULL
 ORDER BY created_at ASC
 LIMIT $1;
 
--- name: MarkEventPublished :exec
+-- name: LockOutboxClaims :exec
+-- Serializes claimers for the rest of the transaction, so two replicas
+-- never compute overlapping per-aggregate prefixes.
+SELECT pg_advisory_xact_lock(hashtext('goledger.outbox_claim'));
+
+-- name: ClaimOutboxEvents :many
+-- Leases up to batch_size pending events. Aggregates with any pending
+-- event still leased are skipped, and candidates are ordered by their
+-- position within the aggregate first, so every aggregate's claimed events
+-- are a prefix of its pending events in aggregate_sequence order. Must run
+-- after LockOutboxClaims in the same transaction.
+WITH pending AS (
+    SELECT
+        id,
+        created_at,
+        row_number() OVER (PARTITION BY aggregate_type, aggregate_id ORDER BY aggregate_sequence) AS position,
+        bool_or(locked_until IS NOT NULL AND locked_until > @now::timestamptz) OVER (PARTITION BY aggregate_type, aggregate_id) AS aggregate_leased
+    FROM outbox_events
+    WHERE published = FALSE AND dead_lettered_at IS NULL
+),
+claimable AS (
+    SELECT id FROM pending
+    WHERE NOT aggregate_leased
+    ORDER BY position, created_at
+    LIMIT @batch_size
+)
 UPDATE outbox_events
-SET published = TRUE, published_at = $2
-WHERE id = $1;
+SET locked_until = @lease_until::timestamptz, locked_by = @locked_by::text
+WHERE outbox_events.id IN (
+    SELECT e.id FROM outbox_events e
+    WHERE e.id IN (SELECT id FROM claimable)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: ReleaseOutboxClaims :exec
+UPDATE outbox_events
+SET locked_until = NULL, locked_by = NULL
+WHERE id = ANY(@ids::text[]) AND locked_by = @locked_by::text;
+
+-- name: GetOldestPendingOutboxEventCreatedAt :one
+SELECT MIN(created_at)::timestamptz AS created_at FROM outbox_events
+WHERE published = FALSE AND dead_lettered_at IS NULL;
+
+-- name: MarkEventPublished :execrows
+-- Only the lease holder may mark the event: a publisher whose lease expired
+-- and was re-claimed by another updates no rows.
+UPDATE outbox_events
+SET published = TRUE, published_at = @published_at, locked_until = NULL, locked_by = NULL
+WHERE id = @id AND locked_by = @locked_by::text;
 
 -- name: RecordOutboxFailure :one
+-- Also releases the event's lease so the next claim retries it. Like
+-- MarkEventPublished, returns no rows unless locked_by still holds it.
 UPDATE outbox_events
-SET attempts = attempts + 1, last_error = $2
-WHERE id = $1
+SET attempts = attempts + 1, last_error = @last_error, locked_until = NULL, locked_by = NULL
+WHERE id = @id AND locked_by = @locked_by::text
 RETURNING attempts;
 
 -- name: MarkOutboxDeadLettered :exec
@@ -42,12 +92,78 @@ WHERE dead_lettered_at IS NOT NULL
 ORDER BY dead_lettered_at DESC
 LIMIT $1 OFFSET $2;
 
+-- name: GetDeadLetteredEventsCursor :many
+-- Keyset pagination on (dead_lettered_at, id), most recently dead-lettered
+-- first. A NULL after_at starts from the first page.
+SELECT * FROM outbox_events
+WHERE dead_lettered_at IS NOT NULL
+  AND (sqlc.narg('after_at')::timestamptz IS NULL
+       OR (dead_lettered_at, id) < (sqlc.narg('after_at')::timestamptz, @after_id::text))
+ORDER BY dead_lettered_at DESC, id DESC
+LIMIT $1;
+
+-- name: ReplayDeadLetteredEvents :many
+-- Requeues the matching dead-lettered events. ids, event_type and the
+-- created_at range each match anything when empty/NULL.
+UPDATE outbox_events
+SET attempts = 0, dead_lettered_at = NULL, locked_until = NULL, locked_by = NULL
+WHERE dead_lettered_at IS NOT NULL
+  AND (cardinality(@ids::text[]) = 0 OR id = ANY(@ids::text[]))
+  AND (@event_type::text = '' OR event_type = @event_type::text)
+  AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from')::timestamptz)
+  AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to')::timestamptz)
+RETURNING *;
+
+-- name: ArchiveDeadLetteredEvents :many
+-- Moves the matching dead-lettered events to outbox_dead_letter_archive,
+-- using the same filters as ReplayDeadLetteredEvents.
+WITH archived AS (
+    DELETE FROM outbox_events
+    WHERE dead_lettered_at IS NOT NULL
+      AND (cardinality(@ids::text[]) = 0 OR id = ANY(@ids::text[]))
+      AND (@event_type::text = '' OR event_type = @event_type::text)
+      AND (sqlc.narg('created_from')::timestamptz IS NULL OR created_at >= sqlc.narg('created_from')::timestamptz)
+      AND (sqlc.narg('created_to')::timestamptz IS NULL OR created_at < sqlc.narg('created_to')::timestamptz)
+    RETURNING *
+)
+INSERT INTO outbox_dead_letter_archive (
+    id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence,
+    payload, created_at, attempts, last_error, dead_lettered_at, archived_at, archive_reason
+)
+SELECT
+    id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence,
+    payload, created_at, attempts, last_error, dead_lettered_at, @archived_at::timestamptz, @archive_reason::text
+FROM archived
+RETURNING id, aggregate_id, aggregate_type, event_type, event_version, aggregate_sequence,
+    created_at, attempts, last_error, dead_lettered_at;
+
 -- name: GetEventsByAggregate :many
 SELECT * FROM outbox_events
 WHERE aggregate_type = $1 AND aggregate_id = $2
 ORDER BY created_at DESC
 LIMIT $3 OFFSET $4;
 
--- name: DeletePublishedEvents :exec
-DELETE FROM outbox_events
-WHERE published = TRUE AND published_at < $1;
+-- name: GetOutboxEvent :one
+SELECT * FROM outbox_events WHERE id = $1;
+
+-- name: GetOutboxEventByAggregateSequence :one
+SELECT * FROM outbox_events
+WHERE aggregate_type = $1 AND aggregate_id = $2 AND aggregate_sequence = $3;
+
+-- name: ListPublishedOutboxEvents :many
+-- Published events after a (published_at, id) position, in publication
+-- order. account_id and aggregate_type narrow the scan ('' = any); the
+-- event stream applies its event type patterns itself.
+SELECT * FROM outbox_events
+WHERE published = TRUE
+  AND (published_at, id) > (@after_published_at::timestamptz, @after_id::text)
+  AND (@aggregate_type::text = '' OR aggregate_type = @aggregate_type::text)
+  AND (
+    @account_id::text = ''
+    OR (aggregate_type = 'account' AND aggregate_id = @account_id::text)
+    OR payload->>'account_id' = @account_id::text
+    OR payload->>'from_account_id' = @account_id::text
+    OR payload->>'to_account_id' = @account_id::text
+  )
+ORDER BY published_at, id
+LIMIT @batch_size;
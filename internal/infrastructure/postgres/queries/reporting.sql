@@ -0,0 +1,100 @@
+-- name: LockProjectionCheckpoint :one
+-- Returns the projection's checkpoint, creating it if needed, and locks it
+-- for the rest of the transaction so concurrent projectors take turns.
+INSERT INTO reporting.projection_checkpoints (name) VALUES (@name)
+ON CONFLICT (name) DO UPDATE SET name = EXCLUDED.name
+RETURNING *;
+
+-- name: GetProjectionCheckpoint :one
+SELECT * FROM reporting.projection_checkpoints WHERE name = @name;
+
+-- name: AdvanceProjectionCheckpoint :exec
+UPDATE reporting.projection_checkpoints
+SET last_tx_id = @last_tx_id,
+    last_entry_id = @last_entry_id,
+    entries_projected = entries_projected + @entries::bigint,
+    updated_at = @updated_at
+WHERE name = @name;
+
+-- name: ResetProjectionCheckpoint :exec
+UPDATE reporting.projection_checkpoints
+SET last_tx_id = 0, last_entry_id = '', entries_projected = 0, updated_at = @updated_at
+WHERE name = @name;
+
+-- name: ListProjectableEntries :many
+-- Entries after the checkpoint whose inserting transaction is older than
+-- every running one, so no entry can later appear before them. Each entry
+-- is joined with its currency, event date and counterparty.
+SELECT COALESCE(e.tx_id, 0)::bigint AS tx_id,
+       e.id,
+       e.account_id,
+       e.transfer_id,
+       e.amount,
+       e.account_current_balance,
+       e.account_version,
+       e.created_at,
+       t.event_at,
+       a.currency,
+       (CASE WHEN t.from_account_id = e.account_id THEN t.to_account_id ELSE t.from_account_id END)::text AS counterparty_account_id
+FROM entries e
+JOIN transfers t ON t.id = e.transfer_id
+JOIN accounts a ON a.id = e.account_id
+WHERE (COALESCE(e.tx_id, 0), e.id) > (@after_tx_id::bigint, @after_entry_id::text)
+  AND COALESCE(e.tx_id, 0) < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY COALESCE(e.tx_id, 0), e.id
+LIMIT @batch_size;
+
+-- name: GetNextUnprojectedEntryTime :one
+-- Creation time of the next entry the projector will apply, for lag.
+SELECT created_at FROM entries
+WHERE (COALESCE(tx_id, 0), id) > (@after_tx_id::bigint, @after_entry_id::text)
+ORDER BY COALESCE(tx_id, 0), id
+LIMIT 1;
+
+-- name: AddAccountDailyVolume :exec
+INSERT INTO reporting.account_daily_volumes (account_id, day, currency, debit_total, credit_total, entry_count)
+VALUES (@account_id, @day, @currency, @debit_total, @credit_total, @entry_count)
+ON CONFLICT (account_id, day) DO UPDATE
+SET debit_total = reporting.account_daily_volumes.debit_total + EXCLUDED.debit_total,
+    credit_total = reporting.account_daily_volumes.credit_total + EXCLUDED.credit_total,
+    entry_count = reporting.account_daily_volumes.entry_count + EXCLUDED.entry_count;
+
+-- name: AddCounterpartyTotal :exec
+INSERT INTO reporting.counterparty_totals (account_id, counterparty_account_id, currency, sent_total, received_total, entry_count)
+VALUES (@account_id, @counterparty_account_id, @currency, @sent_total, @received_total, @entry_count)
+ON CONFLICT (account_id, counterparty_account_id) DO UPDATE
+SET sent_total = reporting.counterparty_totals.sent_total + EXCLUDED.sent_total,
+    received_total = reporting.counterparty_totals.received_total + EXCLUDED.received_total,
+    entry_count = reporting.counterparty_totals.entry_count + EXCLUDED.entry_count;
+
+-- name: SetAccountBalance :exec
+-- Keeps the balance of the account's highest version seen.
+INSERT INTO reporting.account_balances (account_id, currency, balance, account_version, last_entry_id, last_entry_at)
+VALUES (@account_id, @currency, @balance, @account_version, @last_entry_id, @last_entry_at)
+ON CONFLICT (account_id) DO UPDATE
+SET balance = EXCLUDED.balance,
+    account_version = EXCLUDED.account_version,
+    last_entry_id = EXCLUDED.last_entry_id,
+    last_entry_at = EXCLUDED.last_entry_at
+WHERE EXCLUDED.account_version > reporting.account_balances.account_version;
+
+-- name: TruncateReportingReadModels :exec
+TRUNCATE reporting.account_daily_volumes, reporting.counterparty_totals, reporting.account_balances;
+
+-- name: ListAccountDailyVolumes :many
+SELECT * FROM reporting.account_daily_volumes
+WHERE account_id = @account_id
+  AND day >= @from_day
+  AND day < @to_day
+ORDER BY day;
+
+-- name: ListCounterpartyTotals :many
+SELECT * FROM reporting.counterparty_totals
+WHERE account_id = @account_id
+ORDER BY sent_total + received_total DESC, counterparty_account_id
+LIMIT @lim OFFSET @off;
+
+-- name: ListReportingBalances :many
+SELECT * FROM reporting.account_balances
+ORDER BY account_id
+LIMIT @lim OFFSET @off;
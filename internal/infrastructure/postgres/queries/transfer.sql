@@ -1,11 +1,14 @@
 -- name: CreateTransfer :one
-INSERT INTO transfers (id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO transfers (id, from_account_id, to_account_id, amount, created_at, event_at, metadata, reversed_transfer_id, idempotency_key)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 RETURNING *;
 
 -- name: GetTransferByID :one
 SELECT * FROM transfers WHERE id = $1;
 
+-- name: GetTransferByIdempotencyKey :one
+SELECT * FROM transfers WHERE idempotency_key = $1;
+
 -- name: ListTransfersByAccount :many
 SELECT * FROM transfers
 WHERE from_account_id = $1 OR to_account_id = $1
@@ -26,3 +29,11 @@ LIMIT $2;
 -- name: CountTransfersByAccount :one
 SELECT COUNT(*) FROM transfers
 WHERE from_account_id = $1 OR to_account_id = $1;
+
+-- name: GetTransfersByIDs :many
+SELECT * FROM transfers WHERE id = ANY(sqlc.arg(ids)::text[]);
+
+-- name: GetExistingIdempotencyKeys :many
+-- Which of the given idempotency keys already belong to a transfer.
+SELECT idempotency_key::text FROM transfers
+WHERE idempotency_key = ANY(sqlc.arg(keys)::text[]);
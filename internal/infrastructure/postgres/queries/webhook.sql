@@ -0,0 +1,75 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (id, url, secret, event_types, aggregate_type, aggregate_id, description, active, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: GetWebhookSubscriptionByID :one
+SELECT * FROM webhook_subscriptions WHERE id = $1;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListActiveWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+WHERE active = TRUE
+ORDER BY created_at ASC, id ASC;
+
+-- name: UpdateWebhookSubscription :exec
+UPDATE webhook_subscriptions
+SET url = $2, event_types = $3, aggregate_type = $4, aggregate_id = $5, description = $6, active = $7, updated_at = $8
+WHERE id = $1;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions WHERE id = $1;
+
+-- name: CreateWebhookDelivery :exec
+-- A redelivered outbox event (the publisher retrying after a crash) must
+-- not fan out twice, hence the conflict on (subscription_id, event_id).
+INSERT INTO webhook_deliveries (id, subscription_id, event_id, event_type, body, status, next_attempt_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, 'pending', $6, $7, $7)
+ON CONFLICT (subscription_id, event_id) DO NOTHING;
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Leases due deliveries by pushing next_attempt_at to lease_until, so
+-- concurrent dispatchers skip them and a dispatcher that dies mid-send
+-- has its deliveries picked up again once the lease expires. Deliveries
+-- of inactive subscriptions are held back until they are re-enabled.
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg(lease_until)
+WHERE id IN (
+    SELECT d.id FROM webhook_deliveries d
+    JOIN webhook_subscriptions s ON s.id = d.subscription_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= sqlc.arg(now) AND s.active
+    ORDER BY d.next_attempt_at ASC
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING *;
+
+-- name: GetWebhookDeliveryByID :one
+SELECT * FROM webhook_deliveries WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+-- An empty status lists deliveries in every status.
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = sqlc.arg(subscription_id)
+  AND (sqlc.arg(status)::TEXT = '' OR status = sqlc.arg(status)::TEXT)
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: UpdateWebhookDeliveryResult :exec
+UPDATE webhook_deliveries
+SET status = $2, attempts = $3, last_error = $4, last_status_code = $5, next_attempt_at = $6,
+    delivered_at = $7, dead_lettered_at = $8, updated_at = $9
+WHERE id = $1;
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, duration_ms, attempted_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT * FROM webhook_delivery_attempts
+WHERE delivery_id = $1
+ORDER BY id ASC;
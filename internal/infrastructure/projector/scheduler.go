@@ -0,0 +1,116 @@
+// Package projector keeps the reporting read models up to date by applying
+// new ledger entries on a schedule, and reports how far behind they are.
+package projector
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// Projector is the subset of ReportingUseCase the scheduler depends on, so
+// tests can supply a fake without a real database.
+type Projector interface {
+	Project(ctx context.Context, batchSize int) (*usecase.ProjectionResult, error)
+	Status(ctx context.Context) (*usecase.ProjectionStatus, error)
+}
+
+// Scheduler periodically projects new entries into the reporting read
+// models.
+type Scheduler struct {
+	reportingUC Projector
+	logger      *slog.Logger
+	metrics     *metrics.Metrics
+	interval    time.Duration
+	batchSize   int
+}
+
+// Config for Scheduler.
+type Config struct {
+	ReportingUC Projector
+	Logger      *slog.Logger
+	Metrics     *metrics.Metrics
+	Interval    time.Duration
+	// BatchSize is how many entries each projection transaction applies.
+	// Defaults to usecase.DefaultProjectionBatchSize.
+	BatchSize int
+}
+
+// NewScheduler creates a new projector Scheduler.
+func NewScheduler(cfg Config) *Scheduler {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = usecase.DefaultProjectionBatchSize
+	}
+
+	return &Scheduler{
+		reportingUC: cfg.ReportingUC,
+		logger:      cfg.Logger,
+		metrics:     cfg.Metrics,
+		interval:    cfg.Interval,
+		batchSize:   cfg.BatchSize,
+	}
+}
+
+// Start projects on a ticker until the context is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	s.logger.Info("reporting projector started",
+		slog.Duration("interval", s.interval),
+		slog.Int("batch_size", s.batchSize))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.runOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("reporting projector shutting down")
+			return ctx.Err()
+		case <-ticker.C:
+			s.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce applies every projectable entry, then records the remaining lag.
+// Errors are logged but never fatal to the scheduler loop; entries applied
+// before an error stay applied.
+func (s *Scheduler) runOnce(ctx context.Context) {
+	result, err := s.reportingUC.Project(ctx, s.batchSize)
+	if result != nil && result.Entries > 0 {
+		if s.metrics != nil {
+			s.metrics.ProjectionEntries.Add(float64(result.Entries))
+		}
+		s.logger.Debug("reporting projection advanced",
+			slog.Int("entries", result.Entries),
+			slog.Int("batches", result.Batches),
+			slog.String("last_entry_id", result.Checkpoint.EntryID))
+	}
+
+	if err != nil {
+		s.logger.Error("reporting projection failed", slog.String("error", err.Error()))
+		if s.metrics != nil {
+			s.metrics.ProjectionRuns.WithLabelValues("error").Inc()
+		}
+	} else if s.metrics != nil {
+		s.metrics.ProjectionRuns.WithLabelValues("ok").Inc()
+	}
+
+	status, err := s.reportingUC.Status(ctx)
+	if err != nil {
+		s.logger.Error("reporting projection status failed", slog.String("error", err.Error()))
+		return
+	}
+
+	if s.metrics != nil {
+		s.metrics.ProjectionLag.Set(status.Lag.Seconds())
+	}
+}
@@ -0,0 +1,125 @@
+package projector_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/infrastructure/projector"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+type fakeProjector struct {
+	mu         sync.Mutex
+	result     *usecase.ProjectionResult
+	err        error
+	lag        time.Duration
+	batchSizes []int
+}
+
+func (f *fakeProjector) Project(ctx context.Context, batchSize int) (*usecase.ProjectionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.batchSizes = append(f.batchSizes, batchSize)
+	return f.result, f.err
+}
+
+func (f *fakeProjector) Status(ctx context.Context) (*usecase.ProjectionStatus, error) {
+	return &usecase.ProjectionStatus{Checkpoint: &domain.ProjectionCheckpoint{Name: domain.ReportingProjection}, Lag: f.lag}, nil
+}
+
+// newTestMetrics registers metrics against a fresh registry so each test's
+// metrics.Metrics doesn't collide with the process-wide default registry.
+func newTestMetrics(t *testing.T) *metrics.Metrics {
+	t.Helper()
+
+	registry := prometheus.NewRegistry()
+	prevRegisterer, prevGatherer := prometheus.DefaultRegisterer, prometheus.DefaultGatherer
+	prometheus.DefaultRegisterer = registry
+	prometheus.DefaultGatherer = registry
+	t.Cleanup(func() {
+		prometheus.DefaultRegisterer, prometheus.DefaultGatherer = prevRegisterer, prevGatherer
+	})
+
+	return metrics.New()
+}
+
+func runOnceViaShortLoop(t *testing.T, s *projector.Scheduler) {
+	t.Helper()
+	// Start runs immediately on entry; cancel before the next tick.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := s.Start(ctx)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestScheduler_RecordsEntriesAndLag(t *testing.T) {
+	fake := &fakeProjector{
+		result: &usecase.ProjectionResult{Entries: 7, Batches: 2},
+		lag:    3 * time.Second,
+	}
+
+	m := newTestMetrics(t)
+	s := projector.NewScheduler(projector.Config{
+		ReportingUC: fake,
+		Metrics:     m,
+		Interval:    time.Hour,
+		BatchSize:   5,
+	})
+
+	runOnceViaShortLoop(t, s)
+
+	if len(fake.batchSizes) != 1 || fake.batchSizes[0] != 5 {
+		t.Fatalf("expected one run with batch size 5, got %v", fake.batchSizes)
+	}
+
+	if got := testutil.ToFloat64(m.ProjectionEntries); got != 7 {
+		t.Fatalf("expected 7 entries recorded, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.ProjectionLag); got != 3 {
+		t.Fatalf("expected lag of 3s, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.ProjectionRuns.WithLabelValues("ok")); got != 1 {
+		t.Fatalf("expected one ok run, got %v", got)
+	}
+}
+
+func TestScheduler_ErrorKeepsPartialProgress(t *testing.T) {
+	fake := &fakeProjector{
+		result: &usecase.ProjectionResult{Entries: 4, Batches: 1},
+		err:    errors.New("db down"),
+	}
+
+	m := newTestMetrics(t)
+	s := projector.NewScheduler(projector.Config{
+		ReportingUC: fake,
+		Metrics:     m,
+		Interval:    time.Hour,
+	})
+
+	runOnceViaShortLoop(t, s)
+
+	if fake.batchSizes[0] != usecase.DefaultProjectionBatchSize {
+		t.Fatalf("expected default batch size, got %d", fake.batchSizes[0])
+	}
+
+	if got := testutil.ToFloat64(m.ProjectionRuns.WithLabelValues("error")); got != 1 {
+		t.Fatalf("expected one error run, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.ProjectionEntries); got != 4 {
+		t.Fatalf("expected the 4 committed entries recorded, got %v", got)
+	}
+}
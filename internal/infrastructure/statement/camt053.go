@@ -0,0 +1,273 @@
+package statement
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// camt053Namespace is the ISO 20022 BankToCustomerStatement version emitted.
+const camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.08"
+
+// maxAddtlTxInf is the schema's length limit for AddtlTxInf.
+const maxAddtlTxInf = 500
+
+const (
+	creditIndicator = "CRDT"
+	debitIndicator  = "DBIT"
+)
+
+type camtDocument struct {
+	XMLName xml.Name          `xml:"Document"`
+	Xmlns   string            `xml:"xmlns,attr"`
+	Stmt    camtBkToCstmrStmt `xml:"BkToCstmrStmt"`
+}
+
+type camtBkToCstmrStmt struct {
+	GrpHdr camtGrpHdr `xml:"GrpHdr"`
+	Stmt   camtStmt   `xml:"Stmt"`
+}
+
+type camtGrpHdr struct {
+	MsgID   string `xml:"MsgId"`
+	CreDtTm string `xml:"CreDtTm"`
+}
+
+type camtStmt struct {
+	ID        string        `xml:"Id"`
+	CreDtTm   string        `xml:"CreDtTm"`
+	FrToDt    camtFrToDt    `xml:"FrToDt"`
+	Acct      camtAcct      `xml:"Acct"`
+	Bal       []camtBal     `xml:"Bal"`
+	TxsSummry camtTxsSummry `xml:"TxsSummry"`
+	Ntry      []camtNtry    `xml:"Ntry"`
+}
+
+type camtFrToDt struct {
+	FrDtTm string `xml:"FrDtTm"`
+	ToDtTm string `xml:"ToDtTm"`
+}
+
+type camtAcct struct {
+	ID  camtAcctID `xml:"Id"`
+	Ccy string     `xml:"Ccy"`
+	Nm  string     `xml:"Nm,omitempty"`
+}
+
+type camtAcctID struct {
+	Othr struct {
+		ID string `xml:"Id"`
+	} `xml:"Othr"`
+}
+
+type camtAmt struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camtBal struct {
+	Tp        camtBalTp  `xml:"Tp"`
+	Amt       camtAmt    `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	Dt        camtDtTime `xml:"Dt"`
+}
+
+type camtBalTp struct {
+	CdOrPrtry struct {
+		Cd string `xml:"Cd"`
+	} `xml:"CdOrPrtry"`
+}
+
+type camtDtTime struct {
+	DtTm string `xml:"DtTm"`
+}
+
+type camtTxsSummry struct {
+	TtlNtries    camtTtlNtries `xml:"TtlNtries"`
+	TtlCdtNtries camtNbAndSum  `xml:"TtlCdtNtries"`
+	TtlDbtNtries camtNbAndSum  `xml:"TtlDbtNtries"`
+}
+
+type camtTtlNtries struct {
+	NbOfNtries string `xml:"NbOfNtries"`
+	Sum        string `xml:"Sum"`
+	TtlNetNtry struct {
+		Amt       string `xml:"Amt"`
+		CdtDbtInd string `xml:"CdtDbtInd"`
+	} `xml:"TtlNetNtry"`
+}
+
+type camtNbAndSum struct {
+	NbOfNtries string `xml:"NbOfNtries"`
+	Sum        string `xml:"Sum"`
+}
+
+type camtNtry struct {
+	NtryRef   string     `xml:"NtryRef"`
+	Amt       camtAmt    `xml:"Amt"`
+	CdtDbtInd string     `xml:"CdtDbtInd"`
+	RvslInd   bool       `xml:"RvslInd,omitempty"`
+	Sts       camtSts    `xml:"Sts"`
+	BookgDt   camtDtTime `xml:"BookgDt"`
+	ValDt     camtDtTime `xml:"ValDt"`
+	BkTxCd    camtBkTxCd `xml:"BkTxCd"`
+	NtryDtls  struct {
+		TxDtls camtTxDtls `xml:"TxDtls"`
+	} `xml:"NtryDtls"`
+}
+
+type camtSts struct {
+	Cd string `xml:"Cd"`
+}
+
+type camtBkTxCd struct {
+	Prtry struct {
+		Cd string `xml:"Cd"`
+	} `xml:"Prtry"`
+}
+
+type camtTxDtls struct {
+	Refs struct {
+		EndToEndID string `xml:"EndToEndId"`
+	} `xml:"Refs"`
+	RltdPties  *camtRltdPties `xml:"RltdPties,omitempty"`
+	AddtlTxInf string         `xml:"AddtlTxInf,omitempty"`
+}
+
+type camtRltdPties struct {
+	DbtrAcct *camtAcctRef `xml:"DbtrAcct,omitempty"`
+	CdtrAcct *camtAcctRef `xml:"CdtrAcct,omitempty"`
+}
+
+type camtAcctRef struct {
+	ID camtAcctID `xml:"Id"`
+}
+
+// WriteCamt053 renders s as an ISO 20022 camt.053 BankToCustomerStatement.
+// Ledger account IDs are used as proprietary ("Othr") account identifiers;
+// each entry's transfer ID is its EndToEndId and its metadata, JSON-encoded,
+// its AddtlTxInf.
+func WriteCamt053(w io.Writer, s *domain.Statement) error {
+	created := s.GeneratedAt.Format(time.RFC3339)
+	id := fmt.Sprintf("%s-%s-%s", s.AccountID, s.PeriodStart.Format("20060102"), s.PeriodEnd.Format("20060102"))
+
+	stmt := camtStmt{
+		ID:      id,
+		CreDtTm: created,
+		FrToDt: camtFrToDt{
+			FrDtTm: s.PeriodStart.Format(time.RFC3339),
+			ToDtTm: s.PeriodEnd.Format(time.RFC3339),
+		},
+		Acct: camtAcct{Ccy: s.Currency, Nm: s.AccountName},
+		Bal: []camtBal{
+			camtBalance("OPBD", s.OpeningBalance, s.Currency, s.PeriodStart),
+			camtBalance("CLBD", s.ClosingBalance, s.Currency, s.PeriodEnd),
+		},
+		Ntry: make([]camtNtry, 0, len(s.Lines)),
+	}
+	stmt.Acct.ID.Othr.ID = s.AccountID
+
+	var credits, debits int
+	for _, l := range s.Lines {
+		ntry := camtNtry{
+			NtryRef:   l.EntryID,
+			Amt:       camtAmt{Ccy: s.Currency, Value: l.Amount.Abs().String()},
+			CdtDbtInd: creditIndicator,
+			RvslInd:   l.ReversedTransferID != nil,
+			Sts:       camtSts{Cd: "BOOK"},
+			BookgDt:   camtDtTime{DtTm: l.BookedAt.Format(time.RFC3339Nano)},
+			ValDt:     camtDtTime{DtTm: l.ValueAt.Format(time.RFC3339Nano)},
+		}
+		ntry.BkTxCd.Prtry.Cd = "TRANSFER"
+
+		dtls := &ntry.NtryDtls.TxDtls
+		dtls.Refs.EndToEndID = l.TransferID
+
+		var counterparty *camtAcctRef
+		if l.CounterpartyAccountID != "" {
+			counterparty = &camtAcctRef{}
+			counterparty.ID.Othr.ID = l.CounterpartyAccountID
+		}
+
+		if l.Amount.IsNegative() {
+			ntry.CdtDbtInd = debitIndicator
+			debits++
+			if counterparty != nil {
+				dtls.RltdPties = &camtRltdPties{CdtrAcct: counterparty}
+			}
+		} else {
+			credits++
+			if counterparty != nil {
+				dtls.RltdPties = &camtRltdPties{DbtrAcct: counterparty}
+			}
+		}
+
+		if len(l.Metadata) > 0 {
+			b, err := json.Marshal(l.Metadata)
+			if err != nil {
+				return err
+			}
+			if info := string(b); len(info) <= maxAddtlTxInf {
+				dtls.AddtlTxInf = info
+			}
+		}
+
+		stmt.Ntry = append(stmt.Ntry, ntry)
+	}
+
+	net := s.TotalCredits.Sub(s.TotalDebits)
+	stmt.TxsSummry.TtlNtries.NbOfNtries = fmt.Sprint(len(s.Lines))
+	stmt.TxsSummry.TtlNtries.Sum = s.TotalCredits.Add(s.TotalDebits).String()
+	stmt.TxsSummry.TtlNtries.TtlNetNtry.Amt = net.Abs().String()
+	stmt.TxsSummry.TtlNtries.TtlNetNtry.CdtDbtInd = indicator(net)
+	stmt.TxsSummry.TtlCdtNtries = camtNbAndSum{NbOfNtries: fmt.Sprint(credits), Sum: s.TotalCredits.String()}
+	stmt.TxsSummry.TtlDbtNtries = camtNbAndSum{NbOfNtries: fmt.Sprint(debits), Sum: s.TotalDebits.String()}
+
+	doc := camtDocument{
+		Xmlns: camt053Namespace,
+		Stmt: camtBkToCstmrStmt{
+			GrpHdr: camtGrpHdr{MsgID: id, CreDtTm: created},
+			Stmt:   stmt,
+		},
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+func camtBalance(code string, amount decimal.Decimal, currency string, at time.Time) camtBal {
+	bal := camtBal{
+		Amt:       camtAmt{Ccy: currency, Value: amount.Abs().String()},
+		CdtDbtInd: indicator(amount),
+		Dt:        camtDtTime{DtTm: at.Format(time.RFC3339)},
+	}
+	bal.Tp.CdOrPrtry.Cd = code
+
+	return bal
+}
+
+// indicator returns the camt credit/debit indicator for a signed amount.
+func indicator(amount decimal.Decimal) string {
+	if amount.IsNegative() {
+		return debitIndicator
+	}
+
+	return creditIndicator
+}
@@ -0,0 +1,190 @@
+// Package statement renders account statements as JSON, CSV or ISO 20022
+// camt.053 XML, so every transport (HTTP, gRPC, CLI) emits byte-identical
+// documents.
+package statement
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// Format is a statement output format.
+type Format string
+
+const (
+	FormatJSON    Format = "json"
+	FormatCSV     Format = "csv"
+	FormatCamt053 Format = "camt053"
+)
+
+// ParseFormat parses a format name; empty means JSON. "camt.053" and "xml"
+// are accepted as aliases for camt053.
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "", "json":
+		return FormatJSON, nil
+	case "csv":
+		return FormatCSV, nil
+	case "camt053", "camt.053", "xml":
+		return FormatCamt053, nil
+	default:
+		return "", fmt.Errorf("unknown statement format %q (expected json, csv or camt053)", s)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv"
+	case FormatCamt053:
+		return "application/xml"
+	default:
+		return "application/json"
+	}
+}
+
+// FileName returns a download file name for a statement in this format.
+func (f Format) FileName(s *domain.Statement) string {
+	ext := string(f)
+	if f == FormatCamt053 {
+		ext = "xml"
+	}
+
+	return fmt.Sprintf("statement_%s_%s_%s.%s", s.AccountID,
+		s.PeriodStart.Format("20060102"), s.PeriodEnd.Format("20060102"), ext)
+}
+
+// Write renders s to w in the given format.
+func Write(w io.Writer, f Format, s *domain.Statement) error {
+	switch f {
+	case FormatJSON:
+		return WriteJSON(w, s)
+	case FormatCSV:
+		return WriteCSV(w, s)
+	case FormatCamt053:
+		return WriteCamt053(w, s)
+	default:
+		return fmt.Errorf("unknown statement format %q", f)
+	}
+}
+
+type jsonStatement struct {
+	PeriodStart    time.Time   `json:"period_start"`
+	PeriodEnd      time.Time   `json:"period_end"`
+	GeneratedAt    time.Time   `json:"generated_at"`
+	AccountID      string      `json:"account_id"`
+	AccountName    string      `json:"account_name"`
+	Currency       string      `json:"currency"`
+	OpeningBalance string      `json:"opening_balance"`
+	ClosingBalance string      `json:"closing_balance"`
+	TotalCredits   string      `json:"total_credits"`
+	TotalDebits    string      `json:"total_debits"`
+	Lines          []jsonEntry `json:"entries"`
+}
+
+type jsonEntry struct {
+	BookedAt              time.Time      `json:"booked_at"`
+	ValueAt               time.Time      `json:"value_at"`
+	Metadata              map[string]any `json:"metadata,omitempty"`
+	ReversedTransferID    *string        `json:"reversed_transfer_id,omitempty"`
+	EntryID               string         `json:"entry_id"`
+	TransferID            string         `json:"transfer_id"`
+	CounterpartyAccountID string         `json:"counterparty_account_id"`
+	Amount                string         `json:"amount"`
+	Balance               string         `json:"balance"`
+}
+
+// WriteJSON renders s as a single JSON document.
+func WriteJSON(w io.Writer, s *domain.Statement) error {
+	doc := jsonStatement{
+		PeriodStart:    s.PeriodStart,
+		PeriodEnd:      s.PeriodEnd,
+		GeneratedAt:    s.GeneratedAt,
+		AccountID:      s.AccountID,
+		AccountName:    s.AccountName,
+		Currency:       s.Currency,
+		OpeningBalance: s.OpeningBalance.String(),
+		ClosingBalance: s.ClosingBalance.String(),
+		TotalCredits:   s.TotalCredits.String(),
+		TotalDebits:    s.TotalDebits.String(),
+		Lines:          make([]jsonEntry, 0, len(s.Lines)),
+	}
+
+	for _, l := range s.Lines {
+		doc.Lines = append(doc.Lines, jsonEntry{
+			BookedAt:              l.BookedAt,
+			ValueAt:               l.ValueAt,
+			Metadata:              l.Metadata,
+			ReversedTransferID:    l.ReversedTransferID,
+			EntryID:               l.EntryID,
+			TransferID:            l.TransferID,
+			CounterpartyAccountID: l.CounterpartyAccountID,
+			Amount:                l.Amount.String(),
+			Balance:               l.Balance.String(),
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(doc)
+}
+
+// WriteCSV renders s as CSV: an "opening" row, one "entry" row per line and
+// a "closing" row, so the file is self-contained when imported elsewhere.
+// Metadata is JSON-encoded in the last column.
+func WriteCSV(w io.Writer, s *domain.Statement) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{
+		"type", "booked_at", "value_at", "entry_id", "transfer_id",
+		"counterparty_account_id", "amount", "balance", "currency", "metadata",
+	})
+
+	_ = cw.Write([]string{
+		"opening", s.PeriodStart.Format(time.RFC3339), "", "", "", "", "",
+		s.OpeningBalance.String(), s.Currency, "",
+	})
+
+	for _, l := range s.Lines {
+		metadata := ""
+		if len(l.Metadata) > 0 {
+			b, err := json.Marshal(l.Metadata)
+			if err != nil {
+				return err
+			}
+			metadata = string(b)
+		}
+
+		_ = cw.Write([]string{
+			"entry", l.BookedAt.Format(time.RFC3339Nano), l.ValueAt.Format(time.RFC3339Nano),
+			l.EntryID, l.TransferID, l.CounterpartyAccountID,
+			l.Amount.String(), l.Balance.String(), s.Currency, metadata,
+		})
+	}
+
+	_ = cw.Write([]string{
+		"closing", s.PeriodEnd.Format(time.RFC3339), "", "", "", "", "",
+		s.ClosingBalance.String(), s.Currency, "",
+	})
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+// ParseTime parses a statement period bound given either as RFC 3339 or as
+// a date (YYYY-MM-DD, midnight UTC).
+func ParseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+
+	return time.Parse(time.RFC3339, s)
+}
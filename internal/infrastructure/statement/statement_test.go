@@ -0,0 +1,174 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+func testStatement() *domain.Statement {
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	reversed := "tr-0"
+
+	return &domain.Statement{
+		AccountID:      "acc-1",
+		AccountName:    "Main",
+		Currency:       "USD",
+		PeriodStart:    from,
+		PeriodEnd:      from.AddDate(0, 1, 0),
+		GeneratedAt:    from.AddDate(0, 1, 1),
+		OpeningBalance: decimal.RequireFromString("100"),
+		ClosingBalance: decimal.RequireFromString("125.5"),
+		TotalCredits:   decimal.RequireFromString("50.5"),
+		TotalDebits:    decimal.RequireFromString("25"),
+		Lines: []domain.StatementLine{
+			{
+				EntryID: "e-1", TransferID: "tr-1", CounterpartyAccountID: "acc-2",
+				BookedAt: from.Add(time.Hour), ValueAt: from.Add(time.Hour),
+				Amount: decimal.RequireFromString("50.5"), Balance: decimal.RequireFromString("150.5"),
+				Metadata: map[string]any{"invoice": "INV-1"},
+			},
+			{
+				EntryID: "e-2", TransferID: "tr-2", CounterpartyAccountID: "acc-3",
+				BookedAt: from.Add(2 * time.Hour), ValueAt: from.Add(time.Hour),
+				Amount: decimal.RequireFromString("-25"), Balance: decimal.RequireFromString("125.5"),
+				ReversedTransferID: &reversed,
+			},
+		},
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := map[string]Format{
+		"":         FormatJSON,
+		"JSON":     FormatJSON,
+		"csv":      FormatCSV,
+		"camt.053": FormatCamt053,
+		"xml":      FormatCamt053,
+	}
+
+	for in, want := range tests {
+		got, err := ParseFormat(in)
+		if err != nil || got != want {
+			t.Fatalf("ParseFormat(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	if _, err := ParseFormat("pdf"); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	got, err := ParseTime("2026-09-01")
+	if err != nil || !got.Equal(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("ParseTime(date) = %v, %v", got, err)
+	}
+
+	got, err = ParseTime("2026-09-01T12:00:00+02:00")
+	if err != nil || !got.Equal(time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("ParseTime(RFC3339) = %v, %v", got, err)
+	}
+
+	if _, err := ParseTime("yesterday"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, testStatement()); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	var doc jsonStatement
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	if doc.OpeningBalance != "100" || doc.ClosingBalance != "125.5" || len(doc.Lines) != 2 {
+		t.Fatalf("unexpected document: %+v", doc)
+	}
+
+	if doc.Lines[0].Metadata["invoice"] != "INV-1" || doc.Lines[1].CounterpartyAccountID != "acc-3" {
+		t.Fatalf("unexpected entries: %+v", doc.Lines)
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testStatement()); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+
+	if len(rows) != 5 {
+		t.Fatalf("expected header, opening, 2 entries and closing; got %d rows", len(rows))
+	}
+
+	if rows[1][0] != "opening" || rows[1][7] != "100" {
+		t.Fatalf("unexpected opening row: %v", rows[1])
+	}
+
+	if rows[2][0] != "entry" || rows[2][6] != "50.5" || rows[2][9] != `{"invoice":"INV-1"}` {
+		t.Fatalf("unexpected entry row: %v", rows[2])
+	}
+
+	if rows[4][0] != "closing" || rows[4][7] != "125.5" {
+		t.Fatalf("unexpected closing row: %v", rows[4])
+	}
+}
+
+func TestWriteCamt053(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCamt053(&buf, testStatement()); err != nil {
+		t.Fatalf("WriteCamt053: %v", err)
+	}
+
+	var doc camtDocument
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("invalid XML: %v", err)
+	}
+
+	if doc.Xmlns != camt053Namespace {
+		t.Fatalf("unexpected namespace %q", doc.Xmlns)
+	}
+
+	stmt := doc.Stmt.Stmt
+	if stmt.Acct.ID.Othr.ID != "acc-1" || stmt.Acct.Ccy != "USD" {
+		t.Fatalf("unexpected account: %+v", stmt.Acct)
+	}
+
+	if len(stmt.Bal) != 2 || stmt.Bal[0].Tp.CdOrPrtry.Cd != "OPBD" || stmt.Bal[1].Amt.Value != "125.5" {
+		t.Fatalf("unexpected balances: %+v", stmt.Bal)
+	}
+
+	if len(stmt.Ntry) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(stmt.Ntry))
+	}
+
+	credit, debit := stmt.Ntry[0], stmt.Ntry[1]
+	if credit.CdtDbtInd != "CRDT" || credit.NtryDtls.TxDtls.RltdPties.DbtrAcct.ID.Othr.ID != "acc-2" {
+		t.Fatalf("unexpected credit entry: %+v", credit)
+	}
+
+	if debit.CdtDbtInd != "DBIT" || debit.Amt.Value != "25" || !debit.RvslInd ||
+		debit.NtryDtls.TxDtls.RltdPties.CdtrAcct.ID.Othr.ID != "acc-3" {
+		t.Fatalf("unexpected debit entry: %+v", debit)
+	}
+
+	if stmt.TxsSummry.TtlCdtNtries.NbOfNtries != "1" || stmt.TxsSummry.TtlNtries.TtlNetNtry.Amt != "25.5" {
+		t.Fatalf("unexpected summary: %+v", stmt.TxsSummry)
+	}
+}
@@ -0,0 +1,210 @@
+// Package transferimport reads transfer import files (CSV or NDJSON) into
+// usecase.ImportRecords, one row at a time, so arbitrarily large files can
+// be imported without loading them into memory.
+package transferimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// Format is an import file format.
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// Column (and NDJSON field) names.
+const (
+	fieldIdempotencyKey = "idempotency_key"
+	fieldFromAccountID  = "from_account_id"
+	fieldToAccountID    = "to_account_id"
+	fieldAmount         = "amount"
+	fieldEventAt        = "event_at"
+	fieldMetadata       = "metadata"
+)
+
+// maxLineSize bounds a single NDJSON line.
+const maxLineSize = 1 << 20
+
+// ParseFormat parses a format name. When s is empty the format is inferred
+// from the file extension (.csv, .ndjson, .jsonl).
+func ParseFormat(s, path string) (Format, error) {
+	if s == "" {
+		s = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	switch strings.ToLower(s) {
+	case "csv":
+		return FormatCSV, nil
+	case "ndjson", "jsonl":
+		return FormatNDJSON, nil
+	default:
+		return "", fmt.Errorf("unknown import format %q (expected csv or ndjson)", s)
+	}
+}
+
+// NewReader returns a reader for r in the given format.
+func NewReader(r io.Reader, f Format) (usecase.ImportSource, error) {
+	switch f {
+	case FormatCSV:
+		return NewCSVReader(r)
+	case FormatNDJSON:
+		return NewNDJSONReader(r), nil
+	default:
+		return nil, fmt.Errorf("unknown import format %q", f)
+	}
+}
+
+// CSVReader reads a CSV file with a header row. from_account_id,
+// to_account_id and amount are required columns; event_at, idempotency_key
+// and metadata (a JSON object) are optional. Unknown columns are ignored.
+// Line numbers are 1-based physical rows, the header being line 1.
+type CSVReader struct {
+	r       *csv.Reader
+	columns map[string]int
+	line    int
+}
+
+// NewCSVReader reads and checks the header row.
+func NewCSVReader(r io.Reader) (*CSVReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("import file is empty")
+		}
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{fieldFromAccountID, fieldToAccountID, fieldAmount} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing required column %q", required)
+		}
+	}
+
+	return &CSVReader{r: cr, columns: columns, line: 1}, nil
+}
+
+// Next returns the next record, or io.EOF.
+func (r *CSVReader) Next() (*usecase.ImportRecord, error) {
+	row, err := r.r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+
+	r.line++
+	rec := &usecase.ImportRecord{Line: r.line}
+
+	if err != nil {
+		var parseErr *csv.ParseError
+		if !errors.As(err, &parseErr) {
+			return nil, err
+		}
+		rec.Err = err
+		return rec, nil
+	}
+
+	field := func(name string) string {
+		i, ok := r.columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	rec.IdempotencyKey = field(fieldIdempotencyKey)
+	rec.FromAccountID = field(fieldFromAccountID)
+	rec.ToAccountID = field(fieldToAccountID)
+	rec.Amount = field(fieldAmount)
+	rec.EventAt = field(fieldEventAt)
+
+	if raw := field(fieldMetadata); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &rec.Metadata); err != nil {
+			rec.Err = fmt.Errorf("metadata must be a JSON object: %w", err)
+		}
+	}
+
+	return rec, nil
+}
+
+// NDJSONReader reads one JSON object per line, with the same field names as
+// the CSV columns. amount may be a string or a number; blank lines are
+// skipped.
+type NDJSONReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+// NewNDJSONReader creates a new NDJSONReader.
+func NewNDJSONReader(r io.Reader) *NDJSONReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	return &NDJSONReader{s: s}
+}
+
+type ndjsonRow struct {
+	Metadata       map[string]any `json:"metadata"`
+	IdempotencyKey string         `json:"idempotency_key"`
+	FromAccountID  string         `json:"from_account_id"`
+	ToAccountID    string         `json:"to_account_id"`
+	Amount         json.Number    `json:"amount"`
+	EventAt        string         `json:"event_at"`
+}
+
+// Next returns the next record, or io.EOF.
+func (r *NDJSONReader) Next() (*usecase.ImportRecord, error) {
+	for r.s.Scan() {
+		r.line++
+
+		b := bytes.TrimSpace(r.s.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+
+		rec := &usecase.ImportRecord{Line: r.line}
+
+		var row ndjsonRow
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.UseNumber()
+		if err := dec.Decode(&row); err != nil {
+			rec.Err = err
+			return rec, nil
+		}
+
+		rec.IdempotencyKey = row.IdempotencyKey
+		rec.FromAccountID = row.FromAccountID
+		rec.ToAccountID = row.ToAccountID
+		rec.Amount = row.Amount.String()
+		rec.EventAt = row.EventAt
+		rec.Metadata = row.Metadata
+
+		return rec, nil
+	}
+
+	if err := r.s.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
@@ -0,0 +1,124 @@
+package transferimport
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/iho/goledger/internal/usecase"
+)
+
+func readAll(t *testing.T, src usecase.ImportSource) []*usecase.ImportRecord {
+	t.Helper()
+
+	var records []*usecase.ImportRecord
+	for {
+		rec, err := src.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		records = append(records, rec)
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name, format, path string
+		want               Format
+		wantErr            bool
+	}{
+		{name: "explicit csv", format: "CSV", path: "x.txt", want: FormatCSV},
+		{name: "csv extension", path: "/tmp/history.csv", want: FormatCSV},
+		{name: "jsonl extension", path: "history.jsonl", want: FormatNDJSON},
+		{name: "explicit ndjson", format: "ndjson", want: FormatNDJSON},
+		{name: "unknown", path: "history.xlsx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFormat(tt.format, tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	input := strings.Join([]string{
+		"from_account_id,to_account_id,amount,event_at,idempotency_key,metadata,ignored",
+		`acc-1,acc-2,10.50,2024-01-02T03:04:05Z,k-1,"{""invoice"":""INV-1""}",x`,
+		"acc-2,acc-3,1",
+		`acc-1,acc-2,5,,,not-json`,
+	}, "\n")
+
+	r, err := NewCSVReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("NewCSVReader() error = %v", err)
+	}
+
+	records := readAll(t, r)
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+
+	first := records[0]
+	if first.Line != 2 || first.FromAccountID != "acc-1" || first.ToAccountID != "acc-2" ||
+		first.Amount != "10.50" || first.EventAt != "2024-01-02T03:04:05Z" || first.IdempotencyKey != "k-1" {
+		t.Errorf("unexpected first record: %+v", first)
+	}
+	if first.Metadata["invoice"] != "INV-1" {
+		t.Errorf("metadata = %v", first.Metadata)
+	}
+
+	if second := records[1]; second.Line != 3 || second.Amount != "1" || second.EventAt != "" || second.Err != nil {
+		t.Errorf("unexpected second record: %+v", second)
+	}
+
+	if third := records[2]; third.Line != 4 || third.Err == nil {
+		t.Errorf("expected a metadata error on line 4, got %+v", third)
+	}
+}
+
+func TestCSVReader_MissingColumn(t *testing.T) {
+	if _, err := NewCSVReader(strings.NewReader("from_account_id,amount\n")); err == nil {
+		t.Fatal("expected an error for a missing to_account_id column")
+	}
+
+	if _, err := NewCSVReader(strings.NewReader("")); err == nil {
+		t.Fatal("expected an error for an empty file")
+	}
+}
+
+func TestNDJSONReader(t *testing.T) {
+	input := strings.Join([]string{
+		`{"from_account_id":"acc-1","to_account_id":"acc-2","amount":"10.50","metadata":{"k":"v"}}`,
+		``,
+		`{"from_account_id":"acc-1","to_account_id":"acc-2","amount":7.25,"idempotency_key":"k-2"}`,
+		`{not json`,
+	}, "\n")
+
+	records := readAll(t, NewNDJSONReader(strings.NewReader(input)))
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+
+	if records[0].Line != 1 || records[0].Amount != "10.50" || records[0].Metadata["k"] != "v" {
+		t.Errorf("unexpected first record: %+v", records[0])
+	}
+
+	if records[1].Line != 3 || records[1].Amount != "7.25" || records[1].IdempotencyKey != "k-2" {
+		t.Errorf("unexpected second record: %+v", records[1])
+	}
+
+	if records[2].Line != 4 || records[2].Err == nil {
+		t.Errorf("expected a parse error on line 4, got %+v", records[2])
+	}
+}
@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/usecase"
+)
+
+// Deliverer is the subset of WebhookUseCase the dispatcher depends on, so
+// tests can supply a fake without a real database.
+type Deliverer interface {
+	DeliverDue(ctx context.Context, limit int) (*usecase.WebhookDispatchReport, error)
+}
+
+// Dispatcher periodically sends due webhook deliveries.
+type Dispatcher struct {
+	webhookUC Deliverer
+	logger    *slog.Logger
+	metrics   *metrics.Metrics
+	interval  time.Duration
+	batchSize int
+}
+
+// Config for Dispatcher.
+type Config struct {
+	WebhookUC Deliverer
+	Logger    *slog.Logger
+	Metrics   *metrics.Metrics
+	// Interval is how often due deliveries are polled. Defaults to 2s.
+	Interval time.Duration
+	// BatchSize is how many deliveries are claimed at a time. Defaults
+	// to 50.
+	BatchSize int
+}
+
+// NewDispatcher creates a new Dispatcher.
+func NewDispatcher(cfg Config) *Dispatcher {
+	if cfg.Logger == nil {
+		cfg.Logger = slog.Default()
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = 2 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+
+	return &Dispatcher{
+		webhookUC: cfg.WebhookUC,
+		logger:    cfg.Logger,
+		metrics:   cfg.Metrics,
+		interval:  cfg.Interval,
+		batchSize: cfg.BatchSize,
+	}
+}
+
+// Start sends due deliveries on a ticker until the context is cancelled.
+func (d *Dispatcher) Start(ctx context.Context) error {
+	d.logger.Info("webhook dispatcher started",
+		slog.Duration("interval", d.interval),
+		slog.Int("batch_size", d.batchSize))
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	d.runOnce(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook dispatcher shutting down")
+			return ctx.Err()
+		case <-ticker.C:
+			d.runOnce(ctx)
+		}
+	}
+}
+
+// runOnce keeps claiming batches while full ones come back, so a backlog
+// drains without waiting a tick per batch. Errors are logged but never
+// fatal to the dispatcher loop.
+func (d *Dispatcher) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		report, err := d.webhookUC.DeliverDue(ctx, d.batchSize)
+		if report != nil {
+			d.record(report)
+		}
+
+		if err != nil {
+			d.logger.Error("webhook dispatch failed", slog.String("error", err.Error()))
+			return
+		}
+
+		if report.Delivered+report.Failed+report.DeadLettered < d.batchSize {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) record(report *usecase.WebhookDispatchReport) {
+	if report.DeadLettered > 0 {
+		d.logger.Error("webhook deliveries dead-lettered after exhausting attempts",
+			slog.Int("count", report.DeadLettered))
+	}
+
+	if report.Delivered > 0 || report.Failed > 0 {
+		d.logger.Info("webhook deliveries attempted",
+			slog.Int("delivered", report.Delivered),
+			slog.Int("failed", report.Failed))
+	}
+
+	if d.metrics == nil {
+		return
+	}
+
+	d.metrics.WebhookDeliveries.WithLabelValues("delivered").Add(float64(report.Delivered))
+	d.metrics.WebhookDeliveries.WithLabelValues("failed").Add(float64(report.Failed))
+	d.metrics.WebhookDeliveries.WithLabelValues("dead_lettered").Add(float64(report.DeadLettered))
+}
@@ -0,0 +1,68 @@
+// Package webhook delivers queued webhook deliveries over HTTP, signed with
+// the subscription's secret as described in pkg/webhooksig.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/pkg/webhooksig"
+)
+
+// maxDrainBytes bounds how much of a response body is read (and discarded)
+// so the connection can be reused without trusting the receiver's size.
+const maxDrainBytes = 64 * 1024
+
+// HTTPSender implements usecase.WebhookSender with an HTTP client.
+type HTTPSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewHTTPSender creates an HTTPSender whose requests time out after
+// timeout. Redirects are not followed: a receiver must answer at the URL it
+// registered.
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// Send POSTs the delivery body and returns the response status code.
+func (s *HTTPSender) Send(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return 0, err
+	}
+
+	now := s.now()
+
+	req.Header.Set("Content-Type", domain.CloudEventsContentType)
+	req.Header.Set("User-Agent", "goledger-webhooks/1")
+	req.Header.Set(webhooksig.HeaderDeliveryID, delivery.ID)
+	req.Header.Set(webhooksig.HeaderEventID, delivery.EventID)
+	req.Header.Set(webhooksig.HeaderEventType, delivery.EventType)
+	req.Header.Set(webhooksig.HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(webhooksig.HeaderSignature, webhooksig.Sign(sub.Secret, now, delivery.Body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,152 @@
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/infrastructure/metrics"
+	"github.com/iho/goledger/internal/infrastructure/webhook"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/pkg/webhooksig"
+)
+
+func TestHTTPSender_SignsDelivery(t *testing.T) {
+	var gotErr error
+	var gotHeader http.Header
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotHeader = r.Header
+		gotErr = webhooksig.Verify("whsec_test", r.Header, body, webhooksig.DefaultTolerance)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	sub := &domain.WebhookSubscription{ID: "wh-1", URL: srv.URL, Secret: "whsec_test"}
+	delivery := &domain.WebhookDelivery{ID: "del-1", EventID: "evt-1", EventType: "transfer.created", Body: []byte(`{"id":"evt-1"}`)}
+
+	status, err := webhook.NewHTTPSender(5*time.Second).Send(context.Background(), sub, delivery)
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if status != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", status)
+	}
+
+	if gotErr != nil {
+		t.Fatalf("receiver could not verify the signature: %v", gotErr)
+	}
+
+	if gotHeader.Get(webhooksig.HeaderDeliveryID) != "del-1" || gotHeader.Get(webhooksig.HeaderEventType) != "transfer.created" {
+		t.Fatalf("unexpected headers: %v", gotHeader)
+	}
+}
+
+func TestHTTPSender_DoesNotFollowRedirects(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/elsewhere", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	sub := &domain.WebhookSubscription{URL: srv.URL, Secret: "s"}
+
+	status, err := webhook.NewHTTPSender(5*time.Second).Send(context.Background(), sub, &domain.WebhookDelivery{Body: []byte(`{}`)})
+	if err != nil || status != http.StatusFound {
+		t.Fatalf("expected a 302 without following it, got %d (err %v)", status, err)
+	}
+}
+
+type fakeDeliverer struct {
+	reports []*usecase.WebhookDispatchReport
+	err     error
+	calls   int
+}
+
+func (f *fakeDeliverer) DeliverDue(context.Context, int) (*usecase.WebhookDispatchReport, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(f.reports) == 0 {
+		return &usecase.WebhookDispatchReport{}, nil
+	}
+
+	report := f.reports[0]
+	f.reports = f.reports[1:]
+
+	return report, nil
+}
+
+// newTestMetrics registers metrics against a fresh registry so tests don't
+// collide with the process-wide default Prometheus registry.
+func newTestMetrics(t *testing.T) *metrics.Metrics {
+	t.Helper()
+
+	registry := prometheus.NewRegistry()
+	prevRegisterer, prevGatherer := prometheus.DefaultRegisterer, prometheus.DefaultGatherer
+	prometheus.DefaultRegisterer = registry
+	prometheus.DefaultGatherer = registry
+	t.Cleanup(func() {
+		prometheus.DefaultRegisterer, prometheus.DefaultGatherer = prevRegisterer, prevGatherer
+	})
+
+	return metrics.New()
+}
+
+func runBriefly(t *testing.T, d *webhook.Dispatcher) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := d.Start(ctx)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDispatcher_DrainsFullBatchesAndRecordsMetrics(t *testing.T) {
+	m := newTestMetrics(t)
+	deliverer := &fakeDeliverer{reports: []*usecase.WebhookDispatchReport{
+		{Delivered: 1, Failed: 1},
+		{Delivered: 1, DeadLettered: 1},
+		{Delivered: 1},
+	}}
+
+	d := webhook.NewDispatcher(webhook.Config{WebhookUC: deliverer, Metrics: m, Interval: time.Hour, BatchSize: 2})
+	runBriefly(t, d)
+
+	// Two full batches, then a short one ends the pass.
+	if deliverer.calls != 3 {
+		t.Fatalf("expected 3 DeliverDue calls, got %d", deliverer.calls)
+	}
+
+	if got := testutil.ToFloat64(m.WebhookDeliveries.WithLabelValues("delivered")); got != 3 {
+		t.Fatalf("expected 3 delivered, got %v", got)
+	}
+
+	if got := testutil.ToFloat64(m.WebhookDeliveries.WithLabelValues("dead_lettered")); got != 1 {
+		t.Fatalf("expected 1 dead-lettered, got %v", got)
+	}
+}
+
+func TestDispatcher_SurvivesErrors(t *testing.T) {
+	deliverer := &fakeDeliverer{err: errors.New("db down")}
+
+	d := webhook.NewDispatcher(webhook.Config{WebhookUC: deliverer, Interval: 10 * time.Millisecond})
+	runBriefly(t, d)
+
+	if deliverer.calls < 2 {
+		t.Fatalf("expected the dispatcher to keep polling after an error, got %d calls", deliverer.calls)
+	}
+}
@@ -160,6 +160,12 @@ func (uc *AccountUseCase) GetAccount(ctx context.Context, id string) (*domain.Ac
 	return uc.accountRepo.GetByID(ctx, id)
 }
 
+// GetAccountsByIDs retrieves the accounts with the given IDs in one query,
+// in no particular order; unknown IDs are skipped.
+func (uc *AccountUseCase) GetAccountsByIDs(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	return uc.accountRepo.GetByIDs(ctx, ids)
+}
+
 // ListAccountsInput represents input for listing accounts.
 type ListAccountsInput struct {
 	Limit  int
@@ -178,3 +184,41 @@ func (uc *AccountUseCase) ListAccounts(ctx context.Context, input ListAccountsIn
 
 	return uc.accountRepo.List(ctx, input.Limit, input.Offset)
 }
+
+// ListAccountsCursorInput represents input for cursor-paginated account
+// listing.
+type ListAccountsCursorInput struct {
+	Cursor string
+	Limit  int
+}
+
+// ListAccountsCursorResult is a page of accounts plus the cursor to request
+// the next page. NextCursor is empty when there are no more results.
+type ListAccountsCursorResult struct {
+	Accounts   []*domain.Account
+	NextCursor string
+}
+
+// ListAccountsCursor lists accounts using keyset pagination (see
+// AccountRepository.ListCursor).
+func (uc *AccountUseCase) ListAccountsCursor(ctx context.Context, input ListAccountsCursorInput) (*ListAccountsCursorResult, error) {
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+
+	if input.Limit > 100 {
+		input.Limit = 100
+	}
+
+	accounts, err := uc.accountRepo.ListCursor(ctx, input.Cursor, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListAccountsCursorResult{Accounts: accounts}
+	if len(accounts) == input.Limit {
+		result.NextCursor = accounts[len(accounts)-1].ID
+	}
+
+	return result, nil
+}
@@ -120,6 +120,33 @@ func TestAccountUseCase_ListAccounts(t *testing.T) {
 	}
 }
 
+func TestAccountUseCase_ListAccountsCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockAccountRepository(ctrl)
+	repo.EXPECT().ListCursor(gomock.Any(), "acc-9", 2).Return([]*domain.Account{{ID: "acc-8"}, {ID: "acc-7"}}, nil)
+	repo.EXPECT().ListCursor(gomock.Any(), "acc-7", 2).Return([]*domain.Account{{ID: "acc-6"}}, nil)
+
+	uc := usecase.NewAccountUseCase(mocks.NewMockTransactionManager(ctrl), repo, nil, mocks.NewMockIDGenerator(ctrl), nil)
+
+	page, err := uc.ListAccountsCursor(context.Background(), usecase.ListAccountsCursorInput{Cursor: "acc-9", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Accounts) != 2 || page.NextCursor != "acc-7" {
+		t.Fatalf("unexpected first page: %+v", page)
+	}
+
+	page, err = uc.ListAccountsCursor(context.Background(), usecase.ListAccountsCursorInput{Cursor: page.NextCursor, Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Accounts) != 1 || page.NextCursor != "" {
+		t.Fatalf("expected a short last page without a cursor, got %+v", page)
+	}
+}
+
 func TestAccountUseCase_CreateAccount_Error(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -0,0 +1,504 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// TransferCreator is the subset of TransferUseCase the accrual engine posts
+// through, so accruals get the same locking, balance validation, outbox
+// event and audit trail as any other transfer.
+type TransferCreator interface {
+	CreateTransfer(ctx context.Context, input CreateTransferInput) (*domain.Transfer, error)
+	GetTransferByIdempotencyKey(ctx context.Context, key string) (*domain.Transfer, error)
+}
+
+const (
+	// accrualClaimTimeout is how long a claim may stay pending before
+	// RunAccruals assumes its runner died and re-drives it.
+	accrualClaimTimeout = 15 * time.Minute
+
+	// staleAccrualRunBatch caps how many stale claims one pass re-drives.
+	staleAccrualRunBatch = 100
+)
+
+// AccrualUseCase manages interest/fee accrual rules and runs them.
+type AccrualUseCase struct {
+	accrualRepo AccrualRepository
+	accountRepo AccountRepository
+	entryRepo   EntryRepository
+	transfers   TransferCreator
+	idGen       IDGenerator
+	logger      *slog.Logger
+}
+
+// NewAccrualUseCase creates a new AccrualUseCase.
+func NewAccrualUseCase(
+	accrualRepo AccrualRepository,
+	accountRepo AccountRepository,
+	entryRepo EntryRepository,
+	transfers TransferCreator,
+	idGen IDGenerator,
+) *AccrualUseCase {
+	return &AccrualUseCase{
+		accrualRepo: accrualRepo,
+		accountRepo: accountRepo,
+		entryRepo:   entryRepo,
+		transfers:   transfers,
+		idGen:       idGen,
+		logger:      slog.Default(),
+	}
+}
+
+// CreateAccrualRuleInput represents input for creating an accrual rule.
+// Exactly one of AccountID and AccountGroup must be set.
+type CreateAccrualRuleInput struct {
+	Name                  string
+	Kind                  domain.AccrualKind
+	Schedule              domain.AccrualSchedule
+	AccountID             string
+	AccountGroup          string
+	CounterpartyAccountID string
+	Rate                  decimal.Decimal
+	Amount                decimal.Decimal
+	// Precision is the number of decimal places amounts are rounded to;
+	// nil means domain.DefaultAccrualPrecision.
+	Precision *int32
+}
+
+// CreateRule validates and stores a new, active accrual rule.
+func (uc *AccrualUseCase) CreateRule(ctx context.Context, input CreateAccrualRuleInput) (*domain.AccrualRule, error) {
+	now := time.Now().UTC()
+
+	rule := &domain.AccrualRule{
+		ID:                    uc.idGen.Generate(),
+		Name:                  input.Name,
+		Kind:                  input.Kind,
+		Schedule:              input.Schedule,
+		CounterpartyAccountID: input.CounterpartyAccountID,
+		Rate:                  input.Rate,
+		Amount:                input.Amount,
+		Precision:             domain.DefaultAccrualPrecision,
+		Active:                true,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	if input.AccountID != "" {
+		rule.AccountID = &input.AccountID
+	}
+	if input.AccountGroup != "" {
+		rule.AccountGroup = &input.AccountGroup
+	}
+	if input.Precision != nil {
+		rule.Precision = *input.Precision
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	counterparty, err := uc.accountRepo.GetByID(ctx, rule.CounterpartyAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.AccountID != nil {
+		account, err := uc.accountRepo.GetByID(ctx, *rule.AccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		if account.Currency != counterparty.Currency {
+			return nil, domain.ErrCurrencyMismatch
+		}
+	}
+
+	if rule.AccountGroup != nil {
+		if err := uc.checkGroupCurrency(ctx, *rule.AccountGroup, counterparty); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.accrualRepo.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetRule retrieves an accrual rule by ID.
+func (uc *AccrualUseCase) GetRule(ctx context.Context, id string) (*domain.AccrualRule, error) {
+	return uc.accrualRepo.GetRule(ctx, id)
+}
+
+// ListRules lists accrual rules.
+func (uc *AccrualUseCase) ListRules(ctx context.Context, limit, offset int) ([]*domain.AccrualRule, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.accrualRepo.ListRules(ctx, limit, offset)
+}
+
+// SetRuleActive enables or disables an accrual rule. Disabled rules are
+// ignored by RunAccruals; their past runs are kept.
+func (uc *AccrualUseCase) SetRuleActive(ctx context.Context, id string, active bool) error {
+	rule, err := uc.accrualRepo.GetRule(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// Members may have joined the group while the rule was disabled.
+	if active && rule.AccountGroup != nil {
+		counterparty, err := uc.accountRepo.GetByID(ctx, rule.CounterpartyAccountID)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.checkGroupCurrency(ctx, *rule.AccountGroup, counterparty); err != nil {
+			return err
+		}
+	}
+
+	return uc.accrualRepo.SetRuleActive(ctx, id, active, time.Now().UTC())
+}
+
+// AddAccountToGroup adds an account to an account group, making it subject
+// to every rule attached to that group. The account must be in the
+// currency of every active rule's counterparty.
+func (uc *AccrualUseCase) AddAccountToGroup(ctx context.Context, group, accountID string) error {
+	if group == "" {
+		return fmt.Errorf("%w: group name is required", domain.ErrInvalidAccrualRule)
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	rules, err := uc.accrualRepo.ListActiveRules(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if rule.AccountGroup == nil || *rule.AccountGroup != group || rule.CounterpartyAccountID == accountID {
+			continue
+		}
+
+		counterparty, err := uc.accountRepo.GetByID(ctx, rule.CounterpartyAccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Currency != counterparty.Currency {
+			return fmt.Errorf("%w: account %s is %s but accrual rule %s posts in %s",
+				domain.ErrCurrencyMismatch, accountID, account.Currency, rule.ID, counterparty.Currency)
+		}
+	}
+
+	return uc.accrualRepo.AddGroupMember(ctx, group, accountID, time.Now().UTC())
+}
+
+// checkGroupCurrency verifies every member of group (other than the
+// counterparty itself) is in the counterparty's currency.
+func (uc *AccrualUseCase) checkGroupCurrency(ctx context.Context, group string, counterparty *domain.Account) error {
+	members, err := uc.accrualRepo.ListGroupMembers(ctx, group)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range members {
+		if id == counterparty.ID {
+			continue
+		}
+
+		account, err := uc.accountRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if account.Currency != counterparty.Currency {
+			return fmt.Errorf("%w: group %s member %s is %s, counterparty is %s",
+				domain.ErrCurrencyMismatch, group, id, account.Currency, counterparty.Currency)
+		}
+	}
+
+	return nil
+}
+
+// RemoveAccountFromGroup removes an account from an account group.
+func (uc *AccrualUseCase) RemoveAccountFromGroup(ctx context.Context, group, accountID string) error {
+	return uc.accrualRepo.RemoveGroupMember(ctx, group, accountID)
+}
+
+// ListRuns lists accrual runs, optionally filtered to one rule.
+func (uc *AccrualUseCase) ListRuns(ctx context.Context, ruleID string, limit, offset int) ([]*domain.AccrualRun, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.accrualRepo.ListRuns(ctx, ruleID, limit, offset)
+}
+
+// AccrualFailure describes one (rule, account) accrual that could not be
+// posted. Unless its transfer was already posted, the claim is released so
+// the next run for the same day retries it; claims that could not be
+// released are re-driven once stale.
+type AccrualFailure struct {
+	RuleID    string
+	AccountID string
+	Error     string
+}
+
+// AccrualReport summarizes one RunAccruals pass.
+type AccrualReport struct {
+	Day              time.Time
+	Runs             []*domain.AccrualRun
+	Failures         []AccrualFailure
+	Posted           int
+	Skipped          int
+	AlreadyProcessed int
+	// Redriven counts stale pending claims from earlier passes that this
+	// pass picked up again; their outcomes are included in the totals.
+	Redriven int
+}
+
+// RunAccruals computes and posts every active rule's accruals for the
+// periods that close on day (a completed UTC business day): daily rules
+// accrue for day itself, monthly rules only when day is the last day of the
+// month. Balances are end-of-period balances reconstructed from the entry
+// history, so running late (or re-running) gives the same result.
+//
+// Each (rule, account, period) is claimed before its transfer is posted, so
+// re-running a day that was already processed is a no-op. Claims left
+// pending for longer than accrualClaimTimeout (a crashed runner, or a
+// failed release) are re-driven first, whatever day they belong to; the
+// transfer's idempotency key is the run ID, so that never posts twice.
+// Per-account failures are collected in the report rather than aborting
+// the pass.
+func (uc *AccrualUseCase) RunAccruals(ctx context.Context, day time.Time) (*AccrualReport, error) {
+	periodDay, _, _ := domain.AccrualPeriod(domain.AccrualScheduleDaily, day)
+	report := &AccrualReport{
+		Day:      periodDay,
+		Runs:     make([]*domain.AccrualRun, 0),
+		Failures: make([]AccrualFailure, 0),
+	}
+
+	if err := uc.redriveStaleRuns(ctx, report); err != nil {
+		return nil, err
+	}
+
+	rules, err := uc.accrualRepo.ListActiveRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		start, end, complete := domain.AccrualPeriod(rule.Schedule, day)
+		if !complete {
+			continue
+		}
+
+		// Never accrue retroactively for periods that ended before the
+		// rule existed.
+		if !end.After(rule.CreatedAt) {
+			continue
+		}
+
+		accountIDs, err := uc.ruleTargets(ctx, rule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve accounts for accrual rule %s: %w", rule.ID, err)
+		}
+
+		for _, accountID := range accountIDs {
+			run, claimed, err := uc.accrue(ctx, rule, accountID, start, end)
+			if err == nil && !claimed {
+				report.AlreadyProcessed++
+				continue
+			}
+
+			report.record(rule.ID, accountID, run, err)
+		}
+	}
+
+	return report, nil
+}
+
+// record adds the outcome of one claimed accrual to the report.
+func (r *AccrualReport) record(ruleID, accountID string, run *domain.AccrualRun, err error) {
+	switch {
+	case err != nil:
+		r.Failures = append(r.Failures, AccrualFailure{
+			RuleID:    ruleID,
+			AccountID: accountID,
+			Error:     err.Error(),
+		})
+	case run.Status == domain.AccrualRunStatusPosted:
+		r.Posted++
+		r.Runs = append(r.Runs, run)
+	default:
+		r.Skipped++
+		r.Runs = append(r.Runs, run)
+	}
+}
+
+// redriveStaleRuns finishes claims an earlier pass left pending. Failures
+// leave the claim pending, so it is retried (and reported) on every pass
+// until it posts.
+func (uc *AccrualUseCase) redriveStaleRuns(ctx context.Context, report *AccrualReport) error {
+	runs, err := uc.accrualRepo.ListStalePendingRuns(ctx, time.Now().UTC().Add(-accrualClaimTimeout), staleAccrualRunBatch)
+	if err != nil {
+		return fmt.Errorf("failed to list stale accrual runs: %w", err)
+	}
+
+	for _, run := range runs {
+		report.Redriven++
+
+		rule, err := uc.accrualRepo.GetRule(ctx, run.RuleID)
+		if err == nil {
+			start, end, _ := domain.AccrualPeriod(rule.Schedule, run.PeriodStart)
+			err = uc.post(ctx, rule, run, start, end)
+		}
+
+		report.record(run.RuleID, run.AccountID, run, err)
+	}
+
+	return nil
+}
+
+// ruleTargets returns the accounts a rule applies to. Group members that
+// are the rule's own counterparty are skipped.
+func (uc *AccrualUseCase) ruleTargets(ctx context.Context, rule *domain.AccrualRule) ([]string, error) {
+	if rule.AccountID != nil {
+		return []string{*rule.AccountID}, nil
+	}
+
+	members, err := uc.accrualRepo.ListGroupMembers(ctx, *rule.AccountGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]string, 0, len(members))
+	for _, id := range members {
+		if id != rule.CounterpartyAccountID {
+			targets = append(targets, id)
+		}
+	}
+
+	return targets, nil
+}
+
+// accrue claims, computes and posts a single (rule, account, period)
+// accrual. claimed is false when the period was already processed.
+func (uc *AccrualUseCase) accrue(ctx context.Context, rule *domain.AccrualRule, accountID string, start, end time.Time) (*domain.AccrualRun, bool, error) {
+	run := &domain.AccrualRun{
+		ID:          uc.idGen.Generate(),
+		RuleID:      rule.ID,
+		AccountID:   accountID,
+		PeriodStart: start,
+		Status:      domain.AccrualRunStatusPending,
+		CreatedAt:   time.Now().UTC(),
+	}
+
+	claimed, err := uc.accrualRepo.ClaimRun(ctx, run)
+	if err != nil || !claimed {
+		return nil, false, err
+	}
+
+	if err := uc.post(ctx, rule, run, start, end); err != nil {
+		// Release the claim on any failure before the transfer is known to
+		// be posted, so the next run retries this period instead of
+		// silently skipping it. A transfer that committed without us
+		// learning of it is adopted by that retry through the period's
+		// idempotency key.
+		if run.TransferID == nil {
+			uc.releaseRun(ctx, run)
+		}
+		return nil, true, err
+	}
+
+	return run, true, nil
+}
+
+// post computes a claimed run's amount from the end-of-period balance,
+// posts its transfer and completes the run. The transfer's idempotency key
+// is derived from the run's rule, account and period: if an earlier claim
+// of the same period already posted it, that transfer is adopted instead of
+// posting a second one.
+func (uc *AccrualUseCase) post(ctx context.Context, rule *domain.AccrualRule, run *domain.AccrualRun, start, end time.Time) error {
+	// The last instant of the period; entries are stored with microsecond
+	// precision.
+	periodClose := end.Add(-time.Microsecond)
+
+	balance, err := uc.entryRepo.GetBalanceAtTime(ctx, run.AccountID, periodClose)
+	if err != nil {
+		return err
+	}
+
+	run.Balance = balance
+	run.Amount = rule.Compute(balance)
+	run.Status = domain.AccrualRunStatusSkipped
+
+	if run.Amount.IsPositive() {
+		input := CreateTransferInput{
+			FromAccountID:  rule.CounterpartyAccountID,
+			ToAccountID:    run.AccountID,
+			Amount:         run.Amount,
+			EventAt:        &periodClose,
+			SkipFees:       true,
+			IdempotencyKey: run.IdempotencyKey(),
+			Metadata: map[string]any{
+				"accrual_rule_id":      rule.ID,
+				"accrual_run_id":       run.ID,
+				"accrual_kind":         string(rule.Kind),
+				"accrual_period_start": start.Format(time.DateOnly),
+			},
+		}
+		if rule.Kind == domain.AccrualKindFee {
+			input.FromAccountID, input.ToAccountID = run.AccountID, rule.CounterpartyAccountID
+		}
+
+		transfer, err := uc.transfers.CreateTransfer(ctx, input)
+		if errors.Is(err, domain.ErrDuplicateIdempotencyKey) {
+			transfer, err = uc.transfers.GetTransferByIdempotencyKey(ctx, run.IdempotencyKey())
+		}
+		if err != nil {
+			return err
+		}
+
+		run.TransferID = &transfer.ID
+		run.Status = domain.AccrualRunStatusPosted
+	}
+
+	run.UpdatedAt = time.Now().UTC()
+	if err := uc.accrualRepo.CompleteRun(ctx, run); err != nil {
+		// The transfer (if any) is already posted; the claim stays pending
+		// so it is re-driven, adopting that transfer, once stale.
+		return fmt.Errorf("accrual posted but run %s not completed: %w", run.ID, err)
+	}
+
+	return nil
+}
+
+// releaseRun deletes a failed claim. It runs even if ctx was cancelled
+// (that is often why posting failed); a claim that can't be released is
+// logged and left for redriveStaleRuns.
+func (uc *AccrualUseCase) releaseRun(ctx context.Context, run *domain.AccrualRun) {
+	if err := uc.accrualRepo.ReleaseRun(context.WithoutCancel(ctx), run.ID); err != nil {
+		uc.logger.Error("failed to release accrual claim",
+			slog.String("run_id", run.ID),
+			slog.String("rule_id", run.RuleID),
+			slog.String("account_id", run.AccountID),
+			slog.String("error", err.Error()))
+	}
+}
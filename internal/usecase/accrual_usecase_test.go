@@ -0,0 +1,540 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+type fakeTransferCreator struct {
+	inputs   []usecase.CreateTransferInput
+	err      error
+	existing map[string]*domain.Transfer
+	// errAfterCommit, when set, is returned once after the transfer was
+	// posted, as when the commit's acknowledgement is lost.
+	errAfterCommit error
+	posted         int
+}
+
+func (f *fakeTransferCreator) CreateTransfer(ctx context.Context, input usecase.CreateTransferInput) (*domain.Transfer, error) {
+	f.inputs = append(f.inputs, input)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if _, ok := f.existing[input.IdempotencyKey]; ok {
+		return nil, domain.ErrDuplicateIdempotencyKey
+	}
+
+	f.posted++
+	transfer := &domain.Transfer{ID: "transfer-1", FromAccountID: input.FromAccountID, ToAccountID: input.ToAccountID, Amount: input.Amount}
+	if f.errAfterCommit != nil {
+		if f.existing == nil {
+			f.existing = make(map[string]*domain.Transfer)
+		}
+		f.existing[input.IdempotencyKey] = transfer
+		err := f.errAfterCommit
+		f.errAfterCommit = nil
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (f *fakeTransferCreator) GetTransferByIdempotencyKey(ctx context.Context, key string) (*domain.Transfer, error) {
+	if t, ok := f.existing[key]; ok {
+		return t, nil
+	}
+	return nil, domain.ErrTransferNotFound
+}
+
+func expectNoStaleRuns(repo *mocks.MockAccrualRepository) {
+	repo.EXPECT().ListStalePendingRuns(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+}
+
+func TestAccrualUseCase_RunAccruals_PostsInterest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	transfers := &fakeTransferCreator{}
+
+	account := "acc-savings"
+	rule := &domain.AccrualRule{
+		ID:                    "rule-1",
+		Kind:                  domain.AccrualKindInterest,
+		Schedule:              domain.AccrualScheduleDaily,
+		AccountID:             &account,
+		CounterpartyAccountID: "acc-expense",
+		Rate:                  decimal.RequireFromString("0.0365"),
+		Precision:             2,
+		CreatedAt:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	expectNoStaleRuns(accrualRepo)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil)
+	idGen.EXPECT().Generate().Return("run-1")
+	accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *domain.AccrualRun) (bool, error) {
+		if !run.PeriodStart.Equal(day) || run.AccountID != account {
+			t.Fatalf("unexpected claim: %+v", run)
+		}
+		return true, nil
+	})
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), account, day.Add(24*time.Hour-time.Microsecond)).Return(decimal.NewFromInt(10000), nil)
+	accrualRepo.EXPECT().CompleteRun(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *domain.AccrualRun) error {
+		if run.Status != domain.AccrualRunStatusPosted || run.TransferID == nil || *run.TransferID != "transfer-1" {
+			t.Fatalf("expected posted run with transfer, got %+v", run)
+		}
+		return nil
+	})
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, entryRepo, transfers, idGen)
+
+	report, err := uc.RunAccruals(context.Background(), day)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Posted != 1 || len(report.Failures) != 0 {
+		t.Fatalf("expected one posted accrual, got %+v", report)
+	}
+
+	if len(transfers.inputs) != 1 {
+		t.Fatalf("expected one transfer, got %d", len(transfers.inputs))
+	}
+	in := transfers.inputs[0]
+	if in.FromAccountID != "acc-expense" || in.ToAccountID != account || !in.Amount.Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("unexpected transfer input: %+v", in)
+	}
+	if in.Metadata["accrual_rule_id"] != "rule-1" || in.Metadata["accrual_period_start"] != "2026-10-17" {
+		t.Fatalf("expected accrual metadata, got %v", in.Metadata)
+	}
+	if in.IdempotencyKey != "accrual:rule-1:acc-savings:2026-10-17" {
+		t.Fatalf("expected the period's idempotency key, got %q", in.IdempotencyKey)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_FeeDebitsGroupMembers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	transfers := &fakeTransferCreator{}
+
+	group := "wallets"
+	rule := &domain.AccrualRule{
+		ID:                    "rule-fee",
+		Kind:                  domain.AccrualKindFee,
+		Schedule:              domain.AccrualScheduleMonthly,
+		AccountGroup:          &group,
+		CounterpartyAccountID: "acc-income",
+		Amount:                decimal.NewFromInt(3),
+		Precision:             2,
+		CreatedAt:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	expectNoStaleRuns(accrualRepo)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil)
+	// The counterparty itself is a member and must be skipped.
+	accrualRepo.EXPECT().ListGroupMembers(gomock.Any(), group).Return([]string{"acc-a", "acc-income"}, nil)
+	idGen.EXPECT().Generate().Return("run-1")
+	accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).Return(true, nil)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), "acc-a", gomock.Any()).Return(decimal.NewFromInt(50), nil)
+	accrualRepo.EXPECT().CompleteRun(gomock.Any(), gomock.Any()).Return(nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, entryRepo, transfers, idGen)
+
+	report, err := uc.RunAccruals(context.Background(), time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Posted != 1 {
+		t.Fatalf("expected one posted fee, got %+v", report)
+	}
+	in := transfers.inputs[0]
+	if in.FromAccountID != "acc-a" || in.ToAccountID != "acc-income" || !in.Amount.Equal(decimal.NewFromInt(3)) {
+		t.Fatalf("unexpected fee transfer: %+v", in)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_SkipsIncompleteAndAlreadyProcessed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	transfers := &fakeTransferCreator{}
+
+	account := "acc-1"
+	created := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	monthly := &domain.AccrualRule{ID: "monthly", Kind: domain.AccrualKindFee, Schedule: domain.AccrualScheduleMonthly, AccountID: &account, Amount: decimal.NewFromInt(1), CreatedAt: created}
+	daily := &domain.AccrualRule{ID: "daily", Kind: domain.AccrualKindFee, Schedule: domain.AccrualScheduleDaily, AccountID: &account, Amount: decimal.NewFromInt(1), CreatedAt: created}
+
+	expectNoStaleRuns(accrualRepo)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{monthly, daily}, nil)
+	idGen.EXPECT().Generate().Return("run-1")
+	accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, nil, transfers, idGen)
+
+	report, err := uc.RunAccruals(context.Background(), time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.AlreadyProcessed != 1 || report.Posted != 0 || len(transfers.inputs) != 0 {
+		t.Fatalf("expected re-run to be a no-op, got %+v", report)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_NoRetroactiveAccrual(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+
+	account := "acc-1"
+	rule := &domain.AccrualRule{
+		ID:        "rule-1",
+		Kind:      domain.AccrualKindFee,
+		Schedule:  domain.AccrualScheduleDaily,
+		AccountID: &account,
+		Amount:    decimal.NewFromInt(1),
+		CreatedAt: time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC),
+	}
+
+	expectNoStaleRuns(accrualRepo)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, nil, &fakeTransferCreator{}, nil)
+
+	report, err := uc.RunAccruals(context.Background(), time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Posted != 0 || report.AlreadyProcessed != 0 {
+		t.Fatalf("expected no accrual before the rule existed, got %+v", report)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_ReleasesClaimOnTransferFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	transfers := &fakeTransferCreator{err: domain.ErrNegativeBalanceNotAllowed}
+
+	account := "acc-1"
+	rule := &domain.AccrualRule{
+		ID:                    "rule-1",
+		Kind:                  domain.AccrualKindFee,
+		Schedule:              domain.AccrualScheduleDaily,
+		AccountID:             &account,
+		CounterpartyAccountID: "acc-income",
+		Amount:                decimal.NewFromInt(1),
+		Precision:             2,
+		CreatedAt:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	expectNoStaleRuns(accrualRepo)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil)
+	idGen.EXPECT().Generate().Return("run-1")
+	accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).Return(true, nil)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), account, gomock.Any()).Return(decimal.Zero, nil)
+	accrualRepo.EXPECT().ReleaseRun(gomock.Any(), "run-1").Return(nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, entryRepo, transfers, idGen)
+
+	report, err := uc.RunAccruals(context.Background(), time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Failures) != 1 || report.Failures[0].AccountID != account {
+		t.Fatalf("expected one failure, got %+v", report)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_ReleasesClaimAfterCancellation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	account := "acc-1"
+	rule := &domain.AccrualRule{
+		ID:                    "rule-1",
+		Kind:                  domain.AccrualKindFee,
+		Schedule:              domain.AccrualScheduleDaily,
+		AccountID:             &account,
+		CounterpartyAccountID: "acc-income",
+		Amount:                decimal.NewFromInt(1),
+		CreatedAt:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	expectNoStaleRuns(accrualRepo)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil)
+	idGen.EXPECT().Generate().Return("run-1")
+	accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).Return(true, nil)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), account, gomock.Any()).DoAndReturn(func(ctx context.Context, id string, at time.Time) (decimal.Decimal, error) {
+		cancel()
+		return decimal.Zero, ctx.Err()
+	})
+	accrualRepo.EXPECT().ReleaseRun(gomock.Any(), "run-1").DoAndReturn(func(ctx context.Context, id string) error {
+		if ctx.Err() != nil {
+			t.Fatalf("release must not inherit the cancelled context: %v", ctx.Err())
+		}
+		return nil
+	})
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, entryRepo, &fakeTransferCreator{}, idGen)
+
+	report, err := uc.RunAccruals(ctx, time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Failures) != 1 {
+		t.Fatalf("expected one failure, got %+v", report)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_AdoptsTransferCommittedBeforeError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	// The first attempt commits but its caller only sees a timeout.
+	transfers := &fakeTransferCreator{errAfterCommit: context.DeadlineExceeded}
+
+	account := "acc-1"
+	rule := &domain.AccrualRule{
+		ID:                    "rule-1",
+		Kind:                  domain.AccrualKindFee,
+		Schedule:              domain.AccrualScheduleDaily,
+		AccountID:             &account,
+		CounterpartyAccountID: "acc-income",
+		Amount:                decimal.NewFromInt(1),
+		Precision:             2,
+		CreatedAt:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	day := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	accrualRepo.EXPECT().ListStalePendingRuns(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil).Times(2)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), account, gomock.Any()).Return(decimal.NewFromInt(100), nil).Times(2)
+	gomock.InOrder(
+		idGen.EXPECT().Generate().Return("run-1"),
+		accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).Return(true, nil),
+		accrualRepo.EXPECT().ReleaseRun(gomock.Any(), "run-1").Return(nil),
+		idGen.EXPECT().Generate().Return("run-2"),
+		accrualRepo.EXPECT().ClaimRun(gomock.Any(), gomock.Any()).Return(true, nil),
+		accrualRepo.EXPECT().CompleteRun(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *domain.AccrualRun) error {
+			if run.ID != "run-2" || run.TransferID == nil || *run.TransferID != "transfer-1" {
+				t.Fatalf("expected the new claim to adopt the committed transfer, got %+v", run)
+			}
+			return nil
+		}),
+	)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, entryRepo, transfers, idGen)
+
+	first, err := uc.RunAccruals(context.Background(), day)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Failures) != 1 {
+		t.Fatalf("expected the first pass to report the timeout, got %+v", first)
+	}
+
+	second, err := uc.RunAccruals(context.Background(), day)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if second.Posted != 1 || len(second.Failures) != 0 {
+		t.Fatalf("expected the retry to complete the period, got %+v", second)
+	}
+
+	if transfers.posted != 1 {
+		t.Fatalf("expected a single posting, got %d", transfers.posted)
+	}
+	if len(transfers.inputs) != 2 || transfers.inputs[0].IdempotencyKey != transfers.inputs[1].IdempotencyKey {
+		t.Fatalf("expected both claims to use the period's idempotency key, got %+v", transfers.inputs)
+	}
+}
+
+func TestAccrualUseCase_RunAccruals_RedrivesStaleClaim(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+
+	account := "acc-1"
+	rule := &domain.AccrualRule{
+		ID:                    "rule-1",
+		Kind:                  domain.AccrualKindFee,
+		Schedule:              domain.AccrualScheduleMonthly,
+		AccountID:             &account,
+		CounterpartyAccountID: "acc-income",
+		Amount:                decimal.NewFromInt(5),
+		Precision:             2,
+		CreatedAt:             time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	stale := &domain.AccrualRun{
+		ID:          "run-stale",
+		RuleID:      rule.ID,
+		AccountID:   account,
+		PeriodStart: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		Status:      domain.AccrualRunStatusPending,
+	}
+	// The crashed runner got as far as posting the transfer.
+	transfers := &fakeTransferCreator{existing: map[string]*domain.Transfer{
+		stale.IdempotencyKey(): {ID: "transfer-earlier"},
+	}}
+
+	accrualRepo.EXPECT().ListStalePendingRuns(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*domain.AccrualRun{stale}, nil)
+	accrualRepo.EXPECT().GetRule(gomock.Any(), rule.ID).Return(rule, nil)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), account, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC).Add(-time.Microsecond)).Return(decimal.NewFromInt(100), nil)
+	accrualRepo.EXPECT().CompleteRun(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, run *domain.AccrualRun) error {
+		if run.ID != "run-stale" || run.TransferID == nil || *run.TransferID != "transfer-earlier" {
+			t.Fatalf("expected stale run to adopt the earlier transfer, got %+v", run)
+		}
+		return nil
+	})
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return(nil, nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, nil, entryRepo, transfers, nil)
+
+	report, err := uc.RunAccruals(context.Background(), time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Redriven != 1 || report.Posted != 1 {
+		t.Fatalf("expected one re-driven posting, got %+v", report)
+	}
+	if len(transfers.inputs) != 1 || transfers.inputs[0].IdempotencyKey != stale.IdempotencyKey() {
+		t.Fatalf("expected a single idempotent retry, got %+v", transfers.inputs)
+	}
+}
+
+func TestAccrualUseCase_CreateRule_RejectsGroupCurrencyMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	accountRepo := mocks.NewMockAccountRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("rule-1")
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-expense").Return(&domain.Account{ID: "acc-expense", Currency: "EUR"}, nil)
+	accrualRepo.EXPECT().ListGroupMembers(gomock.Any(), "savers").Return([]string{"acc-eur", "acc-usd"}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-eur").Return(&domain.Account{ID: "acc-eur", Currency: "EUR"}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-usd").Return(&domain.Account{ID: "acc-usd", Currency: "USD"}, nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, accountRepo, nil, nil, idGen)
+
+	_, err := uc.CreateRule(context.Background(), usecase.CreateAccrualRuleInput{
+		Name:                  "Interest",
+		Kind:                  domain.AccrualKindInterest,
+		Schedule:              domain.AccrualScheduleDaily,
+		AccountGroup:          "savers",
+		CounterpartyAccountID: "acc-expense",
+		Rate:                  decimal.RequireFromString("0.02"),
+	})
+	if !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestAccrualUseCase_AddAccountToGroup_RejectsCurrencyMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accrualRepo := mocks.NewMockAccrualRepository(ctrl)
+	accountRepo := mocks.NewMockAccountRepository(ctrl)
+
+	group := "savers"
+	rule := &domain.AccrualRule{ID: "rule-1", AccountGroup: &group, CounterpartyAccountID: "acc-expense"}
+
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-usd").Return(&domain.Account{ID: "acc-usd", Currency: "USD"}, nil)
+	accrualRepo.EXPECT().ListActiveRules(gomock.Any()).Return([]*domain.AccrualRule{rule}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-expense").Return(&domain.Account{ID: "acc-expense", Currency: "EUR"}, nil)
+
+	uc := usecase.NewAccrualUseCase(accrualRepo, accountRepo, nil, nil, nil)
+
+	err := uc.AddAccountToGroup(context.Background(), group, "acc-usd")
+	if !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestAccrualUseCase_CreateRule_RejectsCurrencyMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accountRepo := mocks.NewMockAccountRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("rule-1")
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-expense").Return(&domain.Account{ID: "acc-expense", Currency: "EUR"}, nil)
+	accountRepo.EXPECT().GetByID(gomock.Any(), "acc-1").Return(&domain.Account{ID: "acc-1", Currency: "USD"}, nil)
+
+	uc := usecase.NewAccrualUseCase(mocks.NewMockAccrualRepository(ctrl), accountRepo, nil, nil, idGen)
+
+	_, err := uc.CreateRule(context.Background(), usecase.CreateAccrualRuleInput{
+		Name:                  "Interest",
+		Kind:                  domain.AccrualKindInterest,
+		Schedule:              domain.AccrualScheduleDaily,
+		AccountID:             "acc-1",
+		CounterpartyAccountID: "acc-expense",
+		Rate:                  decimal.RequireFromString("0.02"),
+	})
+	if !errors.Is(err, domain.ErrCurrencyMismatch) {
+		t.Fatalf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestAccrualUseCase_CreateRule_RejectsInvalidRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	idGen.EXPECT().Generate().Return("rule-1")
+
+	uc := usecase.NewAccrualUseCase(mocks.NewMockAccrualRepository(ctrl), mocks.NewMockAccountRepository(ctrl), nil, nil, idGen)
+
+	_, err := uc.CreateRule(context.Background(), usecase.CreateAccrualRuleInput{
+		Name:                  "Fee",
+		Kind:                  domain.AccrualKindFee,
+		Schedule:              domain.AccrualScheduleMonthly,
+		AccountID:             "acc-1",
+		CounterpartyAccountID: "acc-income",
+	})
+	if !errors.Is(err, domain.ErrInvalidAccrualRule) {
+		t.Fatalf("expected ErrInvalidAccrualRule, got %v", err)
+	}
+}
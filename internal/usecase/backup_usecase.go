@@ -0,0 +1,305 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+)
+
+var (
+	ErrBackupSchemaMismatch      = errors.New("archive schema version does not match the database")
+	ErrRestoreTargetNotEmpty     = errors.New("restore target database is not empty")
+	ErrRestoreVerificationFailed = errors.New("restored ledger failed verification; the restore was rolled back")
+)
+
+// LedgerBackupTables are the tables a ledger archive holds, in restore
+// order (referenced tables before the tables referencing them): the ledger
+// itself plus its configuration (fee policies, accrual rules, groups and
+// runs, and webhook subscriptions). Users, the outbox, webhook deliveries
+// and the reporting read models are not part of the archive.
+var LedgerBackupTables = []string{
+	"accounts",
+	"transfers",
+	"entries",
+	"holds",
+	"audit_logs",
+	"fee_policies",
+	"accrual_rules",
+	"account_group_members",
+	"accrual_runs",
+	"webhook_subscriptions",
+}
+
+// restoreBatchSize is how many rows are inserted per statement on restore.
+const restoreBatchSize = 1000
+
+// LedgerArchiveTable is one table in a ledger archive.
+type LedgerArchiveTable struct {
+	Name string
+	Rows int64
+}
+
+// LedgerArchiveInfo is a ledger archive's metadata.
+type LedgerArchiveInfo struct {
+	CreatedAt      time.Time
+	AuditChainHead string
+	Tables         []LedgerArchiveTable
+	SchemaVersion  int64
+}
+
+// LedgerArchiveWriter writes a ledger archive. Finish fills in each
+// table's row count.
+type LedgerArchiveWriter interface {
+	WriteRow(table string, row []byte) error
+	Finish(info *LedgerArchiveInfo) error
+}
+
+// LedgerArchiveReader reads a ledger archive table by table. NextTable
+// returns io.EOF after the last table; NextRow returns io.EOF at the end of
+// the current table, once its contents have been verified.
+type LedgerArchiveReader interface {
+	Info() LedgerArchiveInfo
+	NextTable() (string, error)
+	NextRow() ([]byte, error)
+}
+
+// LedgerVerifier is the subset of ReconciliationUseCase a restore is
+// verified with, inside the transaction that loaded it.
+type LedgerVerifier interface {
+	ReconcileAccountTx(ctx context.Context, tx Transaction, accountID string) (*ReconciliationResult, error)
+	VerifyEntryChainTx(ctx context.Context, tx Transaction, accountID string) (*EntryChainResult, error)
+	CheckLedgerConsistencyTx(ctx context.Context, tx Transaction) error
+}
+
+// RestoreReport is the outcome of a restore and of its verification.
+type RestoreReport struct {
+	Tables           []LedgerArchiveTable
+	Discrepancies    []*ReconciliationResult
+	EntryChainBreaks []*EntryChainResult
+	AuditChainBreaks []AuditChainBreak
+	// LedgerConsistencyError is set when CheckLedgerConsistency failed.
+	LedgerConsistencyError string
+	// AuditChainHeadMismatch is set when the rebuilt audit log hash chain
+	// doesn't end at the hash recorded in the archive.
+	AuditChainHeadMismatch bool
+	Verified               bool
+}
+
+// BackupUseCase exports the ledger to a portable archive and restores it
+// into an empty database, e.g. for disaster-recovery drills or seeding
+// staging.
+type BackupUseCase struct {
+	txManager  TransactionManager
+	backupRepo BackupRepository
+	verifier   LedgerVerifier
+}
+
+// NewBackupUseCase creates a new BackupUseCase.
+func NewBackupUseCase(txManager TransactionManager, backupRepo BackupRepository, verifier LedgerVerifier) *BackupUseCase {
+	return &BackupUseCase{
+		txManager:  txManager,
+		backupRepo: backupRepo,
+		verifier:   verifier,
+	}
+}
+
+// Export writes every ledger table to w from one consistent snapshot.
+func (uc *BackupUseCase) Export(ctx context.Context, w LedgerArchiveWriter) (*LedgerArchiveInfo, error) {
+	info := &LedgerArchiveInfo{
+		CreatedAt: time.Now().UTC(),
+		Tables:    make([]LedgerArchiveTable, 0, len(LedgerBackupTables)),
+	}
+	for _, t := range LedgerBackupTables {
+		info.Tables = append(info.Tables, LedgerArchiveTable{Name: t})
+	}
+
+	snapshot, err := uc.backupRepo.Export(ctx, LedgerBackupTables, w.WriteRow)
+	if err != nil {
+		return nil, err
+	}
+
+	info.SchemaVersion = snapshot.SchemaVersion
+	info.AuditChainHead = snapshot.AuditChainHead
+
+	if err := w.Finish(info); err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// Restore loads an archive into an empty database migrated to the same
+// schema version and verifies the result in the same transaction: every
+// account's balance and entry chain, ledger-wide consistency, and the audit
+// log hash chain, which must rebuild to exactly the head recorded in the
+// archive. It only commits once verification passes; a checksum mismatch or
+// a failed verification rolls everything back, the latter returning the
+// report with ErrRestoreVerificationFailed.
+func (uc *BackupUseCase) Restore(ctx context.Context, r LedgerArchiveReader) (_ *RestoreReport, err error) {
+	info := r.Info()
+
+	version, err := uc.backupRepo.SchemaVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != info.SchemaVersion {
+		return nil, fmt.Errorf("%w: archive is at %d, database at %d", ErrBackupSchemaMismatch, info.SchemaVersion, version)
+	}
+
+	for _, t := range info.Tables {
+		if !slices.Contains(LedgerBackupTables, t.Name) {
+			return nil, fmt.Errorf("archive contains unknown table %q", t.Name)
+		}
+	}
+
+	rows, err := uc.backupRepo.CountRows(ctx, LedgerBackupTables)
+	if err != nil {
+		return nil, err
+	}
+
+	if rows > 0 {
+		return nil, fmt.Errorf("%w: found %d existing rows", ErrRestoreTargetNotEmpty, rows)
+	}
+
+	tx, err := uc.txManager.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	report := &RestoreReport{}
+
+	accountIDs, err := uc.load(ctx, tx, r, report)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.verify(ctx, tx, info, accountIDs, report); err != nil {
+		return report, err
+	}
+
+	if !report.Verified {
+		return report, ErrRestoreVerificationFailed
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// load inserts every table within tx and returns the restored account IDs.
+func (uc *BackupUseCase) load(ctx context.Context, tx Transaction, r LedgerArchiveReader, report *RestoreReport) (accountIDs []string, err error) {
+	for {
+		table, err := r.NextTable()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		restored := LedgerArchiveTable{Name: table}
+		batch := make([][]byte, 0, restoreBatchSize)
+
+		for {
+			row, err := r.NextRow()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if table == "accounts" {
+				var account struct {
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(row, &account); err != nil {
+					return nil, fmt.Errorf("invalid accounts row: %w", err)
+				}
+				accountIDs = append(accountIDs, account.ID)
+			}
+
+			batch = append(batch, row)
+			restored.Rows++
+
+			if len(batch) == restoreBatchSize {
+				if err := uc.backupRepo.Restore(ctx, tx, table, batch); err != nil {
+					return nil, fmt.Errorf("failed to restore %s: %w", table, err)
+				}
+				batch = batch[:0]
+			}
+		}
+
+		if len(batch) > 0 {
+			if err := uc.backupRepo.Restore(ctx, tx, table, batch); err != nil {
+				return nil, fmt.Errorf("failed to restore %s: %w", table, err)
+			}
+		}
+
+		report.Tables = append(report.Tables, restored)
+	}
+
+	return accountIDs, nil
+}
+
+// verify checks the rows load wrote, within tx so they are still
+// uncommitted, and fills in report.
+func (uc *BackupUseCase) verify(ctx context.Context, tx Transaction, info LedgerArchiveInfo, accountIDs []string, report *RestoreReport) error {
+	report.Discrepancies = make([]*ReconciliationResult, 0)
+	report.EntryChainBreaks = make([]*EntryChainResult, 0)
+
+	for _, id := range accountIDs {
+		result, err := uc.verifier.ReconcileAccountTx(ctx, tx, id)
+		if err != nil {
+			return fmt.Errorf("failed to reconcile account %s: %w", id, err)
+		}
+		if !result.IsReconciled {
+			report.Discrepancies = append(report.Discrepancies, result)
+		}
+
+		chain, err := uc.verifier.VerifyEntryChainTx(ctx, tx, id)
+		if err != nil {
+			return fmt.Errorf("failed to verify entry chain for account %s: %w", id, err)
+		}
+		if !chain.Valid {
+			report.EntryChainBreaks = append(report.EntryChainBreaks, chain)
+		}
+	}
+
+	if err := uc.verifier.CheckLedgerConsistencyTx(ctx, tx); err != nil {
+		report.LedgerConsistencyError = err.Error()
+	}
+
+	breaks, err := uc.backupRepo.VerifyAuditChain(ctx, tx)
+	if err != nil {
+		return err
+	}
+	report.AuditChainBreaks = breaks
+
+	head, err := uc.backupRepo.AuditChainHead(ctx, tx)
+	if err != nil {
+		return err
+	}
+	report.AuditChainHeadMismatch = head != info.AuditChainHead
+
+	report.Verified = len(report.Discrepancies) == 0 &&
+		len(report.EntryChainBreaks) == 0 &&
+		report.LedgerConsistencyError == "" &&
+		len(report.AuditChainBreaks) == 0 &&
+		!report.AuditChainHeadMismatch
+
+	return nil
+}
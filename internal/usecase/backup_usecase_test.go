@@ -0,0 +1,252 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+type memArchive struct {
+	info   usecase.LedgerArchiveInfo
+	rows   map[string][][]byte
+	order  []string
+	table  int
+	row    int
+	rowErr error
+}
+
+func (a *memArchive) WriteRow(table string, row []byte) error {
+	if a.rows == nil {
+		a.rows = make(map[string][][]byte)
+	}
+	if _, ok := a.rows[table]; !ok {
+		a.order = append(a.order, table)
+	}
+	a.rows[table] = append(a.rows[table], row)
+
+	return nil
+}
+
+func (a *memArchive) Finish(info *usecase.LedgerArchiveInfo) error {
+	for i, t := range info.Tables {
+		info.Tables[i].Rows = int64(len(a.rows[t.Name]))
+	}
+	a.info = *info
+
+	return nil
+}
+
+func (a *memArchive) Info() usecase.LedgerArchiveInfo { return a.info }
+
+func (a *memArchive) NextTable() (string, error) {
+	if a.table >= len(a.info.Tables) {
+		return "", io.EOF
+	}
+
+	a.table++
+	a.row = 0
+
+	return a.info.Tables[a.table-1].Name, nil
+}
+
+func (a *memArchive) NextRow() ([]byte, error) {
+	rows := a.rows[a.info.Tables[a.table-1].Name]
+	if a.row >= len(rows) {
+		return nil, io.EOF
+	}
+	if a.rowErr != nil {
+		return nil, a.rowErr
+	}
+
+	a.row++
+
+	return rows[a.row-1], nil
+}
+
+type stubLedgerVerifier struct {
+	unreconciled map[string]bool
+	brokenChain  map[string]bool
+	inconsistent error
+}
+
+func (v *stubLedgerVerifier) ReconcileAccountTx(_ context.Context, _ usecase.Transaction, id string) (*usecase.ReconciliationResult, error) {
+	return &usecase.ReconciliationResult{AccountID: id, Difference: decimal.Zero, IsReconciled: !v.unreconciled[id]}, nil
+}
+
+func (v *stubLedgerVerifier) VerifyEntryChainTx(_ context.Context, _ usecase.Transaction, id string) (*usecase.EntryChainResult, error) {
+	return &usecase.EntryChainResult{AccountID: id, Valid: !v.brokenChain[id]}, nil
+}
+
+func (v *stubLedgerVerifier) CheckLedgerConsistencyTx(context.Context, usecase.Transaction) error {
+	return v.inconsistent
+}
+
+func testArchive() *memArchive {
+	return &memArchive{
+		info: usecase.LedgerArchiveInfo{
+			SchemaVersion:  16,
+			AuditChainHead: "head",
+			Tables: []usecase.LedgerArchiveTable{
+				{Name: "accounts", Rows: 2}, {Name: "transfers", Rows: 1}, {Name: "audit_logs"},
+			},
+		},
+		rows: map[string][][]byte{
+			"accounts":  {[]byte(`{"id":"acc-1"}`), []byte(`{"id":"acc-2"}`)},
+			"transfers": {[]byte(`{"id":"tr-1"}`)},
+		},
+	}
+}
+
+func TestBackupUseCase_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backupRepo := mocks.NewMockBackupRepository(ctrl)
+	backupRepo.EXPECT().Export(gomock.Any(), usecase.LedgerBackupTables, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ []string, emit func(string, []byte) error) (*usecase.LedgerSnapshot, error) {
+			_ = emit("accounts", []byte(`{"id":"acc-1"}`))
+			_ = emit("transfers", []byte(`{"id":"tr-1"}`))
+			_ = emit("transfers", []byte(`{"id":"tr-2"}`))
+			return &usecase.LedgerSnapshot{SchemaVersion: 16, AuditChainHead: "head"}, nil
+		})
+
+	uc := usecase.NewBackupUseCase(mocks.NewMockTransactionManager(ctrl), backupRepo, &stubLedgerVerifier{})
+
+	archive := &memArchive{}
+	info, err := uc.Export(context.Background(), archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.SchemaVersion != 16 || info.AuditChainHead != "head" || info.CreatedAt.IsZero() {
+		t.Fatalf("unexpected info: %+v", info)
+	}
+
+	if len(info.Tables) != len(usecase.LedgerBackupTables) || info.Tables[1].Name != "transfers" || info.Tables[1].Rows != 2 {
+		t.Fatalf("unexpected tables: %+v", info.Tables)
+	}
+}
+
+func TestBackupUseCase_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	backupRepo := mocks.NewMockBackupRepository(ctrl)
+
+	backupRepo.EXPECT().SchemaVersion(gomock.Any()).Return(int64(16), nil)
+	backupRepo.EXPECT().CountRows(gomock.Any(), usecase.LedgerBackupTables).Return(int64(0), nil)
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	backupRepo.EXPECT().Restore(gomock.Any(), tx, "accounts", gomock.Len(2)).Return(nil)
+	backupRepo.EXPECT().Restore(gomock.Any(), tx, "transfers", gomock.Len(1)).Return(nil)
+	gomock.InOrder(
+		backupRepo.EXPECT().VerifyAuditChain(gomock.Any(), tx).Return(nil, nil),
+		backupRepo.EXPECT().AuditChainHead(gomock.Any(), tx).Return("head", nil),
+		tx.EXPECT().Commit(gomock.Any()).Return(nil),
+	)
+
+	uc := usecase.NewBackupUseCase(txManager, backupRepo, &stubLedgerVerifier{})
+
+	report, err := uc.Restore(context.Background(), testArchive())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !report.Verified || len(report.Tables) != 3 || report.Tables[0].Rows != 2 || report.Tables[2].Rows != 0 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestBackupUseCase_Restore_VerificationFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	backupRepo := mocks.NewMockBackupRepository(ctrl)
+
+	backupRepo.EXPECT().SchemaVersion(gomock.Any()).Return(int64(16), nil)
+	backupRepo.EXPECT().CountRows(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	backupRepo.EXPECT().Restore(gomock.Any(), tx, gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	backupRepo.EXPECT().VerifyAuditChain(gomock.Any(), tx).Return([]usecase.AuditChainBreak{{AuditID: "a-1", Reason: "tampered"}}, nil)
+	backupRepo.EXPECT().AuditChainHead(gomock.Any(), tx).Return("other", nil)
+	// A failed verification must leave nothing committed.
+	tx.EXPECT().Rollback(gomock.Any()).Return(nil)
+
+	verifier := &stubLedgerVerifier{
+		brokenChain:  map[string]bool{"acc-2": true},
+		inconsistent: errors.New("ledger inconsistency detected"),
+	}
+	uc := usecase.NewBackupUseCase(txManager, backupRepo, verifier)
+
+	report, err := uc.Restore(context.Background(), testArchive())
+	if !errors.Is(err, usecase.ErrRestoreVerificationFailed) {
+		t.Fatalf("expected ErrRestoreVerificationFailed, got %v", err)
+	}
+
+	if report.Verified || len(report.EntryChainBreaks) != 1 || report.EntryChainBreaks[0].AccountID != "acc-2" ||
+		report.LedgerConsistencyError == "" || len(report.AuditChainBreaks) != 1 || !report.AuditChainHeadMismatch {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestBackupUseCase_Restore_RejectsBeforeWriting(t *testing.T) {
+	tests := []struct {
+		name    string
+		version int64
+		rows    int64
+		wantErr error
+	}{
+		{name: "schema mismatch", version: 15, wantErr: usecase.ErrBackupSchemaMismatch},
+		{name: "target not empty", version: 16, rows: 3, wantErr: usecase.ErrRestoreTargetNotEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			backupRepo := mocks.NewMockBackupRepository(ctrl)
+			backupRepo.EXPECT().SchemaVersion(gomock.Any()).Return(tt.version, nil)
+			backupRepo.EXPECT().CountRows(gomock.Any(), gomock.Any()).Return(tt.rows, nil).MaxTimes(1)
+
+			uc := usecase.NewBackupUseCase(mocks.NewMockTransactionManager(ctrl), backupRepo, &stubLedgerVerifier{})
+
+			if _, err := uc.Restore(context.Background(), testArchive()); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBackupUseCase_Restore_RollsBackCorruptArchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	backupRepo := mocks.NewMockBackupRepository(ctrl)
+
+	backupRepo.EXPECT().SchemaVersion(gomock.Any()).Return(int64(16), nil)
+	backupRepo.EXPECT().CountRows(gomock.Any(), gomock.Any()).Return(int64(0), nil)
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).Return(nil)
+
+	archive := testArchive()
+	archive.rowErr = errors.New("checksum mismatch")
+
+	uc := usecase.NewBackupUseCase(txManager, backupRepo, &stubLedgerVerifier{})
+
+	if _, err := uc.Restore(context.Background(), archive); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -41,6 +41,45 @@ func (uc *EntryUseCase) GetEntriesByAccount(ctx context.Context, input GetEntrie
 	return uc.entryRepo.GetByAccount(ctx, input.AccountID, input.Limit, input.Offset)
 }
 
+// GetEntriesByAccountCursorInput represents input for cursor-paginated
+// entry listing.
+type GetEntriesByAccountCursorInput struct {
+	AccountID string
+	Cursor    string
+	Limit     int
+}
+
+// GetEntriesByAccountCursorResult is a page of entries plus the cursor to
+// request the next page. NextCursor is empty when there are no more results.
+type GetEntriesByAccountCursorResult struct {
+	Entries    []*domain.Entry
+	NextCursor string
+}
+
+// GetEntriesByAccountCursor lists entries for an account using keyset
+// pagination (see EntryRepository.GetByAccountCursor).
+func (uc *EntryUseCase) GetEntriesByAccountCursor(ctx context.Context, input GetEntriesByAccountCursorInput) (*GetEntriesByAccountCursorResult, error) {
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+
+	if input.Limit > 100 {
+		input.Limit = 100
+	}
+
+	entries, err := uc.entryRepo.GetByAccountCursor(ctx, input.AccountID, input.Cursor, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GetEntriesByAccountCursorResult{Entries: entries}
+	if len(entries) == input.Limit {
+		result.NextCursor = entries[len(entries)-1].ID
+	}
+
+	return result, nil
+}
+
 // GetEntriesByTransfer lists entries for a transfer.
 func (uc *EntryUseCase) GetEntriesByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error) {
 	return uc.entryRepo.GetByTransfer(ctx, transferID)
@@ -50,3 +89,18 @@ func (uc *EntryUseCase) GetEntriesByTransfer(ctx context.Context, transferID str
 func (uc *EntryUseCase) GetHistoricalBalance(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error) {
 	return uc.entryRepo.GetBalanceAtTime(ctx, accountID, at)
 }
+
+// ExportEntriesByAccount streams an account's entries matching filter to
+// emit, oldest first. Memory use is bounded by the repository's batch size,
+// not the account's history, and the export is cancelled after
+// domain.EntryExportTimeout.
+func (uc *EntryUseCase) ExportEntriesByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+	if err := filter.Validate(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, domain.EntryExportTimeout)
+	defer cancel()
+
+	return uc.entryRepo.ExportByAccount(ctx, filter, emit)
+}
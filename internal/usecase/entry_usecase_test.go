@@ -2,6 +2,8 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -40,6 +42,30 @@ func TestEntryUseCase_GetEntriesByAccount(t *testing.T) {
 	}
 }
 
+func TestEntryUseCase_GetEntriesByAccountCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	// Limit defaults to 20.
+	entries := make([]*domain.Entry, 20)
+	for i := range entries {
+		entries[i] = &domain.Entry{ID: fmt.Sprintf("e%02d", 20-i), AccountID: "acc-1"}
+	}
+	entryRepo.EXPECT().GetByAccountCursor(gomock.Any(), "acc-1", "", 20).Return(entries, nil)
+
+	uc := usecase.NewEntryUseCase(entryRepo)
+
+	result, err := uc.GetEntriesByAccountCursor(context.Background(), usecase.GetEntriesByAccountCursorInput{AccountID: "acc-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Entries) != 20 || result.NextCursor != "e01" {
+		t.Fatalf("expected a full page continuing after e01, got %d entries, cursor %q", len(result.Entries), result.NextCursor)
+	}
+}
+
 func TestEntryUseCase_GetEntriesByTransfer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -82,3 +108,56 @@ func TestEntryUseCase_GetHistoricalBalance(t *testing.T) {
 		t.Errorf("expected balance 500, got %s", balance)
 	}
 }
+
+func TestEntryUseCase_ExportEntriesByAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	filter := domain.EntryExportFilter{AccountID: "acc-1"}
+
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	entryRepo.EXPECT().ExportByAccount(gomock.Any(), filter, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+			if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > domain.EntryExportTimeout {
+				t.Errorf("expected the export capped at %s, got deadline %v (set: %v)", domain.EntryExportTimeout, deadline, ok)
+			}
+			for _, id := range []string{"e1", "e2", "e3"} {
+				if err := emit(&domain.Entry{ID: id, AccountID: "acc-1"}); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+	uc := usecase.NewEntryUseCase(entryRepo)
+
+	var ids []string
+	err := uc.ExportEntriesByAccount(context.Background(), filter, func(e *domain.Entry) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ids) != 3 || ids[0] != "e1" || ids[2] != "e3" {
+		t.Errorf("expected e1..e3 in order, got %v", ids)
+	}
+}
+
+func TestEntryUseCase_ExportEntriesByAccount_InvalidRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	uc := usecase.NewEntryUseCase(entryRepo)
+
+	at := time.Now()
+	err := uc.ExportEntriesByAccount(context.Background(), domain.EntryExportFilter{AccountID: "acc-1", From: &at, To: &at}, func(*domain.Entry) error {
+		t.Fatal("emit should not be called")
+		return nil
+	})
+	if !errors.Is(err, domain.ErrInvalidEntryExport) {
+		t.Fatalf("expected ErrInvalidEntryExport, got %v", err)
+	}
+}
@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+const (
+	// eventStreamBatchSize is how many events one outbox read returns.
+	eventStreamBatchSize = 200
+	// eventStreamBuffer is how many live events a subscriber may fall
+	// behind by before it is dropped with domain.ErrEventStreamLagging.
+	eventStreamBuffer = 256
+	// EventStreamOverlap is how far behind the newest seen publish time
+	// each Poll re-reads the outbox. published_at is taken from the
+	// publishing replica's clock just before its update commits, so an
+	// event can become visible with a slightly older published_at than one
+	// already streamed; re-reading the window (and skipping events already
+	// seen) catches it. It bounds the tolerated clock skew between replicas.
+	EventStreamOverlap = 5 * time.Second
+)
+
+// EventStreamUseCase streams published outbox events to live subscribers.
+// A single feeder calls Poll to read newly published events and fan them
+// out; every replica runs its own feeder, so subscribers see events
+// published by any replica.
+type EventStreamUseCase struct {
+	outboxRepo OutboxRepository
+	now        func() time.Time
+
+	// pollMu serializes Poll; the fields below it are only used by Poll.
+	pollMu sync.Mutex
+	primed bool
+	newest time.Time
+	seen   map[string]time.Time
+
+	mu          sync.Mutex
+	closed      bool
+	subscribers map[*EventSubscription]struct{}
+}
+
+// NewEventStreamUseCase creates a new EventStreamUseCase.
+func NewEventStreamUseCase(outboxRepo OutboxRepository) *EventStreamUseCase {
+	return &EventStreamUseCase{
+		outboxRepo:  outboxRepo,
+		now:         func() time.Time { return time.Now().UTC() },
+		seen:        make(map[string]time.Time),
+		subscribers: make(map[*EventSubscription]struct{}),
+	}
+}
+
+// Poll reads events published since the last poll and delivers them to
+// matching subscribers. The first poll only records where the outbox
+// stands, so a starting server does not replay recent history to live
+// subscribers.
+func (uc *EventStreamUseCase) Poll(ctx context.Context) error {
+	uc.pollMu.Lock()
+	defer uc.pollMu.Unlock()
+
+	if !uc.primed {
+		uc.newest = uc.now()
+	}
+
+	after := domain.EventStreamPosition{PublishedAt: uc.newest.Add(-EventStreamOverlap)}
+
+	for {
+		events, err := uc.outboxRepo.ListPublishedAfter(ctx, domain.EventStreamFilter{}, after, eventStreamBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			after = positionOf(event)
+			if _, ok := uc.seen[event.ID]; ok {
+				continue
+			}
+
+			uc.seen[event.ID] = after.PublishedAt
+			if after.PublishedAt.After(uc.newest) {
+				uc.newest = after.PublishedAt
+			}
+
+			if uc.primed {
+				uc.broadcast(event)
+			}
+		}
+
+		if len(events) < eventStreamBatchSize {
+			break
+		}
+	}
+
+	uc.primed = true
+
+	cutoff := uc.newest.Add(-EventStreamOverlap)
+	for id, publishedAt := range uc.seen {
+		if publishedAt.Before(cutoff) {
+			delete(uc.seen, id)
+		}
+	}
+
+	return nil
+}
+
+func (uc *EventStreamUseCase) broadcast(event *domain.OutboxEvent) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for sub := range uc.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case sub.live <- event:
+		default:
+			delete(uc.subscribers, sub)
+			sub.stop(domain.ErrEventStreamLagging)
+		}
+	}
+}
+
+// Close ends every subscription with domain.ErrEventStreamClosed and
+// rejects new ones. Call it before shutting down the servers so streaming
+// requests finish instead of holding the shutdown open.
+func (uc *EventStreamUseCase) Close() {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	uc.closed = true
+	for sub := range uc.subscribers {
+		delete(uc.subscribers, sub)
+		sub.stop(domain.ErrEventStreamClosed)
+	}
+}
+
+// Subscribe starts a subscription. With a cursor, events published after
+// it are replayed from the outbox before live events; each event is
+// delivered once. The subscription ends when ctx is cancelled, the
+// subscriber falls behind, or the stream is closed; see
+// EventSubscription.Err.
+func (uc *EventStreamUseCase) Subscribe(ctx context.Context, filter domain.EventStreamFilter, cursor domain.EventStreamCursor) (*EventSubscription, error) {
+	if err := domain.ValidateEventStreamRequest(filter, cursor); err != nil {
+		return nil, err
+	}
+
+	var from *domain.EventStreamPosition
+	if !cursor.IsZero() {
+		position, err := uc.resolveCursor(ctx, filter, cursor)
+		if err != nil {
+			return nil, err
+		}
+		from = &position
+	}
+
+	sub := &EventSubscription{
+		filter: filter,
+		events: make(chan *domain.OutboxEvent),
+		live:   make(chan *domain.OutboxEvent, eventStreamBuffer),
+		done:   make(chan struct{}),
+	}
+
+	// Register before replaying, so nothing published during the replay
+	// is missed; the overlap is deduplicated in run.
+	uc.mu.Lock()
+	if uc.closed {
+		uc.mu.Unlock()
+		return nil, domain.ErrEventStreamClosed
+	}
+	uc.subscribers[sub] = struct{}{}
+	uc.mu.Unlock()
+
+	go func() {
+		defer uc.unsubscribe(sub)
+		sub.run(ctx, uc.outboxRepo, from)
+	}()
+
+	return sub, nil
+}
+
+func (uc *EventStreamUseCase) unsubscribe(sub *EventSubscription) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	delete(uc.subscribers, sub)
+}
+
+// resolveCursor returns the position of the event a cursor names. A zero
+// aggregate sequence means the start of the account's history.
+func (uc *EventStreamUseCase) resolveCursor(ctx context.Context, filter domain.EventStreamFilter, cursor domain.EventStreamCursor) (domain.EventStreamPosition, error) {
+	var (
+		event *domain.OutboxEvent
+		err   error
+	)
+
+	switch {
+	case cursor.AggregateSequence != nil && *cursor.AggregateSequence == 0:
+		return domain.EventStreamPosition{}, nil
+	case cursor.AggregateSequence != nil:
+		event, err = uc.outboxRepo.GetByAggregateSequence(ctx, domain.AggregateTypeAccount, filter.AccountID, *cursor.AggregateSequence)
+	default:
+		event, err = uc.outboxRepo.GetByID(ctx, cursor.EventID)
+	}
+
+	if errors.Is(err, domain.ErrOutboxEventNotFound) || (err == nil && event.PublishedAt == nil) {
+		return domain.EventStreamPosition{}, domain.ErrEventStreamCursorNotFound
+	}
+	if err != nil {
+		return domain.EventStreamPosition{}, fmt.Errorf("failed to resolve event stream cursor: %w", err)
+	}
+
+	return positionOf(event), nil
+}
+
+func positionOf(event *domain.OutboxEvent) domain.EventStreamPosition {
+	var publishedAt time.Time
+	if event.PublishedAt != nil {
+		publishedAt = *event.PublishedAt
+	}
+
+	return domain.EventStreamPosition{PublishedAt: publishedAt, EventID: event.ID}
+}
+
+// EventSubscription is one subscriber's view of the event stream.
+type EventSubscription struct {
+	filter domain.EventStreamFilter
+	events chan *domain.OutboxEvent
+	live   chan *domain.OutboxEvent
+
+	once sync.Once
+	done chan struct{}
+	err  error
+}
+
+// Events delivers the subscription's events. It is closed when the
+// subscription ends.
+func (s *EventSubscription) Events() <-chan *domain.OutboxEvent {
+	return s.events
+}
+
+// Err reports why the subscription ended, once Events is closed.
+func (s *EventSubscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+func (s *EventSubscription) stop(err error) {
+	s.once.Do(func() {
+		s.err = err
+		close(s.done)
+	})
+}
+
+type aggregateKey struct {
+	aggregateType string
+	aggregateID   string
+}
+
+// run replays from the cursor, if any, then forwards live events until the
+// subscription stops. An aggregate's events are published in sequence
+// order, so a live event is a duplicate of a replayed one exactly when its
+// sequence is not past the last one replayed for its aggregate.
+func (s *EventSubscription) run(ctx context.Context, outboxRepo OutboxRepository, from *domain.EventStreamPosition) {
+	defer close(s.events)
+
+	replayed := make(map[aggregateKey]int64)
+
+	send := func(event *domain.OutboxEvent) bool {
+		select {
+		case s.events <- event:
+			return true
+		case <-s.done:
+			return false
+		case <-ctx.Done():
+			s.stop(ctx.Err())
+			return false
+		}
+	}
+
+	if from != nil {
+		after := *from
+		for {
+			events, err := outboxRepo.ListPublishedAfter(ctx, s.filter, after, eventStreamBatchSize)
+			if err != nil {
+				s.stop(fmt.Errorf("failed to replay events: %w", err))
+				return
+			}
+
+			for _, event := range events {
+				after = positionOf(event)
+				if !s.filter.Matches(event) {
+					continue
+				}
+
+				replayed[aggregateKey{event.AggregateType, event.AggregateID}] = event.AggregateSequence
+				if !send(event) {
+					return
+				}
+			}
+
+			if len(events) < eventStreamBatchSize {
+				break
+			}
+		}
+	}
+
+	for {
+		select {
+		case event := <-s.live:
+			key := aggregateKey{event.AggregateType, event.AggregateID}
+			if last, ok := replayed[key]; ok {
+				if event.AggregateSequence <= last {
+					continue
+				}
+				replayed[key] = event.AggregateSequence
+			}
+
+			if !send(event) {
+				return
+			}
+		case <-s.done:
+			return
+		case <-ctx.Done():
+			s.stop(ctx.Err())
+			return
+		}
+	}
+}
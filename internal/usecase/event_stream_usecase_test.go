@@ -0,0 +1,221 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func publishedEvent(id, aggregateType, aggregateID string, seq int64) *domain.OutboxEvent {
+	publishedAt := time.Now().UTC()
+	return &domain.OutboxEvent{
+		ID:                id,
+		AggregateType:     aggregateType,
+		AggregateID:       aggregateID,
+		EventType:         aggregateType + ".created",
+		AggregateSequence: seq,
+		Published:         true,
+		PublishedAt:       &publishedAt,
+	}
+}
+
+// expectPolls queues the feeder's successive outbox reads.
+func expectPolls(outboxRepo *mocks.MockOutboxRepository, polls ...[]*domain.OutboxEvent) {
+	calls := make([]any, len(polls))
+	for i, events := range polls {
+		calls[i] = outboxRepo.EXPECT().
+			ListPublishedAfter(gomock.Any(), domain.EventStreamFilter{}, gomock.Any(), gomock.Any()).
+			Return(events, nil)
+	}
+	gomock.InOrder(calls...)
+}
+
+func receive(t *testing.T, sub *usecase.EventSubscription, n int) []string {
+	t.Helper()
+
+	var ids []string
+	for len(ids) < n {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				t.Fatalf("subscription ended early after %v: %v", ids, sub.Err())
+			}
+			ids = append(ids, event.ID)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out after receiving %v", ids)
+		}
+	}
+
+	return ids
+}
+
+func TestEventStreamUseCase_DeliversNewMatchingEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	old := publishedEvent("evt-0", domain.AggregateTypeAccount, "acc-1", 1)
+	expectPolls(outboxRepo,
+		[]*domain.OutboxEvent{old},
+		[]*domain.OutboxEvent{
+			old,
+			publishedEvent("evt-1", domain.AggregateTypeAccount, "acc-2", 1),
+			publishedEvent("evt-2", domain.AggregateTypeAccount, "acc-1", 2),
+		},
+	)
+
+	uc := usecase.NewEventStreamUseCase(outboxRepo)
+	ctx := context.Background()
+
+	// The first poll only primes the stream; evt-0 predates every subscriber.
+	if err := uc.Poll(ctx); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	sub, err := uc.Subscribe(ctx, domain.EventStreamFilter{AccountID: "acc-1"}, domain.EventStreamCursor{})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	if err := uc.Poll(ctx); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	if got := receive(t, sub, 1); got[0] != "evt-2" {
+		t.Fatalf("expected only evt-2, got %v", got)
+	}
+}
+
+func TestEventStreamUseCase_ResumesFromCursorWithoutDuplicates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	filter := domain.EventStreamFilter{AggregateType: domain.AggregateTypeTransfer}
+
+	cursorEvent := publishedEvent("evt-1", domain.AggregateTypeTransfer, "tr-1", 1)
+	evt2 := publishedEvent("evt-2", domain.AggregateTypeTransfer, "tr-1", 2)
+	evt3 := publishedEvent("evt-3", domain.AggregateTypeTransfer, "tr-1", 3)
+	evt4 := publishedEvent("evt-4", domain.AggregateTypeTransfer, "tr-1", 4)
+
+	outboxRepo.EXPECT().GetByID(gomock.Any(), "evt-1").Return(cursorEvent, nil)
+	outboxRepo.EXPECT().
+		ListPublishedAfter(gomock.Any(), filter, domain.EventStreamPosition{PublishedAt: *cursorEvent.PublishedAt, EventID: "evt-1"}, gomock.Any()).
+		Return([]*domain.OutboxEvent{evt2, evt3}, nil)
+	// evt-3 is both replayed and seen live; it must be delivered once.
+	expectPolls(outboxRepo, nil, []*domain.OutboxEvent{evt3, evt4})
+
+	uc := usecase.NewEventStreamUseCase(outboxRepo)
+	ctx := context.Background()
+
+	if err := uc.Poll(ctx); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	sub, err := uc.Subscribe(ctx, filter, domain.EventStreamCursor{EventID: "evt-1"})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	if err := uc.Poll(ctx); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	got := receive(t, sub, 3)
+	if fmt.Sprint(got) != "[evt-2 evt-3 evt-4]" {
+		t.Fatalf("unexpected events: %v", got)
+	}
+
+	select {
+	case event := <-sub.Events():
+		t.Fatalf("unexpected extra event %s", event.ID)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestEventStreamUseCase_SubscribeRejectsBadCursors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	outboxRepo.EXPECT().GetByID(gomock.Any(), "gone").Return(nil, domain.ErrOutboxEventNotFound)
+
+	uc := usecase.NewEventStreamUseCase(outboxRepo)
+	seq := int64(3)
+
+	if _, err := uc.Subscribe(context.Background(), domain.EventStreamFilter{}, domain.EventStreamCursor{AggregateSequence: &seq}); !errors.Is(err, domain.ErrInvalidEventStreamRequest) {
+		t.Fatalf("expected ErrInvalidEventStreamRequest without an account, got %v", err)
+	}
+
+	if _, err := uc.Subscribe(context.Background(), domain.EventStreamFilter{}, domain.EventStreamCursor{EventID: "gone"}); !errors.Is(err, domain.ErrEventStreamCursorNotFound) {
+		t.Fatalf("expected ErrEventStreamCursorNotFound, got %v", err)
+	}
+}
+
+func TestEventStreamUseCase_DropsLaggingSubscribers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	burst := make([]*domain.OutboxEvent, 300)
+	for i := range burst {
+		burst[i] = publishedEvent(fmt.Sprintf("evt-%d", i), domain.AggregateTypeAccount, fmt.Sprintf("acc-%d", i), 1)
+	}
+	expectPolls(outboxRepo, nil, burst, nil)
+
+	uc := usecase.NewEventStreamUseCase(outboxRepo)
+	ctx := context.Background()
+
+	if err := uc.Poll(ctx); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	sub, err := uc.Subscribe(ctx, domain.EventStreamFilter{}, domain.EventStreamCursor{})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	if err := uc.Poll(ctx); err != nil {
+		t.Fatalf("Poll() error = %v", err)
+	}
+
+	for range sub.Events() {
+	}
+
+	if !errors.Is(sub.Err(), domain.ErrEventStreamLagging) {
+		t.Fatalf("expected ErrEventStreamLagging, got %v", sub.Err())
+	}
+}
+
+func TestEventStreamUseCase_CloseEndsSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewEventStreamUseCase(mocks.NewMockOutboxRepository(ctrl))
+
+	sub, err := uc.Subscribe(context.Background(), domain.EventStreamFilter{}, domain.EventStreamCursor{})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	uc.Close()
+
+	for range sub.Events() {
+	}
+
+	if !errors.Is(sub.Err(), domain.ErrEventStreamClosed) {
+		t.Fatalf("expected ErrEventStreamClosed, got %v", sub.Err())
+	}
+
+	if _, err := uc.Subscribe(context.Background(), domain.EventStreamFilter{}, domain.EventStreamCursor{}); !errors.Is(err, domain.ErrEventStreamClosed) {
+		t.Fatalf("expected new subscriptions to be rejected, got %v", err)
+	}
+}
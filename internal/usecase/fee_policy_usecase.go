@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// FeePolicyUseCase manages the fee policies TransferUseCase evaluates on
+// transfer creation.
+type FeePolicyUseCase struct {
+	feePolicyRepo FeePolicyRepository
+	accountRepo   AccountRepository
+	idGen         IDGenerator
+}
+
+// NewFeePolicyUseCase creates a new FeePolicyUseCase.
+func NewFeePolicyUseCase(feePolicyRepo FeePolicyRepository, accountRepo AccountRepository, idGen IDGenerator) *FeePolicyUseCase {
+	return &FeePolicyUseCase{
+		feePolicyRepo: feePolicyRepo,
+		accountRepo:   accountRepo,
+		idGen:         idGen,
+	}
+}
+
+// CreateFeePolicyInput represents input for creating a fee policy. Empty
+// match fields match any transfer.
+type CreateFeePolicyInput struct {
+	MetadataMatch    map[string]string
+	Name             string
+	FromAccountID    string
+	ToAccountID      string
+	Currency         string
+	Payer            domain.FeePayer
+	RevenueAccountID string
+	Percentage       decimal.Decimal
+	FixedAmount      decimal.Decimal
+	// Precision is the number of decimal places fees are rounded to; nil
+	// means domain.DefaultFeePrecision.
+	Precision *int32
+}
+
+// CreatePolicy validates and stores a new, active fee policy. Fee legs are
+// posted in the transfer's currency, so a policy only ever matches
+// transfers in its revenue account's currency: an unset Currency defaults
+// to it and a different one is rejected.
+func (uc *FeePolicyUseCase) CreatePolicy(ctx context.Context, input CreateFeePolicyInput) (*domain.FeePolicy, error) {
+	now := time.Now().UTC()
+
+	policy := &domain.FeePolicy{
+		ID:               uc.idGen.Generate(),
+		Name:             input.Name,
+		MetadataMatch:    input.MetadataMatch,
+		Payer:            input.Payer,
+		RevenueAccountID: input.RevenueAccountID,
+		Percentage:       input.Percentage,
+		FixedAmount:      input.FixedAmount,
+		Precision:        domain.DefaultFeePrecision,
+		Active:           true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if policy.Payer == "" {
+		policy.Payer = domain.FeePayerSource
+	}
+	if input.FromAccountID != "" {
+		policy.FromAccountID = &input.FromAccountID
+	}
+	if input.ToAccountID != "" {
+		policy.ToAccountID = &input.ToAccountID
+	}
+	if input.Currency != "" {
+		currency := strings.ToUpper(input.Currency)
+		if err := domain.ValidateCurrency(currency); err != nil {
+			return nil, err
+		}
+		policy.Currency = &currency
+	}
+	if input.Precision != nil {
+		policy.Precision = *input.Precision
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	revenue, err := uc.accountRepo.GetByID(ctx, policy.RevenueAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case policy.Currency == nil:
+		currency := revenue.Currency
+		policy.Currency = &currency
+	case *policy.Currency != revenue.Currency:
+		return nil, domain.NewFieldError("currency", fmt.Errorf("%w: currency %s does not match revenue account currency %s",
+			domain.ErrInvalidFeePolicy, *policy.Currency, revenue.Currency))
+	}
+
+	for _, id := range []*string{policy.FromAccountID, policy.ToAccountID} {
+		if id == nil {
+			continue
+		}
+		if _, err := uc.accountRepo.GetByID(ctx, *id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.feePolicyRepo.Create(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// GetPolicy retrieves a fee policy by ID.
+func (uc *FeePolicyUseCase) GetPolicy(ctx context.Context, id string) (*domain.FeePolicy, error) {
+	return uc.feePolicyRepo.GetByID(ctx, id)
+}
+
+// ListPolicies lists fee policies.
+func (uc *FeePolicyUseCase) ListPolicies(ctx context.Context, limit, offset int) ([]*domain.FeePolicy, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.feePolicyRepo.List(ctx, limit, offset)
+}
+
+// SetPolicyActive enables or disables a fee policy. The change applies to
+// transfers created afterwards; fees already charged are unaffected.
+func (uc *FeePolicyUseCase) SetPolicyActive(ctx context.Context, id string, active bool) error {
+	if _, err := uc.feePolicyRepo.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	return uc.feePolicyRepo.SetActive(ctx, id, active, time.Now().UTC())
+}
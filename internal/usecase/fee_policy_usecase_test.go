@@ -0,0 +1,165 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func TestFeePolicyUseCase_CreatePolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feeRepo := mocks.NewMockFeePolicyRepository(ctrl)
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("fp-1")
+	accRepo.EXPECT().GetByID(gomock.Any(), "acc-rev").Return(&domain.Account{ID: "acc-rev", Currency: "USD"}, nil)
+	accRepo.EXPECT().GetByID(gomock.Any(), "acc-merchant").Return(&domain.Account{ID: "acc-merchant", Currency: "USD"}, nil)
+	feeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	uc := usecase.NewFeePolicyUseCase(feeRepo, accRepo, idGen)
+
+	policy, err := uc.CreatePolicy(context.Background(), usecase.CreateFeePolicyInput{
+		Name:             "Card processing",
+		ToAccountID:      "acc-merchant",
+		Currency:         "usd",
+		Payer:            domain.FeePayerDestination,
+		RevenueAccountID: "acc-rev",
+		Percentage:       decimal.RequireFromString("0.029"),
+		MetadataMatch:    map[string]string{"channel": "card"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if policy.ID != "fp-1" || !policy.Active || policy.Precision != domain.DefaultFeePrecision {
+		t.Fatalf("unexpected policy: %+v", policy)
+	}
+
+	if policy.Currency == nil || *policy.Currency != "USD" || policy.FromAccountID != nil {
+		t.Fatalf("unexpected match criteria: %+v", policy)
+	}
+}
+
+func TestFeePolicyUseCase_CreatePolicy_DefaultsPayerToSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feeRepo := mocks.NewMockFeePolicyRepository(ctrl)
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("fp-1")
+	accRepo.EXPECT().GetByID(gomock.Any(), "acc-rev").Return(&domain.Account{ID: "acc-rev", Currency: "USD"}, nil)
+	feeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	uc := usecase.NewFeePolicyUseCase(feeRepo, accRepo, idGen)
+
+	policy, err := uc.CreatePolicy(context.Background(), usecase.CreateFeePolicyInput{
+		Name:             "Flat fee",
+		RevenueAccountID: "acc-rev",
+		FixedAmount:      decimal.NewFromInt(1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if policy.Payer != domain.FeePayerSource {
+		t.Fatalf("expected source payer, got %s", policy.Payer)
+	}
+
+	if policy.Currency == nil || *policy.Currency != "USD" {
+		t.Fatalf("expected the revenue account's currency, got %v", policy.Currency)
+	}
+}
+
+func TestFeePolicyUseCase_CreatePolicy_RejectsRevenueCurrencyMismatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("fp-1")
+	accRepo.EXPECT().GetByID(gomock.Any(), "acc-rev").Return(&domain.Account{ID: "acc-rev", Currency: "USD"}, nil)
+
+	uc := usecase.NewFeePolicyUseCase(nil, accRepo, idGen)
+
+	_, err := uc.CreatePolicy(context.Background(), usecase.CreateFeePolicyInput{
+		Name:             "Flat fee",
+		Currency:         "EUR",
+		RevenueAccountID: "acc-rev",
+		FixedAmount:      decimal.NewFromInt(1),
+	})
+	if !errors.Is(err, domain.ErrInvalidFeePolicy) {
+		t.Fatalf("expected ErrInvalidFeePolicy, got %v", err)
+	}
+	if fields := domain.FieldErrors(err); len(fields) != 1 || fields[0].Field != "currency" {
+		t.Fatalf("expected a currency field error, got %+v", fields)
+	}
+}
+
+func TestFeePolicyUseCase_CreatePolicy_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	idGen.EXPECT().Generate().Return("fp-1")
+
+	uc := usecase.NewFeePolicyUseCase(nil, nil, idGen)
+
+	_, err := uc.CreatePolicy(context.Background(), usecase.CreateFeePolicyInput{
+		Name:             "No fee",
+		RevenueAccountID: "acc-rev",
+	})
+	if !errors.Is(err, domain.ErrInvalidFeePolicy) {
+		t.Fatalf("expected ErrInvalidFeePolicy, got %v", err)
+	}
+}
+
+func TestFeePolicyUseCase_CreatePolicy_UnknownRevenueAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("fp-1")
+	accRepo.EXPECT().GetByID(gomock.Any(), "missing").Return(nil, domain.ErrAccountNotFound)
+
+	uc := usecase.NewFeePolicyUseCase(nil, accRepo, idGen)
+
+	_, err := uc.CreatePolicy(context.Background(), usecase.CreateFeePolicyInput{
+		Name:             "Flat fee",
+		RevenueAccountID: "missing",
+		FixedAmount:      decimal.NewFromInt(1),
+	})
+	if !errors.Is(err, domain.ErrAccountNotFound) {
+		t.Fatalf("expected ErrAccountNotFound, got %v", err)
+	}
+}
+
+func TestFeePolicyUseCase_SetPolicyActive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feeRepo := mocks.NewMockFeePolicyRepository(ctrl)
+
+	feeRepo.EXPECT().GetByID(gomock.Any(), "fp-1").Return(&domain.FeePolicy{ID: "fp-1"}, nil)
+	feeRepo.EXPECT().SetActive(gomock.Any(), "fp-1", false, gomock.Any()).Return(nil)
+
+	uc := usecase.NewFeePolicyUseCase(feeRepo, nil, nil)
+
+	if err := uc.SetPolicyActive(context.Background(), "fp-1", false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -46,7 +46,18 @@ func NewHoldUseCase(
 	}
 }
 
-func (uc *HoldUseCase) HoldFunds(ctx context.Context, accountID string, amount decimal.Decimal) (hold *domain.Hold, err error) {
+// HoldFunds reserves amount of the account's available balance.
+func (uc *HoldUseCase) HoldFunds(ctx context.Context, accountID string, amount decimal.Decimal) (*domain.Hold, error) {
+	return uc.holdFunds(ctx, accountID, amount, nil)
+}
+
+// HoldFundsAtVersion is HoldFunds conditioned on the account being at
+// expectedVersion; otherwise it fails with domain.ErrVersionMismatch.
+func (uc *HoldUseCase) HoldFundsAtVersion(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion int64) (*domain.Hold, error) {
+	return uc.holdFunds(ctx, accountID, amount, &expectedVersion)
+}
+
+func (uc *HoldUseCase) holdFunds(ctx context.Context, accountID string, amount decimal.Decimal, expectedVersion *int64) (hold *domain.Hold, err error) {
 	defer func() {
 		if err != nil {
 			uc.auditFailedHold(ctx, domain.AuditActionHoldCreate, domain.JSON{
@@ -77,6 +88,10 @@ func (uc *HoldUseCase) HoldFunds(ctx context.Context, accountID string, amount d
 		return nil, err
 	}
 
+	if err := account.CheckVersion(expectedVersion); err != nil {
+		return nil, err
+	}
+
 	// Check available balance
 	if err := account.ValidateDebit(amount); err != nil {
 		return nil, err
@@ -102,20 +117,14 @@ func (uc *HoldUseCase) HoldFunds(ctx context.Context, accountID string, amount d
 	}
 
 	// Emit hold created event
-	event := &domain.OutboxEvent{
-		ID:            uc.idGen.Generate(),
-		AggregateID:   hold.ID,
-		AggregateType: domain.AggregateTypeHold,
-		EventType:     domain.EventTypeHoldCreated,
-		EventVersion:  1,
-		Payload: map[string]any{
-			"hold_id":    hold.ID,
-			"account_id": hold.AccountID,
-			"amount":     hold.Amount.String(),
-			"currency":   account.Currency,
-		},
-		CreatedAt: now,
-		Published: false,
+	event, err := domain.NewOutboxEvent(uc.idGen.Generate(), domain.HoldCreatedEvent{
+		HoldID:    hold.ID,
+		AccountID: hold.AccountID,
+		Amount:    hold.Amount.String(),
+		Currency:  account.Currency,
+	}, now)
+	if err != nil {
+		return nil, err
 	}
 	if err := uc.outboxRepo.Create(txCtx, tx, event); err != nil {
 		return nil, err
@@ -204,19 +213,13 @@ func (uc *HoldUseCase) VoidHold(ctx context.Context, holdID string) (err error)
 	}
 
 	// Emit hold voided event
-	event := &domain.OutboxEvent{
-		ID:            uc.idGen.Generate(),
-		AggregateID:   hold.ID,
-		AggregateType: domain.AggregateTypeHold,
-		EventType:     domain.EventTypeHoldVoided,
-		EventVersion:  1,
-		Payload: map[string]any{
-			"hold_id":    hold.ID,
-			"account_id": hold.AccountID,
-			"amount":     hold.Amount.String(),
-		},
-		CreatedAt: now,
-		Published: false,
+	event, err := domain.NewOutboxEvent(uc.idGen.Generate(), domain.HoldVoidedEvent{
+		HoldID:    hold.ID,
+		AccountID: hold.AccountID,
+		Amount:    hold.Amount.String(),
+	}, now)
+	if err != nil {
+		return err
 	}
 	if err := uc.outboxRepo.Create(txCtx, tx, event); err != nil {
 		return err
@@ -390,20 +393,14 @@ func (uc *HoldUseCase) CaptureHold(ctx context.Context, holdID, toAccountID stri
 	}
 
 	// Emit hold captured event
-	event := &domain.OutboxEvent{
-		ID:            uc.idGen.Generate(),
-		AggregateID:   hold.ID,
-		AggregateType: domain.AggregateTypeHold,
-		EventType:     domain.EventTypeHoldCaptured,
-		EventVersion:  1,
-		Payload: map[string]any{
-			"hold_id":       hold.ID,
-			"transfer_id":   transfer.ID,
-			"to_account_id": toAccountID,
-			"amount":        hold.Amount.String(),
-		},
-		CreatedAt: now,
-		Published: false,
+	event, err := domain.NewOutboxEvent(uc.idGen.Generate(), domain.HoldCapturedEvent{
+		HoldID:      hold.ID,
+		TransferID:  transfer.ID,
+		ToAccountID: toAccountID,
+		Amount:      hold.Amount.String(),
+	}, now)
+	if err != nil {
+		return nil, err
 	}
 	if err := uc.outboxRepo.Create(txCtx, tx, event); err != nil {
 		return nil, err
@@ -481,3 +478,52 @@ type ListHoldsByAccountInput struct {
 func (uc *HoldUseCase) ListHoldsByAccount(ctx context.Context, input ListHoldsByAccountInput) ([]*domain.Hold, error) {
 	return uc.holdRepo.ListByAccount(ctx, input.AccountID, input.Limit, input.Offset)
 }
+
+// GetHold retrieves a hold by ID
+func (uc *HoldUseCase) GetHold(ctx context.Context, id string) (*domain.Hold, error) {
+	return uc.holdRepo.GetByID(ctx, id)
+}
+
+// ListHoldsByAccountCursorInput represents input for cursor-paginated hold
+// listing.
+type ListHoldsByAccountCursorInput struct {
+	AccountID string
+	Filter    domain.HoldFilter
+	Cursor    string
+	Limit     int
+}
+
+// ListHoldsByAccountCursorResult is a page of holds plus the cursor to
+// request the next page. NextCursor is empty when there are no more results.
+type ListHoldsByAccountCursorResult struct {
+	Holds      []*domain.Hold
+	NextCursor string
+}
+
+// ListHoldsByAccountCursor lists holds for an account matching a filter,
+// using keyset pagination (see HoldRepository.ListByAccountCursor).
+func (uc *HoldUseCase) ListHoldsByAccountCursor(ctx context.Context, input ListHoldsByAccountCursorInput) (*ListHoldsByAccountCursorResult, error) {
+	if err := input.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+
+	if input.Limit > 100 {
+		input.Limit = 100
+	}
+
+	holds, err := uc.holdRepo.ListByAccountCursor(ctx, input.AccountID, input.Filter, input.Cursor, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListHoldsByAccountCursorResult{Holds: holds}
+	if len(holds) == input.Limit {
+		result.NextCursor = holds[len(holds)-1].ID
+	}
+
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func TestHoldUseCase_ListHoldsByAccountCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	holdRepo := mocks.NewMockHoldRepository(ctrl)
+	filter := domain.HoldFilter{Status: domain.HoldStatusActive}
+	holdRepo.EXPECT().
+		ListByAccountCursor(gomock.Any(), "acc-1", filter, "hold-9", 2).
+		Return([]*domain.Hold{{ID: "hold-8"}, {ID: "hold-7"}}, nil)
+
+	uc := usecase.NewHoldUseCase(nil, nil, holdRepo, nil, nil, nil, nil, nil, nil)
+
+	result, err := uc.ListHoldsByAccountCursor(context.Background(), usecase.ListHoldsByAccountCursorInput{
+		AccountID: "acc-1",
+		Filter:    filter,
+		Cursor:    "hold-9",
+		Limit:     2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Holds) != 2 || result.NextCursor != "hold-7" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestHoldUseCase_ListHoldsByAccountCursor_InvalidFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewHoldUseCase(nil, nil, mocks.NewMockHoldRepository(ctrl), nil, nil, nil, nil, nil, nil)
+
+	_, err := uc.ListHoldsByAccountCursor(context.Background(), usecase.ListHoldsByAccountCursorInput{
+		AccountID: "acc-1",
+		Filter:    domain.HoldFilter{Status: "expired"},
+	})
+	if !errors.Is(err, domain.ErrInvalidHoldFilter) {
+		t.Fatalf("expected ErrInvalidHoldFilter, got %v", err)
+	}
+}
+
+func TestHoldUseCase_HoldFundsAtVersion_Stale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	txMgr := mocks.NewMockTransactionManager(ctrl)
+	mockTx := mocks.NewMockTransaction(ctrl)
+
+	txMgr.EXPECT().Begin(gomock.Any()).Return(mockTx, nil)
+	accRepo.EXPECT().GetByIDForUpdate(gomock.Any(), mockTx, "acc-1").
+		Return(&domain.Account{ID: "acc-1", Balance: decimal.NewFromInt(500), Version: 7}, nil)
+	mockTx.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	uc := usecase.NewHoldUseCase(txMgr, accRepo, mocks.NewMockHoldRepository(ctrl), nil, nil, nil, nil, nil, nil)
+
+	_, err := uc.HoldFundsAtVersion(context.Background(), "acc-1", decimal.NewFromInt(10), 6)
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("expected ErrVersionMismatch, got %v", err)
+	}
+}
@@ -15,6 +15,9 @@ type AccountRepository interface {
 	CreateTx(ctx context.Context, tx Transaction, account *domain.Account) error
 	GetByID(ctx context.Context, id string) (*domain.Account, error)
 	GetByIDForUpdate(ctx context.Context, tx Transaction, id string) (*domain.Account, error)
+	// GetByIDs returns the accounts with the given IDs, in no particular
+	// order; unknown IDs are skipped.
+	GetByIDs(ctx context.Context, ids []string) ([]*domain.Account, error)
 	GetByIDsForUpdate(ctx context.Context, tx Transaction, ids []string) ([]*domain.Account, error)
 	UpdateBalance(ctx context.Context, tx Transaction, id string, balance decimal.Decimal, updatedAt time.Time) error
 	UpdateEncumberedBalance(ctx context.Context, tx Transaction, id string, encumberedBalance decimal.Decimal, updatedAt time.Time) error
@@ -23,26 +26,45 @@ type AccountRepository interface {
 	// whenever both change together, so the row never has an intermediate
 	// state that violates the accounts balance CHECK constraints.
 	UpdateBalanceAndEncumbered(ctx context.Context, tx Transaction, id string, balance, encumberedBalance decimal.Decimal, updatedAt time.Time) error
+	// List pages by OFFSET; deprecated in favour of ListCursor.
 	List(ctx context.Context, limit, offset int) ([]*domain.Account, error)
+	// ListCursor is the keyset-pagination alternative to List, as
+	// TransferRepository.ListByAccountCursor.
+	ListCursor(ctx context.Context, cursor string, limit int) ([]*domain.Account, error)
 }
 
 // TransferRepository defines data access for transfers.
 type TransferRepository interface {
 	Create(ctx context.Context, tx Transaction, transfer *domain.Transfer) error
 	GetByID(ctx context.Context, id string) (*domain.Transfer, error)
+	GetByIdempotencyKey(ctx context.Context, key string) (*domain.Transfer, error)
 	ListByAccount(ctx context.Context, accountID string, limit, offset int) ([]*domain.Transfer, error)
 	// ListByAccountCursor is the keyset-pagination alternative to
 	// ListByAccount: cursor is the ID of the last transfer seen (empty to
 	// start from the most recent), avoiding the skip/duplicate-under-
 	// concurrent-writes problem OFFSET has on large, actively-written tables.
 	ListByAccountCursor(ctx context.Context, accountID, cursor string, limit int) ([]*domain.Transfer, error)
+	// Search returns up to limit transfers matching filter in sort order,
+	// starting after the cursor's position (from the first when nil).
+	Search(ctx context.Context, filter domain.TransferSearchFilter, sort domain.TransferSort, after *domain.TransferCursor, limit int) ([]*domain.Transfer, error)
+	// GetByIDs returns the transfers with the given IDs, in no particular
+	// order; unknown IDs are skipped.
+	GetByIDs(ctx context.Context, ids []string) ([]*domain.Transfer, error)
+	// ExistingIdempotencyKeys returns the subset of keys already used by a
+	// transfer.
+	ExistingIdempotencyKeys(ctx context.Context, keys []string) ([]string, error)
 }
 
 // EntryRepository defines data access for entries.
 type EntryRepository interface {
 	Create(ctx context.Context, tx Transaction, entry *domain.Entry) error
 	GetByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error)
+	// GetByAccount pages by OFFSET; deprecated in favour of
+	// GetByAccountCursor.
 	GetByAccount(ctx context.Context, accountID string, limit, offset int) ([]*domain.Entry, error)
+	// GetByAccountCursor is the keyset-pagination alternative to
+	// GetByAccount, as TransferRepository.ListByAccountCursor.
+	GetByAccountCursor(ctx context.Context, accountID, cursor string, limit int) ([]*domain.Entry, error)
 	GetBalanceAtTime(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error)
 	// SumAmountsByAccount returns the sum of all entry amounts for an
 	// account. Since balance always starts at zero, this should equal the
@@ -51,6 +73,18 @@ type EntryRepository interface {
 	// GetAllByAccountOrdered returns every entry for an account ordered by
 	// account_version ascending, for walking the balance/version chain.
 	GetAllByAccountOrdered(ctx context.Context, accountID string) ([]*domain.Entry, error)
+	// SumAmountsByAccountTx and GetAllByAccountOrderedTx read within tx,
+	// e.g. to verify rows it wrote before they are committed.
+	SumAmountsByAccountTx(ctx context.Context, tx Transaction, accountID string) (decimal.Decimal, error)
+	GetAllByAccountOrderedTx(ctx context.Context, tx Transaction, accountID string) ([]*domain.Entry, error)
+	// GetByAccountInRange returns an account's entries booked in [from, to),
+	// ordered by account_version ascending.
+	GetByAccountInRange(ctx context.Context, accountID string, from, to time.Time) ([]*domain.Entry, error)
+	// ExportByAccount streams the entries matching filter to emit in
+	// account_version order, without loading them all into memory. It
+	// returns domain.ErrAccountNotFound for an unknown account and stops at
+	// the first error from emit.
+	ExportByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error
 }
 
 // CurrencyConsistency is the debit/credit consistency check result for a
@@ -68,6 +102,7 @@ type LedgerRepository interface {
 	// grouped by currency so offsetting errors in different currencies
 	// don't cancel out in one global sum.
 	CheckConsistencyByCurrency(ctx context.Context) ([]CurrencyConsistency, error)
+	CheckConsistencyByCurrencyTx(ctx context.Context, tx Transaction) ([]CurrencyConsistency, error)
 }
 
 // HoldRepository defines data access for holds.
@@ -77,23 +112,59 @@ type HoldRepository interface {
 	GetByIDForUpdate(ctx context.Context, tx Transaction, id string) (*domain.Hold, error)
 	UpdateStatus(ctx context.Context, tx Transaction, id string, status domain.HoldStatus, updatedAt time.Time) error
 	ListByAccount(ctx context.Context, accountID string, limit, offset int) ([]*domain.Hold, error)
+	// ListByAccountCursor lists an account's holds matching filter with
+	// keyset pagination, as TransferRepository.ListByAccountCursor does.
+	ListByAccountCursor(ctx context.Context, accountID string, filter domain.HoldFilter, cursor string, limit int) ([]*domain.Hold, error)
 }
 
 // OutboxRepository defines data access for outbox events.
 type OutboxRepository interface {
 	Create(ctx context.Context, tx Transaction, event *domain.OutboxEvent) error
 	GetUnpublished(ctx context.Context, limit int) ([]*domain.OutboxEvent, error)
-	MarkPublished(ctx context.Context, id string, publishedAt time.Time) error
+	// ClaimUnpublished leases up to limit pending events to owner until
+	// leaseUntil, so concurrent publishers never claim the same event. An
+	// aggregate is only claimed while none of its pending events is leased,
+	// and each aggregate's events come back as a prefix of its pending
+	// events in aggregate_sequence order.
+	ClaimUnpublished(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.OutboxEvent, error)
+	// ReleaseClaims drops owner's leases on the given events so they can be
+	// claimed again straight away.
+	ReleaseClaims(ctx context.Context, owner string, ids []string) error
+	// OldestUnpublishedAt returns the creation time of the oldest pending
+	// (unpublished, not dead-lettered) event, or nil when there is none.
+	OldestUnpublishedAt(ctx context.Context) (*time.Time, error)
+	// MarkPublished marks an event published and releases its lease. It
+	// returns domain.ErrOutboxLeaseLost, changing nothing, unless owner
+	// still holds the lease.
+	MarkPublished(ctx context.Context, owner, id string, publishedAt time.Time) error
 	GetByAggregate(ctx context.Context, aggregateType, aggregateID string, limit, offset int) ([]*domain.OutboxEvent, error)
-	DeletePublished(ctx context.Context, before time.Time) error
-	// RecordFailure increments the event's attempt counter and stores the
-	// error, returning the new attempt count so the caller can decide
-	// whether to dead-letter it.
-	RecordFailure(ctx context.Context, id, lastError string) (attempts int, err error)
+	// RecordFailure increments the event's attempt counter, stores the
+	// error and releases owner's lease, returning the new attempt count so
+	// the caller can decide whether to dead-letter it. Like MarkPublished,
+	// it returns domain.ErrOutboxLeaseLost unless owner holds the lease.
+	RecordFailure(ctx context.Context, owner, id, lastError string) (attempts int, err error)
 	// MarkDeadLettered stops the publisher from retrying this event.
 	MarkDeadLettered(ctx context.Context, id string, at time.Time) error
 	// GetDeadLettered lists dead-lettered events for operator inspection.
+	// It pages by OFFSET; deprecated in favour of GetDeadLetteredCursor.
 	GetDeadLettered(ctx context.Context, limit, offset int) ([]*domain.OutboxEvent, error)
+	// GetDeadLetteredCursor lists dead-lettered events after the cursor,
+	// most recently dead-lettered first (from the first when nil).
+	GetDeadLetteredCursor(ctx context.Context, after *domain.TimeCursor, limit int) ([]*domain.OutboxEvent, error)
+	// ReplayDeadLettered requeues the matching dead-lettered events by
+	// resetting their attempts and dead_lettered_at, returning them.
+	ReplayDeadLettered(ctx context.Context, tx Transaction, filter domain.DeadLetterFilter) ([]*domain.OutboxEvent, error)
+	// ArchiveDeadLettered moves the matching dead-lettered events out of the
+	// outbox into the dead-letter archive, returning them.
+	ArchiveDeadLettered(ctx context.Context, tx Transaction, filter domain.DeadLetterFilter, reason string, at time.Time) ([]*domain.OutboxEvent, error)
+	// GetByID and GetByAggregateSequence return domain.ErrOutboxEventNotFound
+	// when no event matches.
+	GetByID(ctx context.Context, id string) (*domain.OutboxEvent, error)
+	GetByAggregateSequence(ctx context.Context, aggregateType, aggregateID string, sequence int64) (*domain.OutboxEvent, error)
+	// ListPublishedAfter lists published events after a position, in
+	// publication order. Only the filter's AccountID and AggregateType are
+	// applied; callers match event types themselves.
+	ListPublishedAfter(ctx context.Context, filter domain.EventStreamFilter, after domain.EventStreamPosition, limit int) ([]*domain.OutboxEvent, error)
 }
 
 // AuditRepository defines data access for audit logs.
@@ -104,6 +175,143 @@ type AuditRepository interface {
 	GetByResourceID(ctx context.Context, resourceType, resourceID string) ([]*domain.AuditLog, error)
 }
 
+// AccrualRepository defines data access for interest/fee accrual rules,
+// account groups, and per-period accrual runs.
+type AccrualRepository interface {
+	CreateRule(ctx context.Context, rule *domain.AccrualRule) error
+	GetRule(ctx context.Context, id string) (*domain.AccrualRule, error)
+	ListRules(ctx context.Context, limit, offset int) ([]*domain.AccrualRule, error)
+	ListActiveRules(ctx context.Context) ([]*domain.AccrualRule, error)
+	SetRuleActive(ctx context.Context, id string, active bool, updatedAt time.Time) error
+	AddGroupMember(ctx context.Context, group, accountID string, createdAt time.Time) error
+	RemoveGroupMember(ctx context.Context, group, accountID string) error
+	ListGroupMembers(ctx context.Context, group string) ([]string, error)
+	// ClaimRun inserts a pending run for (rule, account, period). It returns
+	// claimed=false, without error, when that period was already claimed.
+	ClaimRun(ctx context.Context, run *domain.AccrualRun) (claimed bool, err error)
+	CompleteRun(ctx context.Context, run *domain.AccrualRun) error
+	// ReleaseRun deletes a still-pending claim so a later run retries it.
+	ReleaseRun(ctx context.Context, id string) error
+	// ListStalePendingRuns lists up to limit runs still pending that were
+	// last updated before staleBefore.
+	ListStalePendingRuns(ctx context.Context, staleBefore time.Time, limit int) ([]*domain.AccrualRun, error)
+	ListRuns(ctx context.Context, ruleID string, limit, offset int) ([]*domain.AccrualRun, error)
+}
+
+// FeePolicyRepository defines data access for transfer fee policies.
+type FeePolicyRepository interface {
+	Create(ctx context.Context, policy *domain.FeePolicy) error
+	GetByID(ctx context.Context, id string) (*domain.FeePolicy, error)
+	List(ctx context.Context, limit, offset int) ([]*domain.FeePolicy, error)
+	ListActive(ctx context.Context) ([]*domain.FeePolicy, error)
+	SetActive(ctx context.Context, id string, active bool, updatedAt time.Time) error
+}
+
+// WebhookRepository defines data access for webhook subscriptions and
+// their deliveries.
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error)
+	ListActiveSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id string) error
+	// CreateDelivery queues a delivery; it is a no-op if the subscription
+	// already has a delivery for the same event.
+	CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error
+	// ClaimDue leases up to limit pending deliveries due at now until
+	// leaseUntil, skipping deliveries another dispatcher holds.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*domain.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error)
+	// ListDeliveries lists a subscription's deliveries, newest first; an
+	// empty status matches every status.
+	ListDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error)
+	// UpdateDelivery stores the delivery's status fields and, when attempt
+	// is non-nil, appends it to the delivery log in the same transaction.
+	UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error
+	ListAttempts(ctx context.Context, deliveryID string) ([]*domain.WebhookDeliveryAttempt, error)
+}
+
+// ReportingRepository defines data access for the reporting read models
+// and the checkpoint the projector keeps them at.
+type ReportingRepository interface {
+	// LockCheckpoint returns the projection's checkpoint, locked until tx
+	// ends, so only one projector applies entries at a time.
+	LockCheckpoint(ctx context.Context, tx Transaction, name string) (*domain.ProjectionCheckpoint, error)
+	GetCheckpoint(ctx context.Context, name string) (*domain.ProjectionCheckpoint, error)
+	// ListProjectableEntries lists up to limit entries after the cursor,
+	// in projection order, stopping before any entry a still-running
+	// transaction could precede.
+	ListProjectableEntries(ctx context.Context, tx Transaction, after domain.ProjectionCursor, limit int) ([]*domain.ProjectableEntry, error)
+	// NextUnprojectedAt returns when the next entry after the cursor was
+	// created, or nil when there is none.
+	NextUnprojectedAt(ctx context.Context, after domain.ProjectionCursor) (*time.Time, error)
+	// Apply adds the delta to the read models and advances the checkpoint.
+	Apply(ctx context.Context, tx Transaction, name string, delta *domain.ReportingDelta, now time.Time) error
+	// Reset empties the read models and rewinds the checkpoint.
+	Reset(ctx context.Context, tx Transaction, name string, now time.Time) error
+
+	ListDailyVolumes(ctx context.Context, accountID string, from, to time.Time) ([]*domain.AccountDailyVolume, error)
+	ListCounterpartyTotals(ctx context.Context, accountID string, limit, offset int) ([]*domain.CounterpartyTotal, error)
+	ListBalances(ctx context.Context, limit, offset int) ([]*domain.AccountBalanceSnapshot, error)
+}
+
+// LedgerSnapshot describes the database state a backup was exported from.
+type LedgerSnapshot struct {
+	AuditChainHead string
+	SchemaVersion  int64
+}
+
+// AuditChainBreak is one point where the audit log hash chain fails to
+// verify.
+type AuditChainBreak struct {
+	AuditID string
+	Reason  string
+}
+
+// OutboxRetentionRepository manages the monthly partitions of
+// outbox_events. Partitions are detached, archived and dropped inside the
+// caller's transaction, so a failed prune leaves the outbox untouched.
+type OutboxRetentionRepository interface {
+	// EnsurePartition creates p if it doesn't exist, moving the events in
+	// its range out of the default partition into it.
+	EnsurePartition(ctx context.Context, p domain.OutboxPartition) error
+	// ListPartitions lists the monthly partitions, oldest first. The
+	// default partition is not included.
+	ListPartitions(ctx context.Context) ([]domain.OutboxPartition, error)
+	// CountDefaultPartition counts the events in the default partition.
+	CountDefaultPartition(ctx context.Context) (int64, error)
+	PartitionStats(ctx context.Context, p domain.OutboxPartition) (domain.OutboxPartitionStats, error)
+	// ExportPartition streams p's events as JSON objects keyed by column
+	// name, from one consistent snapshot.
+	ExportPartition(ctx context.Context, p domain.OutboxPartition, emit func(row []byte) error) error
+	// DetachPartition detaches p from outbox_events and returns its stats,
+	// which no longer change once it is detached.
+	DetachPartition(ctx context.Context, tx Transaction, p domain.OutboxPartition) (domain.OutboxPartitionStats, error)
+	// ArchivePartition attaches a detached partition to
+	// outbox_events_archive, renamed to p.ArchiveName().
+	ArchivePartition(ctx context.Context, tx Transaction, p domain.OutboxPartition) error
+	// DropPartition drops a detached partition.
+	DropPartition(ctx context.Context, tx Transaction, p domain.OutboxPartition) error
+}
+
+// BackupRepository defines raw, table-level data access for logical
+// backups. Rows are JSON objects keyed by column name.
+type BackupRepository interface {
+	// Export streams every row of tables, table by table in the given
+	// order, from a single consistent snapshot.
+	Export(ctx context.Context, tables []string, emit func(table string, row []byte) error) (*LedgerSnapshot, error)
+	SchemaVersion(ctx context.Context) (int64, error)
+	// CountRows returns the total number of rows in tables.
+	CountRows(ctx context.Context, tables []string) (int64, error)
+	// Restore inserts rows, as produced by Export, into table in order.
+	Restore(ctx context.Context, tx Transaction, table string, rows [][]byte) error
+	// AuditChainHead returns the hash of the latest audit log as seen by
+	// tx.
+	AuditChainHead(ctx context.Context, tx Transaction) (string, error)
+	VerifyAuditChain(ctx context.Context, tx Transaction) ([]AuditChainBreak, error)
+}
+
 // Transaction represents a database transaction.
 type Transaction interface {
 	Commit(ctx context.Context) error
@@ -111,3 +111,7 @@ func (f *fakeLedgerRepository) CheckConsistencyByCurrency(ctx context.Context) (
 	}
 	return []CurrencyConsistency{{Currency: "USD", TotalBalance: f.totalBalance, TotalEntries: f.totalAmount}}, nil
 }
+
+func (f *fakeLedgerRepository) CheckConsistencyByCurrencyTx(ctx context.Context, _ Transaction) ([]CurrencyConsistency, error) {
+	return f.CheckConsistencyByCurrency(ctx)
+}
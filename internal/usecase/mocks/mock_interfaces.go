@@ -102,6 +102,21 @@ func (mr *MockAccountRepositoryMockRecorder) GetByIDForUpdate(ctx, tx, id any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDForUpdate", reflect.TypeOf((*MockAccountRepository)(nil).GetByIDForUpdate), ctx, tx, id)
 }
 
+// GetByIDs mocks base method.
+func (m *MockAccountRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*domain.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockAccountRepositoryMockRecorder) GetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockAccountRepository)(nil).GetByIDs), ctx, ids)
+}
+
 // GetByIDsForUpdate mocks base method.
 func (m *MockAccountRepository) GetByIDsForUpdate(ctx context.Context, tx usecase.Transaction, ids []string) ([]*domain.Account, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +147,21 @@ func (mr *MockAccountRepositoryMockRecorder) List(ctx, limit, offset any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAccountRepository)(nil).List), ctx, limit, offset)
 }
 
+// ListCursor mocks base method.
+func (m *MockAccountRepository) ListCursor(ctx context.Context, cursor string, limit int) ([]*domain.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCursor", ctx, cursor, limit)
+	ret0, _ := ret[0].([]*domain.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCursor indicates an expected call of ListCursor.
+func (mr *MockAccountRepositoryMockRecorder) ListCursor(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCursor", reflect.TypeOf((*MockAccountRepository)(nil).ListCursor), ctx, cursor, limit)
+}
+
 // UpdateBalance mocks base method.
 func (m *MockAccountRepository) UpdateBalance(ctx context.Context, tx usecase.Transaction, id string, balance decimal.Decimal, updatedAt time.Time) error {
 	m.ctrl.T.Helper()
@@ -212,6 +242,21 @@ func (mr *MockTransferRepositoryMockRecorder) Create(ctx, tx, transfer any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransferRepository)(nil).Create), ctx, tx, transfer)
 }
 
+// ExistingIdempotencyKeys mocks base method.
+func (m *MockTransferRepository) ExistingIdempotencyKeys(ctx context.Context, keys []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistingIdempotencyKeys", ctx, keys)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistingIdempotencyKeys indicates an expected call of ExistingIdempotencyKeys.
+func (mr *MockTransferRepositoryMockRecorder) ExistingIdempotencyKeys(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistingIdempotencyKeys", reflect.TypeOf((*MockTransferRepository)(nil).ExistingIdempotencyKeys), ctx, keys)
+}
+
 // GetByID mocks base method.
 func (m *MockTransferRepository) GetByID(ctx context.Context, id string) (*domain.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -227,6 +272,36 @@ func (mr *MockTransferRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTransferRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByIDs mocks base method.
+func (m *MockTransferRepository) GetByIDs(ctx context.Context, ids []string) ([]*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockTransferRepositoryMockRecorder) GetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockTransferRepository)(nil).GetByIDs), ctx, ids)
+}
+
+// GetByIdempotencyKey mocks base method.
+func (m *MockTransferRepository) GetByIdempotencyKey(ctx context.Context, key string) (*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIdempotencyKey indicates an expected call of GetByIdempotencyKey.
+func (mr *MockTransferRepositoryMockRecorder) GetByIdempotencyKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIdempotencyKey", reflect.TypeOf((*MockTransferRepository)(nil).GetByIdempotencyKey), ctx, key)
+}
+
 // ListByAccount mocks base method.
 func (m *MockTransferRepository) ListByAccount(ctx context.Context, accountID string, limit, offset int) ([]*domain.Transfer, error) {
 	m.ctrl.T.Helper()
@@ -257,6 +332,21 @@ func (mr *MockTransferRepositoryMockRecorder) ListByAccountCursor(ctx, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountCursor", reflect.TypeOf((*MockTransferRepository)(nil).ListByAccountCursor), ctx, accountID, cursor, limit)
 }
 
+// Search mocks base method.
+func (m *MockTransferRepository) Search(ctx context.Context, filter domain.TransferSearchFilter, sort domain.TransferSort, after *domain.TransferCursor, limit int) ([]*domain.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, filter, sort, after, limit)
+	ret0, _ := ret[0].([]*domain.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTransferRepositoryMockRecorder) Search(ctx, filter, sort, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTransferRepository)(nil).Search), ctx, filter, sort, after, limit)
+}
+
 // MockEntryRepository is a mock of EntryRepository interface.
 type MockEntryRepository struct {
 	ctrl     *gomock.Controller
@@ -295,6 +385,20 @@ func (mr *MockEntryRepositoryMockRecorder) Create(ctx, tx, entry any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEntryRepository)(nil).Create), ctx, tx, entry)
 }
 
+// ExportByAccount mocks base method.
+func (m *MockEntryRepository) ExportByAccount(ctx context.Context, filter domain.EntryExportFilter, emit func(*domain.Entry) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportByAccount", ctx, filter, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportByAccount indicates an expected call of ExportByAccount.
+func (mr *MockEntryRepositoryMockRecorder) ExportByAccount(ctx, filter, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportByAccount", reflect.TypeOf((*MockEntryRepository)(nil).ExportByAccount), ctx, filter, emit)
+}
+
 // GetAllByAccountOrdered mocks base method.
 func (m *MockEntryRepository) GetAllByAccountOrdered(ctx context.Context, accountID string) ([]*domain.Entry, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +414,21 @@ func (mr *MockEntryRepositoryMockRecorder) GetAllByAccountOrdered(ctx, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByAccountOrdered", reflect.TypeOf((*MockEntryRepository)(nil).GetAllByAccountOrdered), ctx, accountID)
 }
 
+// GetAllByAccountOrderedTx mocks base method.
+func (m *MockEntryRepository) GetAllByAccountOrderedTx(ctx context.Context, tx usecase.Transaction, accountID string) ([]*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByAccountOrderedTx", ctx, tx, accountID)
+	ret0, _ := ret[0].([]*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByAccountOrderedTx indicates an expected call of GetAllByAccountOrderedTx.
+func (mr *MockEntryRepositoryMockRecorder) GetAllByAccountOrderedTx(ctx, tx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByAccountOrderedTx", reflect.TypeOf((*MockEntryRepository)(nil).GetAllByAccountOrderedTx), ctx, tx, accountID)
+}
+
 // GetBalanceAtTime mocks base method.
 func (m *MockEntryRepository) GetBalanceAtTime(ctx context.Context, accountID string, at time.Time) (decimal.Decimal, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +459,36 @@ func (mr *MockEntryRepositoryMockRecorder) GetByAccount(ctx, accountID, limit, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccount", reflect.TypeOf((*MockEntryRepository)(nil).GetByAccount), ctx, accountID, limit, offset)
 }
 
+// GetByAccountCursor mocks base method.
+func (m *MockEntryRepository) GetByAccountCursor(ctx context.Context, accountID, cursor string, limit int) ([]*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountCursor", ctx, accountID, cursor, limit)
+	ret0, _ := ret[0].([]*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountCursor indicates an expected call of GetByAccountCursor.
+func (mr *MockEntryRepositoryMockRecorder) GetByAccountCursor(ctx, accountID, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountCursor", reflect.TypeOf((*MockEntryRepository)(nil).GetByAccountCursor), ctx, accountID, cursor, limit)
+}
+
+// GetByAccountInRange mocks base method.
+func (m *MockEntryRepository) GetByAccountInRange(ctx context.Context, accountID string, from, to time.Time) ([]*domain.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAccountInRange", ctx, accountID, from, to)
+	ret0, _ := ret[0].([]*domain.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAccountInRange indicates an expected call of GetByAccountInRange.
+func (mr *MockEntryRepositoryMockRecorder) GetByAccountInRange(ctx, accountID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAccountInRange", reflect.TypeOf((*MockEntryRepository)(nil).GetByAccountInRange), ctx, accountID, from, to)
+}
+
 // GetByTransfer mocks base method.
 func (m *MockEntryRepository) GetByTransfer(ctx context.Context, transferID string) ([]*domain.Entry, error) {
 	m.ctrl.T.Helper()
@@ -370,6 +519,21 @@ func (mr *MockEntryRepositoryMockRecorder) SumAmountsByAccount(ctx, accountID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumAmountsByAccount", reflect.TypeOf((*MockEntryRepository)(nil).SumAmountsByAccount), ctx, accountID)
 }
 
+// SumAmountsByAccountTx mocks base method.
+func (m *MockEntryRepository) SumAmountsByAccountTx(ctx context.Context, tx usecase.Transaction, accountID string) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumAmountsByAccountTx", ctx, tx, accountID)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumAmountsByAccountTx indicates an expected call of SumAmountsByAccountTx.
+func (mr *MockEntryRepositoryMockRecorder) SumAmountsByAccountTx(ctx, tx, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumAmountsByAccountTx", reflect.TypeOf((*MockEntryRepository)(nil).SumAmountsByAccountTx), ctx, tx, accountID)
+}
+
 // MockLedgerRepository is a mock of LedgerRepository interface.
 type MockLedgerRepository struct {
 	ctrl     *gomock.Controller
@@ -425,6 +589,21 @@ func (mr *MockLedgerRepositoryMockRecorder) CheckConsistencyByCurrency(ctx any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConsistencyByCurrency", reflect.TypeOf((*MockLedgerRepository)(nil).CheckConsistencyByCurrency), ctx)
 }
 
+// CheckConsistencyByCurrencyTx mocks base method.
+func (m *MockLedgerRepository) CheckConsistencyByCurrencyTx(ctx context.Context, tx usecase.Transaction) ([]usecase.CurrencyConsistency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckConsistencyByCurrencyTx", ctx, tx)
+	ret0, _ := ret[0].([]usecase.CurrencyConsistency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckConsistencyByCurrencyTx indicates an expected call of CheckConsistencyByCurrencyTx.
+func (mr *MockLedgerRepositoryMockRecorder) CheckConsistencyByCurrencyTx(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckConsistencyByCurrencyTx", reflect.TypeOf((*MockLedgerRepository)(nil).CheckConsistencyByCurrencyTx), ctx, tx)
+}
+
 // MockHoldRepository is a mock of HoldRepository interface.
 type MockHoldRepository struct {
 	ctrl     *gomock.Controller
@@ -508,6 +687,21 @@ func (mr *MockHoldRepositoryMockRecorder) ListByAccount(ctx, accountID, limit, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccount", reflect.TypeOf((*MockHoldRepository)(nil).ListByAccount), ctx, accountID, limit, offset)
 }
 
+// ListByAccountCursor mocks base method.
+func (m *MockHoldRepository) ListByAccountCursor(ctx context.Context, accountID string, filter domain.HoldFilter, cursor string, limit int) ([]*domain.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountCursor", ctx, accountID, filter, cursor, limit)
+	ret0, _ := ret[0].([]*domain.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountCursor indicates an expected call of ListByAccountCursor.
+func (mr *MockHoldRepositoryMockRecorder) ListByAccountCursor(ctx, accountID, filter, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountCursor", reflect.TypeOf((*MockHoldRepository)(nil).ListByAccountCursor), ctx, accountID, filter, cursor, limit)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockHoldRepository) UpdateStatus(ctx context.Context, tx usecase.Transaction, id string, status domain.HoldStatus, updatedAt time.Time) error {
 	m.ctrl.T.Helper()
@@ -546,32 +740,48 @@ func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockOutboxRepository) Create(ctx context.Context, tx usecase.Transaction, event *domain.OutboxEvent) error {
+// ArchiveDeadLettered mocks base method.
+func (m *MockOutboxRepository) ArchiveDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter, reason string, at time.Time) ([]*domain.OutboxEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, tx, event)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ArchiveDeadLettered", ctx, tx, filter, reason, at)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockOutboxRepositoryMockRecorder) Create(ctx, tx, event any) *gomock.Call {
+// ArchiveDeadLettered indicates an expected call of ArchiveDeadLettered.
+func (mr *MockOutboxRepositoryMockRecorder) ArchiveDeadLettered(ctx, tx, filter, reason, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutboxRepository)(nil).Create), ctx, tx, event)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveDeadLettered", reflect.TypeOf((*MockOutboxRepository)(nil).ArchiveDeadLettered), ctx, tx, filter, reason, at)
 }
 
-// DeletePublished mocks base method.
-func (m *MockOutboxRepository) DeletePublished(ctx context.Context, before time.Time) error {
+// ClaimUnpublished mocks base method.
+func (m *MockOutboxRepository) ClaimUnpublished(ctx context.Context, owner string, now, leaseUntil time.Time, limit int) ([]*domain.OutboxEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePublished", ctx, before)
+	ret := m.ctrl.Call(m, "ClaimUnpublished", ctx, owner, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimUnpublished indicates an expected call of ClaimUnpublished.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimUnpublished(ctx, owner, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimUnpublished", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimUnpublished), ctx, owner, now, leaseUntil, limit)
+}
+
+// Create mocks base method.
+func (m *MockOutboxRepository) Create(ctx context.Context, tx usecase.Transaction, event *domain.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, tx, event)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeletePublished indicates an expected call of DeletePublished.
-func (mr *MockOutboxRepositoryMockRecorder) DeletePublished(ctx, before any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockOutboxRepositoryMockRecorder) Create(ctx, tx, event any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePublished", reflect.TypeOf((*MockOutboxRepository)(nil).DeletePublished), ctx, before)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOutboxRepository)(nil).Create), ctx, tx, event)
 }
 
 // GetByAggregate mocks base method.
@@ -589,6 +799,36 @@ func (mr *MockOutboxRepositoryMockRecorder) GetByAggregate(ctx, aggregateType, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAggregate", reflect.TypeOf((*MockOutboxRepository)(nil).GetByAggregate), ctx, aggregateType, aggregateID, limit, offset)
 }
 
+// GetByAggregateSequence mocks base method.
+func (m *MockOutboxRepository) GetByAggregateSequence(ctx context.Context, aggregateType, aggregateID string, sequence int64) (*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAggregateSequence", ctx, aggregateType, aggregateID, sequence)
+	ret0, _ := ret[0].(*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAggregateSequence indicates an expected call of GetByAggregateSequence.
+func (mr *MockOutboxRepositoryMockRecorder) GetByAggregateSequence(ctx, aggregateType, aggregateID, sequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAggregateSequence", reflect.TypeOf((*MockOutboxRepository)(nil).GetByAggregateSequence), ctx, aggregateType, aggregateID, sequence)
+}
+
+// GetByID mocks base method.
+func (m *MockOutboxRepository) GetByID(ctx context.Context, id string) (*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOutboxRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOutboxRepository)(nil).GetByID), ctx, id)
+}
+
 // GetDeadLettered mocks base method.
 func (m *MockOutboxRepository) GetDeadLettered(ctx context.Context, limit, offset int) ([]*domain.OutboxEvent, error) {
 	m.ctrl.T.Helper()
@@ -604,6 +844,21 @@ func (mr *MockOutboxRepositoryMockRecorder) GetDeadLettered(ctx, limit, offset a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLettered", reflect.TypeOf((*MockOutboxRepository)(nil).GetDeadLettered), ctx, limit, offset)
 }
 
+// GetDeadLetteredCursor mocks base method.
+func (m *MockOutboxRepository) GetDeadLetteredCursor(ctx context.Context, after *domain.TimeCursor, limit int) ([]*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetteredCursor", ctx, after, limit)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetteredCursor indicates an expected call of GetDeadLetteredCursor.
+func (mr *MockOutboxRepositoryMockRecorder) GetDeadLetteredCursor(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetteredCursor", reflect.TypeOf((*MockOutboxRepository)(nil).GetDeadLetteredCursor), ctx, after, limit)
+}
+
 // GetUnpublished mocks base method.
 func (m *MockOutboxRepository) GetUnpublished(ctx context.Context, limit int) ([]*domain.OutboxEvent, error) {
 	m.ctrl.T.Helper()
@@ -619,6 +874,21 @@ func (mr *MockOutboxRepositoryMockRecorder) GetUnpublished(ctx, limit any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnpublished", reflect.TypeOf((*MockOutboxRepository)(nil).GetUnpublished), ctx, limit)
 }
 
+// ListPublishedAfter mocks base method.
+func (m *MockOutboxRepository) ListPublishedAfter(ctx context.Context, filter domain.EventStreamFilter, after domain.EventStreamPosition, limit int) ([]*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublishedAfter", ctx, filter, after, limit)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublishedAfter indicates an expected call of ListPublishedAfter.
+func (mr *MockOutboxRepositoryMockRecorder) ListPublishedAfter(ctx, filter, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublishedAfter", reflect.TypeOf((*MockOutboxRepository)(nil).ListPublishedAfter), ctx, filter, after, limit)
+}
+
 // MarkDeadLettered mocks base method.
 func (m *MockOutboxRepository) MarkDeadLettered(ctx context.Context, id string, at time.Time) error {
 	m.ctrl.T.Helper()
@@ -634,32 +904,76 @@ func (mr *MockOutboxRepositoryMockRecorder) MarkDeadLettered(ctx, id, at any) *g
 }
 
 // MarkPublished mocks base method.
-func (m *MockOutboxRepository) MarkPublished(ctx context.Context, id string, publishedAt time.Time) error {
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, owner, id string, publishedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkPublished", ctx, id, publishedAt)
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, owner, id, publishedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MarkPublished indicates an expected call of MarkPublished.
-func (mr *MockOutboxRepositoryMockRecorder) MarkPublished(ctx, id, publishedAt any) *gomock.Call {
+func (mr *MockOutboxRepositoryMockRecorder) MarkPublished(ctx, owner, id, publishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockOutboxRepository)(nil).MarkPublished), ctx, owner, id, publishedAt)
+}
+
+// OldestUnpublishedAt mocks base method.
+func (m *MockOutboxRepository) OldestUnpublishedAt(ctx context.Context) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OldestUnpublishedAt", ctx)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OldestUnpublishedAt indicates an expected call of OldestUnpublishedAt.
+func (mr *MockOutboxRepositoryMockRecorder) OldestUnpublishedAt(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockOutboxRepository)(nil).MarkPublished), ctx, id, publishedAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OldestUnpublishedAt", reflect.TypeOf((*MockOutboxRepository)(nil).OldestUnpublishedAt), ctx)
 }
 
 // RecordFailure mocks base method.
-func (m *MockOutboxRepository) RecordFailure(ctx context.Context, id, lastError string) (int, error) {
+func (m *MockOutboxRepository) RecordFailure(ctx context.Context, owner, id, lastError string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RecordFailure", ctx, id, lastError)
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, owner, id, lastError)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RecordFailure indicates an expected call of RecordFailure.
-func (mr *MockOutboxRepositoryMockRecorder) RecordFailure(ctx, id, lastError any) *gomock.Call {
+func (mr *MockOutboxRepositoryMockRecorder) RecordFailure(ctx, owner, id, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockOutboxRepository)(nil).RecordFailure), ctx, owner, id, lastError)
+}
+
+// ReleaseClaims mocks base method.
+func (m *MockOutboxRepository) ReleaseClaims(ctx context.Context, owner string, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseClaims", ctx, owner, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseClaims indicates an expected call of ReleaseClaims.
+func (mr *MockOutboxRepositoryMockRecorder) ReleaseClaims(ctx, owner, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseClaims", reflect.TypeOf((*MockOutboxRepository)(nil).ReleaseClaims), ctx, owner, ids)
+}
+
+// ReplayDeadLettered mocks base method.
+func (m *MockOutboxRepository) ReplayDeadLettered(ctx context.Context, tx usecase.Transaction, filter domain.DeadLetterFilter) ([]*domain.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplayDeadLettered", ctx, tx, filter)
+	ret0, _ := ret[0].([]*domain.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplayDeadLettered indicates an expected call of ReplayDeadLettered.
+func (mr *MockOutboxRepositoryMockRecorder) ReplayDeadLettered(ctx, tx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockOutboxRepository)(nil).RecordFailure), ctx, id, lastError)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplayDeadLettered", reflect.TypeOf((*MockOutboxRepository)(nil).ReplayDeadLettered), ctx, tx, filter)
 }
 
 // MockAuditRepository is a mock of AuditRepository interface.
@@ -744,6 +1058,925 @@ func (mr *MockAuditRepositoryMockRecorder) List(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAuditRepository)(nil).List), ctx, filter)
 }
 
+// MockAccrualRepository is a mock of AccrualRepository interface.
+type MockAccrualRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccrualRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccrualRepositoryMockRecorder is the mock recorder for MockAccrualRepository.
+type MockAccrualRepositoryMockRecorder struct {
+	mock *MockAccrualRepository
+}
+
+// NewMockAccrualRepository creates a new mock instance.
+func NewMockAccrualRepository(ctrl *gomock.Controller) *MockAccrualRepository {
+	mock := &MockAccrualRepository{ctrl: ctrl}
+	mock.recorder = &MockAccrualRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccrualRepository) EXPECT() *MockAccrualRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddGroupMember mocks base method.
+func (m *MockAccrualRepository) AddGroupMember(ctx context.Context, group, accountID string, createdAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupMember", ctx, group, accountID, createdAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroupMember indicates an expected call of AddGroupMember.
+func (mr *MockAccrualRepositoryMockRecorder) AddGroupMember(ctx, group, accountID, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupMember", reflect.TypeOf((*MockAccrualRepository)(nil).AddGroupMember), ctx, group, accountID, createdAt)
+}
+
+// ClaimRun mocks base method.
+func (m *MockAccrualRepository) ClaimRun(ctx context.Context, run *domain.AccrualRun) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRun", ctx, run)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRun indicates an expected call of ClaimRun.
+func (mr *MockAccrualRepositoryMockRecorder) ClaimRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRun", reflect.TypeOf((*MockAccrualRepository)(nil).ClaimRun), ctx, run)
+}
+
+// CompleteRun mocks base method.
+func (m *MockAccrualRepository) CompleteRun(ctx context.Context, run *domain.AccrualRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteRun indicates an expected call of CompleteRun.
+func (mr *MockAccrualRepositoryMockRecorder) CompleteRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteRun", reflect.TypeOf((*MockAccrualRepository)(nil).CompleteRun), ctx, run)
+}
+
+// CreateRule mocks base method.
+func (m *MockAccrualRepository) CreateRule(ctx context.Context, rule *domain.AccrualRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRule indicates an expected call of CreateRule.
+func (mr *MockAccrualRepositoryMockRecorder) CreateRule(ctx, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRule", reflect.TypeOf((*MockAccrualRepository)(nil).CreateRule), ctx, rule)
+}
+
+// GetRule mocks base method.
+func (m *MockAccrualRepository) GetRule(ctx context.Context, id string) (*domain.AccrualRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRule", ctx, id)
+	ret0, _ := ret[0].(*domain.AccrualRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRule indicates an expected call of GetRule.
+func (mr *MockAccrualRepositoryMockRecorder) GetRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRule", reflect.TypeOf((*MockAccrualRepository)(nil).GetRule), ctx, id)
+}
+
+// ListActiveRules mocks base method.
+func (m *MockAccrualRepository) ListActiveRules(ctx context.Context) ([]*domain.AccrualRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveRules", ctx)
+	ret0, _ := ret[0].([]*domain.AccrualRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveRules indicates an expected call of ListActiveRules.
+func (mr *MockAccrualRepositoryMockRecorder) ListActiveRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveRules", reflect.TypeOf((*MockAccrualRepository)(nil).ListActiveRules), ctx)
+}
+
+// ListGroupMembers mocks base method.
+func (m *MockAccrualRepository) ListGroupMembers(ctx context.Context, group string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupMembers", ctx, group)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupMembers indicates an expected call of ListGroupMembers.
+func (mr *MockAccrualRepositoryMockRecorder) ListGroupMembers(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupMembers", reflect.TypeOf((*MockAccrualRepository)(nil).ListGroupMembers), ctx, group)
+}
+
+// ListRules mocks base method.
+func (m *MockAccrualRepository) ListRules(ctx context.Context, limit, offset int) ([]*domain.AccrualRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRules", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.AccrualRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRules indicates an expected call of ListRules.
+func (mr *MockAccrualRepositoryMockRecorder) ListRules(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRules", reflect.TypeOf((*MockAccrualRepository)(nil).ListRules), ctx, limit, offset)
+}
+
+// ListRuns mocks base method.
+func (m *MockAccrualRepository) ListRuns(ctx context.Context, ruleID string, limit, offset int) ([]*domain.AccrualRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRuns", ctx, ruleID, limit, offset)
+	ret0, _ := ret[0].([]*domain.AccrualRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRuns indicates an expected call of ListRuns.
+func (mr *MockAccrualRepositoryMockRecorder) ListRuns(ctx, ruleID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRuns", reflect.TypeOf((*MockAccrualRepository)(nil).ListRuns), ctx, ruleID, limit, offset)
+}
+
+// ListStalePendingRuns mocks base method.
+func (m *MockAccrualRepository) ListStalePendingRuns(ctx context.Context, staleBefore time.Time, limit int) ([]*domain.AccrualRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStalePendingRuns", ctx, staleBefore, limit)
+	ret0, _ := ret[0].([]*domain.AccrualRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStalePendingRuns indicates an expected call of ListStalePendingRuns.
+func (mr *MockAccrualRepositoryMockRecorder) ListStalePendingRuns(ctx, staleBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStalePendingRuns", reflect.TypeOf((*MockAccrualRepository)(nil).ListStalePendingRuns), ctx, staleBefore, limit)
+}
+
+// ReleaseRun mocks base method.
+func (m *MockAccrualRepository) ReleaseRun(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseRun", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseRun indicates an expected call of ReleaseRun.
+func (mr *MockAccrualRepositoryMockRecorder) ReleaseRun(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseRun", reflect.TypeOf((*MockAccrualRepository)(nil).ReleaseRun), ctx, id)
+}
+
+// RemoveGroupMember mocks base method.
+func (m *MockAccrualRepository) RemoveGroupMember(ctx context.Context, group, accountID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroupMember", ctx, group, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroupMember indicates an expected call of RemoveGroupMember.
+func (mr *MockAccrualRepositoryMockRecorder) RemoveGroupMember(ctx, group, accountID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroupMember", reflect.TypeOf((*MockAccrualRepository)(nil).RemoveGroupMember), ctx, group, accountID)
+}
+
+// SetRuleActive mocks base method.
+func (m *MockAccrualRepository) SetRuleActive(ctx context.Context, id string, active bool, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRuleActive", ctx, id, active, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRuleActive indicates an expected call of SetRuleActive.
+func (mr *MockAccrualRepositoryMockRecorder) SetRuleActive(ctx, id, active, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRuleActive", reflect.TypeOf((*MockAccrualRepository)(nil).SetRuleActive), ctx, id, active, updatedAt)
+}
+
+// MockFeePolicyRepository is a mock of FeePolicyRepository interface.
+type MockFeePolicyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeePolicyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFeePolicyRepositoryMockRecorder is the mock recorder for MockFeePolicyRepository.
+type MockFeePolicyRepositoryMockRecorder struct {
+	mock *MockFeePolicyRepository
+}
+
+// NewMockFeePolicyRepository creates a new mock instance.
+func NewMockFeePolicyRepository(ctrl *gomock.Controller) *MockFeePolicyRepository {
+	mock := &MockFeePolicyRepository{ctrl: ctrl}
+	mock.recorder = &MockFeePolicyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeePolicyRepository) EXPECT() *MockFeePolicyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockFeePolicyRepository) Create(ctx context.Context, policy *domain.FeePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockFeePolicyRepositoryMockRecorder) Create(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFeePolicyRepository)(nil).Create), ctx, policy)
+}
+
+// GetByID mocks base method.
+func (m *MockFeePolicyRepository) GetByID(ctx context.Context, id string) (*domain.FeePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*domain.FeePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockFeePolicyRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockFeePolicyRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockFeePolicyRepository) List(ctx context.Context, limit, offset int) ([]*domain.FeePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.FeePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockFeePolicyRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockFeePolicyRepository)(nil).List), ctx, limit, offset)
+}
+
+// ListActive mocks base method.
+func (m *MockFeePolicyRepository) ListActive(ctx context.Context) ([]*domain.FeePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActive", ctx)
+	ret0, _ := ret[0].([]*domain.FeePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActive indicates an expected call of ListActive.
+func (mr *MockFeePolicyRepositoryMockRecorder) ListActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActive", reflect.TypeOf((*MockFeePolicyRepository)(nil).ListActive), ctx)
+}
+
+// SetActive mocks base method.
+func (m *MockFeePolicyRepository) SetActive(ctx context.Context, id string, active bool, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, id, active, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockFeePolicyRepositoryMockRecorder) SetActive(ctx, id, active, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockFeePolicyRepository)(nil).SetActive), ctx, id, active, updatedAt)
+}
+
+// MockWebhookRepository is a mock of WebhookRepository interface.
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository.
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance.
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockWebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]*domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockWebhookRepositoryMockRecorder) ClaimDue(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookRepository)(nil).ClaimDue), ctx, now, leaseUntil, limit)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockWebhookRepository) CreateDelivery(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) CreateDelivery(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).CreateDelivery), ctx, delivery)
+}
+
+// CreateSubscription mocks base method.
+func (m *MockWebhookRepository) CreateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) CreateSubscription(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).CreateSubscription), ctx, sub)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockWebhookRepository) DeleteSubscription(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) DeleteSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).DeleteSubscription), ctx, id)
+}
+
+// GetDelivery mocks base method.
+func (m *MockWebhookRepository) GetDelivery(ctx context.Context, id string) (*domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", ctx, id)
+	ret0, _ := ret[0].(*domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) GetDelivery(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).GetDelivery), ctx, id)
+}
+
+// GetSubscription mocks base method.
+func (m *MockWebhookRepository) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscription", ctx, id)
+	ret0, _ := ret[0].(*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscription indicates an expected call of GetSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) GetSubscription(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).GetSubscription), ctx, id)
+}
+
+// ListActiveSubscriptions mocks base method.
+func (m *MockWebhookRepository) ListActiveSubscriptions(ctx context.Context) ([]*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveSubscriptions", ctx)
+	ret0, _ := ret[0].([]*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveSubscriptions indicates an expected call of ListActiveSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) ListActiveSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).ListActiveSubscriptions), ctx)
+}
+
+// ListAttempts mocks base method.
+func (m *MockWebhookRepository) ListAttempts(ctx context.Context, deliveryID string) ([]*domain.WebhookDeliveryAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttempts", ctx, deliveryID)
+	ret0, _ := ret[0].([]*domain.WebhookDeliveryAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttempts indicates an expected call of ListAttempts.
+func (mr *MockWebhookRepositoryMockRecorder) ListAttempts(ctx, deliveryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttempts", reflect.TypeOf((*MockWebhookRepository)(nil).ListAttempts), ctx, deliveryID)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookRepository) ListDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, subscriptionID, status, limit, offset)
+	ret0, _ := ret[0].([]*domain.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookRepositoryMockRecorder) ListDeliveries(ctx, subscriptionID, status, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookRepository)(nil).ListDeliveries), ctx, subscriptionID, status, limit, offset)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockWebhookRepository) ListSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockWebhookRepositoryMockRecorder) ListSubscriptions(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockWebhookRepository)(nil).ListSubscriptions), ctx, limit, offset)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockWebhookRepository) UpdateDelivery(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookDeliveryAttempt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, delivery, attempt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateDelivery(ctx, delivery, attempt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateDelivery), ctx, delivery, attempt)
+}
+
+// UpdateSubscription mocks base method.
+func (m *MockWebhookRepository) UpdateSubscription(ctx context.Context, sub *domain.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscription", ctx, sub)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscription indicates an expected call of UpdateSubscription.
+func (mr *MockWebhookRepositoryMockRecorder) UpdateSubscription(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockWebhookRepository)(nil).UpdateSubscription), ctx, sub)
+}
+
+// MockReportingRepository is a mock of ReportingRepository interface.
+type MockReportingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReportingRepositoryMockRecorder is the mock recorder for MockReportingRepository.
+type MockReportingRepositoryMockRecorder struct {
+	mock *MockReportingRepository
+}
+
+// NewMockReportingRepository creates a new mock instance.
+func NewMockReportingRepository(ctrl *gomock.Controller) *MockReportingRepository {
+	mock := &MockReportingRepository{ctrl: ctrl}
+	mock.recorder = &MockReportingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportingRepository) EXPECT() *MockReportingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockReportingRepository) Apply(ctx context.Context, tx usecase.Transaction, name string, delta *domain.ReportingDelta, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, tx, name, delta, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockReportingRepositoryMockRecorder) Apply(ctx, tx, name, delta, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockReportingRepository)(nil).Apply), ctx, tx, name, delta, now)
+}
+
+// GetCheckpoint mocks base method.
+func (m *MockReportingRepository) GetCheckpoint(ctx context.Context, name string) (*domain.ProjectionCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckpoint", ctx, name)
+	ret0, _ := ret[0].(*domain.ProjectionCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckpoint indicates an expected call of GetCheckpoint.
+func (mr *MockReportingRepositoryMockRecorder) GetCheckpoint(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockReportingRepository)(nil).GetCheckpoint), ctx, name)
+}
+
+// ListBalances mocks base method.
+func (m *MockReportingRepository) ListBalances(ctx context.Context, limit, offset int) ([]*domain.AccountBalanceSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalances", ctx, limit, offset)
+	ret0, _ := ret[0].([]*domain.AccountBalanceSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalances indicates an expected call of ListBalances.
+func (mr *MockReportingRepositoryMockRecorder) ListBalances(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalances", reflect.TypeOf((*MockReportingRepository)(nil).ListBalances), ctx, limit, offset)
+}
+
+// ListCounterpartyTotals mocks base method.
+func (m *MockReportingRepository) ListCounterpartyTotals(ctx context.Context, accountID string, limit, offset int) ([]*domain.CounterpartyTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCounterpartyTotals", ctx, accountID, limit, offset)
+	ret0, _ := ret[0].([]*domain.CounterpartyTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCounterpartyTotals indicates an expected call of ListCounterpartyTotals.
+func (mr *MockReportingRepositoryMockRecorder) ListCounterpartyTotals(ctx, accountID, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCounterpartyTotals", reflect.TypeOf((*MockReportingRepository)(nil).ListCounterpartyTotals), ctx, accountID, limit, offset)
+}
+
+// ListDailyVolumes mocks base method.
+func (m *MockReportingRepository) ListDailyVolumes(ctx context.Context, accountID string, from, to time.Time) ([]*domain.AccountDailyVolume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDailyVolumes", ctx, accountID, from, to)
+	ret0, _ := ret[0].([]*domain.AccountDailyVolume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDailyVolumes indicates an expected call of ListDailyVolumes.
+func (mr *MockReportingRepositoryMockRecorder) ListDailyVolumes(ctx, accountID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDailyVolumes", reflect.TypeOf((*MockReportingRepository)(nil).ListDailyVolumes), ctx, accountID, from, to)
+}
+
+// ListProjectableEntries mocks base method.
+func (m *MockReportingRepository) ListProjectableEntries(ctx context.Context, tx usecase.Transaction, after domain.ProjectionCursor, limit int) ([]*domain.ProjectableEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectableEntries", ctx, tx, after, limit)
+	ret0, _ := ret[0].([]*domain.ProjectableEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectableEntries indicates an expected call of ListProjectableEntries.
+func (mr *MockReportingRepositoryMockRecorder) ListProjectableEntries(ctx, tx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectableEntries", reflect.TypeOf((*MockReportingRepository)(nil).ListProjectableEntries), ctx, tx, after, limit)
+}
+
+// LockCheckpoint mocks base method.
+func (m *MockReportingRepository) LockCheckpoint(ctx context.Context, tx usecase.Transaction, name string) (*domain.ProjectionCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockCheckpoint", ctx, tx, name)
+	ret0, _ := ret[0].(*domain.ProjectionCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockCheckpoint indicates an expected call of LockCheckpoint.
+func (mr *MockReportingRepositoryMockRecorder) LockCheckpoint(ctx, tx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockCheckpoint", reflect.TypeOf((*MockReportingRepository)(nil).LockCheckpoint), ctx, tx, name)
+}
+
+// NextUnprojectedAt mocks base method.
+func (m *MockReportingRepository) NextUnprojectedAt(ctx context.Context, after domain.ProjectionCursor) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextUnprojectedAt", ctx, after)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextUnprojectedAt indicates an expected call of NextUnprojectedAt.
+func (mr *MockReportingRepositoryMockRecorder) NextUnprojectedAt(ctx, after any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextUnprojectedAt", reflect.TypeOf((*MockReportingRepository)(nil).NextUnprojectedAt), ctx, after)
+}
+
+// Reset mocks base method.
+func (m *MockReportingRepository) Reset(ctx context.Context, tx usecase.Transaction, name string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, tx, name, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockReportingRepositoryMockRecorder) Reset(ctx, tx, name, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockReportingRepository)(nil).Reset), ctx, tx, name, now)
+}
+
+// MockOutboxRetentionRepository is a mock of OutboxRetentionRepository interface.
+type MockOutboxRetentionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRetentionRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRetentionRepositoryMockRecorder is the mock recorder for MockOutboxRetentionRepository.
+type MockOutboxRetentionRepositoryMockRecorder struct {
+	mock *MockOutboxRetentionRepository
+}
+
+// NewMockOutboxRetentionRepository creates a new mock instance.
+func NewMockOutboxRetentionRepository(ctrl *gomock.Controller) *MockOutboxRetentionRepository {
+	mock := &MockOutboxRetentionRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRetentionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRetentionRepository) EXPECT() *MockOutboxRetentionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ArchivePartition mocks base method.
+func (m *MockOutboxRetentionRepository) ArchivePartition(ctx context.Context, tx usecase.Transaction, p domain.OutboxPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivePartition", ctx, tx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArchivePartition indicates an expected call of ArchivePartition.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) ArchivePartition(ctx, tx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivePartition", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).ArchivePartition), ctx, tx, p)
+}
+
+// CountDefaultPartition mocks base method.
+func (m *MockOutboxRetentionRepository) CountDefaultPartition(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDefaultPartition", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDefaultPartition indicates an expected call of CountDefaultPartition.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) CountDefaultPartition(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDefaultPartition", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).CountDefaultPartition), ctx)
+}
+
+// DetachPartition mocks base method.
+func (m *MockOutboxRetentionRepository) DetachPartition(ctx context.Context, tx usecase.Transaction, p domain.OutboxPartition) (domain.OutboxPartitionStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachPartition", ctx, tx, p)
+	ret0, _ := ret[0].(domain.OutboxPartitionStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachPartition indicates an expected call of DetachPartition.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) DetachPartition(ctx, tx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachPartition", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).DetachPartition), ctx, tx, p)
+}
+
+// DropPartition mocks base method.
+func (m *MockOutboxRetentionRepository) DropPartition(ctx context.Context, tx usecase.Transaction, p domain.OutboxPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropPartition", ctx, tx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropPartition indicates an expected call of DropPartition.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) DropPartition(ctx, tx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropPartition", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).DropPartition), ctx, tx, p)
+}
+
+// EnsurePartition mocks base method.
+func (m *MockOutboxRetentionRepository) EnsurePartition(ctx context.Context, p domain.OutboxPartition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsurePartition", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsurePartition indicates an expected call of EnsurePartition.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) EnsurePartition(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsurePartition", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).EnsurePartition), ctx, p)
+}
+
+// ExportPartition mocks base method.
+func (m *MockOutboxRetentionRepository) ExportPartition(ctx context.Context, p domain.OutboxPartition, emit func([]byte) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPartition", ctx, p, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportPartition indicates an expected call of ExportPartition.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) ExportPartition(ctx, p, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPartition", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).ExportPartition), ctx, p, emit)
+}
+
+// ListPartitions mocks base method.
+func (m *MockOutboxRetentionRepository) ListPartitions(ctx context.Context) ([]domain.OutboxPartition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPartitions", ctx)
+	ret0, _ := ret[0].([]domain.OutboxPartition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPartitions indicates an expected call of ListPartitions.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) ListPartitions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPartitions", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).ListPartitions), ctx)
+}
+
+// PartitionStats mocks base method.
+func (m *MockOutboxRetentionRepository) PartitionStats(ctx context.Context, p domain.OutboxPartition) (domain.OutboxPartitionStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PartitionStats", ctx, p)
+	ret0, _ := ret[0].(domain.OutboxPartitionStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PartitionStats indicates an expected call of PartitionStats.
+func (mr *MockOutboxRetentionRepositoryMockRecorder) PartitionStats(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PartitionStats", reflect.TypeOf((*MockOutboxRetentionRepository)(nil).PartitionStats), ctx, p)
+}
+
+// MockBackupRepository is a mock of BackupRepository interface.
+type MockBackupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBackupRepositoryMockRecorder is the mock recorder for MockBackupRepository.
+type MockBackupRepositoryMockRecorder struct {
+	mock *MockBackupRepository
+}
+
+// NewMockBackupRepository creates a new mock instance.
+func NewMockBackupRepository(ctrl *gomock.Controller) *MockBackupRepository {
+	mock := &MockBackupRepository{ctrl: ctrl}
+	mock.recorder = &MockBackupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupRepository) EXPECT() *MockBackupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AuditChainHead mocks base method.
+func (m *MockBackupRepository) AuditChainHead(ctx context.Context, tx usecase.Transaction) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditChainHead", ctx, tx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditChainHead indicates an expected call of AuditChainHead.
+func (mr *MockBackupRepositoryMockRecorder) AuditChainHead(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditChainHead", reflect.TypeOf((*MockBackupRepository)(nil).AuditChainHead), ctx, tx)
+}
+
+// CountRows mocks base method.
+func (m *MockBackupRepository) CountRows(ctx context.Context, tables []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountRows", ctx, tables)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountRows indicates an expected call of CountRows.
+func (mr *MockBackupRepositoryMockRecorder) CountRows(ctx, tables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountRows", reflect.TypeOf((*MockBackupRepository)(nil).CountRows), ctx, tables)
+}
+
+// Export mocks base method.
+func (m *MockBackupRepository) Export(ctx context.Context, tables []string, emit func(string, []byte) error) (*usecase.LedgerSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, tables, emit)
+	ret0, _ := ret[0].(*usecase.LedgerSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockBackupRepositoryMockRecorder) Export(ctx, tables, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockBackupRepository)(nil).Export), ctx, tables, emit)
+}
+
+// Restore mocks base method.
+func (m *MockBackupRepository) Restore(ctx context.Context, tx usecase.Transaction, table string, rows [][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, tx, table, rows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockBackupRepositoryMockRecorder) Restore(ctx, tx, table, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockBackupRepository)(nil).Restore), ctx, tx, table, rows)
+}
+
+// SchemaVersion mocks base method.
+func (m *MockBackupRepository) SchemaVersion(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaVersion", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchemaVersion indicates an expected call of SchemaVersion.
+func (mr *MockBackupRepositoryMockRecorder) SchemaVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaVersion", reflect.TypeOf((*MockBackupRepository)(nil).SchemaVersion), ctx)
+}
+
+// VerifyAuditChain mocks base method.
+func (m *MockBackupRepository) VerifyAuditChain(ctx context.Context, tx usecase.Transaction) ([]usecase.AuditChainBreak, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditChain", ctx, tx)
+	ret0, _ := ret[0].([]usecase.AuditChainBreak)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAuditChain indicates an expected call of VerifyAuditChain.
+func (mr *MockBackupRepositoryMockRecorder) VerifyAuditChain(ctx, tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditChain", reflect.TypeOf((*MockBackupRepository)(nil).VerifyAuditChain), ctx, tx)
+}
+
 // MockTransaction is a mock of Transaction interface.
 type MockTransaction struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// OutboxPartitionsAhead is how many months past the current one the
+// retention job keeps partitions created for, so writes never fall into the
+// default partition.
+const OutboxPartitionsAhead = 2
+
+var ErrOutboxArchiverNotConfigured = errors.New("outbox file archiving is not configured")
+
+// OutboxPartitionArchiver stores the events of pruned outbox partitions
+// outside the database.
+type OutboxPartitionArchiver interface {
+	// Create starts the archive of one partition. Nothing is visible at the
+	// archive's final location until Commit.
+	Create(partition string) (OutboxPartitionArchive, error)
+}
+
+// OutboxPartitionArchive is one partition's archive being written.
+type OutboxPartitionArchive interface {
+	WriteRow(row []byte) error
+	// Commit makes the archive durable and returns where it is stored.
+	Commit() (string, error)
+	// Abort discards an archive that was not committed.
+	Abort()
+}
+
+// OutboxPruneOptions configures one retention run.
+type OutboxPruneOptions struct {
+	Mode domain.OutboxArchiveMode
+	// RetentionDays is how long published events stay in the outbox. A
+	// partition is pruned once its whole month is older than that.
+	RetentionDays int
+	DryRun        bool
+}
+
+// OutboxRetentionUseCase keeps outbox_events partitioned by month and
+// prunes the months past the retention window.
+type OutboxRetentionUseCase struct {
+	txManager     TransactionManager
+	retentionRepo OutboxRetentionRepository
+	archiver      OutboxPartitionArchiver
+}
+
+// NewOutboxRetentionUseCase creates a new OutboxRetentionUseCase. archiver
+// may be nil unless partitions are pruned to files.
+func NewOutboxRetentionUseCase(txManager TransactionManager, retentionRepo OutboxRetentionRepository, archiver OutboxPartitionArchiver) *OutboxRetentionUseCase {
+	return &OutboxRetentionUseCase{
+		txManager:     txManager,
+		retentionRepo: retentionRepo,
+		archiver:      archiver,
+	}
+}
+
+// EnsurePartitions creates the partitions for the current month and the
+// next OutboxPartitionsAhead months. Events that fell into the default
+// partition for one of these months are moved into its new partition.
+func (uc *OutboxRetentionUseCase) EnsurePartitions(ctx context.Context) error {
+	now := time.Now().UTC()
+	for i := 0; i <= OutboxPartitionsAhead; i++ {
+		p := domain.OutboxPartitionFor(time.Date(now.Year(), now.Month()+time.Month(i), 1, 0, 0, 0, 0, time.UTC))
+		if err := uc.retentionRepo.EnsurePartition(ctx, p); err != nil {
+			return fmt.Errorf("failed to create partition %s: %w", p.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// Prune removes every partition older than the retention window from the
+// outbox, archiving its events first. A partition still holding events
+// that are not published (including dead-lettered ones) is retained until
+// they are published, replayed or moved to the dead-letter archive. On
+// error the report covers the partitions handled so far.
+func (uc *OutboxRetentionUseCase) Prune(ctx context.Context, opts OutboxPruneOptions) (*domain.OutboxPruneReport, error) {
+	if opts.RetentionDays < 1 {
+		return nil, fmt.Errorf("retention must be at least 1 day, got %d", opts.RetentionDays)
+	}
+
+	switch opts.Mode {
+	case domain.OutboxArchiveTable:
+	case domain.OutboxArchiveFile:
+		if uc.archiver == nil {
+			return nil, ErrOutboxArchiverNotConfigured
+		}
+	default:
+		return nil, fmt.Errorf("unknown outbox archive mode %q", opts.Mode)
+	}
+
+	report := &domain.OutboxPruneReport{
+		Cutoff: time.Now().UTC().AddDate(0, 0, -opts.RetentionDays),
+		Mode:   opts.Mode,
+		DryRun: opts.DryRun,
+	}
+
+	if !opts.DryRun {
+		if err := uc.EnsurePartitions(ctx); err != nil {
+			return report, err
+		}
+	}
+
+	defaultEvents, err := uc.retentionRepo.CountDefaultPartition(ctx)
+	if err != nil {
+		return report, err
+	}
+	report.DefaultPartitionEvents = defaultEvents
+
+	partitions, err := uc.retentionRepo.ListPartitions(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	for _, p := range partitions {
+		if p.To.After(report.Cutoff) {
+			continue
+		}
+
+		result, err := uc.prunePartition(ctx, p, opts)
+		if err != nil {
+			return report, fmt.Errorf("failed to prune partition %s: %w", p.Name, err)
+		}
+
+		report.Partitions = append(report.Partitions, *result)
+	}
+
+	return report, nil
+}
+
+func (uc *OutboxRetentionUseCase) prunePartition(ctx context.Context, p domain.OutboxPartition, opts OutboxPruneOptions) (*domain.OutboxPartitionResult, error) {
+	stats, err := uc.retentionRepo.PartitionStats(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.OutboxPartitionResult{Partition: p, Stats: stats}
+
+	if stats.Unpublished > 0 {
+		return retainPartition(result, stats, fmt.Sprintf("%d events not published", stats.Unpublished)), nil
+	}
+
+	if opts.DryRun {
+		result.Status = domain.OutboxPartitionPrunable
+		return result, nil
+	}
+
+	if opts.Mode == domain.OutboxArchiveFile {
+		return uc.prunePartitionToFile(ctx, result)
+	}
+
+	return uc.prunePartitionToTable(ctx, result)
+}
+
+// prunePartitionToTable moves the partition to outbox_events_archive in
+// one transaction.
+func (uc *OutboxRetentionUseCase) prunePartitionToTable(ctx context.Context, result *domain.OutboxPartitionResult) (*domain.OutboxPartitionResult, error) {
+	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
+	defer cancel()
+
+	tx, err := uc.txManager.Begin(txCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(txCtx) }()
+
+	stats, err := uc.retentionRepo.DetachPartition(txCtx, tx, result.Partition)
+	if err != nil {
+		return nil, err
+	}
+
+	if stats.Unpublished > 0 {
+		return retainPartition(result, stats, fmt.Sprintf("%d events not published", stats.Unpublished)), nil
+	}
+
+	if err := uc.retentionRepo.ArchivePartition(txCtx, tx, result.Partition); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(txCtx); err != nil {
+		return nil, err
+	}
+
+	result.Status = domain.OutboxPartitionPruned
+	result.Stats = stats
+	result.ArchivedTo = result.Partition.ArchiveName()
+
+	return result, nil
+}
+
+// prunePartitionToFile exports the partition, then detaches and drops it.
+// The partition is only dropped if it still holds exactly the exported
+// events; published events are never updated, so equal counts mean the
+// file is complete.
+func (uc *OutboxRetentionUseCase) prunePartitionToFile(ctx context.Context, result *domain.OutboxPartitionResult) (*domain.OutboxPartitionResult, error) {
+	archive, err := uc.archiver.Create(result.Partition.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var exported int64
+	err = uc.retentionRepo.ExportPartition(ctx, result.Partition, func(row []byte) error {
+		exported++
+		return archive.WriteRow(row)
+	})
+	if err != nil {
+		archive.Abort()
+		return nil, err
+	}
+
+	location, err := archive.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
+	defer cancel()
+
+	tx, err := uc.txManager.Begin(txCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(txCtx) }()
+
+	stats, err := uc.retentionRepo.DetachPartition(txCtx, tx, result.Partition)
+	if err != nil {
+		return nil, err
+	}
+
+	if stats.Unpublished > 0 || stats.Events != exported {
+		return retainPartition(result, stats, "events changed while exporting"), nil
+	}
+
+	if err := uc.retentionRepo.DropPartition(txCtx, tx, result.Partition); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(txCtx); err != nil {
+		return nil, err
+	}
+
+	result.Status = domain.OutboxPartitionPruned
+	result.Stats = stats
+	result.ArchivedTo = location
+
+	return result, nil
+}
+
+func retainPartition(result *domain.OutboxPartitionResult, stats domain.OutboxPartitionStats, reason string) *domain.OutboxPartitionResult {
+	result.Status = domain.OutboxPartitionRetained
+	result.Stats = stats
+	result.Reason = reason
+
+	return result
+}
@@ -0,0 +1,235 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+type memoryArchive struct {
+	rows      [][]byte
+	committed bool
+	aborted   bool
+}
+
+func (a *memoryArchive) WriteRow(row []byte) error {
+	a.rows = append(a.rows, row)
+	return nil
+}
+
+func (a *memoryArchive) Commit() (string, error) {
+	a.committed = true
+	return "/archive/outbox_events_2020_01.ndjson.gz", nil
+}
+
+func (a *memoryArchive) Abort() {
+	a.aborted = true
+}
+
+type memoryArchiver struct {
+	archive *memoryArchive
+}
+
+func (a *memoryArchiver) Create(partition string) (usecase.OutboxPartitionArchive, error) {
+	a.archive = &memoryArchive{}
+	return a.archive, nil
+}
+
+func TestOutboxRetentionUseCase_Prune_ArchivesOldPartitionsToTable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockOutboxRetentionRepository(ctrl)
+
+	old := domain.OutboxPartitionFor(time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC))
+	pending := domain.OutboxPartitionFor(time.Date(2020, 2, 15, 0, 0, 0, 0, time.UTC))
+	current := domain.OutboxPartitionFor(time.Now())
+
+	repo.EXPECT().EnsurePartition(gomock.Any(), gomock.Any()).Return(nil).Times(usecase.OutboxPartitionsAhead + 1)
+	repo.EXPECT().CountDefaultPartition(gomock.Any()).Return(int64(0), nil)
+	repo.EXPECT().ListPartitions(gomock.Any()).Return([]domain.OutboxPartition{old, pending, current}, nil)
+	repo.EXPECT().PartitionStats(gomock.Any(), old).Return(domain.OutboxPartitionStats{Events: 10}, nil)
+	repo.EXPECT().PartitionStats(gomock.Any(), pending).Return(domain.OutboxPartitionStats{Events: 4, Unpublished: 1}, nil)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	tx.EXPECT().Commit(gomock.Any()).Return(nil)
+	repo.EXPECT().DetachPartition(gomock.Any(), tx, old).Return(domain.OutboxPartitionStats{Events: 10}, nil)
+	repo.EXPECT().ArchivePartition(gomock.Any(), tx, old).Return(nil)
+
+	uc := usecase.NewOutboxRetentionUseCase(txManager, repo, nil)
+
+	report, err := uc.Prune(context.Background(), usecase.OutboxPruneOptions{Mode: domain.OutboxArchiveTable, RetentionDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(report.Partitions) != 2 || report.Pruned() != 1 || report.Retained() != 1 || report.EventsArchived() != 10 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if report.Partitions[0].ArchivedTo != "outbox_events_archive_2020_01" || report.Partitions[1].Reason == "" {
+		t.Fatalf("unexpected partition results: %+v", report.Partitions)
+	}
+}
+
+func TestOutboxRetentionUseCase_Prune_RetainsPartitionPublishedDuringDetach(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockOutboxRetentionRepository(ctrl)
+
+	old := domain.OutboxPartitionFor(time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC))
+
+	repo.EXPECT().EnsurePartition(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repo.EXPECT().CountDefaultPartition(gomock.Any()).Return(int64(0), nil)
+	repo.EXPECT().ListPartitions(gomock.Any()).Return([]domain.OutboxPartition{old}, nil)
+	repo.EXPECT().PartitionStats(gomock.Any(), old).Return(domain.OutboxPartitionStats{Events: 10}, nil)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).Return(nil)
+	repo.EXPECT().DetachPartition(gomock.Any(), tx, old).Return(domain.OutboxPartitionStats{Events: 11, Unpublished: 1}, nil)
+
+	uc := usecase.NewOutboxRetentionUseCase(txManager, repo, nil)
+
+	report, err := uc.Prune(context.Background(), usecase.OutboxPruneOptions{Mode: domain.OutboxArchiveTable, RetentionDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Retained() != 1 || report.Pruned() != 0 {
+		t.Fatalf("expected the partition to be retained, got %+v", report.Partitions)
+	}
+}
+
+func TestOutboxRetentionUseCase_Prune_ExportsToFileBeforeDropping(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockOutboxRetentionRepository(ctrl)
+	archiver := &memoryArchiver{}
+
+	old := domain.OutboxPartitionFor(time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC))
+
+	repo.EXPECT().EnsurePartition(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repo.EXPECT().CountDefaultPartition(gomock.Any()).Return(int64(0), nil)
+	repo.EXPECT().ListPartitions(gomock.Any()).Return([]domain.OutboxPartition{old}, nil)
+	repo.EXPECT().PartitionStats(gomock.Any(), old).Return(domain.OutboxPartitionStats{Events: 2}, nil)
+
+	gomock.InOrder(
+		repo.EXPECT().ExportPartition(gomock.Any(), old, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ domain.OutboxPartition, emit func([]byte) error) error {
+				for _, row := range []string{`{"id":"evt-1"}`, `{"id":"evt-2"}`} {
+					if err := emit([]byte(row)); err != nil {
+						return err
+					}
+				}
+				return nil
+			}),
+		repo.EXPECT().DetachPartition(gomock.Any(), tx, old).Return(domain.OutboxPartitionStats{Events: 2}, nil),
+		repo.EXPECT().DropPartition(gomock.Any(), tx, old).Return(nil),
+	)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	tx.EXPECT().Commit(gomock.Any()).Return(nil)
+
+	uc := usecase.NewOutboxRetentionUseCase(txManager, repo, archiver)
+
+	report, err := uc.Prune(context.Background(), usecase.OutboxPruneOptions{Mode: domain.OutboxArchiveFile, RetentionDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !archiver.archive.committed || len(archiver.archive.rows) != 2 {
+		t.Fatalf("expected a committed archive of 2 rows, got %+v", archiver.archive)
+	}
+
+	if report.Pruned() != 1 || report.Partitions[0].ArchivedTo != "/archive/outbox_events_2020_01.ndjson.gz" {
+		t.Fatalf("unexpected report: %+v", report.Partitions)
+	}
+}
+
+func TestOutboxRetentionUseCase_Prune_AbortsArchiveOnExportFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockOutboxRetentionRepository(ctrl)
+	archiver := &memoryArchiver{}
+
+	old := domain.OutboxPartitionFor(time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC))
+
+	repo.EXPECT().EnsurePartition(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	repo.EXPECT().CountDefaultPartition(gomock.Any()).Return(int64(0), nil)
+	repo.EXPECT().ListPartitions(gomock.Any()).Return([]domain.OutboxPartition{old}, nil)
+	repo.EXPECT().PartitionStats(gomock.Any(), old).Return(domain.OutboxPartitionStats{Events: 2}, nil)
+	repo.EXPECT().ExportPartition(gomock.Any(), old, gomock.Any()).Return(errors.New("connection reset"))
+
+	uc := usecase.NewOutboxRetentionUseCase(mocks.NewMockTransactionManager(ctrl), repo, archiver)
+
+	if _, err := uc.Prune(context.Background(), usecase.OutboxPruneOptions{Mode: domain.OutboxArchiveFile, RetentionDays: 30}); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if !archiver.archive.aborted || archiver.archive.committed {
+		t.Fatalf("expected the archive to be aborted, got %+v", archiver.archive)
+	}
+}
+
+func TestOutboxRetentionUseCase_Prune_DryRunChangesNothing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockOutboxRetentionRepository(ctrl)
+
+	old := domain.OutboxPartitionFor(time.Date(2020, 1, 15, 0, 0, 0, 0, time.UTC))
+
+	repo.EXPECT().CountDefaultPartition(gomock.Any()).Return(int64(3), nil)
+	repo.EXPECT().ListPartitions(gomock.Any()).Return([]domain.OutboxPartition{old}, nil)
+	repo.EXPECT().PartitionStats(gomock.Any(), old).Return(domain.OutboxPartitionStats{Events: 2}, nil)
+
+	uc := usecase.NewOutboxRetentionUseCase(mocks.NewMockTransactionManager(ctrl), repo, nil)
+
+	report, err := uc.Prune(context.Background(), usecase.OutboxPruneOptions{Mode: domain.OutboxArchiveTable, RetentionDays: 30, DryRun: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Partitions[0].Status != domain.OutboxPartitionPrunable || report.DefaultPartitionEvents != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestOutboxRetentionUseCase_Prune_RejectsInvalidOptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewOutboxRetentionUseCase(mocks.NewMockTransactionManager(ctrl), mocks.NewMockOutboxRetentionRepository(ctrl), nil)
+
+	tests := map[string]usecase.OutboxPruneOptions{
+		"no retention":    {Mode: domain.OutboxArchiveTable},
+		"unknown mode":    {Mode: "s3", RetentionDays: 30},
+		"no file archive": {Mode: domain.OutboxArchiveFile, RetentionDays: 30},
+	}
+
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := uc.Prune(context.Background(), opts); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
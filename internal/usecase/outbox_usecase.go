@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// OutboxUseCase handles operator actions on the transactional outbox.
+type OutboxUseCase struct {
+	txManager  TransactionManager
+	outboxRepo OutboxRepository
+	auditRepo  AuditRepository
+	idGen      IDGenerator
+}
+
+// NewOutboxUseCase creates a new OutboxUseCase.
+func NewOutboxUseCase(txManager TransactionManager, outboxRepo OutboxRepository, auditRepo AuditRepository, idGen IDGenerator) *OutboxUseCase {
+	return &OutboxUseCase{
+		txManager:  txManager,
+		outboxRepo: outboxRepo,
+		auditRepo:  auditRepo,
+		idGen:      idGen,
+	}
+}
+
+// ListDeadLettered lists dead-lettered events, most recently dead-lettered
+// first.
+func (uc *OutboxUseCase) ListDeadLettered(ctx context.Context, limit, offset int) ([]*domain.OutboxEvent, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.outboxRepo.GetDeadLettered(ctx, limit, offset)
+}
+
+// ListDeadLetteredCursorResult is a page of dead-lettered events plus the
+// opaque cursor to request the next page. NextCursor is empty when there
+// are no more results.
+type ListDeadLetteredCursorResult struct {
+	Events     []*domain.OutboxEvent
+	NextCursor string
+}
+
+// ListDeadLetteredCursor lists dead-lettered events, most recently
+// dead-lettered first, using keyset pagination. cursor is the NextCursor of
+// the previous page, empty for the first.
+func (uc *OutboxUseCase) ListDeadLetteredCursor(ctx context.Context, cursor string, limit int) (*ListDeadLetteredCursorResult, error) {
+	limit, _, err := domain.ValidatePagination(limit, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *domain.TimeCursor
+	if cursor != "" {
+		c, err := domain.DecodeTimeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &c
+	}
+
+	events, err := uc.outboxRepo.GetDeadLetteredCursor(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListDeadLetteredCursorResult{Events: events}
+	if len(events) == limit {
+		last := events[len(events)-1]
+		if last.DeadLetteredAt != nil {
+			result.NextCursor = domain.TimeCursor{At: *last.DeadLetteredAt, ID: last.ID}.Encode()
+		}
+	}
+
+	return result, nil
+}
+
+// ReplayDeadLettered requeues the dead-lettered events matching filter so
+// the publisher picks them up again with a fresh attempt budget. Each
+// replayed event is audited in the same transaction.
+func (uc *OutboxUseCase) ReplayDeadLettered(ctx context.Context, filter domain.DeadLetterFilter) ([]*domain.OutboxEvent, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return uc.inTx(ctx, domain.AuditActionOutboxReplay, nil, func(txCtx context.Context, tx Transaction) ([]*domain.OutboxEvent, error) {
+		return uc.outboxRepo.ReplayDeadLettered(txCtx, tx, filter)
+	})
+}
+
+// ArchiveDeadLettered moves the dead-lettered events matching filter to the
+// dead-letter archive, recording why they will not be published. Each
+// archived event is audited in the same transaction.
+func (uc *OutboxUseCase) ArchiveDeadLettered(ctx context.Context, filter domain.DeadLetterFilter, reason string) ([]*domain.OutboxEvent, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: an archive reason is required", domain.ErrInvalidDeadLetterFilter)
+	}
+
+	return uc.inTx(ctx, domain.AuditActionOutboxArchive, domain.JSON{"reason": reason}, func(txCtx context.Context, tx Transaction) ([]*domain.OutboxEvent, error) {
+		return uc.outboxRepo.ArchiveDeadLettered(txCtx, tx, filter, reason, time.Now().UTC())
+	})
+}
+
+// inTx runs op in a transaction and writes one audit row per event it
+// returns, with extra merged into each row's after state.
+func (uc *OutboxUseCase) inTx(ctx context.Context, action domain.AuditAction, extra domain.JSON, op func(context.Context, Transaction) ([]*domain.OutboxEvent, error)) ([]*domain.OutboxEvent, error) {
+	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
+	defer cancel()
+
+	tx, err := uc.txManager.Begin(txCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(txCtx) }()
+
+	events, err := op(txCtx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if uc.auditRepo != nil {
+		userID, requestID, ipAddress, userAgent := auditActor(ctx)
+		now := time.Now().UTC()
+
+		for _, event := range events {
+			after := domain.JSON{
+				"event_type":     event.EventType,
+				"aggregate_type": event.AggregateType,
+				"aggregate_id":   event.AggregateID,
+				"attempts":       event.Attempts,
+				"last_error":     event.LastError,
+			}
+			for k, v := range extra {
+				after[k] = v
+			}
+
+			auditLog := &domain.AuditLog{
+				ID:           uc.idGen.Generate(),
+				UserID:       userID,
+				Action:       string(action),
+				ResourceType: "outbox_event",
+				ResourceID:   event.ID,
+				RequestID:    requestID,
+				IPAddress:    ipAddress,
+				UserAgent:    userAgent,
+				AfterState:   after,
+				Status:       string(domain.AuditStatusSuccess),
+				CreatedAt:    now,
+			}
+			if err := uc.auditRepo.CreateTx(txCtx, tx, auditLog); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(txCtx); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,148 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func TestOutboxUseCase_ListDeadLetteredCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	first := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	second := first.Add(-time.Minute)
+
+	outboxRepo.EXPECT().GetDeadLetteredCursor(gomock.Any(), nil, 2).Return([]*domain.OutboxEvent{
+		{ID: "evt-2", DeadLetteredAt: &first},
+		{ID: "evt-1", DeadLetteredAt: &second},
+	}, nil)
+	outboxRepo.EXPECT().GetDeadLetteredCursor(gomock.Any(), &domain.TimeCursor{At: second, ID: "evt-1"}, 2).Return(nil, nil)
+
+	uc := usecase.NewOutboxUseCase(nil, outboxRepo, nil, nil)
+
+	page, err := uc.ListDeadLetteredCursor(context.Background(), "", 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 2 || page.NextCursor == "" {
+		t.Fatalf("unexpected first page: %+v", page)
+	}
+
+	page, err = uc.ListDeadLetteredCursor(context.Background(), page.NextCursor, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Events) != 0 || page.NextCursor != "" {
+		t.Fatalf("unexpected last page: %+v", page)
+	}
+
+	if _, err := uc.ListDeadLetteredCursor(context.Background(), "not-a-cursor", 2); !errors.Is(err, domain.ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
+
+func TestOutboxUseCase_ReplayDeadLettered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	auditRepo := mocks.NewMockAuditRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	filter := domain.DeadLetterFilter{EventType: domain.EventTypeTransferCreated}
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	tx.EXPECT().Commit(gomock.Any()).Return(nil)
+	outboxRepo.EXPECT().ReplayDeadLettered(gomock.Any(), tx, filter).Return([]*domain.OutboxEvent{
+		{ID: "evt-1", EventType: domain.EventTypeTransferCreated},
+		{ID: "evt-2", EventType: domain.EventTypeTransferCreated},
+	}, nil)
+	idGen.EXPECT().Generate().Return("audit-1")
+	idGen.EXPECT().Generate().Return("audit-2")
+
+	var audited []string
+	auditRepo.EXPECT().CreateTx(gomock.Any(), tx, gomock.Any()).DoAndReturn(func(_ context.Context, _ usecase.Transaction, log *domain.AuditLog) error {
+		if log.Action != string(domain.AuditActionOutboxReplay) || log.ResourceType != "outbox_event" || log.UserID != "system" {
+			t.Fatalf("unexpected audit log: %+v", log)
+		}
+		audited = append(audited, log.ResourceID)
+		return nil
+	}).Times(2)
+
+	uc := usecase.NewOutboxUseCase(txManager, outboxRepo, auditRepo, idGen)
+
+	events, err := uc.ReplayDeadLettered(context.Background(), filter)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(events) != 2 || audited[0] != "evt-1" || audited[1] != "evt-2" {
+		t.Fatalf("unexpected result: %d events, audited %v", len(events), audited)
+	}
+}
+
+func TestOutboxUseCase_ReplayDeadLettered_RejectsEmptyFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewOutboxUseCase(mocks.NewMockTransactionManager(ctrl), mocks.NewMockOutboxRepository(ctrl), nil, mocks.NewMockIDGenerator(ctrl))
+
+	if _, err := uc.ReplayDeadLettered(context.Background(), domain.DeadLetterFilter{}); !errors.Is(err, domain.ErrInvalidDeadLetterFilter) {
+		t.Fatalf("expected ErrInvalidDeadLetterFilter, got %v", err)
+	}
+}
+
+func TestOutboxUseCase_ArchiveDeadLettered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	auditRepo := mocks.NewMockAuditRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	filter := domain.DeadLetterFilter{IDs: []string{"evt-1"}}
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	tx.EXPECT().Commit(gomock.Any()).Return(nil)
+	outboxRepo.EXPECT().ArchiveDeadLettered(gomock.Any(), tx, filter, "consumer retired", gomock.Any()).
+		Return([]*domain.OutboxEvent{{ID: "evt-1"}}, nil)
+	idGen.EXPECT().Generate().Return("audit-1")
+	auditRepo.EXPECT().CreateTx(gomock.Any(), tx, gomock.Any()).DoAndReturn(func(_ context.Context, _ usecase.Transaction, log *domain.AuditLog) error {
+		if log.Action != string(domain.AuditActionOutboxArchive) || log.AfterState["reason"] != "consumer retired" {
+			t.Fatalf("unexpected audit log: %+v", log)
+		}
+		return nil
+	})
+
+	uc := usecase.NewOutboxUseCase(txManager, outboxRepo, auditRepo, idGen)
+
+	if _, err := uc.ArchiveDeadLettered(context.Background(), filter, " consumer retired "); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestOutboxUseCase_ArchiveDeadLettered_RequiresReason(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewOutboxUseCase(mocks.NewMockTransactionManager(ctrl), mocks.NewMockOutboxRepository(ctrl), nil, mocks.NewMockIDGenerator(ctrl))
+
+	if _, err := uc.ArchiveDeadLettered(context.Background(), domain.DeadLetterFilter{All: true}, " "); !errors.Is(err, domain.ErrInvalidDeadLetterFilter) {
+		t.Fatalf("expected ErrInvalidDeadLetterFilter, got %v", err)
+	}
+}
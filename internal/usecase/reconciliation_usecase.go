@@ -56,6 +56,25 @@ func (uc *ReconciliationUseCase) ReconcileAccount(ctx context.Context, accountID
 		return nil, err
 	}
 
+	return reconcile(accountID, account, calculatedBalance), nil
+}
+
+// ReconcileAccountTx is ReconcileAccount within tx.
+func (uc *ReconciliationUseCase) ReconcileAccountTx(ctx context.Context, tx Transaction, accountID string) (*ReconciliationResult, error) {
+	account, err := uc.accountRepo.GetByIDForUpdate(ctx, tx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	calculatedBalance, err := uc.entryRepo.SumAmountsByAccountTx(ctx, tx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return reconcile(accountID, account, calculatedBalance), nil
+}
+
+func reconcile(accountID string, account *domain.Account, calculatedBalance decimal.Decimal) *ReconciliationResult {
 	difference := account.Balance.Sub(calculatedBalance)
 
 	return &ReconciliationResult{
@@ -65,7 +84,7 @@ func (uc *ReconciliationUseCase) ReconcileAccount(ctx context.Context, accountID
 		Difference:        difference,
 		IsReconciled:      difference.IsZero(),
 		LastChecked:       time.Now().UTC(),
-	}, nil
+	}
 }
 
 // ReconcileAllAccounts reconciles all accounts in the system
@@ -91,13 +110,28 @@ func (uc *ReconciliationUseCase) ReconcileAllAccounts(ctx context.Context) ([]*R
 
 // CheckLedgerConsistency verifies double-entry bookkeeping consistency,
 // grouped by currency so an error in one currency can't be masked by an
-// offsetting error in another.
+// offsetting error in another. A mismatch is reported as an error wrapping
+// ErrInconsistentLedger.
 func (uc *ReconciliationUseCase) CheckLedgerConsistency(ctx context.Context) error {
 	results, err := uc.ledgerRepo.CheckConsistencyByCurrency(ctx)
 	if err != nil {
 		return err
 	}
 
+	return ledgerConsistency(results)
+}
+
+// CheckLedgerConsistencyTx is CheckLedgerConsistency within tx.
+func (uc *ReconciliationUseCase) CheckLedgerConsistencyTx(ctx context.Context, tx Transaction) error {
+	results, err := uc.ledgerRepo.CheckConsistencyByCurrencyTx(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	return ledgerConsistency(results)
+}
+
+func ledgerConsistency(results []CurrencyConsistency) error {
 	var mismatches []string
 	for _, r := range results {
 		if !r.TotalBalance.Equal(r.TotalEntries) {
@@ -109,7 +143,7 @@ func (uc *ReconciliationUseCase) CheckLedgerConsistency(ctx context.Context) err
 	}
 
 	if len(mismatches) > 0 {
-		return fmt.Errorf("ledger inconsistency detected: %s", strings.Join(mismatches, "; "))
+		return fmt.Errorf("%w: %s", ErrInconsistentLedger, strings.Join(mismatches, "; "))
 	}
 
 	return nil
@@ -142,6 +176,20 @@ func (uc *ReconciliationUseCase) VerifyEntryChain(ctx context.Context, accountID
 		return nil, err
 	}
 
+	return verifyEntryChain(accountID, entries), nil
+}
+
+// VerifyEntryChainTx is VerifyEntryChain within tx.
+func (uc *ReconciliationUseCase) VerifyEntryChainTx(ctx context.Context, tx Transaction, accountID string) (*EntryChainResult, error) {
+	entries, err := uc.entryRepo.GetAllByAccountOrderedTx(ctx, tx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return verifyEntryChain(accountID, entries), nil
+}
+
+func verifyEntryChain(accountID string, entries []*domain.Entry) *EntryChainResult {
 	result := &EntryChainResult{AccountID: accountID, Valid: true}
 
 	var prevBalance decimal.Decimal
@@ -183,7 +231,7 @@ func (uc *ReconciliationUseCase) VerifyEntryChain(ctx context.Context, accountID
 		prevVersion = e.AccountVersion
 	}
 
-	return result, nil
+	return result
 }
 
 // ReconciliationReport represents a full reconciliation report
@@ -28,6 +28,9 @@ func (s *stubAccountRepository) GetByID(ctx context.Context, id string) (*domain
 func (s *stubAccountRepository) GetByIDForUpdate(context.Context, usecase.Transaction, string) (*domain.Account, error) {
 	return nil, errors.New("not implemented")
 }
+func (s *stubAccountRepository) GetByIDs(context.Context, []string) ([]*domain.Account, error) {
+	return nil, errors.New("not implemented")
+}
 func (s *stubAccountRepository) GetByIDsForUpdate(context.Context, usecase.Transaction, []string) ([]*domain.Account, error) {
 	return nil, errors.New("not implemented")
 }
@@ -43,6 +46,9 @@ func (s *stubAccountRepository) UpdateBalanceAndEncumbered(context.Context, usec
 func (s *stubAccountRepository) List(ctx context.Context, limit, offset int) ([]*domain.Account, error) {
 	return s.listFn(ctx, limit, offset)
 }
+func (s *stubAccountRepository) ListCursor(context.Context, string, int) ([]*domain.Account, error) {
+	return nil, errors.New("not implemented")
+}
 
 type stubEntryRepository struct {
 	sumFn     func(ctx context.Context, accountID string) (decimal.Decimal, error)
@@ -58,6 +64,9 @@ func (s *stubEntryRepository) GetByTransfer(context.Context, string) ([]*domain.
 func (s *stubEntryRepository) GetByAccount(context.Context, string, int, int) ([]*domain.Entry, error) {
 	return nil, nil
 }
+func (s *stubEntryRepository) GetByAccountCursor(context.Context, string, string, int) ([]*domain.Entry, error) {
+	return nil, nil
+}
 func (s *stubEntryRepository) GetBalanceAtTime(context.Context, string, time.Time) (decimal.Decimal, error) {
 	return decimal.Zero, nil
 }
@@ -73,6 +82,19 @@ func (s *stubEntryRepository) GetAllByAccountOrdered(ctx context.Context, accoun
 	}
 	return nil, nil
 }
+func (s *stubEntryRepository) SumAmountsByAccountTx(ctx context.Context, _ usecase.Transaction, accountID string) (decimal.Decimal, error) {
+	return s.SumAmountsByAccount(ctx, accountID)
+}
+func (s *stubEntryRepository) GetAllByAccountOrderedTx(ctx context.Context, _ usecase.Transaction, accountID string) ([]*domain.Entry, error) {
+	return s.GetAllByAccountOrdered(ctx, accountID)
+}
+func (s *stubEntryRepository) GetByAccountInRange(context.Context, string, time.Time, time.Time) ([]*domain.Entry, error) {
+	return nil, nil
+}
+
+func (s *stubEntryRepository) ExportByAccount(context.Context, domain.EntryExportFilter, func(*domain.Entry) error) error {
+	return nil
+}
 
 type stubLedgerRepository struct {
 	checkFn      func(ctx context.Context) (decimal.Decimal, decimal.Decimal, error)
@@ -94,6 +116,10 @@ func (s *stubLedgerRepository) CheckConsistencyByCurrency(ctx context.Context) (
 	return []usecase.CurrencyConsistency{{Currency: "USD", TotalBalance: totalBalance, TotalEntries: totalAmount}}, nil
 }
 
+func (s *stubLedgerRepository) CheckConsistencyByCurrencyTx(ctx context.Context, _ usecase.Transaction) ([]usecase.CurrencyConsistency, error) {
+	return s.CheckConsistencyByCurrency(ctx)
+}
+
 func TestReconcileAccount(t *testing.T) {
 	t.Parallel()
 
@@ -230,8 +256,8 @@ func TestCheckLedgerConsistency(t *testing.T) {
 	}
 
 	uc = usecase.NewReconciliationUseCase(accountRepo, &stubEntryRepository{}, badLedger)
-	if err := uc.CheckLedgerConsistency(context.Background()); err == nil {
-		t.Fatal("expected error for inconsistent ledger")
+	if err := uc.CheckLedgerConsistency(context.Background()); !errors.Is(err, usecase.ErrInconsistentLedger) {
+		t.Fatalf("expected ErrInconsistentLedger, got %v", err)
 	}
 }
 
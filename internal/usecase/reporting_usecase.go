@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// DefaultProjectionBatchSize is how many entries one projection
+// transaction applies when no batch size is given.
+const DefaultProjectionBatchSize = 500
+
+// ReportingUseCase keeps the reporting read models up to date with the
+// entries table and serves queries against them.
+type ReportingUseCase struct {
+	txManager     TransactionManager
+	reportingRepo ReportingRepository
+}
+
+// NewReportingUseCase creates a new ReportingUseCase.
+func NewReportingUseCase(txManager TransactionManager, reportingRepo ReportingRepository) *ReportingUseCase {
+	return &ReportingUseCase{
+		txManager:     txManager,
+		reportingRepo: reportingRepo,
+	}
+}
+
+// ProjectionResult summarizes one Project or Rebuild call.
+type ProjectionResult struct {
+	Entries    int
+	Batches    int
+	Checkpoint domain.ProjectionCursor
+}
+
+// ProjectionStatus is how far the projection is, and how far behind.
+type ProjectionStatus struct {
+	Checkpoint *domain.ProjectionCheckpoint
+	// Lag is the age of the oldest entry not yet projected; zero when the
+	// projection is caught up.
+	Lag time.Duration
+}
+
+// Project applies every projectable entry after the checkpoint, batchSize
+// entries per transaction. Each batch's read-model updates commit together
+// with the checkpoint, so an interrupted run resumes where it stopped
+// without applying any entry twice.
+func (uc *ReportingUseCase) Project(ctx context.Context, batchSize int) (*ProjectionResult, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultProjectionBatchSize
+	}
+
+	result := &ProjectionResult{}
+	for {
+		delta, err := uc.projectBatch(ctx, batchSize)
+		if err != nil {
+			return result, err
+		}
+
+		if delta.Entries == 0 {
+			return result, nil
+		}
+
+		result.Entries += delta.Entries
+		result.Batches++
+		result.Checkpoint = delta.Cursor
+
+		if delta.Entries < batchSize {
+			return result, nil
+		}
+	}
+}
+
+func (uc *ReportingUseCase) projectBatch(ctx context.Context, batchSize int) (*domain.ReportingDelta, error) {
+	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
+	defer cancel()
+
+	tx, err := uc.txManager.Begin(txCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(txCtx) }()
+
+	checkpoint, err := uc.reportingRepo.LockCheckpoint(txCtx, tx, domain.ReportingProjection)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.reportingRepo.ListProjectableEntries(txCtx, tx, checkpoint.Cursor, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	delta := domain.NewReportingDelta(entries)
+	if delta.Entries == 0 {
+		return delta, nil
+	}
+
+	if err := uc.reportingRepo.Apply(txCtx, tx, domain.ReportingProjection, delta, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(txCtx); err != nil {
+		return nil, err
+	}
+
+	return delta, nil
+}
+
+// Rebuild empties the read models and projects every entry again from the
+// start. Readers see empty read models until the first batch commits.
+func (uc *ReportingUseCase) Rebuild(ctx context.Context, batchSize int) (*ProjectionResult, error) {
+	if err := uc.reset(ctx); err != nil {
+		return nil, fmt.Errorf("failed to reset read models: %w", err)
+	}
+
+	return uc.Project(ctx, batchSize)
+}
+
+func (uc *ReportingUseCase) reset(ctx context.Context) error {
+	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
+	defer cancel()
+
+	tx, err := uc.txManager.Begin(txCtx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(txCtx) }()
+
+	// Hold the checkpoint lock so a running projector can't apply a batch
+	// between the truncate and the rewind.
+	if _, err := uc.reportingRepo.LockCheckpoint(txCtx, tx, domain.ReportingProjection); err != nil {
+		return err
+	}
+
+	if err := uc.reportingRepo.Reset(txCtx, tx, domain.ReportingProjection, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return tx.Commit(txCtx)
+}
+
+// Status returns the projection's checkpoint and lag.
+func (uc *ReportingUseCase) Status(ctx context.Context) (*ProjectionStatus, error) {
+	checkpoint, err := uc.reportingRepo.GetCheckpoint(ctx, domain.ReportingProjection)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := uc.reportingRepo.NextUnprojectedAt(ctx, checkpoint.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ProjectionStatus{Checkpoint: checkpoint}
+	if next != nil {
+		status.Lag = max(time.Since(*next), 0)
+	}
+
+	return status, nil
+}
+
+// DailyVolumes returns an account's debits and credits per day in
+// [from, to), by transfer event date.
+func (uc *ReportingUseCase) DailyVolumes(ctx context.Context, accountID string, from, to time.Time) ([]*domain.AccountDailyVolume, error) {
+	if err := domain.ValidateReportingPeriod(from, to); err != nil {
+		return nil, err
+	}
+
+	return uc.reportingRepo.ListDailyVolumes(ctx, accountID, from, to)
+}
+
+// CounterpartyTotals returns what an account sent to and received from each
+// counterparty, largest volume first.
+func (uc *ReportingUseCase) CounterpartyTotals(ctx context.Context, accountID string, limit, offset int) ([]*domain.CounterpartyTotal, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.reportingRepo.ListCounterpartyTotals(ctx, accountID, limit, offset)
+}
+
+// Balances returns the latest projected balance of each account.
+func (uc *ReportingUseCase) Balances(ctx context.Context, limit, offset int) ([]*domain.AccountBalanceSnapshot, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.reportingRepo.ListBalances(ctx, limit, offset)
+}
@@ -0,0 +1,191 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func projectableEntries(txID int64, ids ...string) []*domain.ProjectableEntry {
+	entries := make([]*domain.ProjectableEntry, 0, len(ids))
+	for i, id := range ids {
+		entries = append(entries, &domain.ProjectableEntry{
+			Cursor:                domain.ProjectionCursor{TxID: txID, EntryID: id},
+			AccountID:             "acc-1",
+			CounterpartyAccountID: "acc-2",
+			Amount:                decimal.NewFromInt(10),
+			AccountCurrentBalance: decimal.NewFromInt(int64(10 * (i + 1))),
+			AccountVersion:        int64(i + 1),
+			EventAt:               time.Now().UTC(),
+			Currency:              "USD",
+		})
+	}
+	return entries
+}
+
+func TestReportingUseCase_Project_BatchesUntilCaughtUp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockReportingRepository(ctrl)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil).Times(2)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	tx.EXPECT().Commit(gomock.Any()).Return(nil).Times(2)
+
+	first := domain.ProjectionCursor{TxID: 5, EntryID: "e2"}
+	gomock.InOrder(
+		repo.EXPECT().LockCheckpoint(gomock.Any(), tx, domain.ReportingProjection).
+			Return(&domain.ProjectionCheckpoint{Name: domain.ReportingProjection}, nil),
+		repo.EXPECT().ListProjectableEntries(gomock.Any(), tx, domain.ProjectionCursor{}, 2).
+			Return(projectableEntries(5, "e1", "e2"), nil),
+		repo.EXPECT().Apply(gomock.Any(), tx, domain.ReportingProjection, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ usecase.Transaction, _ string, delta *domain.ReportingDelta, _ time.Time) error {
+				if delta.Entries != 2 || delta.Cursor != first {
+					t.Fatalf("unexpected first delta: %+v", delta)
+				}
+				return nil
+			}),
+		repo.EXPECT().LockCheckpoint(gomock.Any(), tx, domain.ReportingProjection).
+			Return(&domain.ProjectionCheckpoint{Name: domain.ReportingProjection, Cursor: first}, nil),
+		repo.EXPECT().ListProjectableEntries(gomock.Any(), tx, first, 2).
+			Return(projectableEntries(6, "e3"), nil),
+		repo.EXPECT().Apply(gomock.Any(), tx, domain.ReportingProjection, gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	uc := usecase.NewReportingUseCase(txManager, repo)
+
+	result, err := uc.Project(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Entries != 3 || result.Batches != 2 || result.Checkpoint != (domain.ProjectionCursor{TxID: 6, EntryID: "e3"}) {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestReportingUseCase_Project_NothingToApply(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockReportingRepository(ctrl)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	repo.EXPECT().LockCheckpoint(gomock.Any(), tx, domain.ReportingProjection).
+		Return(&domain.ProjectionCheckpoint{Name: domain.ReportingProjection}, nil)
+	repo.EXPECT().ListProjectableEntries(gomock.Any(), tx, gomock.Any(), usecase.DefaultProjectionBatchSize).
+		Return(nil, nil)
+
+	uc := usecase.NewReportingUseCase(txManager, repo)
+
+	result, err := uc.Project(context.Background(), 0)
+	if err != nil || result.Entries != 0 || result.Batches != 0 {
+		t.Fatalf("expected an empty run, got %+v, %v", result, err)
+	}
+}
+
+func TestReportingUseCase_Project_ApplyFailureRollsBack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockReportingRepository(ctrl)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil)
+	tx.EXPECT().Rollback(gomock.Any()).Return(nil)
+	repo.EXPECT().LockCheckpoint(gomock.Any(), tx, domain.ReportingProjection).
+		Return(&domain.ProjectionCheckpoint{Name: domain.ReportingProjection}, nil)
+	repo.EXPECT().ListProjectableEntries(gomock.Any(), tx, gomock.Any(), 10).
+		Return(projectableEntries(5, "e1"), nil)
+	repo.EXPECT().Apply(gomock.Any(), tx, domain.ReportingProjection, gomock.Any(), gomock.Any()).
+		Return(errors.New("deadlock"))
+
+	uc := usecase.NewReportingUseCase(txManager, repo)
+
+	if _, err := uc.Project(context.Background(), 10); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestReportingUseCase_Rebuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txManager := mocks.NewMockTransactionManager(ctrl)
+	tx := mocks.NewMockTransaction(ctrl)
+	repo := mocks.NewMockReportingRepository(ctrl)
+
+	txManager.EXPECT().Begin(gomock.Any()).Return(tx, nil).Times(2)
+	tx.EXPECT().Rollback(gomock.Any()).AnyTimes()
+	tx.EXPECT().Commit(gomock.Any()).Return(nil)
+
+	checkpoint := &domain.ProjectionCheckpoint{Name: domain.ReportingProjection}
+	gomock.InOrder(
+		repo.EXPECT().LockCheckpoint(gomock.Any(), tx, domain.ReportingProjection).Return(checkpoint, nil),
+		repo.EXPECT().Reset(gomock.Any(), tx, domain.ReportingProjection, gomock.Any()).Return(nil),
+		repo.EXPECT().LockCheckpoint(gomock.Any(), tx, domain.ReportingProjection).Return(checkpoint, nil),
+		repo.EXPECT().ListProjectableEntries(gomock.Any(), tx, domain.ProjectionCursor{}, 100).Return(nil, nil),
+	)
+
+	uc := usecase.NewReportingUseCase(txManager, repo)
+
+	if _, err := uc.Rebuild(context.Background(), 100); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestReportingUseCase_Status(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockReportingRepository(ctrl)
+	cursor := domain.ProjectionCursor{TxID: 9, EntryID: "e9"}
+	oldest := time.Now().Add(-time.Minute)
+
+	repo.EXPECT().GetCheckpoint(gomock.Any(), domain.ReportingProjection).
+		Return(&domain.ProjectionCheckpoint{Name: domain.ReportingProjection, Cursor: cursor}, nil).Times(2)
+	repo.EXPECT().NextUnprojectedAt(gomock.Any(), cursor).Return(&oldest, nil)
+	repo.EXPECT().NextUnprojectedAt(gomock.Any(), cursor).Return(nil, nil)
+
+	uc := usecase.NewReportingUseCase(mocks.NewMockTransactionManager(ctrl), repo)
+
+	status, err := uc.Status(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Lag < time.Minute {
+		t.Fatalf("expected at least a minute of lag, got %s", status.Lag)
+	}
+
+	status, err = uc.Status(context.Background())
+	if err != nil || status.Lag != 0 {
+		t.Fatalf("expected no lag when caught up, got %+v, %v", status, err)
+	}
+}
+
+func TestReportingUseCase_DailyVolumes_RejectsInvalidPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewReportingUseCase(mocks.NewMockTransactionManager(ctrl), mocks.NewMockReportingRepository(ctrl))
+
+	now := time.Now()
+	if _, err := uc.DailyVolumes(context.Background(), "acc-1", now, now.Add(-time.Hour)); !errors.Is(err, domain.ErrInvalidReportingPeriod) {
+		t.Fatalf("expected ErrInvalidReportingPeriod, got %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// StatementUseCase builds account statements from the entry and transfer
+// history.
+type StatementUseCase struct {
+	accountRepo  AccountRepository
+	entryRepo    EntryRepository
+	transferRepo TransferRepository
+}
+
+// NewStatementUseCase creates a new StatementUseCase.
+func NewStatementUseCase(accountRepo AccountRepository, entryRepo EntryRepository, transferRepo TransferRepository) *StatementUseCase {
+	return &StatementUseCase{
+		accountRepo:  accountRepo,
+		entryRepo:    entryRepo,
+		transferRepo: transferRepo,
+	}
+}
+
+// GenerateStatementInput represents input for generating a statement for
+// the period [From, To).
+type GenerateStatementInput struct {
+	From      time.Time
+	To        time.Time
+	AccountID string
+}
+
+// GenerateStatement builds the statement for an account and period. The
+// opening balance is the balance as of the last entry booked before From.
+func (uc *StatementUseCase) GenerateStatement(ctx context.Context, input GenerateStatementInput) (*domain.Statement, error) {
+	from, to := input.From.UTC(), input.To.UTC()
+	if err := domain.ValidateStatementPeriod(from, to); err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountRepo.GetByID(ctx, input.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are stored with microsecond precision.
+	opening, err := uc.entryRepo.GetBalanceAtTime(ctx, account.ID, from.Add(-time.Microsecond))
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := uc.entryRepo.GetByAccountInRange(ctx, account.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := uc.transfersFor(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := &domain.Statement{
+		AccountID:      account.ID,
+		AccountName:    account.Name,
+		Currency:       account.Currency,
+		PeriodStart:    from,
+		PeriodEnd:      to,
+		GeneratedAt:    time.Now().UTC(),
+		OpeningBalance: opening,
+		ClosingBalance: opening,
+		TotalCredits:   decimal.Zero,
+		TotalDebits:    decimal.Zero,
+		Lines:          make([]domain.StatementLine, 0, len(entries)),
+	}
+
+	for _, e := range entries {
+		line := domain.StatementLine{
+			EntryID:    e.ID,
+			TransferID: e.TransferID,
+			BookedAt:   e.CreatedAt,
+			ValueAt:    e.CreatedAt,
+			Amount:     e.Amount,
+			Balance:    e.AccountCurrentBalance,
+		}
+
+		if t, ok := transfers[e.TransferID]; ok {
+			line.ValueAt = t.EventAt
+			line.Metadata = t.Metadata
+			line.ReversedTransferID = t.ReversedTransferID
+			line.CounterpartyAccountID = t.FromAccountID
+			if t.FromAccountID == account.ID {
+				line.CounterpartyAccountID = t.ToAccountID
+			}
+		}
+
+		if e.Amount.IsNegative() {
+			statement.TotalDebits = statement.TotalDebits.Add(e.Amount.Neg())
+		} else {
+			statement.TotalCredits = statement.TotalCredits.Add(e.Amount)
+		}
+
+		statement.ClosingBalance = statement.ClosingBalance.Add(e.Amount)
+		statement.Lines = append(statement.Lines, line)
+	}
+
+	return statement, nil
+}
+
+// transfersFor loads the transfers behind entries, keyed by ID.
+func (uc *StatementUseCase) transfersFor(ctx context.Context, entries []*domain.Entry) (map[string]*domain.Transfer, error) {
+	if len(entries) == 0 {
+		return map[string]*domain.Transfer{}, nil
+	}
+
+	seen := make(map[string]bool, len(entries))
+	ids := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if !seen[e.TransferID] {
+			seen[e.TransferID] = true
+			ids = append(ids, e.TransferID)
+		}
+	}
+
+	transfers, err := uc.transferRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*domain.Transfer, len(transfers))
+	for _, t := range transfers {
+		byID[t.ID] = t
+	}
+
+	return byID, nil
+}
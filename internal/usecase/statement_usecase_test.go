@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+func TestStatementUseCase_GenerateStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	txRepo := mocks.NewMockTransferRepository(ctrl)
+
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	eventAt := from.Add(-time.Hour) // back-dated transfer
+
+	accRepo.EXPECT().GetByID(gomock.Any(), "acc-1").Return(&domain.Account{ID: "acc-1", Name: "Main", Currency: "USD"}, nil)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), "acc-1", from.Add(-time.Microsecond)).Return(decimal.NewFromInt(100), nil)
+	entryRepo.EXPECT().GetByAccountInRange(gomock.Any(), "acc-1", from, to).Return([]*domain.Entry{
+		{ID: "e-1", AccountID: "acc-1", TransferID: "tr-1", Amount: decimal.NewFromInt(50), AccountCurrentBalance: decimal.NewFromInt(150), CreatedAt: from.Add(time.Hour)},
+		{ID: "e-2", AccountID: "acc-1", TransferID: "tr-2", Amount: decimal.NewFromInt(-30), AccountCurrentBalance: decimal.NewFromInt(120), CreatedAt: from.Add(2 * time.Hour)},
+	}, nil)
+	txRepo.EXPECT().GetByIDs(gomock.Any(), []string{"tr-1", "tr-2"}).Return([]*domain.Transfer{
+		{ID: "tr-2", FromAccountID: "acc-1", ToAccountID: "acc-3", EventAt: from.Add(2 * time.Hour)},
+		{ID: "tr-1", FromAccountID: "acc-2", ToAccountID: "acc-1", EventAt: eventAt, Metadata: map[string]any{"invoice": "INV-1"}},
+	}, nil)
+
+	uc := usecase.NewStatementUseCase(accRepo, entryRepo, txRepo)
+
+	stmt, err := uc.GenerateStatement(context.Background(), usecase.GenerateStatementInput{
+		AccountID: "acc-1",
+		From:      from,
+		To:        to,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !stmt.OpeningBalance.Equal(decimal.NewFromInt(100)) || !stmt.ClosingBalance.Equal(decimal.NewFromInt(120)) {
+		t.Fatalf("unexpected balances: opening %s closing %s", stmt.OpeningBalance, stmt.ClosingBalance)
+	}
+
+	if !stmt.TotalCredits.Equal(decimal.NewFromInt(50)) || !stmt.TotalDebits.Equal(decimal.NewFromInt(30)) {
+		t.Fatalf("unexpected totals: credits %s debits %s", stmt.TotalCredits, stmt.TotalDebits)
+	}
+
+	if len(stmt.Lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(stmt.Lines))
+	}
+
+	first, second := stmt.Lines[0], stmt.Lines[1]
+	if first.CounterpartyAccountID != "acc-2" || !first.ValueAt.Equal(eventAt) || first.Metadata["invoice"] != "INV-1" {
+		t.Fatalf("unexpected first line: %+v", first)
+	}
+
+	if second.CounterpartyAccountID != "acc-3" {
+		t.Fatalf("unexpected second line: %+v", second)
+	}
+}
+
+func TestStatementUseCase_GenerateStatement_EmptyPeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	accRepo.EXPECT().GetByID(gomock.Any(), "acc-1").Return(&domain.Account{ID: "acc-1", Currency: "USD"}, nil)
+	entryRepo.EXPECT().GetBalanceAtTime(gomock.Any(), "acc-1", gomock.Any()).Return(decimal.NewFromInt(42), nil)
+	entryRepo.EXPECT().GetByAccountInRange(gomock.Any(), "acc-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	uc := usecase.NewStatementUseCase(accRepo, entryRepo, nil)
+
+	stmt, err := uc.GenerateStatement(context.Background(), usecase.GenerateStatementInput{
+		AccountID: "acc-1",
+		From:      from,
+		To:        from.AddDate(0, 0, 1),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !stmt.ClosingBalance.Equal(decimal.NewFromInt(42)) || len(stmt.Lines) != 0 {
+		t.Fatalf("expected an empty statement carrying the opening balance, got %+v", stmt)
+	}
+}
+
+func TestStatementUseCase_GenerateStatement_InvalidPeriod(t *testing.T) {
+	uc := usecase.NewStatementUseCase(nil, nil, nil)
+
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	_, err := uc.GenerateStatement(context.Background(), usecase.GenerateStatementInput{
+		AccountID: "acc-1",
+		From:      from,
+		To:        from,
+	})
+	if !errors.Is(err, domain.ErrInvalidStatementPeriod) {
+		t.Fatalf("expected ErrInvalidStatementPeriod, got %v", err)
+	}
+}
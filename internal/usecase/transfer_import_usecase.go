@@ -0,0 +1,520 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// DefaultImportChunkSize is how many rows are applied per
+// CreateBatchTransfer call when ImportOptions.ChunkSize is unset.
+const DefaultImportChunkSize = 500
+
+// BatchTransferCreator is the subset of TransferUseCase the importer posts
+// through.
+type BatchTransferCreator interface {
+	CreateBatchTransfer(ctx context.Context, input CreateBatchTransferInput) ([]*domain.Transfer, error)
+}
+
+// ImportRecord is one raw row read from an import file. Err is set when the
+// row could not be parsed; it is rejected rather than aborting the import.
+type ImportRecord struct {
+	Metadata       map[string]any
+	Err            error
+	IdempotencyKey string
+	FromAccountID  string
+	ToAccountID    string
+	Amount         string
+	EventAt        string
+	Line           int
+}
+
+// ImportSource streams import records. Next returns io.EOF after the last
+// record.
+type ImportSource interface {
+	Next() (*ImportRecord, error)
+}
+
+// ImportOpener opens a fresh ImportSource over the start of the same file.
+// Import reads the file twice: once to validate every row, once to apply.
+type ImportOpener func() (ImportSource, error)
+
+// ErrImportRejected is returned by Import when validation rejected rows;
+// nothing is posted until the file is fixed.
+var ErrImportRejected = errors.New("import file has invalid rows; nothing was imported")
+
+// ImportRejection is a row that was not imported, and why.
+type ImportRejection struct {
+	IdempotencyKey string
+	Reason         string
+	Line           int
+}
+
+// ImportOptions controls a transfer import.
+type ImportOptions struct {
+	// OnReject is called for every rejected row, in file order: during the
+	// validation pass for invalid rows, and while applying for rows the
+	// ledger refuses, before the checkpoint covering them is reported.
+	OnReject func(ImportRejection) error
+	// OnCheckpoint is called with the last line number whose outcome
+	// (imported, already imported or rejected) is durable. Passing it back
+	// as ResumeAfter continues the import from there.
+	OnCheckpoint func(line int) error
+	// KeyPrefix derives idempotency keys ("<prefix>:<line>") for rows that
+	// don't carry their own, so re-running the same file is safe.
+	KeyPrefix string
+	// ResumeAfter skips every row at or before this line.
+	ResumeAfter int
+	ChunkSize   int
+	// ValidateOnly stops after the validation pass.
+	ValidateOnly bool
+}
+
+// ImportReport summarizes a transfer import.
+type ImportReport struct {
+	Read            int
+	Imported        int
+	AlreadyImported int
+	Rejected        int
+	Skipped         int
+	LastLine        int
+}
+
+// TransferImportUseCase bulk-loads transfers, e.g. history migrated from
+// another ledger with its original event times.
+type TransferImportUseCase struct {
+	transfers    BatchTransferCreator
+	accountRepo  AccountRepository
+	transferRepo TransferRepository
+}
+
+// NewTransferImportUseCase creates a new TransferImportUseCase.
+func NewTransferImportUseCase(transfers BatchTransferCreator, accountRepo AccountRepository, transferRepo TransferRepository) *TransferImportUseCase {
+	return &TransferImportUseCase{
+		transfers:    transfers,
+		accountRepo:  accountRepo,
+		transferRepo: transferRepo,
+	}
+}
+
+// importRow is a validated row waiting in the current chunk.
+type importRow struct {
+	input CreateTransferInput
+	line  int
+}
+
+// importRun holds the state of one Import call.
+type importRun struct {
+	uc       *TransferImportUseCase
+	opts     ImportOptions
+	report   *ImportReport
+	accounts map[string]*domain.Account
+	chunk    []importRow
+	rejects  []ImportRejection
+}
+
+// Import validates every row of the file (after ResumeAfter) before
+// anything is posted; if any row is rejected, the rejects are reported and
+// Import returns ErrImportRejected without posting. Otherwise it re-reads
+// the file and applies the rows in chunks through CreateBatchTransfer, in
+// file order. Each row carries an idempotency key, and keys already in the
+// ledger are skipped, so an import interrupted after a chunk committed but
+// before its checkpoint was saved can simply be resumed. When a chunk fails
+// as a whole (e.g. one row would overdraw an account), its rows are retried
+// one at a time so only the offending rows are rejected. Fees are never
+// applied to imported transfers.
+//
+// Infrastructure errors abort the import, leaving the last checkpoint in
+// place.
+func (uc *TransferImportUseCase) Import(ctx context.Context, open ImportOpener, opts ImportOptions) (*ImportReport, error) {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = DefaultImportChunkSize
+	}
+
+	run := &importRun{
+		uc:       uc,
+		opts:     opts,
+		report:   &ImportReport{LastLine: opts.ResumeAfter},
+		accounts: make(map[string]*domain.Account),
+	}
+
+	lastLine, err := run.validateAll(ctx, open)
+	if err != nil {
+		return run.report, err
+	}
+
+	if opts.ValidateOnly {
+		run.report.LastLine = max(run.report.LastLine, lastLine)
+		return run.report, nil
+	}
+
+	if run.report.Rejected > 0 {
+		return run.report, ErrImportRejected
+	}
+
+	return run.report, run.applyAll(ctx, open)
+}
+
+// validateAll reads the whole file once, reporting every invalid row
+// through OnReject as it is found. It returns the last line read.
+func (r *importRun) validateAll(ctx context.Context, open ImportOpener) (int, error) {
+	src, err := open()
+	if err != nil {
+		return 0, err
+	}
+
+	// Keys are checked across the whole file: a repeated key would
+	// otherwise be silently skipped as already imported.
+	keys := make(map[string]int)
+	lastLine := 0
+
+	for {
+		rec, err := src.Next()
+		if errors.Is(err, io.EOF) {
+			return lastLine, nil
+		}
+		if err != nil {
+			return lastLine, err
+		}
+
+		if rec.Line <= r.opts.ResumeAfter {
+			r.report.Skipped++
+			continue
+		}
+
+		r.report.Read++
+		lastLine = rec.Line
+
+		key := r.key(rec)
+
+		_, err = r.validate(ctx, rec, key)
+		if err == nil && key != "" {
+			if first, dup := keys[key]; dup {
+				err = fmt.Errorf("%w: idempotency key %q already used on line %d", errInvalidImportRow, key, first)
+			} else {
+				keys[key] = rec.Line
+			}
+		}
+
+		if err == nil {
+			continue
+		}
+
+		if !isImportRowError(err) {
+			return lastLine, err
+		}
+
+		r.report.Rejected++
+		if r.opts.OnReject != nil {
+			rej := ImportRejection{Line: rec.Line, IdempotencyKey: key, Reason: err.Error()}
+			if err := r.opts.OnReject(rej); err != nil {
+				return lastLine, err
+			}
+		}
+	}
+}
+
+// applyAll re-reads the validated file and posts it chunk by chunk.
+func (r *importRun) applyAll(ctx context.Context, open ImportOpener) error {
+	src, err := open()
+	if err != nil {
+		return err
+	}
+
+	for {
+		rec, err := src.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if rec.Line <= r.opts.ResumeAfter {
+			continue
+		}
+
+		if err := r.add(ctx, rec); err != nil {
+			return err
+		}
+
+		if len(r.chunk) >= r.opts.ChunkSize {
+			if err := r.flush(ctx, rec.Line); err != nil {
+				return err
+			}
+		}
+	}
+
+	lastLine := r.report.LastLine
+	if n := len(r.chunk); n > 0 {
+		lastLine = max(lastLine, r.chunk[n-1].line)
+	}
+	if n := len(r.rejects); n > 0 {
+		lastLine = max(lastLine, r.rejects[n-1].Line)
+	}
+
+	return r.flush(ctx, lastLine)
+}
+
+// key returns the row's idempotency key, derived from KeyPrefix and the
+// line number when the row has none.
+func (r *importRun) key(rec *ImportRecord) string {
+	if rec.IdempotencyKey == "" && r.opts.KeyPrefix != "" {
+		return fmt.Sprintf("%s:%d", r.opts.KeyPrefix, rec.Line)
+	}
+
+	return rec.IdempotencyKey
+}
+
+// add converts a validated record into a queued chunk row. A row can still
+// be rejected here if the ledger changed since validation (e.g. an account
+// was removed).
+func (r *importRun) add(ctx context.Context, rec *ImportRecord) error {
+	key := r.key(rec)
+
+	input, err := r.validate(ctx, rec, key)
+	if err != nil {
+		if !isImportRowError(err) {
+			return err
+		}
+
+		r.reject(rec.Line, key, err)
+		return nil
+	}
+
+	r.chunk = append(r.chunk, importRow{input: input, line: rec.Line})
+
+	return nil
+}
+
+func (r *importRun) validate(ctx context.Context, rec *ImportRecord, key string) (CreateTransferInput, error) {
+	var input CreateTransferInput
+
+	if rec.Err != nil {
+		return input, fmt.Errorf("%w: %w", errInvalidImportRow, rec.Err)
+	}
+
+	if rec.FromAccountID == "" || rec.ToAccountID == "" {
+		return input, fmt.Errorf("%w: from_account_id and to_account_id are required", errInvalidImportRow)
+	}
+
+	if rec.FromAccountID == rec.ToAccountID {
+		return input, domain.ErrSameAccount
+	}
+
+	amount, err := decimal.NewFromString(rec.Amount)
+	if err != nil {
+		return input, fmt.Errorf("%w: invalid amount %q", errInvalidImportRow, rec.Amount)
+	}
+
+	if err := domain.ValidateAmount(amount); err != nil {
+		return input, err
+	}
+
+	if err := domain.ValidateMetadata(rec.Metadata); err != nil {
+		return input, err
+	}
+
+	from, err := r.account(ctx, rec.FromAccountID)
+	if err != nil {
+		return input, err
+	}
+
+	to, err := r.account(ctx, rec.ToAccountID)
+	if err != nil {
+		return input, err
+	}
+
+	if from.Currency != to.Currency {
+		return input, domain.ErrCurrencyMismatch
+	}
+
+	input = CreateTransferInput{
+		FromAccountID:  rec.FromAccountID,
+		ToAccountID:    rec.ToAccountID,
+		Amount:         amount,
+		Metadata:       rec.Metadata,
+		IdempotencyKey: key,
+		SkipFees:       true,
+	}
+
+	if rec.EventAt != "" {
+		eventAt, err := time.Parse(time.RFC3339Nano, rec.EventAt)
+		if err != nil {
+			return input, fmt.Errorf("%w: invalid event_at %q (use RFC3339)", errInvalidImportRow, rec.EventAt)
+		}
+		eventAt = eventAt.UTC()
+		input.EventAt = &eventAt
+	}
+
+	return input, nil
+}
+
+// account looks up an account once per import.
+func (r *importRun) account(ctx context.Context, id string) (*domain.Account, error) {
+	if a, ok := r.accounts[id]; ok {
+		return a, nil
+	}
+
+	a, err := r.uc.accountRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.accounts[id] = a
+
+	return a, nil
+}
+
+func (r *importRun) reject(line int, key string, err error) {
+	r.report.Rejected++
+	r.rejects = append(r.rejects, ImportRejection{
+		Line:           line,
+		IdempotencyKey: key,
+		Reason:         err.Error(),
+	})
+}
+
+// flush applies the current chunk, reports its rejects and then the
+// checkpoint through line.
+func (r *importRun) flush(ctx context.Context, line int) error {
+	if len(r.chunk) > 0 {
+		if err := r.apply(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Rejects found while applying the chunk come after those found while
+	// queueing it; report them in file order.
+	slices.SortFunc(r.rejects, func(a, b ImportRejection) int {
+		return cmp.Compare(a.Line, b.Line)
+	})
+	for _, rej := range r.rejects {
+		if r.opts.OnReject != nil {
+			if err := r.opts.OnReject(rej); err != nil {
+				return err
+			}
+		}
+	}
+
+	r.chunk = r.chunk[:0]
+	r.rejects = r.rejects[:0]
+
+	if line <= r.report.LastLine {
+		return nil
+	}
+
+	r.report.LastLine = line
+	if r.opts.OnCheckpoint != nil {
+		return r.opts.OnCheckpoint(line)
+	}
+
+	return nil
+}
+
+// apply posts the chunk, skipping rows whose idempotency key is already in
+// the ledger.
+func (r *importRun) apply(ctx context.Context) error {
+	keys := make([]string, 0, len(r.chunk))
+	for _, row := range r.chunk {
+		if row.input.IdempotencyKey != "" {
+			keys = append(keys, row.input.IdempotencyKey)
+		}
+	}
+
+	existing := make(map[string]bool)
+	if len(keys) > 0 {
+		found, err := r.uc.transferRepo.ExistingIdempotencyKeys(ctx, keys)
+		if err != nil {
+			return err
+		}
+		for _, k := range found {
+			existing[k] = true
+		}
+	}
+
+	pending := make([]importRow, 0, len(r.chunk))
+	for _, row := range r.chunk {
+		if existing[row.input.IdempotencyKey] {
+			r.report.AlreadyImported++
+			continue
+		}
+		pending = append(pending, row)
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	inputs := make([]CreateTransferInput, 0, len(pending))
+	for _, row := range pending {
+		inputs = append(inputs, row.input)
+	}
+
+	// A failed chunk isn't audited: rows it rejects are retried below, and
+	// only their own outcome is recorded.
+	_, err := r.uc.transfers.CreateBatchTransfer(ctx, CreateBatchTransferInput{Transfers: inputs, SkipFailureAudit: true})
+	if err == nil {
+		r.report.Imported += len(pending)
+		return nil
+	}
+
+	if !isImportRowError(err) && !errors.Is(err, domain.ErrDuplicateIdempotencyKey) {
+		return err
+	}
+
+	// Retry one at a time to isolate the offending rows; each retry is
+	// audited as usual.
+	for _, row := range pending {
+		_, err := r.uc.transfers.CreateBatchTransfer(ctx, CreateBatchTransferInput{
+			Transfers: []CreateTransferInput{row.input},
+		})
+		switch {
+		case err == nil:
+			r.report.Imported++
+		case errors.Is(err, domain.ErrDuplicateIdempotencyKey):
+			r.report.AlreadyImported++
+		case isImportRowError(err):
+			r.reject(row.line, row.input.IdempotencyKey, err)
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// errInvalidImportRow marks rows that could not be parsed or are missing
+// required fields.
+var errInvalidImportRow = errors.New("invalid row")
+
+// isImportRowError reports whether err is a problem with the row itself
+// (rejected) rather than with the ledger or database (fatal).
+func isImportRowError(err error) bool {
+	for _, target := range []error{
+		errInvalidImportRow,
+		domain.ErrAccountNotFound,
+		domain.ErrSameAccount,
+		domain.ErrInvalidAmount,
+		domain.ErrAmountTooSmall,
+		domain.ErrAmountTooLarge,
+		domain.ErrMetadataTooLarge,
+		domain.ErrCurrencyMismatch,
+		domain.ErrNegativeBalanceNotAllowed,
+		domain.ErrPositiveBalanceNotAllowed,
+	} {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
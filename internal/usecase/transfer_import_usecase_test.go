@@ -0,0 +1,302 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+type sliceImportSource struct {
+	records []*usecase.ImportRecord
+}
+
+func (s *sliceImportSource) Next() (*usecase.ImportRecord, error) {
+	if len(s.records) == 0 {
+		return nil, io.EOF
+	}
+
+	rec := s.records[0]
+	s.records = s.records[1:]
+
+	return rec, nil
+}
+
+// sliceOpener returns an ImportOpener that replays records from the start
+// on every open, like re-reading a file.
+func sliceOpener(records ...*usecase.ImportRecord) usecase.ImportOpener {
+	return func() (usecase.ImportSource, error) {
+		return &sliceImportSource{records: records}, nil
+	}
+}
+
+type batchCreatorFunc func(ctx context.Context, input usecase.CreateBatchTransferInput) ([]*domain.Transfer, error)
+
+func (f batchCreatorFunc) CreateBatchTransfer(ctx context.Context, input usecase.CreateBatchTransferInput) ([]*domain.Transfer, error) {
+	return f(ctx, input)
+}
+
+func importRecord(line int, from, to, amount string) *usecase.ImportRecord {
+	return &usecase.ImportRecord{Line: line, FromAccountID: from, ToAccountID: to, Amount: amount}
+}
+
+func expectImportAccounts(accRepo *mocks.MockAccountRepository) {
+	accounts := map[string]*domain.Account{
+		"acc-1":   {ID: "acc-1", Currency: "USD"},
+		"acc-2":   {ID: "acc-2", Currency: "USD"},
+		"acc-eur": {ID: "acc-eur", Currency: "EUR"},
+	}
+
+	accRepo.EXPECT().GetByID(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, id string) (*domain.Account, error) {
+			if a, ok := accounts[id]; ok {
+				return a, nil
+			}
+			return nil, domain.ErrAccountNotFound
+		}).AnyTimes()
+}
+
+func TestTransferImportUseCase_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	expectImportAccounts(accRepo)
+
+	transferRepo.EXPECT().ExistingIdempotencyKeys(gomock.Any(), []string{"f:2", "f:3"}).Return([]string{"f:2"}, nil)
+	transferRepo.EXPECT().ExistingIdempotencyKeys(gomock.Any(), []string{"f:4"}).Return(nil, nil)
+
+	var batches [][]usecase.CreateTransferInput
+	creator := batchCreatorFunc(func(_ context.Context, input usecase.CreateBatchTransferInput) ([]*domain.Transfer, error) {
+		batches = append(batches, input.Transfers)
+		return nil, nil
+	})
+
+	var checkpoints []int
+
+	uc := usecase.NewTransferImportUseCase(creator, accRepo, transferRepo)
+	report, err := uc.Import(context.Background(), sliceOpener(
+		importRecord(2, "acc-1", "acc-2", "10"),
+		importRecord(3, "acc-2", "acc-1", "5"),
+		&usecase.ImportRecord{Line: 4, FromAccountID: "acc-1", ToAccountID: "acc-2", Amount: "2", EventAt: "2024-01-02T03:04:05Z"},
+	), usecase.ImportOptions{
+		KeyPrefix: "f",
+		ChunkSize: 2,
+		OnCheckpoint: func(line int) error {
+			checkpoints = append(checkpoints, line)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := usecase.ImportReport{Read: 3, Imported: 2, AlreadyImported: 1, LastLine: 4}
+	if *report != want {
+		t.Fatalf("report = %+v, want %+v", *report, want)
+	}
+
+	if len(batches) != 2 || len(batches[0]) != 1 || batches[0][0].IdempotencyKey != "f:3" {
+		t.Fatalf("unexpected batches: %+v", batches)
+	}
+
+	last := batches[1][0]
+	if !last.SkipFees || last.EventAt == nil || last.EventAt.Year() != 2024 {
+		t.Fatalf("unexpected imported transfer: %+v", last)
+	}
+
+	if len(checkpoints) != 2 || checkpoints[0] != 3 || checkpoints[1] != 4 {
+		t.Fatalf("unexpected checkpoints: %v", checkpoints)
+	}
+}
+
+func TestTransferImportUseCase_Import_RejectsFileBeforePosting(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	expectImportAccounts(accRepo)
+
+	creator := batchCreatorFunc(func(context.Context, usecase.CreateBatchTransferInput) ([]*domain.Transfer, error) {
+		t.Fatal("a file with invalid rows must not post anything")
+		return nil, nil
+	})
+
+	dup := importRecord(9, "acc-1", "acc-2", "1")
+	dup.IdempotencyKey = "f:2"
+
+	var rejects []usecase.ImportRejection
+	checkpointed := false
+
+	uc := usecase.NewTransferImportUseCase(creator, accRepo, transferRepo)
+	report, err := uc.Import(context.Background(), sliceOpener(
+		importRecord(2, "acc-1", "acc-2", "10"),
+		importRecord(3, "acc-2", "acc-1", "5"),
+		importRecord(4, "acc-1", "acc-eur", "1"),
+		importRecord(5, "acc-1", "acc-missing", "1"),
+		importRecord(6, "acc-1", "acc-2", "2"),
+		&usecase.ImportRecord{Line: 7, FromAccountID: "acc-1", ToAccountID: "acc-2", Amount: "2", EventAt: "yesterday"},
+		importRecord(8, "acc-1", "acc-2", "2"),
+		dup,
+	), usecase.ImportOptions{
+		KeyPrefix: "f",
+		ChunkSize: 2,
+		OnReject: func(r usecase.ImportRejection) error {
+			rejects = append(rejects, r)
+			return nil
+		},
+		OnCheckpoint: func(int) error {
+			checkpointed = true
+			return nil
+		},
+	})
+	if !errors.Is(err, usecase.ErrImportRejected) {
+		t.Fatalf("expected ErrImportRejected, got %v", err)
+	}
+
+	want := usecase.ImportReport{Read: 8, Rejected: 4}
+	if *report != want {
+		t.Fatalf("report = %+v, want %+v", *report, want)
+	}
+
+	if len(rejects) != 4 || rejects[0].Line != 4 || rejects[1].Line != 5 || rejects[2].Line != 7 || rejects[3].Line != 9 {
+		t.Fatalf("unexpected rejects: %+v", rejects)
+	}
+
+	if checkpointed {
+		t.Fatal("checkpoint must not move when nothing was posted")
+	}
+}
+
+func TestTransferImportUseCase_Import_FallsBackToSingleRows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	expectImportAccounts(accRepo)
+
+	transferRepo.EXPECT().ExistingIdempotencyKeys(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	creator := batchCreatorFunc(func(_ context.Context, input usecase.CreateBatchTransferInput) ([]*domain.Transfer, error) {
+		// Only the per-row retries, which decide each row's outcome, may
+		// audit failures.
+		if input.SkipFailureAudit != (len(input.Transfers) > 1) {
+			t.Errorf("unexpected SkipFailureAudit=%v for %d transfers", input.SkipFailureAudit, len(input.Transfers))
+		}
+		for _, tr := range input.Transfers {
+			switch tr.IdempotencyKey {
+			case "k-2":
+				return nil, domain.ErrNegativeBalanceNotAllowed
+			case "k-3":
+				if len(input.Transfers) == 1 {
+					return nil, domain.ErrDuplicateIdempotencyKey
+				}
+			}
+		}
+		return nil, nil
+	})
+
+	records := []*usecase.ImportRecord{
+		importRecord(1, "acc-1", "acc-2", "10"),
+		importRecord(2, "acc-1", "acc-2", "1000"),
+		importRecord(3, "acc-1", "acc-2", "1"),
+	}
+	for i, rec := range records {
+		rec.IdempotencyKey = []string{"k-1", "k-2", "k-3"}[i]
+	}
+
+	var rejects []usecase.ImportRejection
+
+	uc := usecase.NewTransferImportUseCase(creator, accRepo, transferRepo)
+	report, err := uc.Import(context.Background(), sliceOpener(records...), usecase.ImportOptions{
+		OnReject: func(r usecase.ImportRejection) error {
+			rejects = append(rejects, r)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if report.Imported != 1 || report.AlreadyImported != 1 || report.Rejected != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	if len(rejects) != 1 || rejects[0].Line != 2 || rejects[0].IdempotencyKey != "k-2" {
+		t.Fatalf("unexpected rejects: %+v", rejects)
+	}
+}
+
+func TestTransferImportUseCase_Import_AbortsOnInfrastructureError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	expectImportAccounts(accRepo)
+
+	dbErr := errors.New("connection reset")
+	transferRepo.EXPECT().ExistingIdempotencyKeys(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	creator := batchCreatorFunc(func(context.Context, usecase.CreateBatchTransferInput) ([]*domain.Transfer, error) {
+		return nil, dbErr
+	})
+
+	checkpointed := false
+
+	uc := usecase.NewTransferImportUseCase(creator, accRepo, transferRepo)
+	_, err := uc.Import(context.Background(), sliceOpener(
+		importRecord(2, "acc-1", "acc-2", "10"),
+	), usecase.ImportOptions{
+		KeyPrefix: "f",
+		OnCheckpoint: func(int) error {
+			checkpointed = true
+			return nil
+		},
+	})
+	if !errors.Is(err, dbErr) {
+		t.Fatalf("expected %v, got %v", dbErr, err)
+	}
+
+	if checkpointed {
+		t.Fatal("checkpoint must not advance past a failed chunk")
+	}
+}
+
+func TestTransferImportUseCase_Import_ResumeAndValidateOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	transferRepo := mocks.NewMockTransferRepository(ctrl)
+	expectImportAccounts(accRepo)
+
+	creator := batchCreatorFunc(func(context.Context, usecase.CreateBatchTransferInput) ([]*domain.Transfer, error) {
+		t.Fatal("validate-only import must not post transfers")
+		return nil, nil
+	})
+
+	uc := usecase.NewTransferImportUseCase(creator, accRepo, transferRepo)
+	report, err := uc.Import(context.Background(), sliceOpener(
+		importRecord(2, "acc-1", "acc-2", "10"),
+		importRecord(3, "acc-1", "acc-2", "10"),
+		importRecord(4, "acc-1", "acc-1", "10"),
+	), usecase.ImportOptions{ResumeAfter: 2, ValidateOnly: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := usecase.ImportReport{Read: 2, Rejected: 1, Skipped: 1, LastLine: 4}
+	if *report != want {
+		t.Fatalf("report = %+v, want %+v", *report, want)
+	}
+}
@@ -28,6 +28,8 @@ type TransferUseCase struct {
 	idGen        IDGenerator
 	retrier      Retrier
 	metrics      *metrics.Metrics
+	// feePolicyRepo is optional; when nil no fee policies are evaluated.
+	feePolicyRepo FeePolicyRepository
 }
 
 // NewTransferUseCase creates a new TransferUseCase.
@@ -60,6 +62,14 @@ func (uc *TransferUseCase) WithRetrier(r Retrier) *TransferUseCase {
 	return uc
 }
 
+// WithFeePolicies enables fee policy evaluation on transfer creation: every
+// active policy matching a transfer posts a fee leg to its revenue account
+// in the same database transaction.
+func (uc *TransferUseCase) WithFeePolicies(repo FeePolicyRepository) *TransferUseCase {
+	uc.feePolicyRepo = repo
+	return uc
+}
+
 // noopRetrier is a no-op retrier that just executes the operation once.
 type noopRetrier struct{}
 
@@ -67,7 +77,8 @@ func (r *noopRetrier) Retry(ctx context.Context, operation func() error) error {
 	return operation()
 }
 
-// CreateTransferInput represents input for creating a transfer.
+// CreateTransferInput represents input for creating a transfer. Within a
+// batch, EventAt and Metadata override the batch-level values when set.
 type CreateTransferInput struct {
 	EventAt       *time.Time
 	Metadata      map[string]any
@@ -77,6 +88,18 @@ type CreateTransferInput struct {
 	// ReversedTransferID, when set, marks this transfer as a reversal of the
 	// referenced transfer. Leave nil for ordinary transfers.
 	ReversedTransferID *string
+	// SkipFees disables fee policy evaluation for this transfer, for
+	// system postings (e.g. accruals) that must move the exact amount.
+	// Reversals never have fees applied.
+	SkipFees bool
+	// IdempotencyKey, when set, must be unique across all transfers; a
+	// duplicate fails with domain.ErrDuplicateIdempotencyKey.
+	IdempotencyKey string
+	// ExpectedFromVersion, when set, is the version the source account
+	// must be at; otherwise the transfer fails with
+	// domain.ErrVersionMismatch. It is checked before any transfer of the
+	// batch is applied.
+	ExpectedFromVersion *int64
 }
 
 // CreateBatchTransferInput represents input for creating multiple transfers atomically.
@@ -84,6 +107,10 @@ type CreateBatchTransferInput struct {
 	EventAt   *time.Time
 	Metadata  map[string]any
 	Transfers []CreateTransferInput
+	// SkipFailureAudit suppresses the failure audit rows of a rejected
+	// batch, for callers that retry its transfers one at a time and so
+	// audit each transfer's final outcome instead.
+	SkipFailureAudit bool
 }
 
 // CreateTransfer creates a single transfer.
@@ -110,7 +137,7 @@ func (uc *TransferUseCase) CreateBatchTransfer(ctx context.Context, input Create
 	start := time.Now()
 
 	defer func() {
-		if err != nil {
+		if err != nil && !input.SkipFailureAudit {
 			uc.auditFailedTransfers(ctx, input, err)
 		}
 	}()
@@ -136,15 +163,23 @@ func (uc *TransferUseCase) CreateBatchTransfer(ctx context.Context, input Create
 		}
 	}
 
-	// 1. Collect and sort unique account IDs (DEADLOCK PREVENTION)
+	feePolicies, err := uc.candidateFeePolicies(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Collect and sort unique account IDs (DEADLOCK PREVENTION). Revenue
+	// accounts of candidate fee policies are locked up front too, so fee
+	// legs never take a lock out of order.
 	accountIDs := uc.collectUniqueAccountIDs(input.Transfers)
+	accountIDs = appendRevenueAccountIDs(accountIDs, feePolicies)
 	sort.Strings(accountIDs)
 
 	// Execute with retry for deadlock/serialization errors
 	var currencies []string
 	err = uc.retrier.Retry(ctx, func() error {
 		var txErr error
-		transfers, currencies, txErr = uc.executeTransferTransaction(ctx, input, accountIDs)
+		transfers, currencies, txErr = uc.executeTransferTransaction(ctx, input, accountIDs, feePolicies)
 		return txErr
 	})
 
@@ -214,6 +249,7 @@ func (uc *TransferUseCase) executeTransferTransaction(
 	ctx context.Context,
 	input CreateBatchTransferInput,
 	accountIDs []string,
+	feePolicies [][]*domain.FeePolicy,
 ) ([]*domain.Transfer, []string, error) {
 	// Add transaction timeout to prevent long-running transactions
 	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
@@ -238,6 +274,12 @@ func (uc *TransferUseCase) executeTransferTransaction(
 
 	accountMap := uc.buildAccountMap(accounts)
 
+	for _, ti := range input.Transfers {
+		if err := accountMap[ti.FromAccountID].CheckVersion(ti.ExpectedFromVersion); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// 4. Process each transfer
 	now := time.Now().UTC()
 
@@ -248,13 +290,18 @@ func (uc *TransferUseCase) executeTransferTransaction(
 
 	transfers := make([]*domain.Transfer, 0, len(input.Transfers))
 	currencies := make([]string, 0, len(input.Transfers))
-	for _, ti := range input.Transfers {
+	for i, ti := range input.Transfers {
 		metadata := input.Metadata
 		if ti.Metadata != nil {
 			metadata = ti.Metadata
 		}
 
-		transfer, err := uc.processTransfer(txCtx, tx, accountMap, ti, now, eventAt, metadata)
+		transferEventAt := eventAt
+		if ti.EventAt != nil {
+			transferEventAt = *ti.EventAt
+		}
+
+		transfer, err := uc.processTransfer(txCtx, tx, accountMap, ti, now, transferEventAt, metadata, feePolicies[i])
 		if err != nil {
 			return nil, nil, err
 		}
@@ -308,6 +355,7 @@ func (uc *TransferUseCase) processTransfer(
 	input CreateTransferInput,
 	now, eventAt time.Time,
 	metadata map[string]any,
+	feePolicies []*domain.FeePolicy,
 ) (*domain.Transfer, error) {
 	fromAccount := accountMap[input.FromAccountID]
 	toAccount := accountMap[input.ToAccountID]
@@ -344,6 +392,9 @@ func (uc *TransferUseCase) processTransfer(
 		Metadata:           metadata,
 		ReversedTransferID: input.ReversedTransferID,
 	}
+	if input.IdempotencyKey != "" {
+		transfer.IdempotencyKey = &input.IdempotencyKey
+	}
 
 	err = transfer.Validate()
 	if err != nil {
@@ -409,33 +460,44 @@ func (uc *TransferUseCase) processTransfer(
 	toAccount.Balance = toNewBalance
 	toAccount.Version++
 
-	// Emit transfer created/reversed event
-	event := &domain.OutboxEvent{
-		ID:            uc.idGen.Generate(),
-		AggregateID:   transfer.ID,
-		AggregateType: domain.AggregateTypeTransfer,
-		EventVersion:  1,
-		CreatedAt:     now,
-		Published:     false,
+	// Post fee legs (if any) before emitting the event, so the fee
+	// breakdown is part of the transfer.created payload.
+	if err := uc.postFees(ctx, tx, accountMap, transfer, fromAccount.Currency, feePolicies, now, eventAt); err != nil {
+		return nil, err
 	}
 
+	// Emit transfer created/reversed event
+	var payload domain.EventPayload
 	if transfer.ReversedTransferID != nil {
-		event.EventType = domain.EventTypeTransferReversed
-		event.Payload = map[string]any{
-			"reversal_transfer_id": transfer.ID,
-			"original_transfer_id": *transfer.ReversedTransferID,
-			"amount":               transfer.Amount.String(),
-			"event_at":             transfer.EventAt.Format(time.RFC3339),
+		payload = domain.TransferReversedEvent{
+			ReversalTransferID: transfer.ID,
+			OriginalTransferID: *transfer.ReversedTransferID,
+			Amount:             transfer.Amount.String(),
+			EventAt:            transfer.EventAt.Format(time.RFC3339),
 		}
 	} else {
-		event.EventType = domain.EventTypeTransferCreated
-		event.Payload = map[string]any{
-			"transfer_id":     transfer.ID,
-			"from_account_id": transfer.FromAccountID,
-			"to_account_id":   transfer.ToAccountID,
-			"amount":          transfer.Amount.String(),
-			"event_at":        transfer.EventAt.Format(time.RFC3339),
+		created := domain.TransferCreatedEvent{
+			TransferID:    transfer.ID,
+			FromAccountID: transfer.FromAccountID,
+			ToAccountID:   transfer.ToAccountID,
+			Amount:        transfer.Amount.String(),
+			EventAt:       transfer.EventAt.Format(time.RFC3339),
+		}
+		for _, f := range transfer.Fees {
+			created.Fees = append(created.Fees, domain.TransferFeeEvent{
+				PolicyID:      f.PolicyID,
+				TransferID:    f.TransferID,
+				FromAccountID: f.FromAccountID,
+				ToAccountID:   f.ToAccountID,
+				Amount:        f.Amount.String(),
+			})
 		}
+		payload = created
+	}
+
+	event, err := domain.NewOutboxEvent(uc.idGen.Generate(), payload, now)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := uc.outboxRepo.Create(ctx, tx, event); err != nil {
@@ -445,11 +507,147 @@ func (uc *TransferUseCase) processTransfer(
 	return transfer, nil
 }
 
+// candidateFeePolicies returns, per input transfer (same order), the active
+// fee policies whose account and metadata criteria match it. The currency
+// criterion is checked later, once the accounts are loaded.
+func (uc *TransferUseCase) candidateFeePolicies(ctx context.Context, input CreateBatchTransferInput) ([][]*domain.FeePolicy, error) {
+	candidates := make([][]*domain.FeePolicy, len(input.Transfers))
+	if uc.feePolicyRepo == nil {
+		return candidates, nil
+	}
+
+	var policies []*domain.FeePolicy
+	loaded := false
+	for i, ti := range input.Transfers {
+		if ti.SkipFees || ti.ReversedTransferID != nil {
+			continue
+		}
+
+		if !loaded {
+			var err error
+			if policies, err = uc.feePolicyRepo.ListActive(ctx); err != nil {
+				return nil, err
+			}
+			loaded = true
+		}
+
+		metadata := input.Metadata
+		if ti.Metadata != nil {
+			metadata = ti.Metadata
+		}
+
+		for _, p := range policies {
+			if p.MatchesTransfer(ti.FromAccountID, ti.ToAccountID, metadata) {
+				candidates[i] = append(candidates[i], p)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// appendRevenueAccountIDs adds the revenue accounts of the given fee
+// policies to ids, without duplicates.
+func appendRevenueAccountIDs(ids []string, feePolicies [][]*domain.FeePolicy) []string {
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		seen[id] = true
+	}
+
+	for _, policies := range feePolicies {
+		for _, p := range policies {
+			if !seen[p.RevenueAccountID] {
+				seen[p.RevenueAccountID] = true
+				ids = append(ids, p.RevenueAccountID)
+			}
+		}
+	}
+
+	return ids
+}
+
+// postFees posts one fee transfer per matching policy, from the policy's
+// payer to its revenue account, and records the breakdown on transfer.Fees.
+// Fee legs are ordinary transfers (with their own entries and
+// transfer.created event) tagged with fee_for_transfer_id metadata.
+func (uc *TransferUseCase) postFees(
+	ctx context.Context,
+	tx Transaction,
+	accountMap map[string]*domain.Account,
+	transfer *domain.Transfer,
+	currency string,
+	feePolicies []*domain.FeePolicy,
+	now, eventAt time.Time,
+) error {
+	for _, p := range feePolicies {
+		if !p.MatchesCurrency(currency) {
+			continue
+		}
+
+		// A fee can only be credited to a revenue account in the
+		// transfer's currency. Policies created before currencies were
+		// enforced may lack a currency filter; they must not fail
+		// transfers in other currencies.
+		if revenue := accountMap[p.RevenueAccountID]; revenue != nil && revenue.Currency != currency {
+			continue
+		}
+
+		fee := p.Compute(transfer.Amount)
+		if !fee.IsPositive() {
+			continue
+		}
+
+		payerID := transfer.FromAccountID
+		if p.Payer == domain.FeePayerDestination {
+			payerID = transfer.ToAccountID
+		}
+
+		// A transfer into (or out of) the revenue account itself pays no fee.
+		if payerID == p.RevenueAccountID {
+			continue
+		}
+
+		leg, err := uc.processTransfer(ctx, tx, accountMap, CreateTransferInput{
+			FromAccountID: payerID,
+			ToAccountID:   p.RevenueAccountID,
+			Amount:        fee,
+		}, now, eventAt, map[string]any{
+			"fee_for_transfer_id": transfer.ID,
+			"fee_policy_id":       p.ID,
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		transfer.Fees = append(transfer.Fees, domain.TransferFee{
+			PolicyID:      p.ID,
+			TransferID:    leg.ID,
+			FromAccountID: leg.FromAccountID,
+			ToAccountID:   leg.ToAccountID,
+			Amount:        leg.Amount,
+		})
+	}
+
+	return nil
+}
+
 // GetTransfer retrieves a transfer by ID.
 func (uc *TransferUseCase) GetTransfer(ctx context.Context, id string) (*domain.Transfer, error) {
 	return uc.transferRepo.GetByID(ctx, id)
 }
 
+// GetTransferByIdempotencyKey retrieves the transfer created with an
+// idempotency key.
+func (uc *TransferUseCase) GetTransferByIdempotencyKey(ctx context.Context, key string) (*domain.Transfer, error) {
+	return uc.transferRepo.GetByIdempotencyKey(ctx, key)
+}
+
+// GetTransfersByIDs retrieves the transfers with the given IDs in one
+// query, in no particular order; unknown IDs are skipped.
+func (uc *TransferUseCase) GetTransfersByIDs(ctx context.Context, ids []string) ([]*domain.Transfer, error) {
+	return uc.transferRepo.GetByIDs(ctx, ids)
+}
+
 // ListTransfersByAccountInput represents input for listing transfers.
 type ListTransfersByAccountInput struct {
 	AccountID string
@@ -510,6 +708,65 @@ func (uc *TransferUseCase) ListTransfersByAccountCursor(ctx context.Context, inp
 	return result, nil
 }
 
+// SearchTransfersInput represents input for a transfer search. Cursor is
+// the NextCursor of the previous page, empty for the first.
+type SearchTransfersInput struct {
+	Filter domain.TransferSearchFilter
+	Sort   domain.TransferSort
+	Cursor string
+	Limit  int
+}
+
+// SearchTransfersResult is a page of matching transfers plus the opaque
+// cursor to request the next page. NextCursor is empty when there are no
+// more results.
+type SearchTransfersResult struct {
+	Transfers  []*domain.Transfer
+	NextCursor string
+}
+
+// SearchTransfers lists the transfers matching a filter, in the requested
+// order, using keyset pagination (see TransferRepository.Search).
+func (uc *TransferUseCase) SearchTransfers(ctx context.Context, input SearchTransfersInput) (*SearchTransfersResult, error) {
+	if err := input.Filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	order, err := input.Sort.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	var after *domain.TransferCursor
+	if input.Cursor != "" {
+		cursor, err := domain.DecodeTransferCursor(input.Cursor, order)
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor
+	}
+
+	if input.Limit <= 0 {
+		input.Limit = 20
+	}
+
+	if input.Limit > 100 {
+		input.Limit = 100
+	}
+
+	transfers, err := uc.transferRepo.Search(ctx, input.Filter, order, after, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SearchTransfersResult{Transfers: transfers}
+	if len(transfers) == input.Limit {
+		result.NextCursor = domain.NewTransferCursor(order, transfers[len(transfers)-1]).Encode()
+	}
+
+	return result, nil
+}
+
 func (uc *TransferUseCase) collectUniqueAccountIDs(transfers []CreateTransferInput) []string {
 	seen := make(map[string]bool)
 
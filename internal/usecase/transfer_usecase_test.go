@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -201,6 +202,37 @@ func TestTransferUseCase_InsufficientBalance(t *testing.T) {
 	}
 }
 
+func TestTransferUseCase_StaleExpectedFromVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	txMgr := mocks.NewMockTransactionManager(ctrl)
+	mockTx := mocks.NewMockTransaction(ctrl)
+
+	txMgr.EXPECT().Begin(gomock.Any()).Return(mockTx, nil)
+	accRepo.EXPECT().GetByIDsForUpdate(gomock.Any(), mockTx, gomock.Any()).Return([]*domain.Account{
+		{ID: "acc-1", Balance: decimal.NewFromInt(500), Currency: "USD", Version: 4},
+		{ID: "acc-2", Balance: decimal.Zero, Currency: "USD", AllowPositiveBalance: true},
+	}, nil)
+	mockTx.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	uc := usecase.NewTransferUseCase(txMgr, accRepo, mocks.NewMockTransferRepository(ctrl), mocks.NewMockEntryRepository(ctrl),
+		mocks.NewMockOutboxRepository(ctrl), nil, mocks.NewMockIDGenerator(ctrl), nil)
+
+	expected := int64(3)
+	_, err := uc.CreateTransfer(context.Background(), usecase.CreateTransferInput{
+		FromAccountID:       "acc-1",
+		ToAccountID:         "acc-2",
+		Amount:              decimal.NewFromInt(100),
+		ExpectedFromVersion: &expected,
+	})
+
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Errorf("expected ErrVersionMismatch, got %v", err)
+	}
+}
+
 func TestTransferUseCase_ListByAccount(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -228,6 +260,89 @@ func TestTransferUseCase_ListByAccount(t *testing.T) {
 	}
 }
 
+func TestTransferUseCase_SearchTransfers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sort := domain.TransferSort{Field: domain.TransferSortAmount}
+	after := &domain.Transfer{ID: "tx-3", Amount: decimal.NewFromInt(500)}
+	filter := domain.TransferSearchFilter{Currency: "EUR", Metadata: map[string]any{"order_id": "X"}}
+
+	txRepo := mocks.NewMockTransferRepository(ctrl)
+	txRepo.EXPECT().Search(gomock.Any(), filter, sort, &domain.TransferCursor{Sort: sort, Value: "500", ID: "tx-3"}, 2).Return([]*domain.Transfer{
+		{ID: "tx-2", Amount: decimal.NewFromInt(400)},
+		{ID: "tx-1", Amount: decimal.NewFromInt(300)},
+	}, nil)
+
+	uc := usecase.NewTransferUseCase(nil, nil, txRepo, nil, nil, nil, nil, nil)
+
+	result, err := uc.SearchTransfers(context.Background(), usecase.SearchTransfersInput{
+		Filter: filter,
+		Sort:   sort,
+		Cursor: domain.NewTransferCursor(sort, after).Encode(),
+		Limit:  2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Transfers) != 2 {
+		t.Fatalf("expected 2 transfers, got %d", len(result.Transfers))
+	}
+
+	next, err := domain.DecodeTransferCursor(result.NextCursor, sort)
+	if err != nil || next.ID != "tx-1" || next.Value != "300" {
+		t.Fatalf("expected a cursor after tx-1, got %+v, %v", next, err)
+	}
+}
+
+func TestTransferUseCase_SearchTransfers_LastPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	txRepo := mocks.NewMockTransferRepository(ctrl)
+	txRepo.EXPECT().Search(gomock.Any(), domain.TransferSearchFilter{}, domain.TransferSort{Field: domain.TransferSortCreatedAt}, nil, 20).
+		Return([]*domain.Transfer{{ID: "tx-1"}}, nil)
+
+	uc := usecase.NewTransferUseCase(nil, nil, txRepo, nil, nil, nil, nil, nil)
+
+	result, err := uc.SearchTransfers(context.Background(), usecase.SearchTransfersInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.NextCursor != "" {
+		t.Fatalf("expected no next cursor, got %q", result.NextCursor)
+	}
+}
+
+func TestTransferUseCase_SearchTransfers_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewTransferUseCase(nil, nil, mocks.NewMockTransferRepository(ctrl), nil, nil, nil, nil, nil)
+
+	createdCursor := domain.NewTransferCursor(domain.TransferSort{Field: domain.TransferSortCreatedAt}, &domain.Transfer{ID: "tx-1"}).Encode()
+
+	tests := []struct {
+		name  string
+		input usecase.SearchTransfersInput
+	}{
+		{name: "filter", input: usecase.SearchTransfersInput{Filter: domain.TransferSearchFilter{Direction: domain.TransferDirectionIncoming}}},
+		{name: "sort", input: usecase.SearchTransfersInput{Sort: domain.TransferSort{Field: "id"}}},
+		{name: "cursor", input: usecase.SearchTransfersInput{Cursor: "garbage"}},
+		{name: "cursor from another sort", input: usecase.SearchTransfersInput{Sort: domain.TransferSort{Field: domain.TransferSortEventAt}, Cursor: createdCursor}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.SearchTransfers(context.Background(), tt.input); !errors.Is(err, domain.ErrInvalidTransferSearch) {
+				t.Fatalf("expected ErrInvalidTransferSearch, got %v", err)
+			}
+		})
+	}
+}
+
 func TestTransferUseCase_MetricsSuccess(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -424,6 +539,26 @@ func TestTransferUseCase_AuditLogFailure(t *testing.T) {
 	}
 }
 
+func TestTransferUseCase_CreateBatchTransfer_SkipFailureAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	auditRepo := mocks.NewMockAuditRepository(ctrl) // Create must not be called
+
+	uc := usecase.NewTransferUseCase(nil, nil, nil, nil, nil, auditRepo, nil, nil)
+
+	_, err := uc.CreateBatchTransfer(context.Background(), usecase.CreateBatchTransferInput{
+		Transfers: []usecase.CreateTransferInput{
+			{FromAccountID: "acc-1", ToAccountID: "acc-2", Amount: decimal.NewFromInt(10)},
+			{FromAccountID: "acc-1", ToAccountID: "acc-1", Amount: decimal.NewFromInt(10)},
+		},
+		SkipFailureAudit: true,
+	})
+	if !errors.Is(err, domain.ErrSameAccount) {
+		t.Fatalf("expected ErrSameAccount, got %v", err)
+	}
+}
+
 type fakeRetrier struct {
 	err   error
 	calls int
@@ -462,3 +597,189 @@ func newTestMetrics() *metrics.Metrics {
 		),
 	}
 }
+
+func TestTransferUseCase_CreateTransferWithFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	txRepo := mocks.NewMockTransferRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	feeRepo := mocks.NewMockFeePolicyRepository(ctrl)
+	txMgr := mocks.NewMockTransactionManager(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	mockTx := mocks.NewMockTransaction(ctrl)
+
+	usd := "USD"
+	eur := "EUR"
+	feeRepo.EXPECT().ListActive(gomock.Any()).Return([]*domain.FeePolicy{
+		{
+			ID: "fp-1", Payer: domain.FeePayerSource, RevenueAccountID: "acc-rev", Currency: &usd,
+			Percentage: decimal.RequireFromString("0.01"), FixedAmount: decimal.RequireFromString("0.5"), Precision: 2,
+		},
+		// Filtered out by currency once accounts are loaded.
+		{ID: "fp-2", Payer: domain.FeePayerSource, RevenueAccountID: "acc-rev", Currency: &eur, FixedAmount: decimal.NewFromInt(1)},
+		// Filtered out by metadata before accounts are locked.
+		{ID: "fp-3", Payer: domain.FeePayerSource, RevenueAccountID: "acc-other", MetadataMatch: map[string]string{"channel": "card"}, FixedAmount: decimal.NewFromInt(1)},
+	}, nil)
+
+	txMgr.EXPECT().Begin(gomock.Any()).Return(mockTx, nil)
+	accRepo.EXPECT().GetByIDsForUpdate(gomock.Any(), mockTx, []string{"acc-1", "acc-2", "acc-rev"}).Return([]*domain.Account{
+		{ID: "acc-1", Balance: decimal.NewFromInt(500), Currency: "USD", AllowPositiveBalance: true},
+		{ID: "acc-2", Balance: decimal.Zero, Currency: "USD", AllowPositiveBalance: true},
+		{ID: "acc-rev", Balance: decimal.Zero, Currency: "USD", AllowPositiveBalance: true},
+	}, nil)
+
+	n := 0
+	idGen.EXPECT().Generate().DoAndReturn(func() string {
+		n++
+		return fmt.Sprintf("id-%d", n)
+	}).AnyTimes()
+
+	var transfers []*domain.Transfer
+	txRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).DoAndReturn(func(_ context.Context, _ usecase.Transaction, tr *domain.Transfer) error {
+		transfers = append(transfers, tr)
+		return nil
+	}).Times(2)
+	entryRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil).Times(4)
+	accRepo.EXPECT().UpdateBalance(gomock.Any(), mockTx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(4)
+
+	var events []*domain.OutboxEvent
+	outboxRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).DoAndReturn(func(_ context.Context, _ usecase.Transaction, e *domain.OutboxEvent) error {
+		events = append(events, e)
+		return nil
+	}).Times(2)
+	mockTx.EXPECT().Commit(gomock.Any()).Return(nil)
+	mockTx.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	uc := usecase.NewTransferUseCase(txMgr, accRepo, txRepo, entryRepo, outboxRepo, nil, idGen, nil).
+		WithFeePolicies(feeRepo)
+
+	transfer, err := uc.CreateTransfer(context.Background(), usecase.CreateTransferInput{
+		FromAccountID: "acc-1",
+		ToAccountID:   "acc-2",
+		Amount:        decimal.NewFromInt(100),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(transfer.Fees) != 1 {
+		t.Fatalf("expected 1 fee, got %+v", transfer.Fees)
+	}
+
+	fee := transfer.Fees[0]
+	if fee.PolicyID != "fp-1" || fee.FromAccountID != "acc-1" || fee.ToAccountID != "acc-rev" || !fee.Amount.Equal(decimal.RequireFromString("1.5")) {
+		t.Fatalf("unexpected fee: %+v", fee)
+	}
+
+	leg := transfers[1]
+	if leg.ID != fee.TransferID || leg.Metadata["fee_for_transfer_id"] != transfer.ID {
+		t.Fatalf("unexpected fee leg: %+v", leg)
+	}
+
+	created := events[len(events)-1]
+	if created.AggregateID != transfer.ID {
+		t.Fatalf("expected last event to be the transfer's, got %+v", created)
+	}
+
+	// Payloads are JSON-shaped, as they are when read back from the outbox.
+	fees, ok := created.Payload["fees"].([]any)
+	if !ok || len(fees) != 1 || fees[0].(map[string]any)["amount"] != "1.5" {
+		t.Fatalf("unexpected fees in payload: %+v", created.Payload["fees"])
+	}
+}
+
+func TestTransferUseCase_FeePolicyWithoutCurrencySkipsOtherCurrencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	txRepo := mocks.NewMockTransferRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	feeRepo := mocks.NewMockFeePolicyRepository(ctrl)
+	txMgr := mocks.NewMockTransactionManager(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	mockTx := mocks.NewMockTransaction(ctrl)
+
+	// A policy with no currency filter whose revenue account is in USD.
+	feeRepo.EXPECT().ListActive(gomock.Any()).Return([]*domain.FeePolicy{
+		{ID: "fp-1", Payer: domain.FeePayerSource, RevenueAccountID: "acc-rev", FixedAmount: decimal.NewFromInt(1), Precision: 2},
+	}, nil)
+
+	txMgr.EXPECT().Begin(gomock.Any()).Return(mockTx, nil)
+	accRepo.EXPECT().GetByIDsForUpdate(gomock.Any(), mockTx, []string{"acc-1", "acc-2", "acc-rev"}).Return([]*domain.Account{
+		{ID: "acc-1", Balance: decimal.NewFromInt(500), Currency: "EUR", AllowPositiveBalance: true},
+		{ID: "acc-2", Balance: decimal.Zero, Currency: "EUR", AllowPositiveBalance: true},
+		{ID: "acc-rev", Balance: decimal.Zero, Currency: "USD", AllowPositiveBalance: true},
+	}, nil)
+	idGen.EXPECT().Generate().Return("generated-id").AnyTimes()
+	txRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil)
+	entryRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil).Times(2)
+	accRepo.EXPECT().UpdateBalance(gomock.Any(), mockTx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	outboxRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil)
+	mockTx.EXPECT().Commit(gomock.Any()).Return(nil)
+	mockTx.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	uc := usecase.NewTransferUseCase(txMgr, accRepo, txRepo, entryRepo, outboxRepo, nil, idGen, nil).
+		WithFeePolicies(feeRepo)
+
+	transfer, err := uc.CreateTransfer(context.Background(), usecase.CreateTransferInput{
+		FromAccountID: "acc-1",
+		ToAccountID:   "acc-2",
+		Amount:        decimal.NewFromInt(100),
+	})
+	if err != nil {
+		t.Fatalf("expected the EUR transfer to succeed without the USD fee, got %v", err)
+	}
+
+	if len(transfer.Fees) != 0 {
+		t.Fatalf("expected no fees, got %+v", transfer.Fees)
+	}
+}
+
+func TestTransferUseCase_SkipFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accRepo := mocks.NewMockAccountRepository(ctrl)
+	txRepo := mocks.NewMockTransferRepository(ctrl)
+	entryRepo := mocks.NewMockEntryRepository(ctrl)
+	outboxRepo := mocks.NewMockOutboxRepository(ctrl)
+	feeRepo := mocks.NewMockFeePolicyRepository(ctrl) // ListActive must not be called
+	txMgr := mocks.NewMockTransactionManager(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+	mockTx := mocks.NewMockTransaction(ctrl)
+
+	txMgr.EXPECT().Begin(gomock.Any()).Return(mockTx, nil)
+	accRepo.EXPECT().GetByIDsForUpdate(gomock.Any(), mockTx, gomock.Any()).Return([]*domain.Account{
+		{ID: "acc-1", Balance: decimal.NewFromInt(500), Currency: "USD", AllowPositiveBalance: true},
+		{ID: "acc-2", Balance: decimal.Zero, Currency: "USD", AllowPositiveBalance: true},
+	}, nil)
+	idGen.EXPECT().Generate().Return("generated-id").Times(4)
+	txRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil)
+	entryRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil).Times(2)
+	accRepo.EXPECT().UpdateBalance(gomock.Any(), mockTx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	outboxRepo.EXPECT().Create(gomock.Any(), mockTx, gomock.Any()).Return(nil)
+	mockTx.EXPECT().Commit(gomock.Any()).Return(nil)
+	mockTx.EXPECT().Rollback(gomock.Any()).Return(nil).AnyTimes()
+
+	uc := usecase.NewTransferUseCase(txMgr, accRepo, txRepo, entryRepo, outboxRepo, nil, idGen, nil).
+		WithFeePolicies(feeRepo)
+
+	transfer, err := uc.CreateTransfer(context.Background(), usecase.CreateTransferInput{
+		FromAccountID: "acc-1",
+		ToAccountID:   "acc-2",
+		Amount:        decimal.NewFromInt(100),
+		SkipFees:      true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(transfer.Fees) != 0 {
+		t.Fatalf("expected no fees, got %+v", transfer.Fees)
+	}
+}
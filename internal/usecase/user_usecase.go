@@ -12,23 +12,35 @@ import (
 
 // UserRepository defines the interface for user persistence
 type UserRepository interface {
-	Create(ctx context.Context, user *domain.User) error
+	CreateTx(ctx context.Context, tx Transaction, user *domain.User) error
 	GetByID(ctx context.Context, id string) (*domain.User, error)
+	GetByIDForUpdate(ctx context.Context, tx Transaction, id string) (*domain.User, error)
 	GetByEmail(ctx context.Context, email string) (*domain.User, error)
-	Update(ctx context.Context, user *domain.User) error
-	Delete(ctx context.Context, id string) error
+	UpdateTx(ctx context.Context, tx Transaction, user *domain.User) error
+	DeleteTx(ctx context.Context, tx Transaction, id string) error
+	// CountActiveAdminsForUpdate counts active admins and locks them until
+	// the transaction ends.
+	CountActiveAdminsForUpdate(ctx context.Context, tx Transaction) (int, error)
 	List(ctx context.Context, limit, offset int) ([]*domain.User, error)
 }
 
-// UserUseCase handles user management operations
+// UserUseCase handles user management operations. Every change is
+// recorded to the audit trail in the same transaction.
 type UserUseCase struct {
-	userRepo UserRepository
+	txManager TransactionManager
+	userRepo  UserRepository
+	auditRepo AuditRepository
+	idGen     IDGenerator
 }
 
-// NewUserUseCase creates a new user use case
-func NewUserUseCase(userRepo UserRepository) *UserUseCase {
+// NewUserUseCase creates a new user use case. auditRepo may be nil to
+// skip audit logging.
+func NewUserUseCase(txManager TransactionManager, userRepo UserRepository, auditRepo AuditRepository, idGen IDGenerator) *UserUseCase {
 	return &UserUseCase{
-		userRepo: userRepo,
+		txManager: txManager,
+		userRepo:  userRepo,
+		auditRepo: auditRepo,
+		idGen:     idGen,
 	}
 }
 
@@ -49,18 +61,21 @@ func (uc *UserUseCase) CreateUser(ctx context.Context, input CreateUserInput) (*
 
 	// Validate password
 	if err := domain.ValidatePassword(input.Password); err != nil {
-		return nil, err
+		return nil, domain.NewFieldError("password", err)
 	}
 
 	// Validate role
 	if !input.Role.IsValid() {
-		return nil, errors.New("invalid role")
+		return nil, domain.NewFieldError("role", domain.ErrInvalidRole)
 	}
 
 	// Check if user already exists
 	existing, err := uc.userRepo.GetByEmail(ctx, input.Email)
 	if err == nil && existing != nil {
-		return nil, errors.New("user with this email already exists")
+		return nil, domain.ErrUserAlreadyExists
+	}
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
 	}
 
 	// Hash password
@@ -69,18 +84,26 @@ func (uc *UserUseCase) CreateUser(ctx context.Context, input CreateUserInput) (*
 		return nil, err
 	}
 
+	now := time.Now().UTC()
 	user := &domain.User{
-		ID:             generateID(),
+		ID:             uc.idGen.Generate(),
 		Email:          input.Email,
 		Name:           input.Name,
 		HashedPassword: hashedPassword,
 		Role:           input.Role,
 		Active:         true,
-		CreatedAt:      time.Now().UTC(),
-		UpdatedAt:      time.Now().UTC(),
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}
 
-	if err := uc.userRepo.Create(ctx, user); err != nil {
+	err = uc.inTx(ctx, func(txCtx context.Context, tx Transaction) (*domain.AuditLog, error) {
+		if err := uc.userRepo.CreateTx(txCtx, tx, user); err != nil {
+			return nil, err
+		}
+
+		return uc.auditLog(ctx, domain.AuditActionUserCreate, user.ID, nil, user.AuditState()), nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -103,7 +126,7 @@ func (uc *UserUseCase) Authenticate(ctx context.Context, input AuthenticateInput
 	}
 
 	if !user.Active {
-		return nil, errors.New("user account is inactive")
+		return nil, domain.ErrUserInactive
 	}
 
 	// Verify password
@@ -136,42 +159,68 @@ type UpdateUserInput struct {
 	Password *string
 }
 
-// UpdateUser updates user information
+// UpdateUser updates user information. Setting Password resets the user's
+// password without requiring the current one. The last active admin can't
+// be demoted or deactivated.
 func (uc *UserUseCase) UpdateUser(ctx context.Context, input UpdateUserInput) (*domain.User, error) {
-	user, err := uc.userRepo.GetByID(ctx, input.ID)
-	if err != nil {
-		return nil, err
+	if input.Role != nil && !input.Role.IsValid() {
+		return nil, domain.NewFieldError("role", domain.ErrInvalidRole)
 	}
 
-	if input.Name != nil {
-		user.Name = *input.Name
-	}
-
-	if input.Role != nil {
-		if !input.Role.IsValid() {
-			return nil, errors.New("invalid role")
+	var hashedPassword string
+	if input.Password != nil {
+		if err := domain.ValidatePassword(*input.Password); err != nil {
+			return nil, domain.NewFieldError("password", err)
 		}
-		user.Role = *input.Role
-	}
 
-	if input.Active != nil {
-		user.Active = *input.Active
+		var err error
+		if hashedPassword, err = hashPassword(*input.Password); err != nil {
+			return nil, err
+		}
 	}
 
-	if input.Password != nil {
-		if err := domain.ValidatePassword(*input.Password); err != nil {
+	var user *domain.User
+	err := uc.inTx(ctx, func(txCtx context.Context, tx Transaction) (*domain.AuditLog, error) {
+		var err error
+		if user, err = uc.userRepo.GetByIDForUpdate(txCtx, tx, input.ID); err != nil {
 			return nil, err
 		}
-		hashedPassword, err := hashPassword(*input.Password)
-		if err != nil {
+
+		before := user.AuditState()
+		wasActiveAdmin := user.IsActiveAdmin()
+
+		if input.Name != nil {
+			user.Name = *input.Name
+		}
+		if input.Role != nil {
+			user.Role = *input.Role
+		}
+		if input.Active != nil {
+			user.Active = *input.Active
+		}
+		if input.Password != nil {
+			user.HashedPassword = hashedPassword
+		}
+		user.UpdatedAt = time.Now().UTC()
+
+		if wasActiveAdmin && !user.IsActiveAdmin() {
+			if err := uc.ensureAnotherAdmin(txCtx, tx); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := uc.userRepo.UpdateTx(txCtx, tx, user); err != nil {
 			return nil, err
 		}
-		user.HashedPassword = hashedPassword
-	}
 
-	user.UpdatedAt = time.Now().UTC()
+		after := user.AuditState()
+		if input.Password != nil {
+			after["password_changed"] = true
+		}
 
-	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return uc.auditLog(ctx, domain.AuditActionUserUpdate, user.ID, before, after), nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -179,9 +228,75 @@ func (uc *UserUseCase) UpdateUser(ctx context.Context, input UpdateUserInput) (*
 	return user, nil
 }
 
-// DeleteUser deletes a user
+// ChangePasswordInput represents a user changing their own password
+type ChangePasswordInput struct {
+	UserID          string
+	CurrentPassword string
+	NewPassword     string
+}
+
+// ChangePassword changes a user's own password after verifying the
+// current one.
+func (uc *UserUseCase) ChangePassword(ctx context.Context, input ChangePasswordInput) error {
+	if err := domain.ValidatePassword(input.NewPassword); err != nil {
+		return domain.NewFieldError("new_password", err)
+	}
+
+	hashedPassword, err := hashPassword(input.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	return uc.inTx(ctx, func(txCtx context.Context, tx Transaction) (*domain.AuditLog, error) {
+		user, err := uc.userRepo.GetByIDForUpdate(txCtx, tx, input.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !user.Active {
+			return nil, domain.ErrUserInactive
+		}
+
+		if err := verifyPassword(user.HashedPassword, input.CurrentPassword); err != nil {
+			return nil, domain.NewFieldError("current_password", domain.ErrIncorrectPassword)
+		}
+
+		before := user.AuditState()
+
+		user.HashedPassword = hashedPassword
+		user.UpdatedAt = time.Now().UTC()
+
+		if err := uc.userRepo.UpdateTx(txCtx, tx, user); err != nil {
+			return nil, err
+		}
+
+		after := user.AuditState()
+		after["password_changed"] = true
+
+		return uc.auditLog(ctx, domain.AuditActionUserPasswordChange, user.ID, before, after), nil
+	})
+}
+
+// DeleteUser deletes a user. The last active admin can't be deleted.
 func (uc *UserUseCase) DeleteUser(ctx context.Context, id string) error {
-	return uc.userRepo.Delete(ctx, id)
+	return uc.inTx(ctx, func(txCtx context.Context, tx Transaction) (*domain.AuditLog, error) {
+		user, err := uc.userRepo.GetByIDForUpdate(txCtx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if user.IsActiveAdmin() {
+			if err := uc.ensureAnotherAdmin(txCtx, tx); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := uc.userRepo.DeleteTx(txCtx, tx, id); err != nil {
+			return nil, err
+		}
+
+		return uc.auditLog(ctx, domain.AuditActionUserDelete, id, user.AuditState(), nil), nil
+	})
 }
 
 // ListUsers lists all users with pagination
@@ -201,6 +316,66 @@ func (uc *UserUseCase) ListUsers(ctx context.Context, limit, offset int) ([]*dom
 	return users, nil
 }
 
+// ensureAnotherAdmin returns ErrLastAdmin unless an active admin other than
+// the one being removed remains.
+func (uc *UserUseCase) ensureAnotherAdmin(ctx context.Context, tx Transaction) error {
+	admins, err := uc.userRepo.CountActiveAdminsForUpdate(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	if admins <= 1 {
+		return domain.ErrLastAdmin
+	}
+
+	return nil
+}
+
+// inTx runs op in a transaction and writes the audit log it returns in the
+// same transaction.
+func (uc *UserUseCase) inTx(ctx context.Context, op func(txCtx context.Context, tx Transaction) (*domain.AuditLog, error)) error {
+	txCtx, cancel := context.WithTimeout(ctx, DefaultTransactionTimeout)
+	defer cancel()
+
+	tx, err := uc.txManager.Begin(txCtx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(txCtx) }()
+
+	auditLog, err := op(txCtx, tx)
+	if err != nil {
+		return err
+	}
+
+	if uc.auditRepo != nil {
+		if err := uc.auditRepo.CreateTx(txCtx, tx, auditLog); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(txCtx)
+}
+
+func (uc *UserUseCase) auditLog(ctx context.Context, action domain.AuditAction, userID string, before, after domain.JSON) *domain.AuditLog {
+	actorID, requestID, ipAddress, userAgent := auditActor(ctx)
+
+	return &domain.AuditLog{
+		ID:           uc.idGen.Generate(),
+		UserID:       actorID,
+		Action:       string(action),
+		ResourceType: "user",
+		ResourceID:   userID,
+		RequestID:    requestID,
+		IPAddress:    ipAddress,
+		UserAgent:    userAgent,
+		BeforeState:  before,
+		AfterState:   after,
+		Status:       string(domain.AuditStatusSuccess),
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
 // hashPassword hashes a password using bcrypt
 func hashPassword(password string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -214,17 +389,3 @@ func hashPassword(password string) (string, error) {
 func verifyPassword(hashedPassword, password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 }
-
-// generateID generates a unique ID for a user
-func generateID() string {
-	return "user-" + time.Now().Format("20060102150405") + "-" + randomString(8)
-}
-
-func randomString(n int) string {
-	const letters = "abcdefghijklmnopqrstuvwxyz0123456789"
-	b := make([]byte, n)
-	for i := range b {
-		b[i] = letters[time.Now().UnixNano()%int64(len(letters))]
-	}
-	return string(b)
-}
@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 
 	"golang.org/x/crypto/bcrypt"
@@ -12,15 +13,16 @@ import (
 )
 
 type stubUserRepo struct {
-	createFn     func(ctx context.Context, user *domain.User) error
-	getByIDFn    func(ctx context.Context, id string) (*domain.User, error)
-	getByEmailFn func(ctx context.Context, email string) (*domain.User, error)
-	updateFn     func(ctx context.Context, user *domain.User) error
-	deleteFn     func(ctx context.Context, id string) error
-	listFn       func(ctx context.Context, limit, offset int) ([]*domain.User, error)
+	createFn      func(ctx context.Context, user *domain.User) error
+	getByIDFn     func(ctx context.Context, id string) (*domain.User, error)
+	getByEmailFn  func(ctx context.Context, email string) (*domain.User, error)
+	updateFn      func(ctx context.Context, user *domain.User) error
+	deleteFn      func(ctx context.Context, id string) error
+	countAdminsFn func(ctx context.Context) (int, error)
+	listFn        func(ctx context.Context, limit, offset int) ([]*domain.User, error)
 }
 
-func (s *stubUserRepo) Create(ctx context.Context, user *domain.User) error {
+func (s *stubUserRepo) CreateTx(ctx context.Context, _ usecase.Transaction, user *domain.User) error {
 	if s.createFn != nil {
 		return s.createFn(ctx, user)
 	}
@@ -34,6 +36,10 @@ func (s *stubUserRepo) GetByID(ctx context.Context, id string) (*domain.User, er
 	return nil, nil
 }
 
+func (s *stubUserRepo) GetByIDForUpdate(ctx context.Context, _ usecase.Transaction, id string) (*domain.User, error) {
+	return s.GetByID(ctx, id)
+}
+
 func (s *stubUserRepo) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	if s.getByEmailFn != nil {
 		return s.getByEmailFn(ctx, email)
@@ -41,20 +47,27 @@ func (s *stubUserRepo) GetByEmail(ctx context.Context, email string) (*domain.Us
 	return nil, nil
 }
 
-func (s *stubUserRepo) Update(ctx context.Context, user *domain.User) error {
+func (s *stubUserRepo) UpdateTx(ctx context.Context, _ usecase.Transaction, user *domain.User) error {
 	if s.updateFn != nil {
 		return s.updateFn(ctx, user)
 	}
 	return nil
 }
 
-func (s *stubUserRepo) Delete(ctx context.Context, id string) error {
+func (s *stubUserRepo) DeleteTx(ctx context.Context, _ usecase.Transaction, id string) error {
 	if s.deleteFn != nil {
 		return s.deleteFn(ctx, id)
 	}
 	return nil
 }
 
+func (s *stubUserRepo) CountActiveAdminsForUpdate(ctx context.Context, _ usecase.Transaction) (int, error) {
+	if s.countAdminsFn != nil {
+		return s.countAdminsFn(ctx)
+	}
+	return 0, nil
+}
+
 func (s *stubUserRepo) List(ctx context.Context, limit, offset int) ([]*domain.User, error) {
 	if s.listFn != nil {
 		return s.listFn(ctx, limit, offset)
@@ -62,6 +75,61 @@ func (s *stubUserRepo) List(ctx context.Context, limit, offset int) ([]*domain.U
 	return nil, nil
 }
 
+type stubUserTx struct {
+	committed bool
+}
+
+func (t *stubUserTx) Commit(context.Context) error {
+	t.committed = true
+	return nil
+}
+
+func (t *stubUserTx) Rollback(context.Context) error { return nil }
+
+type stubUserTxManager struct {
+	tx *stubUserTx
+}
+
+func (m *stubUserTxManager) Begin(context.Context) (usecase.Transaction, error) {
+	m.tx = &stubUserTx{}
+	return m.tx, nil
+}
+
+type recordingAuditRepo struct {
+	logs []*domain.AuditLog
+}
+
+func (r *recordingAuditRepo) Create(_ context.Context, log *domain.AuditLog) error {
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+func (r *recordingAuditRepo) CreateTx(_ context.Context, _ usecase.Transaction, log *domain.AuditLog) error {
+	r.logs = append(r.logs, log)
+	return nil
+}
+
+func (r *recordingAuditRepo) List(context.Context, domain.AuditFilter) ([]*domain.AuditLog, error) {
+	return r.logs, nil
+}
+
+func (r *recordingAuditRepo) GetByResourceID(context.Context, string, string) ([]*domain.AuditLog, error) {
+	return r.logs, nil
+}
+
+type sequentialIDGenerator struct {
+	n int
+}
+
+func (g *sequentialIDGenerator) Generate() string {
+	g.n++
+	return fmt.Sprintf("id-%d", g.n)
+}
+
+func newUserUseCase(repo usecase.UserRepository) *usecase.UserUseCase {
+	return usecase.NewUserUseCase(&stubUserTxManager{}, repo, nil, &sequentialIDGenerator{})
+}
+
 func TestUserUseCase_CreateUser_Success(t *testing.T) {
 	t.Parallel()
 
@@ -86,7 +154,7 @@ func TestUserUseCase_CreateUser_Success(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 
 	user, err := uc.CreateUser(context.Background(), usecase.CreateUserInput{
 		Email:    "user@example.com",
@@ -110,7 +178,7 @@ func TestUserUseCase_CreateUser_Success(t *testing.T) {
 func TestUserUseCase_CreateUser_ValidationErrors(t *testing.T) {
 	t.Parallel()
 
-	uc := usecase.NewUserUseCase(&stubUserRepo{})
+	uc := newUserUseCase(&stubUserRepo{})
 
 	_, err := uc.CreateUser(context.Background(), usecase.CreateUserInput{
 		Email:    "invalid-email",
@@ -138,8 +206,8 @@ func TestUserUseCase_CreateUser_ValidationErrors(t *testing.T) {
 		Password: "StrongPass1",
 		Role:     "invalid",
 	})
-	if err == nil || err.Error() != "invalid role" {
-		t.Fatalf("expected invalid role error, got %v", err)
+	if !errors.Is(err, domain.ErrInvalidRole) {
+		t.Fatalf("expected ErrInvalidRole, got %v", err)
 	}
 }
 
@@ -152,7 +220,7 @@ func TestUserUseCase_CreateUser_DuplicateEmail(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 
 	_, err := uc.CreateUser(context.Background(), usecase.CreateUserInput{
 		Email:    "user@example.com",
@@ -160,7 +228,7 @@ func TestUserUseCase_CreateUser_DuplicateEmail(t *testing.T) {
 		Password: "StrongPass1",
 		Role:     domain.RoleAdmin,
 	})
-	if err == nil || err.Error() != "user with this email already exists" {
+	if !errors.Is(err, domain.ErrUserAlreadyExists) {
 		t.Fatalf("expected duplicate email error, got %v", err)
 	}
 }
@@ -187,7 +255,7 @@ func TestUserUseCase_Authenticate(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 
 	user, err := uc.Authenticate(context.Background(), usecase.AuthenticateInput{
 		Email:    "user@example.com",
@@ -226,13 +294,13 @@ func TestUserUseCase_AuthenticateErrors(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 
 	_, err = uc.Authenticate(context.Background(), usecase.AuthenticateInput{
 		Email:    "user@example.com",
 		Password: "StrongPass1",
 	})
-	if err == nil || err.Error() != "user account is inactive" {
+	if !errors.Is(err, domain.ErrUserInactive) {
 		t.Fatalf("expected inactive error, got %v", err)
 	}
 
@@ -272,7 +340,7 @@ func TestUserUseCase_GetUser(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 	user, err := uc.GetUser(context.Background(), "user-1")
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -310,7 +378,7 @@ func TestUserUseCase_UpdateUser(t *testing.T) {
 	active := false
 	newPassword := "NewStrong1"
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 	user, err := uc.UpdateUser(context.Background(), usecase.UpdateUserInput{
 		ID:       "user-1",
 		Name:     &newName,
@@ -349,13 +417,13 @@ func TestUserUseCase_UpdateUser_InvalidRole(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 	_, err := uc.UpdateUser(context.Background(), usecase.UpdateUserInput{
 		ID:   "user-1",
 		Role: &invalidRole,
 	})
-	if err == nil || err.Error() != "invalid role" {
-		t.Fatalf("expected invalid role error, got %v", err)
+	if !errors.Is(err, domain.ErrInvalidRole) {
+		t.Fatalf("expected ErrInvalidRole, got %v", err)
 	}
 }
 
@@ -368,7 +436,7 @@ func TestUserUseCase_UpdateUser_InvalidPassword(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 	weak := "weak"
 	_, err := uc.UpdateUser(context.Background(), usecase.UpdateUserInput{
 		ID:       "user-1",
@@ -384,13 +452,16 @@ func TestUserUseCase_DeleteUser(t *testing.T) {
 
 	var deletedID string
 	repo := &stubUserRepo{
+		getByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Role: domain.RoleViewer, Active: true}, nil
+		},
 		deleteFn: func(_ context.Context, id string) error {
 			deletedID = id
 			return nil
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 	if err := uc.DeleteUser(context.Background(), "user-123"); err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -416,7 +487,7 @@ func TestUserUseCase_ListUsers(t *testing.T) {
 		},
 	}
 
-	uc := usecase.NewUserUseCase(repo)
+	uc := newUserUseCase(repo)
 	users, err := uc.ListUsers(context.Background(), 0, -5)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -435,3 +506,129 @@ func TestUserUseCase_ListUsers(t *testing.T) {
 		t.Fatal("expected hashed password to be hidden")
 	}
 }
+
+func TestUserUseCase_UpdateUser_AuditsRedactedState(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubUserRepo{
+		getByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Name: "Alice", Role: domain.RoleViewer, Active: true, HashedPassword: "old-hash"}, nil
+		},
+	}
+	txManager := &stubUserTxManager{}
+	auditRepo := &recordingAuditRepo{}
+	uc := usecase.NewUserUseCase(txManager, repo, auditRepo, &sequentialIDGenerator{})
+
+	ctx := context.WithValue(context.Background(), domain.UserContextKey, &domain.User{ID: "admin-1", Role: domain.RoleAdmin})
+	role := domain.RoleOperator
+	password := "NewStrong1"
+	if _, err := uc.UpdateUser(ctx, usecase.UpdateUserInput{ID: "user-1", Role: &role, Password: &password}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !txManager.tx.committed {
+		t.Fatal("expected the update to be committed")
+	}
+
+	if len(auditRepo.logs) != 1 {
+		t.Fatalf("expected one audit log, got %d", len(auditRepo.logs))
+	}
+
+	log := auditRepo.logs[0]
+	if log.Action != string(domain.AuditActionUserUpdate) || log.UserID != "admin-1" || log.ResourceID != "user-1" {
+		t.Fatalf("unexpected audit log: %+v", log)
+	}
+
+	if log.BeforeState["role"] != "viewer" || log.AfterState["role"] != "operator" || log.AfterState["password_changed"] != true {
+		t.Fatalf("unexpected audit state: before=%v after=%v", log.BeforeState, log.AfterState)
+	}
+
+	for _, state := range []domain.JSON{log.BeforeState, log.AfterState} {
+		if state["hashed_password"] != "[REDACTED]" {
+			t.Fatalf("expected the password hash to be redacted, got %v", state["hashed_password"])
+		}
+	}
+}
+
+func TestUserUseCase_LastAdminCannotBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubUserRepo{
+		getByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Role: domain.RoleAdmin, Active: true}, nil
+		},
+		countAdminsFn: func(context.Context) (int, error) { return 1, nil },
+		updateFn: func(context.Context, *domain.User) error {
+			t.Fatal("the last admin must not be updated")
+			return nil
+		},
+		deleteFn: func(context.Context, string) error {
+			t.Fatal("the last admin must not be deleted")
+			return nil
+		},
+	}
+	uc := newUserUseCase(repo)
+
+	viewer := domain.RoleViewer
+	if _, err := uc.UpdateUser(context.Background(), usecase.UpdateUserInput{ID: "admin-1", Role: &viewer}); !errors.Is(err, domain.ErrLastAdmin) {
+		t.Fatalf("expected ErrLastAdmin when demoting, got %v", err)
+	}
+
+	inactive := false
+	if _, err := uc.UpdateUser(context.Background(), usecase.UpdateUserInput{ID: "admin-1", Active: &inactive}); !errors.Is(err, domain.ErrLastAdmin) {
+		t.Fatalf("expected ErrLastAdmin when deactivating, got %v", err)
+	}
+
+	if err := uc.DeleteUser(context.Background(), "admin-1"); !errors.Is(err, domain.ErrLastAdmin) {
+		t.Fatalf("expected ErrLastAdmin when deleting, got %v", err)
+	}
+
+	repo.countAdminsFn = func(context.Context) (int, error) { return 2, nil }
+	repo.deleteFn = nil
+	if err := uc.DeleteUser(context.Background(), "admin-1"); err != nil {
+		t.Fatalf("expected an admin to be deletable while another remains, got %v", err)
+	}
+}
+
+func TestUserUseCase_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("OldStrong1"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	var updated *domain.User
+	repo := &stubUserRepo{
+		getByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Role: domain.RoleViewer, Active: true, HashedPassword: string(hashed)}, nil
+		},
+		updateFn: func(_ context.Context, user *domain.User) error {
+			updated = user
+			return nil
+		},
+	}
+	auditRepo := &recordingAuditRepo{}
+	uc := usecase.NewUserUseCase(&stubUserTxManager{}, repo, auditRepo, &sequentialIDGenerator{})
+
+	err = uc.ChangePassword(context.Background(), usecase.ChangePasswordInput{UserID: "user-1", CurrentPassword: "WrongPass1", NewPassword: "NewStrong1"})
+	if !errors.Is(err, domain.ErrIncorrectPassword) {
+		t.Fatalf("expected ErrIncorrectPassword, got %v", err)
+	}
+	if updated != nil || len(auditRepo.logs) != 0 {
+		t.Fatal("expected nothing to change on a wrong current password")
+	}
+
+	err = uc.ChangePassword(context.Background(), usecase.ChangePasswordInput{UserID: "user-1", CurrentPassword: "OldStrong1", NewPassword: "NewStrong1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updated == nil || bcrypt.CompareHashAndPassword([]byte(updated.HashedPassword), []byte("NewStrong1")) != nil {
+		t.Fatal("expected the new password to be stored")
+	}
+
+	if len(auditRepo.logs) != 1 || auditRepo.logs[0].Action != string(domain.AuditActionUserPasswordChange) {
+		t.Fatalf("expected a password change audit log, got %+v", auditRepo.logs)
+	}
+}
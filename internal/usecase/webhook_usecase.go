@@ -0,0 +1,462 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iho/goledger/internal/domain"
+)
+
+// ErrWebhookSenderNotConfigured is returned by DeliverDue when the use case
+// was built without WithSender.
+var ErrWebhookSenderNotConfigured = errors.New("webhook sender not configured")
+
+// WebhookSender performs one signed delivery attempt. A nil error with a
+// non-2xx status code is a failed attempt.
+type WebhookSender interface {
+	Send(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) (statusCode int, err error)
+}
+
+// WebhookRetryPolicy controls how failed deliveries are retried.
+type WebhookRetryPolicy struct {
+	// MaxAttempts is how many failed attempts a delivery tolerates before
+	// it is dead-lettered.
+	MaxAttempts int
+	// BackoffBase is the delay after the first failure; each further
+	// failure doubles it, up to BackoffMax.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// Lease is how long a claimed delivery is hidden from other
+	// dispatchers; it must exceed the sender's request timeout.
+	Lease time.Duration
+}
+
+// DefaultWebhookRetryPolicy retries for roughly a day before giving up.
+var DefaultWebhookRetryPolicy = WebhookRetryPolicy{
+	MaxAttempts: 10,
+	BackoffBase: 30 * time.Second,
+	BackoffMax:  6 * time.Hour,
+	Lease:       time.Minute,
+}
+
+// Backoff returns the delay before the next attempt after the given number
+// of failed attempts.
+func (p WebhookRetryPolicy) Backoff(attempts int) time.Duration {
+	delay := p.BackoffBase
+	for i := 1; i < attempts && delay < p.BackoffMax; i++ {
+		delay *= 2
+	}
+
+	return min(delay, p.BackoffMax)
+}
+
+// WebhookUseCase manages webhook subscriptions, fans outbox events out to
+// them and delivers the resulting webhook deliveries.
+type WebhookUseCase struct {
+	webhookRepo WebhookRepository
+	idGen       IDGenerator
+	sender      WebhookSender
+	policy      WebhookRetryPolicy
+	cloudEvents domain.CloudEventOptions
+	now         func() time.Time
+}
+
+// NewWebhookUseCase creates a new WebhookUseCase. Delivery additionally
+// needs WithSender.
+func NewWebhookUseCase(webhookRepo WebhookRepository, idGen IDGenerator) *WebhookUseCase {
+	return &WebhookUseCase{
+		webhookRepo: webhookRepo,
+		idGen:       idGen,
+		policy:      DefaultWebhookRetryPolicy,
+		now:         func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// WithSender sets the sender and retry policy DeliverDue uses. Zero fields
+// of policy fall back to DefaultWebhookRetryPolicy.
+func (uc *WebhookUseCase) WithSender(sender WebhookSender, policy WebhookRetryPolicy) *WebhookUseCase {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultWebhookRetryPolicy.MaxAttempts
+	}
+	if policy.BackoffBase <= 0 {
+		policy.BackoffBase = DefaultWebhookRetryPolicy.BackoffBase
+	}
+	if policy.BackoffMax <= 0 {
+		policy.BackoffMax = DefaultWebhookRetryPolicy.BackoffMax
+	}
+	if policy.Lease <= 0 {
+		policy.Lease = DefaultWebhookRetryPolicy.Lease
+	}
+
+	uc.sender = sender
+	uc.policy = policy
+
+	return uc
+}
+
+// WithCloudEvents sets the source and dataschema attributes of delivered
+// events.
+func (uc *WebhookUseCase) WithCloudEvents(opts domain.CloudEventOptions) *WebhookUseCase {
+	uc.cloudEvents = opts
+	return uc
+}
+
+// WithClock overrides the clock (tests).
+func (uc *WebhookUseCase) WithClock(now func() time.Time) *WebhookUseCase {
+	uc.now = now
+	return uc
+}
+
+// CreateWebhookSubscriptionInput represents input for creating a webhook
+// subscription. Empty match fields match any event.
+type CreateWebhookSubscriptionInput struct {
+	URL           string
+	EventTypes    []string
+	AggregateType string
+	AggregateID   string
+	Description   string
+	// Secret signs deliveries; a random one is generated when empty.
+	Secret string
+}
+
+// CreateSubscription validates and stores a new, active subscription. The
+// returned subscription carries the signing secret.
+func (uc *WebhookUseCase) CreateSubscription(ctx context.Context, input CreateWebhookSubscriptionInput) (*domain.WebhookSubscription, error) {
+	now := uc.now()
+
+	sub := &domain.WebhookSubscription{
+		ID:          uc.idGen.Generate(),
+		URL:         input.URL,
+		Secret:      input.Secret,
+		EventTypes:  input.EventTypes,
+		Description: input.Description,
+		Active:      true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if sub.EventTypes == nil {
+		sub.EventTypes = []string{}
+	}
+	if input.AggregateType != "" {
+		sub.AggregateType = &input.AggregateType
+	}
+	if input.AggregateID != "" {
+		sub.AggregateID = &input.AggregateID
+	}
+
+	if sub.Secret == "" {
+		secret, err := generateWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		sub.Secret = secret
+	}
+
+	if err := sub.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// GetSubscription retrieves a subscription by ID.
+func (uc *WebhookUseCase) GetSubscription(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	return uc.webhookRepo.GetSubscription(ctx, id)
+}
+
+// ListSubscriptions lists subscriptions.
+func (uc *WebhookUseCase) ListSubscriptions(ctx context.Context, limit, offset int) ([]*domain.WebhookSubscription, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.webhookRepo.ListSubscriptions(ctx, limit, offset)
+}
+
+// UpdateWebhookSubscriptionInput holds the fields to change; nil fields are
+// left as they are. An empty AggregateType or AggregateID clears it.
+type UpdateWebhookSubscriptionInput struct {
+	URL           *string
+	EventTypes    *[]string
+	AggregateType *string
+	AggregateID   *string
+	Description   *string
+	Active        *bool
+}
+
+// UpdateSubscription changes a subscription. Match criteria apply to events
+// fanned out afterwards; deliveries already queued are unaffected. While a
+// subscription is inactive its pending deliveries are held back.
+func (uc *WebhookUseCase) UpdateSubscription(ctx context.Context, id string, input UpdateWebhookSubscriptionInput) (*domain.WebhookSubscription, error) {
+	sub, err := uc.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.URL != nil {
+		sub.URL = *input.URL
+	}
+	if input.EventTypes != nil {
+		sub.EventTypes = *input.EventTypes
+		if sub.EventTypes == nil {
+			sub.EventTypes = []string{}
+		}
+	}
+	if input.AggregateType != nil {
+		sub.AggregateType = optionalString(*input.AggregateType)
+	}
+	if input.AggregateID != nil {
+		sub.AggregateID = optionalString(*input.AggregateID)
+	}
+	if input.Description != nil {
+		sub.Description = *input.Description
+	}
+	if input.Active != nil {
+		sub.Active = *input.Active
+	}
+	sub.UpdatedAt = uc.now()
+
+	if err := sub.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := uc.webhookRepo.UpdateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	return sub, nil
+}
+
+// DeleteSubscription removes a subscription together with its deliveries.
+func (uc *WebhookUseCase) DeleteSubscription(ctx context.Context, id string) error {
+	return uc.webhookRepo.DeleteSubscription(ctx, id)
+}
+
+// ListDeliveries lists a subscription's deliveries, newest first.
+func (uc *WebhookUseCase) ListDeliveries(ctx context.Context, subscriptionID string, status domain.WebhookDeliveryStatus, limit, offset int) ([]*domain.WebhookDelivery, error) {
+	limit, offset, err := domain.ValidatePagination(limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.webhookRepo.GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	return uc.webhookRepo.ListDeliveries(ctx, subscriptionID, status, limit, offset)
+}
+
+// GetDelivery retrieves one of a subscription's deliveries together with
+// its attempt log.
+func (uc *WebhookUseCase) GetDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, []*domain.WebhookDeliveryAttempt, error) {
+	delivery, err := uc.getDelivery(ctx, subscriptionID, deliveryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attempts, err := uc.webhookRepo.ListAttempts(ctx, deliveryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return delivery, attempts, nil
+}
+
+// Redeliver queues a delivery for immediate sending with a fresh attempt
+// budget, whatever its status: dead-lettered deliveries are revived and
+// delivered ones are sent again. The attempt log is kept.
+func (uc *WebhookUseCase) Redeliver(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	delivery, err := uc.getDelivery(ctx, subscriptionID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := uc.now()
+	delivery.Status = domain.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.DeliveredAt = nil
+	delivery.DeadLetteredAt = nil
+	delivery.UpdatedAt = now
+
+	if err := uc.webhookRepo.UpdateDelivery(ctx, delivery, nil); err != nil {
+		return nil, err
+	}
+
+	return delivery, nil
+}
+
+func (uc *WebhookUseCase) getDelivery(ctx context.Context, subscriptionID, deliveryID string) (*domain.WebhookDelivery, error) {
+	delivery, err := uc.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if delivery.SubscriptionID != subscriptionID {
+		return nil, domain.ErrWebhookDeliveryNotFound
+	}
+
+	return delivery, nil
+}
+
+// EnqueueDeliveries queues the event for every active subscription it
+// matches. The body is the event in CloudEvents structured JSON. It is idempotent per event, so the outbox publisher may call it
+// again when it retries the event.
+func (uc *WebhookUseCase) EnqueueDeliveries(ctx context.Context, event *domain.OutboxEvent) error {
+	subs, err := uc.webhookRepo.ListActiveSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body []byte
+
+	for _, sub := range subs {
+		if !sub.Matches(event) {
+			continue
+		}
+
+		if body == nil {
+			body, err = json.Marshal(domain.NewCloudEvent(event, uc.cloudEvents))
+			if err != nil {
+				return err
+			}
+		}
+
+		now := uc.now()
+		if err := uc.webhookRepo.CreateDelivery(ctx, &domain.WebhookDelivery{
+			ID:             uc.idGen.Generate(),
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			EventType:      event.EventType,
+			Body:           body,
+			Status:         domain.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}); err != nil {
+			return fmt.Errorf("failed to queue webhook delivery for subscription %s: %w", sub.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// WebhookDispatchReport summarizes one DeliverDue pass.
+type WebhookDispatchReport struct {
+	Delivered    int
+	Failed       int
+	DeadLettered int
+}
+
+// DeliverDue claims up to limit due deliveries and attempts each once.
+// Failures are rescheduled with exponential backoff, or dead-lettered once
+// they exhaust the policy's MaxAttempts.
+func (uc *WebhookUseCase) DeliverDue(ctx context.Context, limit int) (*WebhookDispatchReport, error) {
+	if uc.sender == nil {
+		return nil, ErrWebhookSenderNotConfigured
+	}
+
+	now := uc.now()
+
+	deliveries, err := uc.webhookRepo.ClaimDue(ctx, now, now.Add(uc.policy.Lease), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &WebhookDispatchReport{}
+	subs := make(map[string]*domain.WebhookSubscription)
+
+	for _, delivery := range deliveries {
+		sub, ok := subs[delivery.SubscriptionID]
+		if !ok {
+			sub, err = uc.webhookRepo.GetSubscription(ctx, delivery.SubscriptionID)
+			if errors.Is(err, domain.ErrWebhookSubscriptionNotFound) {
+				// Deleted since the claim; its deliveries went with it.
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			subs[delivery.SubscriptionID] = sub
+		}
+
+		if err := uc.attempt(ctx, sub, delivery, report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func (uc *WebhookUseCase) attempt(ctx context.Context, sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery, report *WebhookDispatchReport) error {
+	started := uc.now()
+	statusCode, sendErr := uc.sender.Send(ctx, sub, delivery)
+	finished := uc.now()
+
+	delivery.Attempts++
+	delivery.UpdatedAt = finished
+
+	attempt := &domain.WebhookDeliveryAttempt{
+		DeliveryID:  delivery.ID,
+		Attempt:     delivery.Attempts,
+		AttemptedAt: started,
+		Duration:    finished.Sub(started),
+	}
+	if statusCode != 0 {
+		attempt.StatusCode = &statusCode
+	}
+
+	if sendErr == nil && (statusCode < 200 || statusCode > 299) {
+		sendErr = fmt.Errorf("unexpected status code %d", statusCode)
+	}
+
+	delivery.LastStatusCode = attempt.StatusCode
+
+	switch {
+	case sendErr == nil:
+		delivery.Status = domain.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &finished
+		delivery.LastError = ""
+		report.Delivered++
+	case delivery.Attempts >= uc.policy.MaxAttempts:
+		attempt.Error = sendErr.Error()
+		delivery.Status = domain.WebhookDeliveryDeadLettered
+		delivery.DeadLetteredAt = &finished
+		delivery.LastError = attempt.Error
+		report.DeadLettered++
+	default:
+		attempt.Error = sendErr.Error()
+		delivery.NextAttemptAt = finished.Add(uc.policy.Backoff(delivery.Attempts))
+		delivery.LastError = attempt.Error
+		report.Failed++
+	}
+
+	return uc.webhookRepo.UpdateDelivery(ctx, delivery, attempt)
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,259 @@
+package usecase_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/internal/usecase/mocks"
+)
+
+type stubWebhookSender struct {
+	status int
+	err    error
+	sent   []string
+}
+
+func (s *stubWebhookSender) Send(_ context.Context, _ *domain.WebhookSubscription, d *domain.WebhookDelivery) (int, error) {
+	s.sent = append(s.sent, d.ID)
+	return s.status, s.err
+}
+
+var webhookTestNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newWebhookUseCase(repo usecase.WebhookRepository, idGen usecase.IDGenerator, sender usecase.WebhookSender) *usecase.WebhookUseCase {
+	return usecase.NewWebhookUseCase(repo, idGen).
+		WithSender(sender, usecase.WebhookRetryPolicy{MaxAttempts: 3, BackoffBase: time.Minute, BackoffMax: 3 * time.Minute, Lease: time.Minute}).
+		WithClock(func() time.Time { return webhookTestNow })
+}
+
+func TestWebhookUseCase_CreateSubscription_GeneratesSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockWebhookRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	idGen.EXPECT().Generate().Return("wh-1")
+	repo.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).Return(nil)
+
+	sub, err := usecase.NewWebhookUseCase(repo, idGen).CreateSubscription(context.Background(), usecase.CreateWebhookSubscriptionInput{
+		URL:           "https://example.com/hooks",
+		EventTypes:    []string{"transfer.*"},
+		AggregateType: domain.AggregateTypeTransfer,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sub.ID != "wh-1" || !sub.Active || !strings.HasPrefix(sub.Secret, "whsec_") || sub.AggregateType == nil {
+		t.Fatalf("unexpected subscription: %+v", sub)
+	}
+}
+
+func TestWebhookUseCase_CreateSubscription_Invalid(t *testing.T) {
+	tests := map[string]usecase.CreateWebhookSubscriptionInput{
+		"relative url":            {URL: "/hooks"},
+		"unsupported scheme":      {URL: "ftp://example.com"},
+		"aggregate id, no type":   {URL: "https://example.com", AggregateID: "acc-1"},
+		"blank event type filter": {URL: "https://example.com", EventTypes: []string{" "}},
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			idGen := mocks.NewMockIDGenerator(ctrl)
+			idGen.EXPECT().Generate().Return("wh-1")
+
+			uc := usecase.NewWebhookUseCase(mocks.NewMockWebhookRepository(ctrl), idGen)
+
+			if _, err := uc.CreateSubscription(context.Background(), input); !errors.Is(err, domain.ErrInvalidWebhookSubscription) {
+				t.Fatalf("expected ErrInvalidWebhookSubscription, got %v", err)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_EnqueueDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockWebhookRepository(ctrl)
+	idGen := mocks.NewMockIDGenerator(ctrl)
+
+	account := domain.AggregateTypeAccount
+	repo.EXPECT().ListActiveSubscriptions(gomock.Any()).Return([]*domain.WebhookSubscription{
+		{ID: "wh-all", Active: true},
+		{ID: "wh-transfers", Active: true, EventTypes: []string{"transfer.*"}},
+		{ID: "wh-accounts", Active: true, AggregateType: &account},
+	}, nil)
+
+	idGen.EXPECT().Generate().Return("del-1")
+	idGen.EXPECT().Generate().Return("del-2")
+
+	var queued []*domain.WebhookDelivery
+	repo.EXPECT().CreateDelivery(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, d *domain.WebhookDelivery) error {
+		queued = append(queued, d)
+		return nil
+	}).Times(2)
+
+	event := &domain.OutboxEvent{
+		ID:            "evt-1",
+		AggregateType: domain.AggregateTypeTransfer,
+		AggregateID:   "tr-1",
+		EventType:     domain.EventTypeTransferCreated,
+		EventVersion:  1,
+		Payload:       map[string]any{"amount": "10.00"},
+	}
+
+	if err := newWebhookUseCase(repo, idGen, nil).EnqueueDeliveries(context.Background(), event); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if queued[0].SubscriptionID != "wh-all" || queued[1].SubscriptionID != "wh-transfers" {
+		t.Fatalf("unexpected subscriptions: %s, %s", queued[0].SubscriptionID, queued[1].SubscriptionID)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(queued[0].Body, &body); err != nil {
+		t.Fatalf("invalid body: %v", err)
+	}
+
+	if body["specversion"] != "1.0" || body["id"] != "evt-1" || body["type"] != "transfer.created" || body["subject"] != "tr-1" ||
+		body["data"].(map[string]any)["amount"] != "10.00" {
+		t.Fatalf("unexpected body: %s", queued[0].Body)
+	}
+
+	if !queued[0].NextAttemptAt.Equal(webhookTestNow) || queued[0].Status != domain.WebhookDeliveryPending {
+		t.Fatalf("unexpected delivery: %+v", queued[0])
+	}
+}
+
+func TestWebhookUseCase_DeliverDue(t *testing.T) {
+	tests := []struct {
+		name          string
+		attempts      int
+		status        int
+		sendErr       error
+		wantStatus    domain.WebhookDeliveryStatus
+		wantNext      time.Time
+		wantAttemptOK bool
+	}{
+		{name: "delivered", status: 204, wantStatus: domain.WebhookDeliveryDelivered, wantAttemptOK: true},
+		{name: "server error backs off", attempts: 1, status: 500, wantStatus: domain.WebhookDeliveryPending, wantNext: webhookTestNow.Add(2 * time.Minute)},
+		{name: "network error", attempts: 0, sendErr: errors.New("connection refused"), wantStatus: domain.WebhookDeliveryPending, wantNext: webhookTestNow.Add(time.Minute)},
+		{name: "dead-lettered", attempts: 2, status: 410, wantStatus: domain.WebhookDeliveryDeadLettered},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mocks.NewMockWebhookRepository(ctrl)
+			sender := &stubWebhookSender{status: tt.status, err: tt.sendErr}
+
+			delivery := &domain.WebhookDelivery{ID: "del-1", SubscriptionID: "wh-1", Attempts: tt.attempts, Status: domain.WebhookDeliveryPending}
+			repo.EXPECT().ClaimDue(gomock.Any(), webhookTestNow, webhookTestNow.Add(time.Minute), 10).Return([]*domain.WebhookDelivery{delivery}, nil)
+			repo.EXPECT().GetSubscription(gomock.Any(), "wh-1").Return(&domain.WebhookSubscription{ID: "wh-1", Active: true}, nil)
+			repo.EXPECT().UpdateDelivery(gomock.Any(), delivery, gomock.Any()).DoAndReturn(
+				func(_ context.Context, d *domain.WebhookDelivery, a *domain.WebhookDeliveryAttempt) error {
+					if d.Status != tt.wantStatus || d.Attempts != tt.attempts+1 || a.Attempt != tt.attempts+1 {
+						t.Fatalf("unexpected delivery %+v / attempt %+v", d, a)
+					}
+					if tt.wantAttemptOK != (a.Error == "") {
+						t.Fatalf("unexpected attempt error %q", a.Error)
+					}
+					if !tt.wantNext.IsZero() && !d.NextAttemptAt.Equal(tt.wantNext) {
+						t.Fatalf("expected next attempt at %s, got %s", tt.wantNext, d.NextAttemptAt)
+					}
+					if tt.wantStatus == domain.WebhookDeliveryDeadLettered && d.DeadLetteredAt == nil {
+						t.Fatal("expected dead_lettered_at to be set")
+					}
+					return nil
+				})
+
+			report, err := newWebhookUseCase(repo, mocks.NewMockIDGenerator(ctrl), sender).DeliverDue(context.Background(), 10)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if report.Delivered+report.Failed+report.DeadLettered != 1 {
+				t.Fatalf("unexpected report: %+v", report)
+			}
+		})
+	}
+}
+
+func TestWebhookRetryPolicy_Backoff(t *testing.T) {
+	policy := usecase.WebhookRetryPolicy{BackoffBase: 30 * time.Second, BackoffMax: 5 * time.Minute}
+
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, w := range want {
+		if got := policy.Backoff(i + 1); got != w {
+			t.Errorf("Backoff(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+
+	if got := policy.Backoff(1000); got != 5*time.Minute {
+		t.Errorf("Backoff(1000) = %s, want the cap", got)
+	}
+}
+
+func TestWebhookUseCase_DeliverDue_RequiresSender(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := usecase.NewWebhookUseCase(mocks.NewMockWebhookRepository(ctrl), mocks.NewMockIDGenerator(ctrl))
+
+	if _, err := uc.DeliverDue(context.Background(), 10); !errors.Is(err, usecase.ErrWebhookSenderNotConfigured) {
+		t.Fatalf("expected ErrWebhookSenderNotConfigured, got %v", err)
+	}
+}
+
+func TestWebhookUseCase_Redeliver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockWebhookRepository(ctrl)
+	deadAt := webhookTestNow.Add(-time.Hour)
+
+	repo.EXPECT().GetDelivery(gomock.Any(), "del-1").Return(&domain.WebhookDelivery{
+		ID: "del-1", SubscriptionID: "wh-1", Status: domain.WebhookDeliveryDeadLettered, Attempts: 3, DeadLetteredAt: &deadAt,
+	}, nil)
+	repo.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any(), nil).Return(nil)
+
+	uc := newWebhookUseCase(repo, mocks.NewMockIDGenerator(ctrl), nil)
+
+	delivery, err := uc.Redeliver(context.Background(), "wh-1", "del-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if delivery.Status != domain.WebhookDeliveryPending || delivery.Attempts != 0 || delivery.DeadLetteredAt != nil || !delivery.NextAttemptAt.Equal(webhookTestNow) {
+		t.Fatalf("unexpected delivery: %+v", delivery)
+	}
+}
+
+func TestWebhookUseCase_Redeliver_OtherSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mocks.NewMockWebhookRepository(ctrl)
+	repo.EXPECT().GetDelivery(gomock.Any(), "del-1").Return(&domain.WebhookDelivery{ID: "del-1", SubscriptionID: "wh-2"}, nil)
+
+	uc := newWebhookUseCase(repo, mocks.NewMockIDGenerator(ctrl), nil)
+
+	if _, err := uc.Redeliver(context.Background(), "wh-1", "del-1"); !errors.Is(err, domain.ErrWebhookDeliveryNotFound) {
+		t.Fatalf("expected ErrWebhookDeliveryNotFound, got %v", err)
+	}
+}
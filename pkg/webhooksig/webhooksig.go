@@ -0,0 +1,110 @@
+// Package webhooksig signs and verifies goledger webhook deliveries.
+//
+// Every delivery is a POST whose body is signed with the subscription's
+// secret:
+//
+//	X-Goledger-Signature: v1=hex(HMAC-SHA256(secret, timestamp + "." + body))
+//
+// where timestamp is the X-Goledger-Timestamp header (Unix seconds). The
+// signature header may carry several comma-separated v1= values; a request
+// is authentic if any of them matches. Consumers should verify against the
+// raw body before decoding it and reject stale timestamps to defeat
+// replays:
+//
+//	body, _ := io.ReadAll(r.Body)
+//	if err := webhooksig.Verify(secret, r.Header, body, webhooksig.DefaultTolerance); err != nil {
+//		http.Error(w, "invalid signature", http.StatusUnauthorized)
+//		return
+//	}
+package webhooksig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers set on every webhook delivery.
+const (
+	HeaderSignature  = "X-Goledger-Signature"
+	HeaderTimestamp  = "X-Goledger-Timestamp"
+	HeaderDeliveryID = "X-Goledger-Delivery-Id"
+	HeaderEventID    = "X-Goledger-Event-Id"
+	HeaderEventType  = "X-Goledger-Event-Type"
+)
+
+// DefaultTolerance is how far a delivery's timestamp may be from the
+// receiver's clock before Verify rejects it.
+const DefaultTolerance = 5 * time.Minute
+
+const signatureVersion = "v1="
+
+var (
+	ErrMissingSignature = errors.New("webhooksig: missing signature or timestamp header")
+	ErrInvalidTimestamp = errors.New("webhooksig: invalid timestamp")
+	ErrTimestampExpired = errors.New("webhooksig: timestamp outside tolerance")
+	ErrInvalidSignature = errors.New("webhooksig: signature mismatch")
+)
+
+// Sign returns the X-Goledger-Signature value for body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	return signatureVersion + hex.EncodeToString(mac(secret, strconv.FormatInt(timestamp.Unix(), 10), body))
+}
+
+// Verify checks the signature headers of a delivery against its raw body.
+// A non-positive tolerance disables the timestamp check.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	return VerifyAt(secret, header.Get(HeaderTimestamp), header.Get(HeaderSignature), body, tolerance, time.Now())
+}
+
+// VerifyAt is Verify with explicit header values and clock.
+func VerifyAt(secret, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	if timestamp == "" || signature == "" {
+		return ErrMissingSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+
+	if tolerance > 0 {
+		if skew := now.Sub(time.Unix(unix, 0)); skew > tolerance || skew < -tolerance {
+			return ErrTimestampExpired
+		}
+	}
+
+	expected := mac(secret, timestamp, body)
+
+	for _, candidate := range strings.Split(signature, ",") {
+		sig, ok := strings.CutPrefix(strings.TrimSpace(candidate), signatureVersion)
+		if !ok {
+			continue
+		}
+
+		got, err := hex.DecodeString(sig)
+		if err != nil {
+			continue
+		}
+
+		if hmac.Equal(got, expected) {
+			return nil
+		}
+	}
+
+	return ErrInvalidSignature
+}
+
+func mac(secret, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,59 @@
+package webhooksig
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"evt-1"}`)
+	sig := Sign("secret", now, body)
+	ts := strconv.FormatInt(now.Unix(), 10)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp string
+		signature string
+		body      []byte
+		now       time.Time
+		wantErr   error
+	}{
+		{name: "valid", secret: "secret", timestamp: ts, signature: sig, body: body, now: now},
+		{name: "one of several", secret: "secret", timestamp: ts, signature: "v1=00ff, " + sig, body: body, now: now},
+		{name: "wrong secret", secret: "other", timestamp: ts, signature: sig, body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "tampered body", secret: "secret", timestamp: ts, signature: sig, body: []byte(`{"id":"evt-2"}`), now: now, wantErr: ErrInvalidSignature},
+		{name: "tampered timestamp", secret: "secret", timestamp: strconv.FormatInt(now.Unix()+1, 10), signature: sig, body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "unknown version", secret: "secret", timestamp: ts, signature: "v0=" + sig[len("v1="):], body: body, now: now, wantErr: ErrInvalidSignature},
+		{name: "stale", secret: "secret", timestamp: ts, signature: sig, body: body, now: now.Add(DefaultTolerance + time.Second), wantErr: ErrTimestampExpired},
+		{name: "future", secret: "secret", timestamp: ts, signature: sig, body: body, now: now.Add(-DefaultTolerance - time.Second), wantErr: ErrTimestampExpired},
+		{name: "missing", secret: "secret", signature: sig, body: body, now: now, wantErr: ErrMissingSignature},
+		{name: "bad timestamp", secret: "secret", timestamp: "yesterday", signature: sig, body: body, now: now, wantErr: ErrInvalidTimestamp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyAt(tt.secret, tt.timestamp, tt.signature, tt.body, DefaultTolerance, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyAt() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerify_Headers(t *testing.T) {
+	now := time.Now()
+	body := []byte(`{}`)
+
+	header := http.Header{}
+	header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	header.Set(HeaderSignature, Sign("secret", now, body))
+
+	if err := Verify("secret", header, body, DefaultTolerance); err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/adapter/repository/postgres"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/tests/testutil"
+)
+
+func TestEntryExport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	testDB := testutil.NewTestDB(t)
+	defer testDB.Cleanup()
+
+	testDB.TruncateAll(ctx)
+
+	pool := testDB.Pool
+	accountRepo := postgres.NewAccountRepository(pool)
+	transferRepo := postgres.NewTransferRepository(pool)
+	entryRepo := postgres.NewEntryRepository(pool)
+	txManager := postgres.NewTxManager(pool)
+	idGen := postgres.NewULIDGenerator()
+
+	entryUC := usecase.NewEntryUseCase(entryRepo)
+	transferUC := usecase.NewTransferUseCase(txManager, accountRepo, transferRepo, entryRepo, postgres.NewNullOutboxRepository(), nil, idGen, nil)
+
+	source := testDB.CreateTestAccountWithBalance(ctx, "source", "USD", decimal.NewFromInt(100000), false, true)
+	dest := testDB.CreateTestAccount(ctx, "dest", "USD", false, true)
+
+	// More than one cursor batch, so the export has to FETCH repeatedly.
+	total := domain.EntryExportBatchSize + 5
+	for range total {
+		if _, err := transferUC.CreateTransfer(ctx, usecase.CreateTransferInput{
+			FromAccountID: source.ID,
+			ToAccountID:   dest.ID,
+			Amount:        decimal.NewFromInt(1),
+		}); err != nil {
+			t.Fatalf("failed to create transfer: %v", err)
+		}
+	}
+
+	t.Run("all entries in chain order", func(t *testing.T) {
+		var (
+			count   int
+			version int64
+		)
+		err := entryUC.ExportEntriesByAccount(ctx, domain.EntryExportFilter{AccountID: dest.ID}, func(e *domain.Entry) error {
+			if e.AccountVersion <= version {
+				t.Fatalf("entry %s out of order: version %d after %d", e.ID, e.AccountVersion, version)
+			}
+			version = e.AccountVersion
+			count++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed to export entries: %v", err)
+		}
+
+		if count != total {
+			t.Fatalf("expected %d entries, got %d", total, count)
+		}
+	})
+
+	t.Run("time range", func(t *testing.T) {
+		future := time.Now().Add(time.Hour)
+		count := 0
+		err := entryUC.ExportEntriesByAccount(ctx, domain.EntryExportFilter{AccountID: dest.ID, From: &future}, func(*domain.Entry) error {
+			count++
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("failed to export entries: %v", err)
+		}
+
+		if count != 0 {
+			t.Fatalf("expected no entries after %s, got %d", future, count)
+		}
+	})
+
+	t.Run("emit error stops the export", func(t *testing.T) {
+		stop := errors.New("stop")
+		count := 0
+		err := entryUC.ExportEntriesByAccount(ctx, domain.EntryExportFilter{AccountID: dest.ID}, func(*domain.Entry) error {
+			count++
+			return stop
+		})
+		if !errors.Is(err, stop) || count != 1 {
+			t.Fatalf("expected export to stop after one entry, got %d entries and %v", count, err)
+		}
+	})
+
+	t.Run("unknown account", func(t *testing.T) {
+		err := entryUC.ExportEntriesByAccount(ctx, domain.EntryExportFilter{AccountID: idGen.Generate()}, func(*domain.Entry) error {
+			return nil
+		})
+		if !errors.Is(err, domain.ErrAccountNotFound) {
+			t.Fatalf("expected ErrAccountNotFound, got %v", err)
+		}
+	})
+}
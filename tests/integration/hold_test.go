@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 
@@ -184,4 +185,83 @@ func TestHoldLifecycle(t *testing.T) {
 			t.Fatalf("failed to void hold B: %v", err)
 		}
 	})
+
+	t.Run("list holds by account filters by status and expiry and pages by cursor", func(t *testing.T) {
+		testDB.TruncateAll(ctx)
+		acc := testDB.CreateTestAccountWithBalance(ctx, "acc", "USD", decimal.NewFromInt(100), false, true)
+
+		first, err := holdUC.HoldFunds(ctx, acc.ID, decimal.NewFromInt(10))
+		if err != nil {
+			t.Fatalf("failed to create hold: %v", err)
+		}
+		second, err := holdUC.HoldFunds(ctx, acc.ID, decimal.NewFromInt(20))
+		if err != nil {
+			t.Fatalf("failed to create hold: %v", err)
+		}
+		if err := holdUC.VoidHold(ctx, first.ID); err != nil {
+			t.Fatalf("failed to void hold: %v", err)
+		}
+
+		// HoldFunds sets no expiry, so insert an expiring hold directly.
+		now := time.Now().UTC()
+		expiresAt := now.Add(time.Hour)
+		expiring := &domain.Hold{
+			ID:        idGen.Generate(),
+			AccountID: acc.ID,
+			Amount:    decimal.NewFromInt(5),
+			Status:    domain.HoldStatusActive,
+			ExpiresAt: &expiresAt,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		tx, err := txManager.Begin(ctx)
+		if err != nil {
+			t.Fatalf("failed to begin tx: %v", err)
+		}
+		if err := holdRepo.Create(ctx, tx, expiring); err != nil {
+			t.Fatalf("failed to insert hold: %v", err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			t.Fatalf("failed to commit: %v", err)
+		}
+
+		page, err := holdUC.ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{AccountID: acc.ID, Limit: 2})
+		if err != nil {
+			t.Fatalf("failed to list holds: %v", err)
+		}
+		if len(page.Holds) != 2 || page.Holds[0].ID != expiring.ID || page.Holds[1].ID != second.ID || page.NextCursor != second.ID {
+			t.Fatalf("unexpected first page: %+v", page)
+		}
+
+		page, err = holdUC.ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{AccountID: acc.ID, Cursor: page.NextCursor, Limit: 2})
+		if err != nil {
+			t.Fatalf("failed to list holds: %v", err)
+		}
+		if len(page.Holds) != 1 || page.Holds[0].ID != first.ID || page.NextCursor != "" {
+			t.Fatalf("unexpected second page: %+v", page)
+		}
+
+		active, err := holdUC.ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{
+			AccountID: acc.ID,
+			Filter:    domain.HoldFilter{Status: domain.HoldStatusActive},
+		})
+		if err != nil {
+			t.Fatalf("failed to list holds: %v", err)
+		}
+		if len(active.Holds) != 2 {
+			t.Fatalf("expected 2 active holds, got %d", len(active.Holds))
+		}
+
+		before := now.Add(2 * time.Hour)
+		expiringSoon, err := holdUC.ListHoldsByAccountCursor(ctx, usecase.ListHoldsByAccountCursorInput{
+			AccountID: acc.ID,
+			Filter:    domain.HoldFilter{ExpiresBefore: &before},
+		})
+		if err != nil {
+			t.Fatalf("failed to list holds: %v", err)
+		}
+		if len(expiringSoon.Holds) != 1 || expiringSoon.Holds[0].ID != expiring.ID {
+			t.Fatalf("expected only the expiring hold, got %+v", expiringSoon.Holds)
+		}
+	})
 }
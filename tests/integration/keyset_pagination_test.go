@@ -0,0 +1,136 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/adapter/repository/postgres"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/tests/testutil"
+)
+
+func TestKeysetPagination(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	testDB := testutil.NewTestDB(t)
+	defer testDB.Cleanup()
+
+	testDB.TruncateAll(ctx)
+
+	pool := testDB.Pool
+	accountRepo := postgres.NewAccountRepository(pool)
+	transferRepo := postgres.NewTransferRepository(pool)
+	entryRepo := postgres.NewEntryRepository(pool)
+	auditRepo := postgres.NewAuditRepository(pool)
+	txManager := postgres.NewTxManager(pool)
+	idGen := postgres.NewULIDGenerator()
+
+	accountUC := usecase.NewAccountUseCase(txManager, accountRepo, auditRepo, idGen, nil)
+	entryUC := usecase.NewEntryUseCase(entryRepo)
+	transferUC := usecase.NewTransferUseCase(txManager, accountRepo, transferRepo, entryRepo, postgres.NewNullOutboxRepository(), nil, idGen, nil)
+
+	source := testDB.CreateTestAccountWithBalance(ctx, "source", "USD", decimal.NewFromInt(1000), false, true)
+	dest := testDB.CreateTestAccount(ctx, "dest", "USD", false, true)
+
+	for range 5 {
+		if _, err := transferUC.CreateTransfer(ctx, usecase.CreateTransferInput{
+			FromAccountID: source.ID,
+			ToAccountID:   dest.ID,
+			Amount:        decimal.NewFromInt(10),
+		}); err != nil {
+			t.Fatalf("failed to create transfer: %v", err)
+		}
+	}
+
+	t.Run("entries", func(t *testing.T) {
+		var (
+			seen   = map[string]bool{}
+			cursor string
+			pages  int
+		)
+
+		for {
+			result, err := entryUC.GetEntriesByAccountCursor(ctx, usecase.GetEntriesByAccountCursorInput{AccountID: dest.ID, Cursor: cursor, Limit: 2})
+			if err != nil {
+				t.Fatalf("failed to list entries: %v", err)
+			}
+			pages++
+			for _, entry := range result.Entries {
+				if seen[entry.ID] {
+					t.Fatalf("entry %s returned twice", entry.ID)
+				}
+				seen[entry.ID] = true
+			}
+			if result.NextCursor == "" {
+				break
+			}
+			cursor = result.NextCursor
+		}
+
+		if len(seen) != 5 || pages != 3 {
+			t.Fatalf("expected 5 entries over 3 pages, got %d over %d", len(seen), pages)
+		}
+	})
+
+	t.Run("accounts", func(t *testing.T) {
+		first, err := accountUC.ListAccountsCursor(ctx, usecase.ListAccountsCursorInput{Limit: 1})
+		if err != nil {
+			t.Fatalf("failed to list accounts: %v", err)
+		}
+		if len(first.Accounts) != 1 || first.NextCursor == "" {
+			t.Fatalf("unexpected first page: %+v", first)
+		}
+
+		second, err := accountUC.ListAccountsCursor(ctx, usecase.ListAccountsCursorInput{Cursor: first.NextCursor, Limit: 1})
+		if err != nil {
+			t.Fatalf("failed to list accounts: %v", err)
+		}
+		if len(second.Accounts) != 1 || second.Accounts[0].ID == first.Accounts[0].ID {
+			t.Fatalf("unexpected second page: %+v", second)
+		}
+	})
+
+	t.Run("audit logs", func(t *testing.T) {
+		// Audit logs aren't truncated between tests, so attribute these to
+		// a fresh user and list only theirs.
+		user := &domain.User{ID: idGen.Generate()}
+		userCtx := context.WithValue(ctx, domain.UserContextKey, user)
+		for range 3 {
+			if _, err := accountUC.CreateAccount(userCtx, usecase.CreateAccountInput{Name: "audited", Currency: "USD"}); err != nil {
+				t.Fatalf("failed to create account: %v", err)
+			}
+		}
+
+		filter := domain.AuditFilter{UserID: user.ID, Limit: 2}
+		var seen []string
+		for {
+			logs, err := auditRepo.List(ctx, filter)
+			if err != nil {
+				t.Fatalf("failed to list audit logs: %v", err)
+			}
+			for _, log := range logs {
+				seen = append(seen, log.ID)
+			}
+
+			next := domain.NextAuditCursor(logs, filter.Limit)
+			if next == "" {
+				break
+			}
+			after, err := domain.DecodeTimeCursor(next)
+			if err != nil {
+				t.Fatalf("failed to decode cursor: %v", err)
+			}
+			filter.After = &after
+		}
+
+		if len(seen) != 3 || seen[0] == seen[1] || seen[1] == seen[2] {
+			t.Fatalf("expected 3 distinct audit logs, got %v", seen)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iho/goledger/internal/adapter/repository/postgres"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/tests/testutil"
+)
+
+func TestEnsureOutboxPartitionMovesDefaultPartitionEvents(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	testDB := testutil.NewTestDB(t)
+	defer testDB.Cleanup()
+
+	pool := testDB.Pool
+	retentionRepo := postgres.NewOutboxRetentionRepository(pool)
+
+	// No partition exists this far ahead, so the event lands in the default
+	// partition, as events do when the retention job misses a month.
+	createdAt := time.Date(2099, time.March, 14, 12, 0, 0, 0, time.UTC)
+	p := domain.OutboxPartitionFor(createdAt)
+	defer func() { _, _ = pool.Exec(ctx, "DROP TABLE IF EXISTS "+p.Name) }()
+
+	_, err := pool.Exec(ctx, `INSERT INTO outbox_events (id, aggregate_id, aggregate_type, event_type, payload, created_at)
+		VALUES ('evt-default-2099', 'acc-1', 'account', 'account.created', '{}', $1)`, createdAt)
+	if err != nil {
+		t.Fatalf("failed to insert event: %v", err)
+	}
+
+	before, err := retentionRepo.CountDefaultPartition(ctx)
+	if err != nil {
+		t.Fatalf("failed to count default partition: %v", err)
+	}
+
+	if err := retentionRepo.EnsurePartition(ctx, p); err != nil {
+		t.Fatalf("failed to ensure partition: %v", err)
+	}
+
+	after, err := retentionRepo.CountDefaultPartition(ctx)
+	if err != nil {
+		t.Fatalf("failed to count default partition: %v", err)
+	}
+	if after != before-1 {
+		t.Errorf("expected the event to leave the default partition, count went from %d to %d", before, after)
+	}
+
+	var partition string
+	err = pool.QueryRow(ctx, "SELECT tableoid::regclass::text FROM outbox_events WHERE id = 'evt-default-2099'").Scan(&partition)
+	if err != nil {
+		t.Fatalf("failed to find event: %v", err)
+	}
+	if partition != p.Name {
+		t.Errorf("expected the event in %s, got %s", p.Name, partition)
+	}
+
+	// Ensuring an existing partition stays a no-op.
+	if err := retentionRepo.EnsurePartition(ctx, p); err != nil {
+		t.Fatalf("failed to re-ensure partition: %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/iho/goledger/internal/adapter/repository/postgres"
+	"github.com/iho/goledger/internal/domain"
+	"github.com/iho/goledger/internal/usecase"
+	"github.com/iho/goledger/tests/testutil"
+)
+
+func TestTransferSearch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	testDB := testutil.NewTestDB(t)
+	defer testDB.Cleanup()
+
+	testDB.TruncateAll(ctx)
+
+	pool := testDB.Pool
+	accountRepo := postgres.NewAccountRepository(pool)
+	transferRepo := postgres.NewTransferRepository(pool)
+	entryRepo := postgres.NewEntryRepository(pool)
+	txManager := postgres.NewTxManager(pool)
+	idGen := postgres.NewULIDGenerator()
+
+	outboxRepo := postgres.NewNullOutboxRepository()
+	transferUC := usecase.NewTransferUseCase(txManager, accountRepo, transferRepo, entryRepo, outboxRepo, nil, idGen, nil)
+
+	eurSource := testDB.CreateTestAccountWithBalance(ctx, "eur-source", "EUR", decimal.NewFromInt(100000), false, true)
+	eurDest := testDB.CreateTestAccount(ctx, "eur-dest", "EUR", false, true)
+	usdSource := testDB.CreateTestAccountWithBalance(ctx, "usd-source", "USD", decimal.NewFromInt(100000), false, true)
+	usdDest := testDB.CreateTestAccount(ctx, "usd-dest", "USD", false, true)
+
+	create := func(from, to *domain.Account, amount int64, metadata map[string]any) *domain.Transfer {
+		t.Helper()
+
+		transfer, err := transferUC.CreateTransfer(ctx, usecase.CreateTransferInput{
+			FromAccountID: from.ID,
+			ToAccountID:   to.ID,
+			Amount:        decimal.NewFromInt(amount),
+			Metadata:      metadata,
+		})
+		if err != nil {
+			t.Fatalf("failed to create transfer: %v", err)
+		}
+
+		return transfer
+	}
+
+	small := create(eurSource, eurDest, 500, map[string]any{"order_id": "A"})
+	large := create(eurSource, eurDest, 15000, map[string]any{"order_id": "X", "channel": "web"})
+	larger := create(eurSource, eurDest, 20000, map[string]any{"order_id": "Y"})
+	usd := create(usdSource, usdDest, 12000, map[string]any{"order_id": "X"})
+
+	reversal, err := transferUC.ReverseTransfer(ctx, usecase.ReverseTransferInput{TransferID: larger.ID})
+	if err != nil {
+		t.Fatalf("failed to reverse transfer: %v", err)
+	}
+
+	search := func(input usecase.SearchTransfersInput) []string {
+		t.Helper()
+
+		result, err := transferUC.SearchTransfers(ctx, input)
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+
+		ids := make([]string, len(result.Transfers))
+		for i, transfer := range result.Transfers {
+			ids[i] = transfer.ID
+		}
+
+		return ids
+	}
+
+	assertIDs := func(t *testing.T, got []string, want ...string) {
+		t.Helper()
+
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	}
+
+	t.Run("amount, currency and metadata", func(t *testing.T) {
+		minAmount := decimal.NewFromInt(10000)
+		got := search(usecase.SearchTransfersInput{Filter: domain.TransferSearchFilter{
+			MinAmount: &minAmount,
+			Currency:  "EUR",
+			Metadata:  map[string]any{"order_id": "X"},
+		}})
+
+		assertIDs(t, got, large.ID)
+	})
+
+	t.Run("direction", func(t *testing.T) {
+		got := search(usecase.SearchTransfersInput{Filter: domain.TransferSearchFilter{
+			AccountID: eurSource.ID,
+			Direction: domain.TransferDirectionIncoming,
+		}})
+
+		assertIDs(t, got, reversal.ID)
+	})
+
+	t.Run("reversal status", func(t *testing.T) {
+		assertIDs(t, search(usecase.SearchTransfersInput{Filter: domain.TransferSearchFilter{ReversalStatus: domain.TransferReversalStatusReversed}}), larger.ID)
+		assertIDs(t, search(usecase.SearchTransfersInput{Filter: domain.TransferSearchFilter{ReversalStatus: domain.TransferReversalStatusReversal}}), reversal.ID)
+		assertIDs(t, search(usecase.SearchTransfersInput{Filter: domain.TransferSearchFilter{ReversalStatus: domain.TransferReversalStatusNotReversed}}), usd.ID, large.ID, small.ID)
+	})
+
+	t.Run("pages by amount", func(t *testing.T) {
+		sort := domain.TransferSort{Field: domain.TransferSortAmount}
+		var (
+			got    []string
+			cursor string
+		)
+
+		for {
+			result, err := transferUC.SearchTransfers(ctx, usecase.SearchTransfersInput{Sort: sort, Cursor: cursor, Limit: 2})
+			if err != nil {
+				t.Fatalf("search failed: %v", err)
+			}
+			for _, transfer := range result.Transfers {
+				got = append(got, transfer.ID)
+			}
+			if result.NextCursor == "" {
+				break
+			}
+			cursor = result.NextCursor
+		}
+
+		// The reversal and the transfer it reverses tie on amount; the
+		// higher ID comes first.
+		assertIDs(t, got, reversal.ID, larger.ID, large.ID, usd.ID, small.ID)
+	})
+}